@@ -1,49 +1,191 @@
 package config
 
 import (
-	"os"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/labstack/gommon/bytes"
 	"github.com/shopspring/decimal"
 )
 
+// Config はアプリケーション全体の設定です。
+// 各フィールドの config タグは設定ファイルのキーで、大文字にしたものが環境変数名になります。
+// secret を指定したフィールドは `config print --redacted` で値を伏せます。
 type Config struct {
-	DBHost     string
-	DBPort     string
-	DBUser     string
-	DBPassword string
-	DBName     string
-	JWTSecret  string
-	FeeRate    decimal.Decimal
-	TaxRate    decimal.Decimal
+	DBHost     string          `config:"db_host"`
+	DBPort     string          `config:"db_port"`
+	DBUser     string          `config:"db_user"`
+	DBPassword string          `config:"db_password,secret"`
+	DBName     string          `config:"db_name"`
+	JWTSecret  string          `config:"jwt_secret,secret"`
+	FeeRate    decimal.Decimal `config:"fee_rate"`
+	TaxRate    decimal.Decimal `config:"tax_rate"`
+	LogLevel   string          `config:"log_level"`
+
+	TraceExporter    string  `config:"trace_exporter"`
+	TraceSampleRatio float64 `config:"trace_sample_ratio"`
+
+	ServerAddr              string        `config:"server_addr"`
+	TLSCertFile             string        `config:"tls_cert_file"`
+	TLSKeyFile              string        `config:"tls_key_file"`
+	ServerReadTimeout       time.Duration `config:"server_read_timeout"`
+	ServerReadHeaderTimeout time.Duration `config:"server_read_header_timeout"`
+	ServerWriteTimeout      time.Duration `config:"server_write_timeout"`
+	ServerIdleTimeout       time.Duration `config:"server_idle_timeout"`
+	ShutdownTimeout         time.Duration `config:"shutdown_timeout"`
+	BodyLimit               string        `config:"body_limit"`
+
+	// EncryptionKeys は `<キーID>:<base64の32バイト鍵>` をカンマで区切ったマスターキーの一覧です
+	EncryptionKeys        string `config:"encryption_keys,secret"`
+	EncryptionActiveKeyID string `config:"encryption_active_key_id"`
+	// BlindIndexKey はブラインドインデックス用の base64 の鍵（32バイト以上）です
+	BlindIndexKey string `config:"blind_index_key,secret"`
+
+	// RetentionInvoiceYears は削除した請求書を発行日から保存する年数です
+	RetentionInvoiceYears int `config:"retention_invoice_years"`
+	// RetentionInvoiceExtendedYears は保存期間の区分が extended の請求書を発行日から保存する年数です
+	RetentionInvoiceExtendedYears int `config:"retention_invoice_extended_years"`
+	// RetentionClientYears は削除した取引先と銀行口座を削除日から保存する年数です
+	RetentionClientYears int `config:"retention_client_years"`
+	// RestoreGracePeriod は削除したデータを管理者が復元できる期間です
+	RestoreGracePeriod time.Duration `config:"restore_grace_period"`
+	// PurgeInterval は保存期間を過ぎたデータを削除・匿名化する間隔です（0 の場合は実行しない）
+	PurgeInterval time.Duration `config:"purge_interval"`
+
+	// RecurringInvoiceInterval は発行日が来た定期請求の請求書を作成する間隔です（0 の場合は実行しない）
+	RecurringInvoiceInterval time.Duration `config:"recurring_invoice_interval"`
+
+	// DuplicateInvoiceWindowDays は重複の疑いを確認する発行日の前後の日数です
+	DuplicateInvoiceWindowDays int `config:"duplicate_invoice_window_days"`
+	// DuplicateInvoiceAmountTolerance は重複の疑いとする支払金額の差の割合です（0 の場合は同額だけ）
+	DuplicateInvoiceAmountTolerance decimal.Decimal `config:"duplicate_invoice_amount_tolerance"`
+
+	// HolidayFile は祝日を追加・削除する YAML ファイルのパスです（空の場合は計算した祝日だけを使う）
+	HolidayFile string `config:"holiday_file"`
+
+	// AttachmentStorage は請求書の添付ファイルの保存先です（local または s3）
+	AttachmentStorage string `config:"attachment_storage"`
+	// AttachmentDir は保存先が local の場合に添付ファイルを保存するディレクトリです
+	AttachmentDir string `config:"attachment_dir"`
+	// AttachmentMaxSize は添付ファイル1つのサイズの上限です（10M のように指定する）
+	AttachmentMaxSize string `config:"attachment_max_size"`
+	// S3Endpoint は S3 互換ストレージのエンドポイントです（空の場合は AWS の S3 を使う）。指定した場合はパス形式でアクセスします
+	S3Endpoint        string `config:"s3_endpoint"`
+	S3Region          string `config:"s3_region"`
+	S3Bucket          string `config:"s3_bucket"`
+	S3AccessKeyID     string `config:"s3_access_key_id"`
+	S3SecretAccessKey string `config:"s3_secret_access_key,secret"`
 }
 
-func Load() *Config {
+// Default は設定ファイルや環境変数で上書きする前の既定値を返します
+func Default() *Config {
 	return &Config{
-		DBHost:     getEnv("DB_HOST", "localhost"),
-		DBPort:     getEnv("DB_PORT", "3306"),
-		DBUser:     getEnv("DB_USER", "root"),
-		DBPassword: getEnv("DB_PASSWORD", ""),
-		DBName:     getEnv("DB_NAME", "practice"),
-		JWTSecret:  getEnv("JWT_SECRET", "your-secret-key"),
-		FeeRate:    getDecimalEnv("FEE_RATE", "0.04"),
-		TaxRate:    getDecimalEnv("TAX_RATE", "0.10"),
+		DBHost:   "localhost",
+		DBPort:   "3306",
+		DBUser:   "root",
+		DBName:   "practice",
+		FeeRate:  decimal.RequireFromString("0.04"),
+		TaxRate:  decimal.RequireFromString("0.10"),
+		LogLevel: "info",
+
+		TraceExporter:    "none",
+		TraceSampleRatio: 1.0,
+
+		ServerAddr:              ":8080",
+		ServerReadTimeout:       15 * time.Second,
+		ServerReadHeaderTimeout: 5 * time.Second,
+		ServerWriteTimeout:      30 * time.Second,
+		ServerIdleTimeout:       60 * time.Second,
+		ShutdownTimeout:         30 * time.Second,
+		BodyLimit:               "1M",
+
+		RetentionInvoiceYears:         MinRetentionInvoiceYears,
+		RetentionInvoiceExtendedYears: 10,
+		RetentionClientYears:          7,
+		RestoreGracePeriod:            30 * 24 * time.Hour,
+		PurgeInterval:                 24 * time.Hour,
+
+		RecurringInvoiceInterval: time.Hour,
+
+		DuplicateInvoiceWindowDays:      3,
+		DuplicateInvoiceAmountTolerance: decimal.RequireFromString("0"),
+
+		AttachmentStorage: "local",
+		AttachmentDir:     "data/attachments",
+		AttachmentMaxSize: "10M",
+		S3Region:          "ap-northeast-1",
 	}
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// Load は既定値、設定ファイル（path が空の場合は読み込まない）、環境変数の順に重ねた設定を検証して返します。
+// 不正な値はすべてまとめて1つのエラーとして返します
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	var errs []error
+	if path != "" {
+		if err := cfg.applyFile(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := cfg.applyEnv(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to load config:\n%w", errors.Join(errs...))
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	return cfg, nil
+}
+
+// MasterKeys は EncryptionKeys をキーIDと鍵の対応に変換します
+func (c *Config) MasterKeys() (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for _, entry := range strings.Split(c.EncryptionKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, errors.New("key entry must be in <id>:<base64> form")
+		}
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("duplicate key id %q", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64", id)
+		}
+		keys[id] = key
+	}
+
+	return keys, nil
+}
+
+// AttachmentMaxSizeBytes は AttachmentMaxSize をバイト数に変換します。Validate で検証していない不正な値の場合は 0 です
+func (c *Config) AttachmentMaxSizeBytes() int64 {
+	size, err := bytes.Parse(c.AttachmentMaxSize)
+	if err != nil {
+		return 0
 	}
 
-	return defaultValue
+	return size
 }
 
-func getDecimalEnv(key, defaultValue string) decimal.Decimal {
-	value := getEnv(key, defaultValue)
-	dec, err := decimal.NewFromString(value)
+// BlindIndexKeyBytes は BlindIndexKey を base64 デコードした鍵を返します
+func (c *Config) BlindIndexKeyBytes() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(c.BlindIndexKey)
 	if err != nil {
-		dec, _ = decimal.NewFromString(defaultValue)
+		return nil, errors.New("blind index key is not valid base64")
 	}
 
-	return dec
+	return key, nil
 }
@@ -0,0 +1,273 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testJWTSecret      = "0123456789abcdef0123456789abcdef"
+	testEncryptionKeys = "k1:AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=,k2:AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI="
+	testBlindIndexKey  = "//////////////////////////////////////////8="
+)
+
+// clearEnv はテスト中に設定の環境変数が読み込まれないよう空にします
+func clearEnv(t *testing.T) {
+	for _, f := range Default().fields() {
+		t.Setenv(f.envName(), "")
+	}
+}
+
+// setEncryptionEnv は検証を通る暗号鍵を環境変数に設定します
+func setEncryptionEnv(t *testing.T) {
+	t.Setenv("ENCRYPTION_KEYS", testEncryptionKeys)
+	t.Setenv("ENCRYPTION_ACTIVE_KEY_ID", "k2")
+	t.Setenv("BLIND_INDEX_KEY", testBlindIndexKey)
+}
+
+// validConfig は既定値に検証を通る署名鍵と暗号鍵を設定した設定を返します
+func validConfig() *Config {
+	cfg := Default()
+	cfg.JWTSecret = testJWTSecret
+	cfg.EncryptionKeys = testEncryptionKeys
+	cfg.EncryptionActiveKeyID = "k2"
+	cfg.BlindIndexKey = testBlindIndexKey
+
+	return cfg
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("設定ファイルの作成に失敗しました: %v", err)
+	}
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("既定値と環境変数を重ねる", func(t *testing.T) {
+		clearEnv(t)
+		setEncryptionEnv(t)
+		t.Setenv("JWT_SECRET", testJWTSecret)
+		t.Setenv("FEE_RATE", "0.05")
+		t.Setenv("SHUTDOWN_TIMEOUT", "10s")
+		t.Setenv("RETENTION_INVOICE_YEARS", "10")
+
+		cfg, err := Load("")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "localhost", cfg.DBHost)
+		assert.Equal(t, testJWTSecret, cfg.JWTSecret)
+		assert.True(t, decimal.RequireFromString("0.05").Equal(cfg.FeeRate))
+		assert.True(t, decimal.RequireFromString("0.10").Equal(cfg.TaxRate))
+		assert.Equal(t, 10*time.Second, cfg.ShutdownTimeout)
+		assert.Equal(t, "k2", cfg.EncryptionActiveKeyID)
+		assert.Equal(t, 10, cfg.RetentionInvoiceYears)
+	})
+
+	t.Run("YAMLファイルの値を環境変数で上書きする", func(t *testing.T) {
+		clearEnv(t)
+		path := writeFile(t, "config.yaml", `
+db:
+  host: db.internal
+  port: 3307
+jwt_secret: `+testJWTSecret+`
+fee_rate: 0.035
+trace_sample_ratio: 0.25
+server_read_timeout: 3s
+`)
+		t.Setenv("DB_HOST", "db.override")
+		setEncryptionEnv(t)
+
+		cfg, err := Load(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "db.override", cfg.DBHost)
+		assert.Equal(t, "3307", cfg.DBPort)
+		assert.True(t, decimal.RequireFromString("0.035").Equal(cfg.FeeRate))
+		assert.Equal(t, 0.25, cfg.TraceSampleRatio)
+		assert.Equal(t, 3*time.Second, cfg.ServerReadTimeout)
+	})
+
+	t.Run("TOMLファイルを読み込む", func(t *testing.T) {
+		clearEnv(t)
+		path := writeFile(t, "config.toml", `
+jwt_secret = "`+testJWTSecret+`"
+log_level = "debug"
+
+[db]
+name = "invoices"
+`)
+		setEncryptionEnv(t)
+
+		cfg, err := Load(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "invoices", cfg.DBName)
+		assert.Equal(t, "debug", cfg.LogLevel)
+	})
+
+	t.Run("不正な値をまとめてエラーにする", func(t *testing.T) {
+		clearEnv(t)
+		path := writeFile(t, "config.yaml", `
+jwt_secret: `+testJWTSecret+`
+fee_rate: 0.o4
+db_hots: db.internal
+`)
+		t.Setenv("SHUTDOWN_TIMEOUT", "30")
+		t.Setenv("RETENTION_CLIENT_YEARS", "7y")
+
+		_, err := Load(path)
+
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, `fee_rate (`+path+`): invalid decimal "0.o4"`)
+		assert.ErrorContains(t, err, `db_hots (`+path+`): unknown key`)
+		assert.ErrorContains(t, err, `SHUTDOWN_TIMEOUT: invalid duration "30"`)
+		assert.ErrorContains(t, err, `RETENTION_CLIENT_YEARS: invalid integer "7y"`)
+	})
+
+	t.Run("検証エラー", func(t *testing.T) {
+		clearEnv(t)
+		t.Setenv("JWT_SECRET", "short")
+
+		_, err := Load("")
+
+		assert.ErrorContains(t, err, "jwt_secret: must be at least 32 bytes")
+	})
+
+	t.Run("対応していない拡張子はエラー", func(t *testing.T) {
+		clearEnv(t)
+		path := writeFile(t, "config.json", `{}`)
+
+		_, err := Load(path)
+
+		assert.ErrorContains(t, err, `unsupported config file extension ".json"`)
+	})
+
+	t.Run("存在しないファイルはエラー", func(t *testing.T) {
+		clearEnv(t)
+
+		_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+
+		assert.ErrorContains(t, err, "failed to read config file")
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("既定値と署名鍵・暗号鍵で有効", func(t *testing.T) {
+		assert.NoError(t, validConfig().Validate())
+	})
+
+	t.Run("すべての問題を報告する", func(t *testing.T) {
+		cfg := Default()
+		cfg.DBHost = ""
+		cfg.DBPort = "99999"
+		cfg.JWTSecret = "your-secret-key"
+		cfg.FeeRate = decimal.RequireFromString("1.5")
+		cfg.TaxRate = decimal.RequireFromString("-0.1")
+		cfg.LogLevel = "verbose"
+		cfg.TraceExporter = "zipkin"
+		cfg.TraceSampleRatio = 2
+		cfg.ServerAddr = "8080"
+		cfg.TLSCertFile = "cert.pem"
+		cfg.ServerWriteTimeout = -time.Second
+		cfg.ShutdownTimeout = 0
+		cfg.BodyLimit = "lots"
+		cfg.EncryptionKeys = "k1:AQID"
+		cfg.EncryptionActiveKeyID = "k2"
+		cfg.BlindIndexKey = "not base64"
+		cfg.RetentionInvoiceYears = 5
+		cfg.RetentionInvoiceExtendedYears = 4
+		cfg.RetentionClientYears = -1
+		cfg.RestoreGracePeriod = 0
+		cfg.PurgeInterval = -time.Hour
+		cfg.RecurringInvoiceInterval = -time.Hour
+		cfg.DuplicateInvoiceWindowDays = 91
+		cfg.DuplicateInvoiceAmountTolerance = decimal.RequireFromString("-0.01")
+		cfg.AttachmentMaxSize = "0"
+
+		err := cfg.Validate()
+
+		for _, key := range []string{
+			"db_host", "db_port", "jwt_secret", "fee_rate", "tax_rate", "log_level", "trace_exporter",
+			"trace_sample_ratio", "server_addr", "tls_cert_file", "server_write_timeout", "shutdown_timeout", "body_limit",
+			"encryption_keys", "encryption_active_key_id", "blind_index_key",
+			"retention_invoice_years", "retention_invoice_extended_years", "retention_client_years", "restore_grace_period", "purge_interval",
+			"recurring_invoice_interval", "duplicate_invoice_window_days", "duplicate_invoice_amount_tolerance",
+			"attachment_max_size",
+		} {
+			assert.ErrorContains(t, err, key+":")
+		}
+	})
+
+	t.Run("添付ファイルの保存先ごとに必要な設定を確認する", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.AttachmentStorage = "s3"
+		cfg.S3Bucket = "invoices"
+
+		err := cfg.Validate()
+
+		assert.ErrorContains(t, err, "s3_access_key_id:")
+		assert.ErrorContains(t, err, "s3_secret_access_key:")
+		assert.NotContains(t, err.Error(), "s3_bucket:")
+
+		cfg.AttachmentStorage = "gcs"
+		assert.ErrorContains(t, cfg.Validate(), "attachment_storage:")
+
+		cfg.AttachmentStorage = "local"
+		cfg.AttachmentDir = ""
+		assert.ErrorContains(t, cfg.Validate(), "attachment_dir:")
+	})
+}
+
+func TestConfig_WriteYAML(t *testing.T) {
+	t.Run("秘匿項目を伏せて出力する", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DBPassword = "db-password"
+
+		var buf bytes.Buffer
+		err := cfg.WriteYAML(&buf, true)
+
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "jwt_secret: '[REDACTED]'\n")
+		assert.Contains(t, buf.String(), "db_password: '[REDACTED]'\n")
+		assert.Contains(t, buf.String(), "encryption_keys: '[REDACTED]'\n")
+		assert.Contains(t, buf.String(), "blind_index_key: '[REDACTED]'\n")
+		assert.Contains(t, buf.String(), "encryption_active_key_id: k2\n")
+		assert.Contains(t, buf.String(), "db_host: localhost\n")
+		assert.Contains(t, buf.String(), "fee_rate: \"0.04\"\n")
+		assert.Contains(t, buf.String(), "shutdown_timeout: 30s\n")
+		assert.Contains(t, buf.String(), "retention_invoice_years: 7\n")
+		assert.NotContains(t, buf.String(), testJWTSecret)
+		assert.NotContains(t, buf.String(), "db-password")
+		assert.NotContains(t, buf.String(), testBlindIndexKey)
+	})
+
+	t.Run("出力した設定ファイルを読み込むと同じ設定になる", func(t *testing.T) {
+		clearEnv(t)
+		cfg := validConfig()
+		cfg.DBPort = "3307"
+		cfg.TraceSampleRatio = 0.5
+		cfg.RetentionInvoiceYears = 10
+
+		var buf bytes.Buffer
+		assert.NoError(t, cfg.WriteYAML(&buf, false))
+		path := writeFile(t, "config.yaml", buf.String())
+
+		loaded, err := Load(path)
+
+		assert.NoError(t, err)
+		// 小数は値として比較する（0.10 は 0.1 として出力される）
+		assert.True(t, cfg.FeeRate.Equal(loaded.FeeRate))
+		assert.True(t, cfg.TaxRate.Equal(loaded.TaxRate))
+		loaded.FeeRate, loaded.TaxRate = cfg.FeeRate, cfg.TaxRate
+		assert.Equal(t, cfg, loaded)
+	})
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"io"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+// RedactedValue は秘匿項目を伏せて出力するときの値です
+const RedactedValue = "[REDACTED]"
+
+// WriteYAML は設定ファイルとしてそのまま読み込める YAML 形式で設定を出力します。
+// redacted が true の場合、秘匿項目の値は RedactedValue に置き換えます
+func (c *Config) WriteYAML(w io.Writer, redacted bool) error {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	for _, f := range c.fields() {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Value: f.String()}
+		switch f.value.Interface().(type) {
+		case string, decimal.Decimal:
+			// "1M" のような値や小数を YAML の型に解釈されないよう文字列として出力する
+			value.Tag = "!!str"
+		}
+		if redacted && f.secret && value.Value != "" {
+			value.Value = RedactedValue
+		}
+		doc.Content = append(doc.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: f.key}, value)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
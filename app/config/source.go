@@ -0,0 +1,174 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+// field は Config の1項目と、その値を文字列で読み書きするための情報です
+type field struct {
+	key    string
+	secret bool
+	value  reflect.Value
+}
+
+func (c *Config) fields() []field {
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+
+	fields := make([]field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup("config")
+		if !ok {
+			continue
+		}
+		key, option, _ := strings.Cut(tag, ",")
+		fields = append(fields, field{
+			key:    key,
+			secret: option == "secret",
+			value:  v.Field(i),
+		})
+	}
+
+	return fields
+}
+
+func (f field) envName() string {
+	return strings.ToUpper(f.key)
+}
+
+func (f field) set(s string) error {
+	switch f.value.Interface().(type) {
+	case string:
+		f.value.SetString(s)
+	case int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		f.value.SetInt(int64(n))
+	case float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		f.value.SetFloat(n)
+	case time.Duration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", s)
+		}
+		f.value.Set(reflect.ValueOf(d))
+	case decimal.Decimal:
+		d, err := decimal.NewFromString(s)
+		if err != nil {
+			return fmt.Errorf("invalid decimal %q", s)
+		}
+		f.value.Set(reflect.ValueOf(d))
+	default:
+		return fmt.Errorf("unsupported type %s", f.value.Type())
+	}
+
+	return nil
+}
+
+func (f field) String() string {
+	switch v := f.value.Interface().(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// applyEnv は空でない環境変数の値で設定を上書きします
+func (c *Config) applyEnv() error {
+	var errs []error
+	for _, f := range c.fields() {
+		value := os.Getenv(f.envName())
+		if value == "" {
+			continue
+		}
+		if err := f.set(value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.envName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// applyFile は YAML または TOML の設定ファイルの値で設定を上書きします。
+// ネストしたキーは `_` で連結して扱うため、`db: {host: x}` と `db_host: x` は同じ意味になります
+func (c *Config) applyFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	raw := map[string]any{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return fmt.Errorf("unsupported config file extension %q", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := map[string]string{}
+	flatten("", raw, values)
+
+	var errs []error
+	for _, f := range c.fields() {
+		value, ok := values[f.key]
+		if !ok {
+			continue
+		}
+		delete(values, f.key)
+		if err := f.set(value); err != nil {
+			errs = append(errs, fmt.Errorf("%s (%s): %w", f.key, path, err))
+		}
+	}
+	// 綴り間違いに気付けるよう、知らないキーはエラーにする
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		errs = append(errs, fmt.Errorf("%s (%s): unknown key", key, path))
+	}
+
+	return errors.Join(errs...)
+}
+
+func flatten(prefix string, raw map[string]any, values map[string]string) {
+	for key, value := range raw {
+		key = strings.ToLower(key)
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			flatten(key, v, values)
+		case float64:
+			values[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/labstack/gommon/bytes"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MinJWTSecretLength は JWT の署名鍵に求める最小のバイト数です（HS256 の鍵長）
+	MinJWTSecretLength = 32
+	// EncryptionKeyLength はマスターキーのバイト数です（AES-256）
+	EncryptionKeyLength = 32
+	// MinBlindIndexKeyLength はブラインドインデックスの鍵に求める最小のバイト数です
+	MinBlindIndexKeyLength = 32
+	// MinRetentionInvoiceYears は請求書の法定の保存年数です
+	MinRetentionInvoiceYears = 7
+	// MaxDuplicateInvoiceWindowDays は重複の疑いを確認する発行日の前後の日数の上限です
+	MaxDuplicateInvoiceWindowDays = 90
+)
+
+var (
+	logLevels       = []string{"debug", "info", "warn", "error"}
+	traceExporters  = []string{"none", "stdout", "otlp"}
+	storageBackends = []string{"local", "s3"}
+)
+
+// Validate は設定値を検証し、問題のある項目をすべてまとめたエラーを返します
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(key, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	// データベース
+	if c.DBHost == "" {
+		add("db_host", "must not be empty")
+	}
+	if port, err := strconv.Atoi(c.DBPort); err != nil || port < 1 || port > 65535 {
+		add("db_port", "must be a port number between 1 and 65535, got %q", c.DBPort)
+	}
+	if c.DBUser == "" {
+		add("db_user", "must not be empty")
+	}
+	if c.DBName == "" {
+		add("db_name", "must not be empty")
+	}
+
+	// 認証
+	if len(c.JWTSecret) < MinJWTSecretLength {
+		add("jwt_secret", "must be at least %d bytes, got %d", MinJWTSecretLength, len(c.JWTSecret))
+	}
+
+	// 料率
+	if c.FeeRate.IsNegative() || c.FeeRate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		add("fee_rate", "must be in [0, 1), got %s", c.FeeRate)
+	}
+	if c.TaxRate.IsNegative() || c.TaxRate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		add("tax_rate", "must be in [0, 1), got %s", c.TaxRate)
+	}
+
+	// ログ・トレース
+	if !slices.Contains(logLevels, c.LogLevel) {
+		add("log_level", "must be one of %v, got %q", logLevels, c.LogLevel)
+	}
+	if !slices.Contains(traceExporters, c.TraceExporter) {
+		add("trace_exporter", "must be one of %v, got %q", traceExporters, c.TraceExporter)
+	}
+	if c.TraceSampleRatio < 0 || c.TraceSampleRatio > 1 {
+		add("trace_sample_ratio", "must be in [0, 1], got %v", c.TraceSampleRatio)
+	}
+
+	// HTTPサーバー
+	if _, _, err := net.SplitHostPort(c.ServerAddr); err != nil {
+		add("server_addr", "must be in host:port form, got %q", c.ServerAddr)
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		add("tls_cert_file", "tls_cert_file and tls_key_file must be set together")
+	}
+	for _, timeout := range []struct {
+		key   string
+		value time.Duration
+	}{
+		{"server_read_timeout", c.ServerReadTimeout},
+		{"server_read_header_timeout", c.ServerReadHeaderTimeout},
+		{"server_write_timeout", c.ServerWriteTimeout},
+		{"server_idle_timeout", c.ServerIdleTimeout},
+	} {
+		if timeout.value < 0 {
+			add(timeout.key, "must not be negative")
+		}
+	}
+	if c.ShutdownTimeout <= 0 {
+		add("shutdown_timeout", "must be positive")
+	}
+	if limit, err := bytes.Parse(c.BodyLimit); err != nil || limit <= 0 {
+		add("body_limit", "must be a positive size such as 1M, got %q", c.BodyLimit)
+	}
+
+	// 暗号化
+	masterKeys, err := c.MasterKeys()
+	switch {
+	case err != nil:
+		add("encryption_keys", "%v", err)
+	case len(masterKeys) == 0:
+		add("encryption_keys", "must not be empty")
+	default:
+		for _, id := range slices.Sorted(maps.Keys(masterKeys)) {
+			if len(masterKeys[id]) != EncryptionKeyLength {
+				add("encryption_keys", "key %q must be %d bytes, got %d", id, EncryptionKeyLength, len(masterKeys[id]))
+			}
+		}
+		if _, ok := masterKeys[c.EncryptionActiveKeyID]; !ok {
+			add("encryption_active_key_id", "must be one of the ids in encryption_keys, got %q", c.EncryptionActiveKeyID)
+		}
+	}
+	if key, err := c.BlindIndexKeyBytes(); err != nil {
+		add("blind_index_key", "%v", err)
+	} else if len(key) < MinBlindIndexKeyLength {
+		add("blind_index_key", "must be at least %d bytes, got %d", MinBlindIndexKeyLength, len(key))
+	}
+
+	// データ保存期間
+	if c.RetentionInvoiceYears < MinRetentionInvoiceYears {
+		add("retention_invoice_years", "must be at least %d, got %d", MinRetentionInvoiceYears, c.RetentionInvoiceYears)
+	}
+	if c.RetentionInvoiceExtendedYears < c.RetentionInvoiceYears {
+		add("retention_invoice_extended_years", "must be at least retention_invoice_years (%d), got %d", c.RetentionInvoiceYears, c.RetentionInvoiceExtendedYears)
+	}
+	if c.RetentionClientYears < 0 {
+		add("retention_client_years", "must not be negative")
+	}
+	if c.RestoreGracePeriod <= 0 {
+		add("restore_grace_period", "must be positive")
+	}
+	if c.PurgeInterval < 0 {
+		add("purge_interval", "must not be negative")
+	}
+
+	// 定期請求
+	if c.RecurringInvoiceInterval < 0 {
+		add("recurring_invoice_interval", "must not be negative")
+	}
+
+	// 重複の確認
+	if c.DuplicateInvoiceWindowDays < 0 || c.DuplicateInvoiceWindowDays > MaxDuplicateInvoiceWindowDays {
+		add("duplicate_invoice_window_days", "must be in [0, %d], got %d", MaxDuplicateInvoiceWindowDays, c.DuplicateInvoiceWindowDays)
+	}
+	if c.DuplicateInvoiceAmountTolerance.IsNegative() || c.DuplicateInvoiceAmountTolerance.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		add("duplicate_invoice_amount_tolerance", "must be in [0, 1), got %s", c.DuplicateInvoiceAmountTolerance)
+	}
+
+	// 添付ファイル
+	switch c.AttachmentStorage {
+	case "local":
+		if c.AttachmentDir == "" {
+			add("attachment_dir", "must not be empty when attachment_storage is local")
+		}
+	case "s3":
+		for _, setting := range []struct {
+			key   string
+			value string
+		}{
+			{"s3_region", c.S3Region},
+			{"s3_bucket", c.S3Bucket},
+			{"s3_access_key_id", c.S3AccessKeyID},
+			{"s3_secret_access_key", c.S3SecretAccessKey},
+		} {
+			if setting.value == "" {
+				add(setting.key, "must not be empty when attachment_storage is s3")
+			}
+		}
+	default:
+		add("attachment_storage", "must be one of %v, got %q", storageBackends, c.AttachmentStorage)
+	}
+	if size, err := bytes.Parse(c.AttachmentMaxSize); err != nil || size <= 0 {
+		add("attachment_max_size", "must be a positive size such as 10M, got %q", c.AttachmentMaxSize)
+	}
+
+	return errors.Join(errs...)
+}
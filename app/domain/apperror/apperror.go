@@ -0,0 +1,173 @@
+package apperror
+
+import "errors"
+
+// Kind はエラーの分類です。HTTPステータスへの対応付けはプレゼンテーション層で行います
+type Kind string
+
+const (
+	KindBadRequest           Kind = "bad_request"
+	KindValidation           Kind = "validation"
+	KindUnauthorized         Kind = "unauthorized"
+	KindForbidden            Kind = "forbidden"
+	KindNotFound             Kind = "not_found"
+	KindConflict             Kind = "conflict"
+	KindPreconditionFailed   Kind = "precondition_failed"
+	KindPreconditionRequired Kind = "precondition_required"
+	KindPayloadTooLarge      Kind = "payload_too_large"
+	KindUnsupportedMediaType Kind = "unsupported_media_type"
+	KindUnavailable          Kind = "unavailable"
+	KindInternal             Kind = "internal"
+)
+
+// Code はクライアントが判定に利用する安定したエラーコードです
+type Code string
+
+const (
+	CodeBadRequest                 Code = "BAD_REQUEST"
+	CodeValidationFailed           Code = "VALIDATION_FAILED"
+	CodeInvalidRequestBody         Code = "INVALID_REQUEST_BODY"
+	CodeInvalidCredentials         Code = "INVALID_CREDENTIALS"
+	CodeUnauthorized               Code = "UNAUTHORIZED"
+	CodeMissingAuthorization       Code = "MISSING_AUTHORIZATION"
+	CodeInvalidAuthorizationFormat Code = "INVALID_AUTHORIZATION_FORMAT"
+	CodeInvalidToken               Code = "INVALID_TOKEN"
+	CodeForbidden                  Code = "FORBIDDEN"
+	CodeNotFound                   Code = "NOT_FOUND"
+	CodeConflict                   Code = "CONFLICT"
+	CodeRestoreWindowExpired       Code = "RESTORE_WINDOW_EXPIRED"
+	CodeSelfApprovalForbidden      Code = "SELF_APPROVAL_FORBIDDEN"
+	CodeNotPendingApproval         Code = "NOT_PENDING_APPROVAL"
+	CodeInvoiceNotPayable          Code = "INVOICE_NOT_PAYABLE"
+	CodeStatementLineNotPending    Code = "STATEMENT_LINE_NOT_PENDING"
+	CodeDuplicateInvoiceSuspected  Code = "DUPLICATE_INVOICE_SUSPECTED"
+	CodePreconditionFailed         Code = "PRECONDITION_FAILED"
+	CodePreconditionRequired       Code = "PRECONDITION_REQUIRED"
+	CodeMethodNotAllowed           Code = "METHOD_NOT_ALLOWED"
+	CodePayloadTooLarge            Code = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMediaType       Code = "UNSUPPORTED_MEDIA_TYPE"
+	CodeTooManyRequests            Code = "TOO_MANY_REQUESTS"
+	CodeServiceUnavailable         Code = "SERVICE_UNAVAILABLE"
+	CodeInternal                   Code = "INTERNAL_ERROR"
+)
+
+// FieldCode はフィールド単位のエラー種別です
+type FieldCode string
+
+const (
+	FieldCodeRequired      FieldCode = "required"
+	FieldCodeEmail         FieldCode = "email"
+	FieldCodeMin           FieldCode = "min"
+	FieldCodeMax           FieldCode = "max"
+	FieldCodeInvalidFormat FieldCode = "invalid_format"
+	FieldCodeInvalidValue  FieldCode = "invalid_value"
+	FieldCodeExclusive     FieldCode = "exclusive"
+	FieldCodeDuplicate     FieldCode = "duplicate"
+)
+
+// FieldError は入力フィールド単位のエラーです
+type FieldError struct {
+	Field string
+	Code  FieldCode
+	Param string
+}
+
+// Error はアプリケーション全体で扱う型付きエラーです
+type Error struct {
+	Kind    Kind
+	Code    Code
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap は原因となったエラーを保持したコピーを返します
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+
+	return &wrapped
+}
+
+func New(kind Kind, code Code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func NewBadRequest(code Code, message string) *Error {
+	return New(KindBadRequest, code, message)
+}
+
+func NewUnauthorized(code Code, message string) *Error {
+	return New(KindUnauthorized, code, message)
+}
+
+func NewForbidden(code Code, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+func NewNotFound(code Code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+func NewConflict(code Code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+func NewPreconditionFailed(code Code, message string) *Error {
+	return New(KindPreconditionFailed, code, message)
+}
+
+func NewPreconditionRequired(code Code, message string) *Error {
+	return New(KindPreconditionRequired, code, message)
+}
+
+func NewPayloadTooLarge(code Code, message string) *Error {
+	return New(KindPayloadTooLarge, code, message)
+}
+
+func NewUnsupportedMediaType(code Code, message string) *Error {
+	return New(KindUnsupportedMediaType, code, message)
+}
+
+func NewUnavailable(code Code, message string) *Error {
+	return New(KindUnavailable, code, message)
+}
+
+// NewValidation はフィールド単位のエラーを持つバリデーションエラーを生成します
+func NewValidation(fields ...FieldError) *Error {
+	return &Error{
+		Kind:    KindValidation,
+		Code:    CodeValidationFailed,
+		Message: "validation failed",
+		Fields:  fields,
+	}
+}
+
+// As は err から *Error を取り出します
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+
+	return nil, false
+}
+
+// IsKind は err が指定した分類の *Error かを判定します
+func IsKind(err error, kind Kind) bool {
+	appErr, ok := As(err)
+
+	return ok && appErr.Kind == kind
+}
@@ -0,0 +1,38 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError(t *testing.T) {
+	t.Run("原因エラーを保持してラップ", func(t *testing.T) {
+		cause := errors.New("record not found")
+		err := NewNotFound(CodeNotFound, "invoice not found").Wrap(cause)
+
+		assert.Equal(t, "invoice not found: record not found", err.Error())
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("fmt.Errorfでラップされても分類を判定できる", func(t *testing.T) {
+		err := fmt.Errorf("usecase: %w", NewConflict(CodeConflict, "conflict"))
+
+		appErr, ok := As(err)
+		assert.True(t, ok)
+		assert.Equal(t, CodeConflict, appErr.Code)
+		assert.True(t, IsKind(err, KindConflict))
+		assert.False(t, IsKind(err, KindNotFound))
+		assert.False(t, IsKind(errors.New("plain"), KindConflict))
+	})
+
+	t.Run("バリデーションエラー", func(t *testing.T) {
+		err := NewValidation(FieldError{Field: "client_id", Code: FieldCodeRequired})
+
+		assert.Equal(t, KindValidation, err.Kind)
+		assert.Equal(t, CodeValidationFailed, err.Code)
+		assert.Len(t, err.Fields, 1)
+	})
+}
@@ -0,0 +1,118 @@
+// Package calendar は支払期日の調整に使う営業日カレンダーを提供します。
+// 国民の祝日は法律の規定から計算し、上書きファイルで追加・削除できます
+package calendar
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// 年末年始の銀行休業日の理由
+const reasonYearEnd = "年末年始"
+
+// Calendar は日本の祝日と銀行休業日を判定する営業日カレンダーです
+type Calendar struct {
+	overrides *Overrides
+
+	mu    sync.Mutex
+	years map[int]map[date]string
+}
+
+// New は祝日の上書き（nil の場合は上書きしない）を反映したカレンダーを作成します
+func New(overrides *Overrides) *Calendar {
+	if overrides == nil {
+		overrides = &Overrides{}
+	}
+
+	return &Calendar{
+		overrides: overrides,
+		years:     map[int]map[date]string{},
+	}
+}
+
+// Holiday は t の日付が祝日（振替休日と国民の休日を含む）であれば、その名称を返します
+func (c *Calendar) Holiday(t time.Time) (string, bool) {
+	d := dateOf(t)
+	name, ok := c.holidays(d.year)[d]
+
+	return name, ok
+}
+
+// ClosedReason は t の日付が銀行の休業日であれば、その理由（曜日・祝日名・年末年始）を返します
+func (c *Calendar) ClosedReason(t time.Time) (string, bool) {
+	// 土日と重なる場合は祝日名を優先する
+	if name, ok := c.Holiday(t); ok {
+		return name, true
+	}
+	if (t.Month() == time.December && t.Day() == 31) || (t.Month() == time.January && t.Day() <= 3) {
+		return reasonYearEnd, true
+	}
+	switch t.Weekday() {
+	case time.Saturday:
+		return "土曜日", true
+	case time.Sunday:
+		return "日曜日", true
+	}
+
+	return "", false
+}
+
+// IsBusinessDay は t の日付が銀行の営業日かを判定します
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	_, closed := c.ClosedReason(t)
+	return !closed
+}
+
+// PreviousBusinessDay は t 以前で最も近い営業日を返します
+func (c *Calendar) PreviousBusinessDay(t time.Time) time.Time {
+	for !c.IsBusinessDay(t) {
+		t = t.AddDate(0, 0, -1)
+	}
+
+	return t
+}
+
+// NextBusinessDay は t 以降で最も近い営業日を返します
+func (c *Calendar) NextBusinessDay(t time.Time) time.Time {
+	for !c.IsBusinessDay(t) {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t
+}
+
+// Adjust は休業日に当たる t を policy に従って営業日に移動します。
+// 移動した場合は休業日の理由と true を返します
+func (c *Calendar) Adjust(t time.Time, policy value.DueDatePolicy) (time.Time, string, bool) {
+	reason, closed := c.ClosedReason(t)
+	if !closed {
+		return t, "", false
+	}
+
+	switch policy {
+	case value.DueDatePolicyPrevious:
+		return c.PreviousBusinessDay(t), reason, true
+	case value.DueDatePolicyNext:
+		return c.NextBusinessDay(t), reason, true
+	}
+
+	return t, "", false
+}
+
+func (c *Calendar) holidays(year int) map[date]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if holidays, ok := c.years[year]; ok {
+		return holidays
+	}
+
+	holidays := nationalHolidays(year)
+	addSubstituteHolidays(holidays)
+	c.overrides.apply(year, holidays)
+	c.years[year] = holidays
+
+	return holidays
+}
@@ -0,0 +1,180 @@
+package calendar
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendar_Holiday(t *testing.T) {
+	c := New(nil)
+
+	t.Run("2025年の祝日と振替休日", func(t *testing.T) {
+		expected := map[time.Time]string{
+			day(2025, time.January, 1):    "元日",
+			day(2025, time.January, 13):   "成人の日",
+			day(2025, time.February, 11):  "建国記念の日",
+			day(2025, time.February, 23):  "天皇誕生日",
+			day(2025, time.February, 24):  "振替休日",
+			day(2025, time.March, 20):     "春分の日",
+			day(2025, time.April, 29):     "昭和の日",
+			day(2025, time.May, 3):        "憲法記念日",
+			day(2025, time.May, 4):        "みどりの日",
+			day(2025, time.May, 5):        "こどもの日",
+			day(2025, time.May, 6):        "振替休日",
+			day(2025, time.July, 21):      "海の日",
+			day(2025, time.August, 11):    "山の日",
+			day(2025, time.September, 15): "敬老の日",
+			day(2025, time.September, 23): "秋分の日",
+			day(2025, time.October, 13):   "スポーツの日",
+			day(2025, time.November, 3):   "文化の日",
+			day(2025, time.November, 23):  "勤労感謝の日",
+			day(2025, time.November, 24):  "振替休日",
+		}
+
+		for d := day(2025, time.January, 1); d.Year() == 2025; d = d.AddDate(0, 0, 1) {
+			name, ok := c.Holiday(d)
+			want, holiday := expected[d]
+			assert.Equal(t, holiday, ok, d.Format(dateLayout))
+			assert.Equal(t, want, name, d.Format(dateLayout))
+		}
+	})
+
+	t.Run("前後を祝日に挟まれた日は国民の休日", func(t *testing.T) {
+		for _, d := range []time.Time{
+			day(2019, time.April, 30),
+			day(2019, time.May, 2),
+			day(2026, time.September, 22),
+		} {
+			name, ok := c.Holiday(d)
+			assert.True(t, ok, d.Format(dateLayout))
+			assert.Equal(t, "国民の休日", name)
+		}
+	})
+
+	t.Run("改元・オリンピックの特例と天皇誕生日の変更", func(t *testing.T) {
+		name, _ := c.Holiday(day(2019, time.May, 1))
+		assert.Equal(t, "天皇の即位の日", name)
+		name, _ = c.Holiday(day(2020, time.July, 24))
+		assert.Equal(t, "スポーツの日", name)
+		name, _ = c.Holiday(day(2021, time.August, 9))
+		assert.Equal(t, "振替休日", name)
+
+		_, ok := c.Holiday(day(2018, time.December, 23))
+		assert.True(t, ok)
+		_, ok = c.Holiday(day(2019, time.December, 23))
+		assert.False(t, ok)
+		_, ok = c.Holiday(day(2019, time.February, 23))
+		assert.False(t, ok)
+	})
+
+	t.Run("春分・秋分の日を近似式で求める", func(t *testing.T) {
+		for _, d := range []time.Time{
+			day(2023, time.March, 21),
+			day(2024, time.March, 20),
+			day(2024, time.September, 22),
+			day(2030, time.March, 20),
+			day(2030, time.September, 23),
+		} {
+			_, ok := c.Holiday(d)
+			assert.True(t, ok, d.Format(dateLayout))
+		}
+	})
+}
+
+func TestCalendar_Adjust(t *testing.T) {
+	c := New(nil)
+
+	t.Run("休業日は方針に従って前後の営業日に移動する", func(t *testing.T) {
+		tests := []struct {
+			date     time.Time
+			policy   value.DueDatePolicy
+			expected time.Time
+			reason   string
+		}{
+			{day(2025, time.May, 31), value.DueDatePolicyPrevious, day(2025, time.May, 30), "土曜日"},
+			{day(2025, time.June, 1), value.DueDatePolicyNext, day(2025, time.June, 2), "日曜日"},
+			{day(2025, time.May, 3), value.DueDatePolicyNext, day(2025, time.May, 7), "憲法記念日"},
+			{day(2025, time.May, 6), value.DueDatePolicyPrevious, day(2025, time.May, 2), "振替休日"},
+			{day(2025, time.December, 31), value.DueDatePolicyPrevious, day(2025, time.December, 30), "年末年始"},
+			{day(2025, time.December, 31), value.DueDatePolicyNext, day(2026, time.January, 5), "年末年始"},
+		}
+		for _, tt := range tests {
+			adjusted, reason, ok := c.Adjust(tt.date, tt.policy)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, adjusted)
+			assert.Equal(t, tt.reason, reason)
+		}
+	})
+
+	t.Run("営業日と調整しない方針では移動しない", func(t *testing.T) {
+		adjusted, reason, ok := c.Adjust(day(2025, time.May, 30), value.DueDatePolicyNext)
+		assert.False(t, ok)
+		assert.Empty(t, reason)
+		assert.Equal(t, day(2025, time.May, 30), adjusted)
+
+		adjusted, _, ok = c.Adjust(day(2025, time.May, 31), value.DueDatePolicyNone)
+		assert.False(t, ok)
+		assert.Equal(t, day(2025, time.May, 31), adjusted)
+	})
+
+	t.Run("時刻とタイムゾーンを保ったまま移動する", func(t *testing.T) {
+		jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+		adjusted, _, ok := c.Adjust(time.Date(2025, time.May, 31, 10, 30, 0, 0, jst), value.DueDatePolicyPrevious)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2025, time.May, 30, 10, 30, 0, 0, jst), adjusted)
+	})
+}
+
+func TestLoadOverrides(t *testing.T) {
+	writeFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "holidays.yaml")
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("祝日を追加・削除する", func(t *testing.T) {
+		overrides, err := LoadOverrides(writeFile(t, `
+holidays:
+  - date: 2025-05-07
+    name: 臨時休日
+removed:
+  - 2025-05-06
+`))
+		assert.NoError(t, err)
+
+		c := New(overrides)
+		name, ok := c.Holiday(day(2025, time.May, 7))
+		assert.True(t, ok)
+		assert.Equal(t, "臨時休日", name)
+		assert.True(t, c.IsBusinessDay(day(2025, time.May, 6)))
+
+		adjusted, _, _ := c.Adjust(day(2025, time.May, 7), value.DueDatePolicyNext)
+		assert.Equal(t, day(2025, time.May, 8), adjusted)
+	})
+
+	t.Run("不正な日付や名称のない祝日はエラー", func(t *testing.T) {
+		for _, content := range []string{
+			"holidays:\n  - date: 2025/05/07\n    name: 臨時休日\n",
+			"holidays:\n  - date: 2025-05-07\n",
+			"removed:\n  - tomorrow\n",
+			"holidays:\n  - date: 2025-05-07\n    name: 臨時休日\nremoved:\n  - 2025-05-07\n",
+		} {
+			_, err := LoadOverrides(writeFile(t, content))
+			assert.Error(t, err, content)
+		}
+	})
+
+	t.Run("ファイルがない場合はエラー", func(t *testing.T) {
+		_, err := LoadOverrides(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,144 @@
+package calendar
+
+import (
+	"time"
+)
+
+// date は時刻とタイムゾーンを持たない日付です
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) date {
+	return date{year: t.Year(), month: t.Month(), day: t.Day()}
+}
+
+func (d date) time() time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+}
+
+func (d date) addDays(days int) date {
+	return dateOf(d.time().AddDate(0, 0, days))
+}
+
+func (d date) weekday() time.Weekday {
+	return d.time().Weekday()
+}
+
+// nthWeekday は year 年 month 月の n 番目の weekday の日付を返します（ハッピーマンデー制度）
+func nthWeekday(year int, month time.Month, n int, weekday time.Weekday) date {
+	first := date{year: year, month: month, day: 1}
+	offset := (int(weekday) - int(first.weekday()) + 7) % 7
+
+	return date{year: year, month: month, day: 1 + offset + (n-1)*7}
+}
+
+// vernalEquinoxDay は春分日を近似式で求めます（1980〜2099年で有効）
+func vernalEquinoxDay(year int) int {
+	return int(20.8431+0.242194*float64(year-1980)) - (year-1980)/4
+}
+
+// autumnalEquinoxDay は秋分日を近似式で求めます（1980〜2099年で有効）
+func autumnalEquinoxDay(year int) int {
+	return int(23.2488+0.242194*float64(year-1980)) - (year-1980)/4
+}
+
+// nationalHolidays は「国民の祝日に関する法律」で定める year 年の祝日を返します。
+// 振替休日と国民の休日は含みません。2007年以降の規定で計算します
+func nationalHolidays(year int) map[date]string {
+	holidays := map[date]string{}
+	add := func(month time.Month, day int, name string) {
+		holidays[date{year: year, month: month, day: day}] = name
+	}
+	addDate := func(d date, name string) {
+		holidays[d] = name
+	}
+
+	add(time.January, 1, "元日")
+	addDate(nthWeekday(year, time.January, 2, time.Monday), "成人の日")
+	add(time.February, 11, "建国記念の日")
+	switch {
+	case year >= 2020:
+		add(time.February, 23, "天皇誕生日")
+	case year <= 2018:
+		add(time.December, 23, "天皇誕生日")
+	}
+	add(time.March, vernalEquinoxDay(year), "春分の日")
+	add(time.April, 29, "昭和の日")
+	add(time.May, 3, "憲法記念日")
+	add(time.May, 4, "みどりの日")
+	add(time.May, 5, "こどもの日")
+	add(time.September, autumnalEquinoxDay(year), "秋分の日")
+	add(time.November, 3, "文化の日")
+	add(time.November, 23, "勤労感謝の日")
+	addDate(nthWeekday(year, time.September, 3, time.Monday), "敬老の日")
+
+	// 東京オリンピック・パラリンピックの開催に伴う特例
+	switch year {
+	case 2020:
+		add(time.July, 23, "海の日")
+		add(time.July, 24, "スポーツの日")
+		add(time.August, 10, "山の日")
+	case 2021:
+		add(time.July, 22, "海の日")
+		add(time.July, 23, "スポーツの日")
+		add(time.August, 8, "山の日")
+	default:
+		addDate(nthWeekday(year, time.July, 3, time.Monday), "海の日")
+		if year >= 2020 {
+			addDate(nthWeekday(year, time.October, 2, time.Monday), "スポーツの日")
+		} else {
+			addDate(nthWeekday(year, time.October, 2, time.Monday), "体育の日")
+		}
+		if year >= 2016 {
+			add(time.August, 11, "山の日")
+		}
+	}
+
+	// 天皇の即位に伴う特例
+	if year == 2019 {
+		add(time.May, 1, "天皇の即位の日")
+		add(time.October, 22, "即位礼正殿の儀の行われる日")
+	}
+
+	return holidays
+}
+
+// addSubstituteHolidays は祝日から振替休日と国民の休日を求めて holidays に加えます
+func addSubstituteHolidays(holidays map[date]string) {
+	// 国民の休日: 前日と翌日が祝日である祝日でない日
+	sandwiched := []date{}
+	for d := range holidays {
+		candidate := d.addDays(1)
+		if _, ok := holidays[candidate]; ok {
+			continue
+		}
+		if _, ok := holidays[candidate.addDays(1)]; ok && candidate.weekday() != time.Sunday {
+			sandwiched = append(sandwiched, candidate)
+		}
+	}
+	for _, d := range sandwiched {
+		holidays[d] = "国民の休日"
+	}
+
+	// 振替休日: 祝日が日曜日に当たる場合、その後の最も近い祝日でない日
+	substitutes := []date{}
+	for d, name := range holidays {
+		if d.weekday() != time.Sunday || name == "国民の休日" {
+			continue
+		}
+		substitute := d.addDays(1)
+		for {
+			if _, ok := holidays[substitute]; !ok {
+				break
+			}
+			substitute = substitute.addDays(1)
+		}
+		substitutes = append(substitutes, substitute)
+	}
+	for _, d := range substitutes {
+		holidays[d] = "振替休日"
+	}
+}
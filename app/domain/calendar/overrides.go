@@ -0,0 +1,98 @@
+package calendar
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const dateLayout = "2006-01-02"
+
+// Overrides は計算した祝日に対する追加と削除です。
+// 法改正や臨時の祝日など、規定の計算では求められない日を反映するために使います
+type Overrides struct {
+	added   map[date]string
+	removed map[date]bool
+}
+
+// overridesFile は上書きファイルの形式です
+type overridesFile struct {
+	// Holidays は追加する祝日です（計算した祝日と同じ日付の場合は名称を置き換えます）
+	Holidays []struct {
+		Date string `yaml:"date"`
+		Name string `yaml:"name"`
+	} `yaml:"holidays"`
+	// Removed は祝日から除く日付です
+	Removed []string `yaml:"removed"`
+}
+
+// LoadOverrides は YAML の上書きファイルを読み込みます。日付は YYYY-MM-DD で指定します
+//
+//	holidays:
+//	  - date: 2026-05-07
+//	    name: 臨時休日
+//	removed:
+//	  - 2026-05-06
+func LoadOverrides(path string) (*Overrides, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read holiday file: %w", err)
+	}
+
+	var file overridesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse holiday file %s: %w", path, err)
+	}
+	overrides, err := file.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid holiday file %s: %w", path, err)
+	}
+
+	return overrides, nil
+}
+
+func (f overridesFile) parse() (*Overrides, error) {
+	o := &Overrides{
+		added:   map[date]string{},
+		removed: map[date]bool{},
+	}
+
+	for _, holiday := range f.Holidays {
+		t, err := time.Parse(dateLayout, holiday.Date)
+		if err != nil {
+			return nil, fmt.Errorf("holiday date %q must be YYYY-MM-DD", holiday.Date)
+		}
+		if holiday.Name == "" {
+			return nil, fmt.Errorf("holiday %s must have a name", holiday.Date)
+		}
+		o.added[dateOf(t)] = holiday.Name
+	}
+	for _, removed := range f.Removed {
+		t, err := time.Parse(dateLayout, removed)
+		if err != nil {
+			return nil, fmt.Errorf("removed date %q must be YYYY-MM-DD", removed)
+		}
+		if _, ok := o.added[dateOf(t)]; ok {
+			return nil, fmt.Errorf("%s is both added and removed", removed)
+		}
+		o.removed[dateOf(t)] = true
+	}
+
+	return o, nil
+}
+
+// apply は year 年の祝日に追加・削除を反映します
+func (o *Overrides) apply(year int, holidays map[date]string) {
+	for d, name := range o.added {
+		if d.year == year {
+			holidays[d] = name
+		}
+	}
+	for d := range o.removed {
+		if d.year == year {
+			delete(holidays, d)
+		}
+	}
+}
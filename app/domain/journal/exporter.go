@@ -0,0 +1,73 @@
+// Package journal は仕訳を会計ソフトのインポート形式の CSV に書き出します
+package journal
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+)
+
+// dateFormat は3つの会計ソフトに共通する取引日の書式です
+const dateFormat = "2006/01/02"
+
+// Exporter は仕訳を1つの会計ソフトのインポート形式で書き出します
+type Exporter interface {
+	// ContentType は書き出すファイルの Content-Type（文字コードを含む）です
+	ContentType() string
+	// Write は仕訳を通し番号の順に書き出します
+	Write(w io.Writer, entries []*models.JournalEntry) error
+}
+
+// NewExporter は形式の Exporter を返します
+func NewExporter(format value.JournalFormat) (Exporter, error) {
+	switch format {
+	case value.JournalFormatFreee:
+		return &freeeExporter{}, nil
+	case value.JournalFormatMoneyForward:
+		return &moneyForwardExporter{}, nil
+	case value.JournalFormatYayoi:
+		return &yayoiExporter{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported journal format %q", format)
+}
+
+// posting は借方または貸方の列の値です
+type posting struct {
+	account     string
+	taxCategory string
+	amount      string
+	tax         string
+}
+
+// postingColumns は科目・会計ソフトの税区分の名前・金額・税額を返します。p が nil の場合はすべて空欄です
+func postingColumns(p *models.JournalPosting, taxCategories map[value.JournalTaxCategory]string) posting {
+	if p == nil {
+		return posting{}
+	}
+
+	return posting{
+		account:     p.Account,
+		taxCategory: taxCategories[p.TaxCategory],
+		amount:      formatAmount(p.Amount),
+		tax:         formatAmount(p.Tax),
+	}
+}
+
+// formatAmount は円の金額を桁区切りなしの整数で書き出します
+func formatAmount(amount decimal.Decimal) string {
+	return amount.Truncate(0).String()
+}
+
+// writeRecords は records を CSV として書き出します
+func writeRecords(w *csv.Writer, records [][]string) error {
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+
+	return w.Error()
+}
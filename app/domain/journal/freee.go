@@ -0,0 +1,50 @@
+package journal
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// freeeTaxCategories は freee会計の税区分の名前です
+var freeeTaxCategories = map[value.JournalTaxCategory]string{
+	value.JournalTaxPurchaseStandard: "課対仕入10%",
+	value.JournalTaxPurchaseReduced:  "課対仕入8%（軽）",
+	value.JournalTaxNone:             "対象外",
+}
+
+var freeeHeader = []string{
+	"伝票番号", "取引日",
+	"借方勘定科目", "借方税区分", "借方金額", "借方税額",
+	"貸方勘定科目", "貸方税区分", "貸方金額", "貸方税額",
+	"摘要",
+}
+
+// freeeExporter は freee会計の仕訳のインポート形式（見出し行付き、UTF-8）で書き出します。
+// 複合仕訳は同じ伝票番号の行にします
+type freeeExporter struct{}
+
+func (e *freeeExporter) ContentType() string {
+	return "text/csv; charset=UTF-8"
+}
+
+func (e *freeeExporter) Write(w io.Writer, entries []*models.JournalEntry) error {
+	records := [][]string{freeeHeader}
+	for _, entry := range entries {
+		for _, line := range entry.Lines {
+			debit := postingColumns(line.Debit, freeeTaxCategories)
+			credit := postingColumns(line.Credit, freeeTaxCategories)
+			records = append(records, []string{
+				strconv.Itoa(entry.No), entry.Date.Format(dateFormat),
+				debit.account, debit.taxCategory, debit.amount, debit.tax,
+				credit.account, credit.taxCategory, credit.amount, credit.tax,
+				entry.Description,
+			})
+		}
+	}
+
+	return writeRecords(csv.NewWriter(w), records)
+}
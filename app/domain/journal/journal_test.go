@@ -0,0 +1,131 @@
+package journal
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// update を指定すると testdata のゴールデンファイルを書き出した内容で更新します
+var update = flag.Bool("update", false, "update golden files")
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// testEntries は明細のない請求書・軽減税率の明細を含む請求書・返品・値引き・支払の仕訳です
+func testEntries() []*models.JournalEntry {
+	simple := &models.Invoice{
+		ID:               "invoice1",
+		IssueDate:        day(time.December, 1),
+		Currency:         value.CurrencyJPY,
+		PaymentAmount:    decimal.NewFromInt(10000),
+		ExchangeRate:     decimal.NewFromInt(1),
+		PaymentAmountJPY: decimal.NewFromInt(10000),
+		Fee:              decimal.NewFromInt(400),
+		Tax:              decimal.NewFromInt(40),
+		TaxRate:          decimal.NewFromFloat(0.1),
+		InvoiceAmount:    decimal.NewFromInt(10440),
+	}
+	lined := &models.Invoice{
+		ID:               "invoice2",
+		IssueDate:        day(time.December, 5),
+		Currency:         value.CurrencyJPY,
+		PaymentAmount:    decimal.NewFromInt(16400),
+		ExchangeRate:     decimal.NewFromInt(1),
+		PaymentAmountJPY: decimal.NewFromInt(16400),
+		Fee:              decimal.NewFromInt(656),
+		Tax:              decimal.NewFromInt(65),
+		TaxRate:          decimal.NewFromFloat(0.1),
+		InvoiceAmount:    decimal.NewFromInt(17121),
+		Lines: []*models.InvoiceLine{
+			{LineNo: 1, Description: "コンサルティング", TaxCategory: value.TaxCategoryStandard, Amount: decimal.NewFromInt(10000)},
+			{LineNo: 2, Description: "弁当", TaxCategory: value.TaxCategoryReduced, Amount: decimal.NewFromInt(5000)},
+		},
+	}
+	source := &models.JournalSource{
+		Invoices: []*models.Invoice{simple, lined},
+		CreditNotes: []*models.CreditNote{{
+			InvoiceID:     simple.ID,
+			IssueDate:     day(time.December, 5),
+			PaymentAmount: decimal.NewFromInt(1000),
+			Fee:           decimal.NewFromInt(40),
+			Tax:           decimal.NewFromInt(4),
+			TaxRate:       decimal.NewFromFloat(0.1),
+			CreditAmount:  decimal.NewFromInt(1044),
+		}},
+		CreditedInvoices: map[string]*models.Invoice{simple.ID: simple},
+		Payments: []*models.Payment{{
+			InvoiceID: simple.ID,
+			Amount:    decimal.NewFromInt(9396),
+			PaidDate:  day(time.December, 26),
+		}},
+		ClientNames: map[string]string{simple.ID: "株式会社サンプル", lined.ID: "テスト商店"},
+	}
+
+	return source.Entries(models.NewJournalAccounts([]*models.JournalAccount{
+		{Type: value.JournalAccountExpense, Name: "外注費"},
+	}))
+}
+
+func TestExporter_Golden(t *testing.T) {
+	for _, format := range value.JournalFormats() {
+		t.Run(string(format), func(t *testing.T) {
+			exporter, err := NewExporter(format)
+			assert.NoError(t, err)
+
+			var buf bytes.Buffer
+			assert.NoError(t, exporter.Write(&buf, testEntries()))
+
+			golden := filepath.Join("testdata", string(format)+".csv")
+			if *update {
+				assert.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
+
+func TestExporter_ContentType(t *testing.T) {
+	tests := []struct {
+		format   value.JournalFormat
+		expected string
+	}{
+		{format: value.JournalFormatFreee, expected: "text/csv; charset=UTF-8"},
+		{format: value.JournalFormatMoneyForward, expected: "text/csv; charset=UTF-8"},
+		{format: value.JournalFormatYayoi, expected: "text/csv; charset=Shift_JIS"},
+	}
+
+	for _, tt := range tests {
+		exporter, err := NewExporter(tt.format)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, exporter.ContentType())
+	}
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Run("対応していない形式はエラー", func(t *testing.T) {
+		_, err := NewExporter("kaikei")
+		assert.Error(t, err)
+	})
+}
+
+func TestYayoiFlag(t *testing.T) {
+	t.Run("1行の仕訳", func(t *testing.T) {
+		assert.Equal(t, "2000", yayoiFlag(0, 1))
+	})
+
+	t.Run("複合仕訳の最初・途中・最後の行", func(t *testing.T) {
+		assert.Equal(t, []string{"2110", "2100", "2101"}, []string{yayoiFlag(0, 3), yayoiFlag(1, 3), yayoiFlag(2, 3)})
+	})
+}
@@ -0,0 +1,50 @@
+package journal
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// moneyForwardTaxCategories はマネーフォワード クラウド会計の税区分の名前です
+var moneyForwardTaxCategories = map[value.JournalTaxCategory]string{
+	value.JournalTaxPurchaseStandard: "課税仕入 10%",
+	value.JournalTaxPurchaseReduced:  "課税仕入 (軽)8%",
+	value.JournalTaxNone:             "対象外",
+}
+
+var moneyForwardHeader = []string{
+	"取引No", "取引日",
+	"借方勘定科目", "借方補助科目", "借方税区分", "借方金額(円)", "借方税額",
+	"貸方勘定科目", "貸方補助科目", "貸方税区分", "貸方金額(円)", "貸方税額",
+	"摘要",
+}
+
+// moneyForwardExporter はマネーフォワード クラウド会計の仕訳帳のインポート形式（見出し行付き、UTF-8）で書き出します。
+// 複合仕訳は同じ取引No の行にします。補助科目は使いません
+type moneyForwardExporter struct{}
+
+func (e *moneyForwardExporter) ContentType() string {
+	return "text/csv; charset=UTF-8"
+}
+
+func (e *moneyForwardExporter) Write(w io.Writer, entries []*models.JournalEntry) error {
+	records := [][]string{moneyForwardHeader}
+	for _, entry := range entries {
+		for _, line := range entry.Lines {
+			debit := postingColumns(line.Debit, moneyForwardTaxCategories)
+			credit := postingColumns(line.Credit, moneyForwardTaxCategories)
+			records = append(records, []string{
+				strconv.Itoa(entry.No), entry.Date.Format(dateFormat),
+				debit.account, "", debit.taxCategory, debit.amount, debit.tax,
+				credit.account, "", credit.taxCategory, credit.amount, credit.tax,
+				entry.Description,
+			})
+		}
+	}
+
+	return writeRecords(csv.NewWriter(w), records)
+}
@@ -0,0 +1,71 @@
+package journal
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// yayoiTaxCategories は弥生会計の税区分の名前です。金額は税込みのため内税の区分にします
+var yayoiTaxCategories = map[value.JournalTaxCategory]string{
+	value.JournalTaxPurchaseStandard: "課対仕入内10%",
+	value.JournalTaxPurchaseReduced:  "課対仕入内軽減8%",
+	value.JournalTaxNone:             "対象外",
+}
+
+// 弥生会計の識別フラグ。1行の仕訳と、複合仕訳の最初・途中・最後の行を区別します
+const (
+	yayoiFlagSingle = "2000"
+	yayoiFlagFirst  = "2110"
+	yayoiFlagMiddle = "2100"
+	yayoiFlagLast   = "2101"
+)
+
+// yayoiExporter は弥生会計の仕訳日記帳のインポート形式（見出し行なし、25列、Shift_JIS、CRLF）で書き出します
+type yayoiExporter struct{}
+
+func (e *yayoiExporter) ContentType() string {
+	return "text/csv; charset=Shift_JIS"
+}
+
+func (e *yayoiExporter) Write(w io.Writer, entries []*models.JournalEntry) error {
+	var records [][]string
+	for _, entry := range entries {
+		for i, line := range entry.Lines {
+			debit := postingColumns(line.Debit, yayoiTaxCategories)
+			credit := postingColumns(line.Credit, yayoiTaxCategories)
+			records = append(records, []string{
+				yayoiFlag(i, len(entry.Lines)), strconv.Itoa(entry.No), "", entry.Date.Format(dateFormat),
+				debit.account, "", "", debit.taxCategory, debit.amount, debit.tax,
+				credit.account, "", "", credit.taxCategory, credit.amount, credit.tax,
+				entry.Description,
+				// 番号・期日・タイプ・生成元・仕訳メモ・付箋1・付箋2・調整
+				"", "", "0", "", "", "0", "0", "no",
+			})
+		}
+	}
+
+	writer := csv.NewWriter(japanese.ShiftJIS.NewEncoder().Writer(w))
+	writer.UseCRLF = true
+
+	return writeRecords(writer, records)
+}
+
+// yayoiFlag は仕訳の lines 行のうち i 行目の識別フラグを返します
+func yayoiFlag(i, lines int) string {
+	switch {
+	case lines == 1:
+		return yayoiFlagSingle
+	case i == 0:
+		return yayoiFlagFirst
+	case i == lines-1:
+		return yayoiFlagLast
+	}
+
+	return yayoiFlagMiddle
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// ApprovalRule は会社の承認フローの1段階です。
+// 請求金額が MinAmount 以上の請求書は、Step の順に各段階の Role のユーザーの承認が必要です
+type ApprovalRule struct {
+	ID        string
+	CompanyID string
+	Step      int
+	MinAmount decimal.Decimal
+	Role      value.UserRole
+	CreatedAt time.Time
+}
+
+func (a *ApprovalRule) ToDAO() *entities.ApprovalRule {
+	return &entities.ApprovalRule{
+		ID:        a.ID,
+		CompanyID: a.CompanyID,
+		Step:      a.Step,
+		MinAmount: a.MinAmount,
+		Role:      a.Role,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func ApprovalRuleFromDAO(daoRule *entities.ApprovalRule) *ApprovalRule {
+	return &ApprovalRule{
+		ID:        daoRule.ID,
+		CompanyID: daoRule.CompanyID,
+		Step:      daoRule.Step,
+		MinAmount: daoRule.MinAmount,
+		Role:      daoRule.Role,
+		CreatedAt: daoRule.CreatedAt,
+	}
+}
+
+// ApprovalWorkflow は会社の承認フローの段階と、フロー全体の楽観ロック用のバージョンです
+type ApprovalWorkflow struct {
+	Rules   []*ApprovalRule
+	Version int
+}
+
+// InvoiceApproval は請求書に必要な承認の1段階と、その判断の記録です。
+// 作成後に承認フローを変更しても、作成時に決めた段階で承認します
+type InvoiceApproval struct {
+	ID        string
+	InvoiceID string
+	Step      int
+	Role      value.UserRole
+	Status    value.ApprovalStatus
+	// DecidedBy は承認・却下したユーザーです（判断前は nil）
+	DecidedBy *string
+	Comment   string
+	DecidedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (a *InvoiceApproval) ToDAO() *entities.InvoiceApproval {
+	return &entities.InvoiceApproval{
+		ID:        a.ID,
+		InvoiceID: a.InvoiceID,
+		Step:      a.Step,
+		Role:      a.Role,
+		Status:    a.Status,
+		DecidedBy: a.DecidedBy,
+		Comment:   a.Comment,
+		DecidedAt: a.DecidedAt,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func InvoiceApprovalFromDAO(daoApproval *entities.InvoiceApproval) *InvoiceApproval {
+	return &InvoiceApproval{
+		ID:        daoApproval.ID,
+		InvoiceID: daoApproval.InvoiceID,
+		Step:      daoApproval.Step,
+		Role:      daoApproval.Role,
+		Status:    daoApproval.Status,
+		DecidedBy: daoApproval.DecidedBy,
+		Comment:   daoApproval.Comment,
+		DecidedAt: daoApproval.DecidedAt,
+		CreatedAt: daoApproval.CreatedAt,
+	}
+}
+
+// Decide は承認・却下の判断を記録します
+func (a *InvoiceApproval) Decide(status value.ApprovalStatus, userID, comment string, decidedAt time.Time) {
+	a.Status = status
+	a.DecidedBy = &userID
+	a.Comment = comment
+	a.DecidedAt = &decidedAt
+}
+
+// NewInvoiceApprovals は承認フローのうち請求金額が下限以上の段階を、請求書に必要な承認として返します。
+// rules は Step の順に並んでいる必要があります
+func NewInvoiceApprovals(rules []*ApprovalRule, invoice *Invoice) []*InvoiceApproval {
+	var approvals []*InvoiceApproval
+	for _, rule := range rules {
+		if invoice.InvoiceAmount.LessThan(rule.MinAmount) {
+			continue
+		}
+		approvals = append(approvals, &InvoiceApproval{
+			InvoiceID: invoice.ID,
+			Step:      len(approvals) + 1,
+			Role:      rule.Role,
+			Status:    value.ApprovalStatusPending,
+		})
+	}
+
+	return approvals
+}
+
+// NextPendingApproval は次に判断する段階を返します。すべて承認済みの場合は nil を返します
+func NextPendingApproval(approvals []*InvoiceApproval) *InvoiceApproval {
+	for _, approval := range approvals {
+		if approval.Status == value.ApprovalStatusPending {
+			return approval
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatement は取り込んだ銀行の入出金明細です
+type BankStatement struct {
+	ID         string
+	CompanyID  string
+	Format     value.StatementFormat
+	ImportedBy string
+	CreatedAt  time.Time
+
+	// Lines は取り込んだ明細です。取り込み済みの明細は含めません
+	Lines []*BankStatementLine
+	// DuplicateCount は取り込み済みのため除いた明細の件数です（保存しません）
+	DuplicateCount int
+}
+
+func (s *BankStatement) ToDAO() *entities.BankStatement {
+	return &entities.BankStatement{
+		ID:         s.ID,
+		CompanyID:  s.CompanyID,
+		Format:     s.Format,
+		ImportedBy: s.ImportedBy,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+// CountByStatus は消し込みの状態ごとの明細の件数を返します
+func (s *BankStatement) CountByStatus(status value.StatementLineStatus) int {
+	count := 0
+	for _, line := range s.Lines {
+		if line.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// BankStatementLine は入出金明細の1件の取引です
+type BankStatementLine struct {
+	ID          string
+	StatementID string
+	CompanyID   string
+	// LineNo は取り込んだファイルでの取引の順番です（1から）
+	LineNo int
+	// Fingerprint は同じ取引を2回取り込まないための、取引の内容から計算した値です
+	Fingerprint     string
+	TransactionDate time.Time
+	Direction       value.StatementDirection
+	Amount          decimal.Decimal
+	// CounterpartyName は振込依頼人名または受取人名（半角カナのことがあります）です
+	CounterpartyName string
+	Description      string
+	// Reference は銀行の照会番号などの参照番号で、記録する支払の参照番号にします
+	Reference string
+	Status    value.StatementLineStatus
+	// InvoiceID と PaymentID は消し込んだ請求書と記録した支払です（確認済みの場合だけ）
+	InvoiceID *string
+	PaymentID *string
+	// Score は消し込んだ請求書との一致度（0〜100）です
+	Score         int
+	AutoConfirmed bool
+	// DecidedBy は消し込み・除外したユーザーです。自動で消し込んだ場合は取り込んだユーザーです
+	DecidedBy *string
+	DecidedAt *time.Time
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Candidates は確認待ちの明細に一致する可能性のある請求書です（保存しません）
+	Candidates []*StatementCandidate
+}
+
+func (l *BankStatementLine) ToDAO() *entities.BankStatementLine {
+	return &entities.BankStatementLine{
+		ID:               l.ID,
+		StatementID:      l.StatementID,
+		CompanyID:        l.CompanyID,
+		LineNo:           l.LineNo,
+		Fingerprint:      l.Fingerprint,
+		TransactionDate:  l.TransactionDate,
+		Direction:        l.Direction,
+		Amount:           l.Amount,
+		CounterpartyName: l.CounterpartyName,
+		Description:      l.Description,
+		Reference:        l.Reference,
+		Status:           l.Status,
+		InvoiceID:        l.InvoiceID,
+		PaymentID:        l.PaymentID,
+		Score:            l.Score,
+		AutoConfirmed:    l.AutoConfirmed,
+		DecidedBy:        l.DecidedBy,
+		DecidedAt:        l.DecidedAt,
+		Version:          l.Version,
+		CreatedAt:        l.CreatedAt,
+		UpdatedAt:        l.UpdatedAt,
+	}
+}
+
+func BankStatementLineFromDAO(daoLine *entities.BankStatementLine) *BankStatementLine {
+	return &BankStatementLine{
+		ID:               daoLine.ID,
+		StatementID:      daoLine.StatementID,
+		CompanyID:        daoLine.CompanyID,
+		LineNo:           daoLine.LineNo,
+		Fingerprint:      daoLine.Fingerprint,
+		TransactionDate:  daoLine.TransactionDate,
+		Direction:        daoLine.Direction,
+		Amount:           daoLine.Amount,
+		CounterpartyName: daoLine.CounterpartyName,
+		Description:      daoLine.Description,
+		Reference:        daoLine.Reference,
+		Status:           daoLine.Status,
+		InvoiceID:        daoLine.InvoiceID,
+		PaymentID:        daoLine.PaymentID,
+		Score:            daoLine.Score,
+		AutoConfirmed:    daoLine.AutoConfirmed,
+		DecidedBy:        daoLine.DecidedBy,
+		DecidedAt:        daoLine.DecidedAt,
+		Version:          daoLine.Version,
+		CreatedAt:        daoLine.CreatedAt,
+		UpdatedAt:        daoLine.UpdatedAt,
+	}
+}
+
+// Confirm は明細を請求書の支払として消し込みます
+func (l *BankStatementLine) Confirm(invoiceID, paymentID string, score int, auto bool, userID string, now time.Time) {
+	l.Status = value.StatementLineStatusConfirmed
+	l.InvoiceID = &invoiceID
+	l.PaymentID = &paymentID
+	l.Score = score
+	l.AutoConfirmed = auto
+	l.DecidedBy = &userID
+	l.DecidedAt = &now
+}
+
+// Ignore は明細を請求書の支払ではないものとして消し込みの対象から除きます
+func (l *BankStatementLine) Ignore(userID string, now time.Time) {
+	l.Status = value.StatementLineStatusIgnored
+	l.DecidedBy = &userID
+	l.DecidedAt = &now
+}
+
+// SetFingerprints は明細の内容から Fingerprint を計算します。
+// 同じ日に同じ相手へ同じ金額を2回振り込んだ場合も区別できるよう、同じ内容の明細にはファイルでの出現順を含めます
+func SetFingerprints(lines []*BankStatementLine) {
+	occurrences := make(map[string]int)
+	for _, line := range lines {
+		key := strings.Join([]string{
+			line.TransactionDate.Format(time.DateOnly),
+			string(line.Direction),
+			line.Amount.String(),
+			line.CounterpartyName,
+			line.Description,
+			line.Reference,
+		}, "\x00")
+		occurrences[key]++
+		sum := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(occurrences[key])))
+		line.Fingerprint = hex.EncodeToString(sum[:])
+	}
+}
+
+// BankStatementLineCondition は明細の一覧の条件です
+type BankStatementLineCondition struct {
+	Status value.StatementLineStatus
+	Limit  int
+	Offset int
+}
+
+// ReconciliationTarget は明細を消し込める、未払いの残高がある請求書です
+type ReconciliationTarget struct {
+	Invoice    *Invoice
+	ClientName string
+	// AccountNames は取引先の銀行口座の名義です
+	AccountNames []string
+}
+
+// StatementCandidate は明細に一致する可能性のある請求書と、その一致度です。
+// Score は金額・日付・名義の一致度の合計（0〜100）です
+type StatementCandidate struct {
+	Invoice     *Invoice
+	ClientName  string
+	Score       int
+	AmountScore int
+	DateScore   int
+	NameScore   int
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
 
 	"time"
@@ -14,34 +15,58 @@ type Client struct {
 	PhoneNumber        string
 	PostalCode         string
 	Address            string
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
+	ClientType         value.ClientType
+	// Withholding は個人の取引先への支払から源泉徴収するかどうかです
+	Withholding         bool
+	WithholdingCategory value.WithholdingCategory
+	Version             int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 func (c *Client) ToDAO() *entities.Client {
 	return &entities.Client{
-		ID:                 c.ID,
-		CompanyID:          c.CompanyID,
-		CorporateName:      c.CorporateName,
-		RepresentativeName: c.RepresentativeName,
-		PhoneNumber:        c.PhoneNumber,
-		PostalCode:         c.PostalCode,
-		Address:            c.Address,
-		CreatedAt:          c.CreatedAt,
-		UpdatedAt:          c.UpdatedAt,
+		ID:                  c.ID,
+		CompanyID:           c.CompanyID,
+		CorporateName:       c.CorporateName,
+		RepresentativeName:  c.RepresentativeName,
+		PhoneNumber:         c.PhoneNumber,
+		PostalCode:          c.PostalCode,
+		Address:             c.Address,
+		ClientType:          c.ClientType,
+		Withholding:         c.Withholding,
+		WithholdingCategory: c.WithholdingCategory,
+		Version:             c.Version,
+		CreatedAt:           c.CreatedAt,
+		UpdatedAt:           c.UpdatedAt,
 	}
 }
 
 func ClientFromDAO(daoClient *entities.Client) *Client {
 	return &Client{
-		ID:                 daoClient.ID,
-		CompanyID:          daoClient.CompanyID,
-		CorporateName:      daoClient.CorporateName,
-		RepresentativeName: daoClient.RepresentativeName,
-		PhoneNumber:        daoClient.PhoneNumber,
-		PostalCode:         daoClient.PostalCode,
-		Address:            daoClient.Address,
-		CreatedAt:          daoClient.CreatedAt,
-		UpdatedAt:          daoClient.UpdatedAt,
+		ID:                  daoClient.ID,
+		CompanyID:           daoClient.CompanyID,
+		CorporateName:       daoClient.CorporateName,
+		RepresentativeName:  daoClient.RepresentativeName,
+		PhoneNumber:         daoClient.PhoneNumber,
+		PostalCode:          daoClient.PostalCode,
+		Address:             daoClient.Address,
+		ClientType:          daoClient.ClientType,
+		Withholding:         daoClient.Withholding,
+		WithholdingCategory: daoClient.WithholdingCategory,
+		Version:             daoClient.Version,
+		CreatedAt:           daoClient.CreatedAt,
+		UpdatedAt:           daoClient.UpdatedAt,
 	}
 }
+
+// IsWithholdingTarget は支払から源泉徴収する取引先かを判定します。法人への支払は源泉徴収しません
+func (c *Client) IsWithholdingTarget() bool {
+	return c.ClientType == value.ClientTypeIndividual && c.Withholding
+}
+
+// ClientDetail は取引先と、その取引先の銀行口座です
+type ClientDetail struct {
+	Client       *Client
+	BankAccounts []*ClientBankAccount
+}
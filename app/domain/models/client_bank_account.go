@@ -3,6 +3,7 @@ package models
 import (
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
 
+	"log/slog"
 	"time"
 )
 
@@ -13,6 +14,7 @@ type ClientBankAccount struct {
 	BranchName    string
 	AccountNumber string
 	AccountName   string
+	Version       int
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -25,6 +27,7 @@ func (c *ClientBankAccount) ToDAO() *entities.ClientBankAccount {
 		BranchName:    c.BranchName,
 		AccountNumber: c.AccountNumber,
 		AccountName:   c.AccountName,
+		Version:       c.Version,
 		CreatedAt:     c.CreatedAt,
 		UpdatedAt:     c.UpdatedAt,
 	}
@@ -38,7 +41,31 @@ func ClientBankAccountFromDAO(daoAccount *entities.ClientBankAccount) *ClientBan
 		BranchName:    daoAccount.BranchName,
 		AccountNumber: daoAccount.AccountNumber,
 		AccountName:   daoAccount.AccountName,
+		Version:       daoAccount.Version,
 		CreatedAt:     daoAccount.CreatedAt,
 		UpdatedAt:     daoAccount.UpdatedAt,
 	}
 }
+
+// LogValue は口座番号を下4桁以外マスクしたログ出力用の値を返します
+func (c *ClientBankAccount) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", c.ID),
+		slog.String("client_id", c.ClientID),
+		slog.String("bank_name", c.BankName),
+		slog.String("branch_name", c.BranchName),
+		slog.String("account", c.MaskedAccountNumber()),
+	)
+}
+
+// MaskedAccountNumber は口座番号を下4桁以外マスクした値（例: ****1234）を返します。
+// 桁数も分からないよう、マスク部分は常に4文字にします
+func (c *ClientBankAccount) MaskedAccountNumber() string {
+	const visible = 4
+	const mask = "****"
+	if len(c.AccountNumber) <= visible {
+		return mask
+	}
+
+	return mask + c.AccountNumber[len(c.AccountNumber)-visible:]
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
 
 	"time"
@@ -13,20 +14,26 @@ type Company struct {
 	PhoneNumber        string
 	PostalCode         string
 	Address            string
+	DueDatePolicy      value.DueDatePolicy
+	InvoiceNumbering   InvoiceNumbering
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
 
 func (c *Company) ToDAO() *entities.Company {
 	return &entities.Company{
-		ID:                 c.ID,
-		CorporateName:      c.CorporateName,
-		RepresentativeName: c.RepresentativeName,
-		PhoneNumber:        c.PhoneNumber,
-		PostalCode:         c.PostalCode,
-		Address:            c.Address,
-		CreatedAt:          c.CreatedAt,
-		UpdatedAt:          c.UpdatedAt,
+		ID:                      c.ID,
+		CorporateName:           c.CorporateName,
+		RepresentativeName:      c.RepresentativeName,
+		PhoneNumber:             c.PhoneNumber,
+		PostalCode:              c.PostalCode,
+		Address:                 c.Address,
+		DueDatePolicy:           c.DueDatePolicy,
+		InvoiceNumberFormat:     c.InvoiceNumbering.Format,
+		FiscalYearStartMonth:    c.InvoiceNumbering.FiscalYearStartMonth,
+		InvoiceNumberingVersion: c.InvoiceNumbering.Version,
+		CreatedAt:               c.CreatedAt,
+		UpdatedAt:               c.UpdatedAt,
 	}
 }
 
@@ -38,7 +45,13 @@ func CompanyFromDAO(daoCompany *entities.Company) *Company {
 		PhoneNumber:        daoCompany.PhoneNumber,
 		PostalCode:         daoCompany.PostalCode,
 		Address:            daoCompany.Address,
-		CreatedAt:          daoCompany.CreatedAt,
-		UpdatedAt:          daoCompany.UpdatedAt,
+		DueDatePolicy:      daoCompany.DueDatePolicy,
+		InvoiceNumbering: InvoiceNumbering{
+			Format:               daoCompany.InvoiceNumberFormat,
+			FiscalYearStartMonth: daoCompany.FiscalYearStartMonth,
+			Version:              daoCompany.InvoiceNumberingVersion,
+		},
+		CreatedAt: daoCompany.CreatedAt,
+		UpdatedAt: daoCompany.UpdatedAt,
 	}
 }
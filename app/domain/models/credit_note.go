@@ -0,0 +1,98 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// CreditNote は元の請求書を変更せずに減額する返品・値引きです
+type CreditNote struct {
+	ID        string
+	CompanyID string
+	// InvoiceID は減額する元の請求書の ID です
+	InvoiceID string
+	IssueDate time.Time
+	Reason    value.CreditNoteReason
+	Note      string
+	// PaymentAmount は支払金額のうち減額する金額です
+	PaymentAmount decimal.Decimal
+	Fee           decimal.Decimal
+	FeeRate       decimal.Decimal
+	Tax           decimal.Decimal
+	TaxRate       decimal.Decimal
+	// CreditAmount は請求金額から差し引く金額です（減額する金額 + 手数料 + 消費税）
+	CreditAmount decimal.Decimal
+	CreatedAt    time.Time
+}
+
+func (c *CreditNote) ToDAO() *entities.CreditNote {
+	return &entities.CreditNote{
+		ID:            c.ID,
+		CompanyID:     c.CompanyID,
+		InvoiceID:     c.InvoiceID,
+		IssueDate:     c.IssueDate,
+		Reason:        c.Reason,
+		Note:          c.Note,
+		PaymentAmount: c.PaymentAmount,
+		Fee:           c.Fee,
+		FeeRate:       c.FeeRate,
+		Tax:           c.Tax,
+		TaxRate:       c.TaxRate,
+		CreditAmount:  c.CreditAmount,
+		CreatedAt:     c.CreatedAt,
+	}
+}
+
+func CreditNoteFromDAO(daoCreditNote *entities.CreditNote) *CreditNote {
+	return &CreditNote{
+		ID:            daoCreditNote.ID,
+		CompanyID:     daoCreditNote.CompanyID,
+		InvoiceID:     daoCreditNote.InvoiceID,
+		IssueDate:     daoCreditNote.IssueDate,
+		Reason:        daoCreditNote.Reason,
+		Note:          daoCreditNote.Note,
+		PaymentAmount: daoCreditNote.PaymentAmount,
+		Fee:           daoCreditNote.Fee,
+		FeeRate:       daoCreditNote.FeeRate,
+		Tax:           daoCreditNote.Tax,
+		TaxRate:       daoCreditNote.TaxRate,
+		CreditAmount:  daoCreditNote.CreditAmount,
+		CreatedAt:     daoCreditNote.CreatedAt,
+	}
+}
+
+// CalculateAmounts は元の請求書の手数料率・税率で、減額する手数料・消費税と請求金額から差し引く金額を計算します。
+// 手数料・消費税の計算は請求書の CalculateFee / CalculateTax と同じ規則です
+func (c *CreditNote) CalculateAmounts(invoice *Invoice) {
+	c.FeeRate = invoice.FeeRate
+	c.Fee = calculateFee(c.PaymentAmount, c.FeeRate)
+	c.TaxRate = invoice.TaxRate
+	c.Tax = calculateTax(c.Fee, c.TaxRate)
+	c.CreditAmount = c.PaymentAmount.Add(c.Fee).Add(c.Tax)
+}
+
+// MaxCreditPaymentAmount は手数料・消費税を含めた減額が請求書の残高を超えない、減額する金額の上限を返します
+func MaxCreditPaymentAmount(invoice *Invoice) decimal.Decimal {
+	outstanding := invoice.OutstandingAmount()
+	exceeds := func(paymentAmount decimal.Decimal) bool {
+		creditNote := &CreditNote{PaymentAmount: paymentAmount}
+		creditNote.CalculateAmounts(invoice)
+		return creditNote.CreditAmount.GreaterThan(outstanding)
+	}
+
+	// 手数料・消費税の切り捨てで概算からずれる分は1円ずつ補正する
+	one := decimal.NewFromInt(1)
+	rate := one.Add(invoice.FeeRate).Add(invoice.FeeRate.Mul(invoice.TaxRate))
+	amount := decimal.Max(outstanding.Div(rate).Floor(), decimal.Zero)
+	for !exceeds(amount.Add(one)) {
+		amount = amount.Add(one)
+	}
+	for amount.IsPositive() && exceeds(amount) {
+		amount = amount.Sub(one)
+	}
+
+	return amount
+}
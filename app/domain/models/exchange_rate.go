@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// ExchangeRate は外貨1単位あたりの円の為替レート（仲値）です
+type ExchangeRate struct {
+	ID       string
+	Currency value.Currency
+	// RateDate はレートを公表した日です
+	RateDate  time.Time
+	Rate      decimal.Decimal
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (r *ExchangeRate) ToDAO() *entities.ExchangeRate {
+	return &entities.ExchangeRate{
+		ID:        r.ID,
+		Currency:  r.Currency,
+		RateDate:  r.RateDate,
+		Rate:      r.Rate,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func ExchangeRateFromDAO(daoRate *entities.ExchangeRate) *ExchangeRate {
+	return &ExchangeRate{
+		ID:        daoRate.ID,
+		Currency:  daoRate.Currency,
+		RateDate:  daoRate.RateDate,
+		Rate:      daoRate.Rate,
+		CreatedAt: daoRate.CreatedAt,
+		UpdatedAt: daoRate.UpdatedAt,
+	}
+}
@@ -9,73 +9,259 @@ import (
 )
 
 type Invoice struct {
-	ID             string
-	CompanyID      string
-	ClientID       string
-	IssueDate      time.Time
-	PaymentAmount  decimal.Decimal
-	Fee            decimal.Decimal
-	FeeRate        decimal.Decimal
-	Tax            decimal.Decimal
-	TaxRate        decimal.Decimal
-	InvoiceAmount  decimal.Decimal
+	ID        string
+	CompanyID string
+	// InvoiceNumber は会社の会計年度ごとの連番の請求書番号です。番号付けを導入する前に作成した請求書は nil です
+	InvoiceNumber *string
+	ClientID      string
+	IssueDate     time.Time
+	// Currency は支払金額・明細・源泉徴収税額の通貨です。手数料以降の金額はすべて円です
+	Currency      value.Currency
+	PaymentAmount decimal.Decimal
+	// ExchangeRate は発行日時点の通貨1単位あたりの円の為替レートです（円建ては1）
+	ExchangeRate decimal.Decimal
+	// PaymentAmountJPY は発行日時点のレートで換算した支払金額の円換算額で、手数料の計算と集計に使います
+	PaymentAmountJPY decimal.Decimal
+	Fee              decimal.Decimal
+	FeeRate          decimal.Decimal
+	Tax              decimal.Decimal
+	TaxRate          decimal.Decimal
+	InvoiceAmount    decimal.Decimal
+	// WithholdingTax は個人の取引先への支払から源泉徴収する所得税（復興特別所得税を含む）です
+	WithholdingTax decimal.Decimal
+	// CreditedAmount は発行済みの返品・値引きによる減額の合計です
+	CreditedAmount decimal.Decimal
+	// PaidAmount は記録済みの支払の合計です
+	PaidAmount     decimal.Decimal
 	PaymentDueDate time.Time
 	Status         value.InvoiceStatus
+	Version        int
+	// CreatedBy は作成したユーザーで、承認フローではこのユーザーは承認できません
+	CreatedBy string
+	// DuplicateCheck は作成時の重複の確認の結果で、SuspectedDuplicateID は force を指定して作成した場合の最も近い疑いのある請求書です
+	DuplicateCheck       value.DuplicateCheck
+	SuspectedDuplicateID *string
+	// RetentionClass は削除した後の保存期間の区分です
+	RetentionClass value.RetentionClass
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+
+	// Lines は明細です。明細を指定せずに作成した請求書、明細を読み込んでいない場合は空です
+	Lines []*InvoiceLine
+
+	// DueDateAdjustment は作成時に支払期日を営業日へ移動した内容です（保存しません）
+	DueDateAdjustment *DueDateAdjustment
+}
+
+// DueDateAdjustment は休業日に当たる支払期日を営業日へ移動した内容です
+type DueDateAdjustment struct {
+	// RequestedDate は指定された支払期日です
+	RequestedDate time.Time
+	// Reason は指定された支払期日が休業日である理由（土曜日・日曜日・祝日名・年末年始）です
+	Reason string
+	Policy value.DueDatePolicy
 }
 
 func (i *Invoice) ToDAO() *entities.Invoice {
 	return &entities.Invoice{
-		ID:             i.ID,
-		CompanyID:      i.CompanyID,
-		ClientID:       i.ClientID,
-		IssueDate:      i.IssueDate,
-		PaymentAmount:  i.PaymentAmount,
-		Fee:            i.Fee,
-		FeeRate:        i.FeeRate,
-		Tax:            i.Tax,
-		TaxRate:        i.TaxRate,
-		InvoiceAmount:  i.InvoiceAmount,
-		PaymentDueDate: i.PaymentDueDate,
-		Status:         i.Status,
-		CreatedAt:      i.CreatedAt,
-		UpdatedAt:      i.UpdatedAt,
+		ID:                   i.ID,
+		CompanyID:            i.CompanyID,
+		InvoiceNumber:        i.InvoiceNumber,
+		ClientID:             i.ClientID,
+		IssueDate:            i.IssueDate,
+		Currency:             i.Currency,
+		PaymentAmount:        i.PaymentAmount,
+		ExchangeRate:         i.ExchangeRate,
+		PaymentAmountJPY:     i.PaymentAmountJPY,
+		Fee:                  i.Fee,
+		FeeRate:              i.FeeRate,
+		Tax:                  i.Tax,
+		TaxRate:              i.TaxRate,
+		InvoiceAmount:        i.InvoiceAmount,
+		WithholdingTax:       i.WithholdingTax,
+		CreditedAmount:       i.CreditedAmount,
+		PaidAmount:           i.PaidAmount,
+		PaymentDueDate:       i.PaymentDueDate,
+		Status:               i.Status,
+		Version:              i.Version,
+		CreatedBy:            i.CreatedBy,
+		DuplicateCheck:       i.DuplicateCheck,
+		SuspectedDuplicateID: i.SuspectedDuplicateID,
+		RetentionClass:       i.RetentionClass,
+		CreatedAt:            i.CreatedAt,
+		UpdatedAt:            i.UpdatedAt,
 	}
 }
 
 func InvoiceFromDAO(daoInvoice *entities.Invoice) *Invoice {
 	return &Invoice{
-		ID:             daoInvoice.ID,
-		CompanyID:      daoInvoice.CompanyID,
-		ClientID:       daoInvoice.ClientID,
-		IssueDate:      daoInvoice.IssueDate,
-		PaymentAmount:  daoInvoice.PaymentAmount,
-		Fee:            daoInvoice.Fee,
-		FeeRate:        daoInvoice.FeeRate,
-		Tax:            daoInvoice.Tax,
-		TaxRate:        daoInvoice.TaxRate,
-		InvoiceAmount:  daoInvoice.InvoiceAmount,
-		PaymentDueDate: daoInvoice.PaymentDueDate,
-		Status:         daoInvoice.Status,
-		CreatedAt:      daoInvoice.CreatedAt,
-		UpdatedAt:      daoInvoice.UpdatedAt,
+		ID:                   daoInvoice.ID,
+		CompanyID:            daoInvoice.CompanyID,
+		InvoiceNumber:        daoInvoice.InvoiceNumber,
+		ClientID:             daoInvoice.ClientID,
+		IssueDate:            daoInvoice.IssueDate,
+		Currency:             daoInvoice.Currency,
+		PaymentAmount:        daoInvoice.PaymentAmount,
+		ExchangeRate:         daoInvoice.ExchangeRate,
+		PaymentAmountJPY:     daoInvoice.PaymentAmountJPY,
+		Fee:                  daoInvoice.Fee,
+		FeeRate:              daoInvoice.FeeRate,
+		Tax:                  daoInvoice.Tax,
+		TaxRate:              daoInvoice.TaxRate,
+		InvoiceAmount:        daoInvoice.InvoiceAmount,
+		WithholdingTax:       daoInvoice.WithholdingTax,
+		CreditedAmount:       daoInvoice.CreditedAmount,
+		PaidAmount:           daoInvoice.PaidAmount,
+		PaymentDueDate:       daoInvoice.PaymentDueDate,
+		Status:               daoInvoice.Status,
+		Version:              daoInvoice.Version,
+		CreatedBy:            daoInvoice.CreatedBy,
+		DuplicateCheck:       daoInvoice.DuplicateCheck,
+		SuspectedDuplicateID: daoInvoice.SuspectedDuplicateID,
+		RetentionClass:       daoInvoice.RetentionClass,
+		CreatedAt:            daoInvoice.CreatedAt,
+		UpdatedAt:            daoInvoice.UpdatedAt,
+	}
+}
+
+// ApplyLines は明細に順番と金額を設定し、支払金額を明細の合計と税区分ごとの消費税の合計にします。
+// 金額は請求書の通貨の補助単位未満を切り捨てます
+func (i *Invoice) ApplyLines(lines []*InvoiceLine) {
+	i.PaymentAmount = decimal.Zero
+	for n, line := range lines {
+		line.LineNo = n + 1
+		line.CalculateAmount(i.Currency)
+	}
+	for _, tax := range SummarizeLineTaxes(lines, i.Currency) {
+		i.PaymentAmount = i.PaymentAmount.Add(tax.Amount).Add(tax.Tax)
 	}
+	i.Lines = lines
+}
+
+// PaymentMoney は請求書の通貨での支払金額を返します
+func (i *Invoice) PaymentMoney() value.Money {
+	return value.NewMoney(i.PaymentAmount, i.Currency)
+}
+
+// ApplyExchangeRate は発行日時点の為替レートと、そのレートで換算した支払金額の円換算額（1円未満切り捨て）を記録します
+func (i *Invoice) ApplyExchangeRate(rate decimal.Decimal) {
+	i.ExchangeRate = rate
+	i.PaymentAmountJPY = i.PaymentMoney().Convert(rate, value.CurrencyJPY).Amount
 }
 
-// CalculateFee は支払金額に対する手数料を計算します
+// CalculateFee は支払金額の円換算額に対する手数料を計算します
 func (i *Invoice) CalculateFee(feeRate decimal.Decimal) {
-	i.Fee = i.PaymentAmount.Mul(feeRate).Truncate(0)
+	i.Fee = calculateFee(i.PaymentAmountJPY, feeRate)
 	i.FeeRate = feeRate
 }
 
 // CalculateTax は手数料に対する消費税を計算します
 func (i *Invoice) CalculateTax(taxRate decimal.Decimal) {
-	i.Tax = i.Fee.Mul(taxRate).Truncate(0)
+	i.Tax = calculateTax(i.Fee, taxRate)
 	i.TaxRate = taxRate
 }
 
-// CalculateInvoiceAmount は請求金額を計算します（支払金額 + 手数料 + 消費税）
+// calculateFee は金額に手数料率を掛け、1円未満を切り捨てます。請求書と返品・値引きで同じ規則を使います
+func calculateFee(amount, feeRate decimal.Decimal) decimal.Decimal {
+	return amount.Mul(feeRate).Truncate(0)
+}
+
+// calculateTax は手数料に税率を掛け、1円未満を切り捨てます
+func calculateTax(fee, taxRate decimal.Decimal) decimal.Decimal {
+	return fee.Mul(taxRate).Truncate(0)
+}
+
+// CalculateInvoiceAmount は請求金額を計算します（支払金額の円換算額 + 手数料 + 消費税）
 func (i *Invoice) CalculateInvoiceAmount() {
-	i.InvoiceAmount = i.PaymentAmount.Add(i.Fee).Add(i.Tax)
+	i.InvoiceAmount = i.PaymentAmountJPY.Add(i.Fee).Add(i.Tax)
+}
+
+var (
+	// withholdingThreshold を超える部分には高い税率を適用します
+	withholdingThreshold  = decimal.NewFromInt(1000000)
+	withholdingRate       = decimal.NewFromFloat(0.1021)
+	withholdingHigherRate = decimal.NewFromFloat(0.2042)
+)
+
+// CalculateWithholdingTax は源泉徴収の対象の取引先への支払から源泉徴収する税額を計算します。
+// 明細を指定した請求書は消費税を区分しているため、消費税を除いた明細の金額の合計を対象にします
+func (i *Invoice) CalculateWithholdingTax(client *Client) {
+	i.WithholdingTax = decimal.Zero
+	if !client.IsWithholdingTarget() {
+		return
+	}
+
+	amount := i.PaymentAmount
+	if len(i.Lines) > 0 {
+		amount = decimal.Zero
+		for _, line := range i.Lines {
+			amount = amount.Add(line.Amount)
+		}
+	}
+	i.WithholdingTax = calculateWithholdingTax(amount)
+}
+
+// calculateWithholdingTax は100万円以下の部分に10.21%、100万円を超える部分に20.42%を掛け、1円未満を切り捨てます
+func calculateWithholdingTax(amount decimal.Decimal) decimal.Decimal {
+	if amount.LessThanOrEqual(withholdingThreshold) {
+		return amount.Mul(withholdingRate).Truncate(0)
+	}
+
+	return withholdingThreshold.Mul(withholdingRate).
+		Add(amount.Sub(withholdingThreshold).Mul(withholdingHigherRate)).
+		Truncate(0)
+}
+
+// TransferAmount は支払金額から源泉徴収税額を差し引いた、取引先へ振り込む金額を返します
+func (i *Invoice) TransferAmount() decimal.Decimal {
+	return i.PaymentAmount.Sub(i.WithholdingTax)
+}
+
+// NetAmount は請求金額から返品・値引きによる減額を差し引いた、支払うべき金額を返します
+func (i *Invoice) NetAmount() decimal.Decimal {
+	return i.InvoiceAmount.Sub(i.CreditedAmount)
+}
+
+// OutstandingAmount は支払うべき金額のうち未払いの残高を返します
+func (i *Invoice) OutstandingAmount() decimal.Decimal {
+	return i.NetAmount().Sub(i.PaidAmount)
+}
+
+// ApplyPayment は支払額を支払済みの合計に加え、全額支払われた場合は支払済、それ以外は一部支払済にします
+func (i *Invoice) ApplyPayment(amount decimal.Decimal) {
+	i.PaidAmount = i.PaidAmount.Add(amount)
+	i.updatePaymentStatus()
+}
+
+// ApplyCreditNote は返品・値引きの減額を請求書に反映します。
+// 減額で残高がなくなった場合は支払済、支払を記録済みで残高が残る場合は一部支払済にします
+func (i *Invoice) ApplyCreditNote(creditNote *CreditNote) {
+	i.CreditedAmount = i.CreditedAmount.Add(creditNote.CreditAmount)
+	if i.OutstandingAmount().IsPositive() && !i.PaidAmount.IsPositive() {
+		// 支払がなく残高が残る場合は処理の状況を表すステータスのままにする
+		return
+	}
+	i.updatePaymentStatus()
+}
+
+func (i *Invoice) updatePaymentStatus() {
+	if i.OutstandingAmount().IsPositive() {
+		i.Status = value.InvoiceStatusPartiallyPaid
+	} else {
+		i.Status = value.InvoiceStatusPaid
+	}
+}
+
+// ApplyApproval は段階の判断を請求書のステータスに反映します。
+// 却下した場合は却下、最後の段階まで承認した場合は未処理（支払える状態）にし、それ以外は承認待ちのままにします
+func (i *Invoice) ApplyApproval(approvals []*InvoiceApproval) {
+	for _, approval := range approvals {
+		if approval.Status == value.ApprovalStatusRejected {
+			i.Status = value.InvoiceStatusRejected
+			return
+		}
+	}
+	if NextPendingApproval(approvals) == nil {
+		i.Status = value.InvoiceStatusUnprocessed
+	}
 }
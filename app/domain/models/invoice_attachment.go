@@ -0,0 +1,56 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"time"
+)
+
+// InvoiceAttachment は請求書に添付した取引先の原本（PDF やスキャンした画像）です
+type InvoiceAttachment struct {
+	ID          string
+	InvoiceID   string
+	FileName    string
+	ContentType value.AttachmentContentType
+	Size        int64
+	// SHA256 は内容の SHA-256 を16進数で表した値です。保存後に内容が変わっていないことの確認に使います
+	SHA256 string
+	// StorageKey は BlobStore で内容を保存しているキーです
+	StorageKey string
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+// InvoiceAttachmentStorageKey は請求書の添付ファイルを BlobStore に保存するキーです
+func InvoiceAttachmentStorageKey(invoiceID, attachmentID string) string {
+	return "invoices/" + invoiceID + "/attachments/" + attachmentID
+}
+
+func (a *InvoiceAttachment) ToDAO() *entities.InvoiceAttachment {
+	return &entities.InvoiceAttachment{
+		ID:          a.ID,
+		InvoiceID:   a.InvoiceID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		SHA256:      a.SHA256,
+		StorageKey:  a.StorageKey,
+		CreatedBy:   a.CreatedBy,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func InvoiceAttachmentFromDAO(daoAttachment *entities.InvoiceAttachment) *InvoiceAttachment {
+	return &InvoiceAttachment{
+		ID:          daoAttachment.ID,
+		InvoiceID:   daoAttachment.InvoiceID,
+		FileName:    daoAttachment.FileName,
+		ContentType: daoAttachment.ContentType,
+		Size:        daoAttachment.Size,
+		SHA256:      daoAttachment.SHA256,
+		StorageKey:  daoAttachment.StorageKey,
+		CreatedBy:   daoAttachment.CreatedBy,
+		CreatedAt:   daoAttachment.CreatedAt,
+	}
+}
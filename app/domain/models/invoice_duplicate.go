@@ -0,0 +1,79 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DuplicateRule は同じ請求書を取引先が二重に送ってきた疑いを判定する条件です。
+// 取引先と通貨が同じで、発行日が前後 WindowDays 日以内、支払金額の差が AmountTolerance の割合以内の請求書を疑いとします
+type DuplicateRule struct {
+	WindowDays      int
+	AmountTolerance decimal.Decimal
+}
+
+// IssueDateRange は重複の疑いを確認する発行日の範囲（両端を含む）を返します
+func (r DuplicateRule) IssueDateRange(issueDate time.Time) (time.Time, time.Time) {
+	return issueDate.AddDate(0, 0, -r.WindowDays), issueDate.AddDate(0, 0, r.WindowDays)
+}
+
+// PaymentAmountRange は重複の疑いを確認する支払金額の範囲（両端を含む）を返します
+func (r DuplicateRule) PaymentAmountRange(paymentAmount decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	tolerance := paymentAmount.Mul(r.AmountTolerance)
+	return paymentAmount.Sub(tolerance), paymentAmount.Add(tolerance)
+}
+
+// SuspectedDuplicates は candidates のうち invoice の重複の疑いのある請求書を、発行日、支払金額の近い順に返します
+func (r DuplicateRule) SuspectedDuplicates(invoice *Invoice, candidates []*Invoice) []*Invoice {
+	type suspect struct {
+		invoice    *Invoice
+		days       int
+		amountDiff decimal.Decimal
+	}
+	var suspects []suspect
+	for _, candidate := range candidates {
+		if candidate.ID == invoice.ID || candidate.ClientID != invoice.ClientID || candidate.Currency != invoice.Currency {
+			continue
+		}
+		days := daysBetween(invoice.IssueDate, candidate.IssueDate)
+		if days > r.WindowDays {
+			continue
+		}
+		amountDiff := invoice.PaymentAmount.Sub(candidate.PaymentAmount).Abs()
+		if amountDiff.GreaterThan(invoice.PaymentAmount.Mul(r.AmountTolerance)) {
+			continue
+		}
+		suspects = append(suspects, suspect{invoice: candidate, days: days, amountDiff: amountDiff})
+	}
+
+	sort.SliceStable(suspects, func(i, j int) bool {
+		if suspects[i].days != suspects[j].days {
+			return suspects[i].days < suspects[j].days
+		}
+		if !suspects[i].amountDiff.Equal(suspects[j].amountDiff) {
+			return suspects[i].amountDiff.LessThan(suspects[j].amountDiff)
+		}
+		return suspects[i].invoice.ID < suspects[j].invoice.ID
+	})
+	invoices := make([]*Invoice, len(suspects))
+	for i, s := range suspects {
+		invoices[i] = s.invoice
+	}
+
+	return invoices
+}
+
+// daysBetween は2つの日付の暦日の差（絶対値）を返します。保存した日付のタイムゾーンに関係なく年月日だけで比べます
+func daysBetween(a, b time.Time) int {
+	dateOf := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	days := int(dateOf(a).Sub(dateOf(b)).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+
+	return days
+}
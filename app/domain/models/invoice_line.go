@@ -0,0 +1,91 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// InvoiceLine は請求書の明細です
+type InvoiceLine struct {
+	ID        string
+	InvoiceID string
+	// LineNo は請求書での明細の順番です（1から）
+	LineNo      int
+	Description string
+	Quantity    decimal.Decimal
+	UnitPrice   decimal.Decimal
+	TaxCategory value.TaxCategory
+	// Amount は税抜きの金額です（数量 × 単価）
+	Amount    decimal.Decimal
+	CreatedAt time.Time
+}
+
+func (l *InvoiceLine) ToDAO() *entities.InvoiceLine {
+	return &entities.InvoiceLine{
+		ID:          l.ID,
+		InvoiceID:   l.InvoiceID,
+		LineNo:      l.LineNo,
+		Description: l.Description,
+		Quantity:    l.Quantity,
+		UnitPrice:   l.UnitPrice,
+		TaxCategory: l.TaxCategory,
+		Amount:      l.Amount,
+		CreatedAt:   l.CreatedAt,
+	}
+}
+
+func InvoiceLineFromDAO(daoLine *entities.InvoiceLine) *InvoiceLine {
+	return &InvoiceLine{
+		ID:          daoLine.ID,
+		InvoiceID:   daoLine.InvoiceID,
+		LineNo:      daoLine.LineNo,
+		Description: daoLine.Description,
+		Quantity:    daoLine.Quantity,
+		UnitPrice:   daoLine.UnitPrice,
+		TaxCategory: daoLine.TaxCategory,
+		Amount:      daoLine.Amount,
+		CreatedAt:   daoLine.CreatedAt,
+	}
+}
+
+// CalculateAmount は数量 × 単価を通貨の補助単位未満（円は1円未満）切り捨てで計算します
+func (l *InvoiceLine) CalculateAmount(currency value.Currency) {
+	l.Amount = value.NewMoney(l.Quantity.Mul(l.UnitPrice), currency).Truncate().Amount
+}
+
+// LineTax は税区分ごとの明細の合計と消費税です
+type LineTax struct {
+	TaxCategory value.TaxCategory
+	TaxRate     decimal.Decimal
+	// Amount は税区分の明細の税抜き合計です
+	Amount decimal.Decimal
+	Tax    decimal.Decimal
+}
+
+// SummarizeLineTaxes は明細を税区分ごとに合計し、区分ごとに1回だけ通貨の補助単位未満を切り捨てて消費税を計算します。
+// 明細のない税区分は含めません
+func SummarizeLineTaxes(lines []*InvoiceLine, currency value.Currency) []*LineTax {
+	amounts := make(map[value.TaxCategory]decimal.Decimal)
+	for _, line := range lines {
+		amounts[line.TaxCategory] = amounts[line.TaxCategory].Add(line.Amount)
+	}
+
+	var taxes []*LineTax
+	for _, category := range value.TaxCategories() {
+		amount, ok := amounts[category]
+		if !ok {
+			continue
+		}
+		taxes = append(taxes, &LineTax{
+			TaxCategory: category,
+			TaxRate:     category.Rate(),
+			Amount:      amount,
+			Tax:         value.NewMoney(amount.Mul(category.Rate()), currency).Truncate().Amount,
+		})
+	}
+
+	return taxes
+}
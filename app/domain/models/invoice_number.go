@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// InvoiceNumbering は会社の請求書番号の振り方です。連番は会計年度ごとに1から振り直します
+type InvoiceNumbering struct {
+	Format value.InvoiceNumberFormat
+	// FiscalYearStartMonth は会計年度の始まる月（1〜12）です
+	FiscalYearStartMonth int
+	// Version は振り方の楽観ロック用のバージョンです
+	Version int
+}
+
+// FiscalYear は date を含む会計年度を、年度の始まる日の年で返します。4月始まりの場合、2026年3月は2025年度です
+func (n InvoiceNumbering) FiscalYear(date time.Time) int {
+	if int(date.Month()) < n.FiscalYearStartMonth {
+		return date.Year() - 1
+	}
+
+	return date.Year()
+}
+
+// Number は会計年度 fiscalYear の seq 番目の請求書番号を返します
+func (n InvoiceNumbering) Number(fiscalYear int, seq int64) string {
+	return n.Format.Format(fiscalYear, seq)
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceSearchCondition は請求書一覧の検索条件です
+type InvoiceSearchCondition struct {
+	CompanyID          string
+	ClientID           string
+	Statuses           []value.InvoiceStatus
+	PaymentDueDateFrom *time.Time
+	PaymentDueDateTo   *time.Time
+	IssueDateFrom      *time.Time
+	IssueDateTo        *time.Time
+	// MinPaymentAmount と MaxPaymentAmount は通貨の異なる請求書を比べられるよう、支払金額の円換算額と比べます
+	MinPaymentAmount *decimal.Decimal
+	MaxPaymentAmount *decimal.Decimal
+	SortKey          value.InvoiceSortKey
+	SortOrder        value.SortOrder
+	Cursor           *value.InvoiceCursor
+	Offset           int
+	Limit            int
+}
+
+// InvoicePage は請求書一覧の1ページ分の結果です
+type InvoicePage struct {
+	Invoices   []*Invoice
+	NextCursor *value.InvoiceCursor
+	TotalCount int64
+}
+
+// SortValue はカーソルに格納するソートキーの値を返します
+func (i *Invoice) SortValue(key value.InvoiceSortKey) string {
+	switch key {
+	case value.InvoiceSortKeyIssueDate:
+		return i.IssueDate.Format(time.RFC3339Nano)
+	case value.InvoiceSortKeyPaymentAmount:
+		return i.PaymentAmountJPY.String()
+	case value.InvoiceSortKeyCreatedAt:
+		return i.CreatedAt.Format(time.RFC3339Nano)
+	default:
+		return i.PaymentDueDate.Format(time.RFC3339Nano)
+	}
+}
+
+// CursorAfter は請求書の直後から次ページを取得するためのカーソルを生成します
+func (i *Invoice) CursorAfter(key value.InvoiceSortKey, order value.SortOrder) *value.InvoiceCursor {
+	return &value.InvoiceCursor{
+		SortKey:   key,
+		SortOrder: order,
+		Value:     i.SortValue(key),
+		ID:        i.ID,
+	}
+}
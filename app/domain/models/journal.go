@@ -0,0 +1,263 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+)
+
+// JournalAccount は会社が仕訳に使う勘定科目の名前の設定です
+type JournalAccount struct {
+	ID        string
+	CompanyID string
+	Type      value.JournalAccountType
+	Name      string
+	CreatedAt time.Time
+}
+
+func (a *JournalAccount) ToDAO() *entities.JournalAccount {
+	return &entities.JournalAccount{
+		ID:        a.ID,
+		CompanyID: a.CompanyID,
+		Type:      a.Type,
+		Name:      a.Name,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func JournalAccountFromDAO(daoAccount *entities.JournalAccount) *JournalAccount {
+	return &JournalAccount{
+		ID:        daoAccount.ID,
+		CompanyID: daoAccount.CompanyID,
+		Type:      daoAccount.Type,
+		Name:      daoAccount.Name,
+		CreatedAt: daoAccount.CreatedAt,
+	}
+}
+
+// JournalAccounts は勘定科目の役割ごとの名前です
+type JournalAccounts map[value.JournalAccountType]string
+
+// NewJournalAccounts は会社の設定から、すべての役割の勘定科目の名前を返します。設定のない役割は既定の名前にします
+func NewJournalAccounts(accounts []*JournalAccount) JournalAccounts {
+	names := make(JournalAccounts)
+	for _, accountType := range value.JournalAccountTypes() {
+		names[accountType] = accountType.DefaultName()
+	}
+	for _, account := range accounts {
+		if account.Name != "" {
+			names[account.Type] = account.Name
+		}
+	}
+
+	return names
+}
+
+// Name は役割の勘定科目の名前を返します
+func (a JournalAccounts) Name(accountType value.JournalAccountType) string {
+	if name := a[accountType]; name != "" {
+		return name
+	}
+
+	return accountType.DefaultName()
+}
+
+// JournalEntry は1件の取引の仕訳です。借方・貸方が複数の科目になる複合仕訳は Lines に行を並べます
+type JournalEntry struct {
+	// No は書き出す仕訳の通し番号（1から）です
+	No          int
+	Date        time.Time
+	Description string
+	Lines       []*JournalLine
+}
+
+// JournalLine は仕訳の1行です。複合仕訳では借方・貸方の片方が nil の行があります
+type JournalLine struct {
+	Debit  *JournalPosting
+	Credit *JournalPosting
+}
+
+// JournalPosting は借方または貸方の科目と金額です。Amount は税込みの金額、Tax はそのうちの消費税です
+type JournalPosting struct {
+	Account     string
+	TaxCategory value.JournalTaxCategory
+	Amount      decimal.Decimal
+	Tax         decimal.Decimal
+}
+
+// JournalSource は期間の仕訳のもとになる取引です
+type JournalSource struct {
+	// Invoices は発行日が期間に入る請求書（明細を含む）です
+	Invoices []*Invoice
+	// CreditNotes は発行日が期間に入る返品・値引きで、CreditedInvoices はその元の請求書（明細を含む）を ID ごとに持ちます
+	CreditNotes      []*CreditNote
+	CreditedInvoices map[string]*Invoice
+	// Payments は支払日が期間に入る支払です
+	Payments []*Payment
+	// ClientNames は取引先名を請求書の ID ごとに持ちます
+	ClientNames map[string]string
+}
+
+// Entries は取引を日付の順に仕訳にし、通し番号を付けて返します。同じ日付は請求書・返品・値引き・支払の順です。
+//   - 請求書: 支払金額を費用、手数料と消費税を手数料の科目の借方に、請求金額を債務の貸方に計上します
+//   - 返品・値引き: 請求書の仕訳の逆で、減額する金額を債務の借方に、費用と手数料の貸方に計上します
+//   - 支払: 支払額を債務の借方と口座の貸方に計上します
+func (s *JournalSource) Entries(accounts JournalAccounts) []*JournalEntry {
+	entries := make([]*JournalEntry, 0, len(s.Invoices)+len(s.CreditNotes)+len(s.Payments))
+	for _, invoice := range s.Invoices {
+		entries = append(entries, invoiceJournalEntry(invoice, s.ClientNames[invoice.ID], accounts))
+	}
+	for _, creditNote := range s.CreditNotes {
+		entries = append(entries, creditNoteJournalEntry(creditNote, s.CreditedInvoices[creditNote.InvoiceID], s.ClientNames[creditNote.InvoiceID], accounts))
+	}
+	for _, payment := range s.Payments {
+		entries = append(entries, paymentJournalEntry(payment, s.ClientNames[payment.InvoiceID], accounts))
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+	for i, entry := range entries {
+		entry.No = i + 1
+	}
+
+	return entries
+}
+
+func invoiceJournalEntry(invoice *Invoice, clientName string, accounts JournalAccounts) *JournalEntry {
+	var debits []*JournalPosting
+	for _, expense := range invoiceExpenses(invoice) {
+		expense.Account = accounts.Name(value.JournalAccountExpense)
+		debits = append(debits, expense)
+	}
+	if fee := invoice.Fee.Add(invoice.Tax); fee.IsPositive() {
+		debits = append(debits, &JournalPosting{
+			Account:     accounts.Name(value.JournalAccountFee),
+			TaxCategory: journalTaxCategoryOfRate(invoice.TaxRate),
+			Amount:      fee,
+			Tax:         invoice.Tax,
+		})
+	}
+	credits := []*JournalPosting{{
+		Account:     accounts.Name(value.JournalAccountPayable),
+		TaxCategory: value.JournalTaxNone,
+		Amount:      invoice.InvoiceAmount,
+		Tax:         decimal.Zero,
+	}}
+
+	return newJournalEntry(invoice.IssueDate, clientName+" 請求書", debits, credits)
+}
+
+// invoiceExpenses は支払金額の円換算額を税区分ごとの費用にします。
+// 明細のない請求書は全額を標準税率の税込み金額とし、明細を円換算した端数は最後の税区分で調整します
+func invoiceExpenses(invoice *Invoice) []*JournalPosting {
+	if len(invoice.Lines) == 0 {
+		return []*JournalPosting{includedTaxPosting(value.JournalTaxPurchaseStandard, value.TaxCategoryStandard.Rate(), invoice.PaymentAmountJPY)}
+	}
+
+	taxes := SummarizeLineTaxes(invoice.Lines, invoice.Currency)
+	expenses := make([]*JournalPosting, len(taxes))
+	remaining := invoice.PaymentAmountJPY
+	for i, tax := range taxes {
+		amount := remaining
+		if i < len(taxes)-1 {
+			amount = value.NewMoney(tax.Amount.Add(tax.Tax), invoice.Currency).Convert(invoice.ExchangeRate, value.CurrencyJPY).Amount
+		}
+		remaining = remaining.Sub(amount)
+		expenses[i] = &JournalPosting{
+			TaxCategory: value.JournalTaxCategoryOf(tax.TaxCategory),
+			Amount:      amount,
+			Tax:         value.NewMoney(tax.Tax, invoice.Currency).Convert(invoice.ExchangeRate, value.CurrencyJPY).Amount,
+		}
+	}
+
+	return expenses
+}
+
+// creditNoteJournalEntry は減額する金額を元の請求書の税区分で費用から差し引きます。
+// 元の請求書の明細が複数の税区分にわたる場合は標準税率とします
+func creditNoteJournalEntry(creditNote *CreditNote, invoice *Invoice, clientName string, accounts JournalAccounts) *JournalEntry {
+	debits := []*JournalPosting{{
+		Account:     accounts.Name(value.JournalAccountPayable),
+		TaxCategory: value.JournalTaxNone,
+		Amount:      creditNote.CreditAmount,
+		Tax:         decimal.Zero,
+	}}
+
+	taxCategory := value.TaxCategoryStandard
+	if invoice != nil {
+		if taxes := SummarizeLineTaxes(invoice.Lines, invoice.Currency); len(taxes) == 1 {
+			taxCategory = taxes[0].TaxCategory
+		}
+	}
+	expense := includedTaxPosting(value.JournalTaxCategoryOf(taxCategory), taxCategory.Rate(), creditNote.PaymentAmount)
+	expense.Account = accounts.Name(value.JournalAccountExpense)
+	credits := []*JournalPosting{expense}
+	if fee := creditNote.Fee.Add(creditNote.Tax); fee.IsPositive() {
+		credits = append(credits, &JournalPosting{
+			Account:     accounts.Name(value.JournalAccountFee),
+			TaxCategory: journalTaxCategoryOfRate(creditNote.TaxRate),
+			Amount:      fee,
+			Tax:         creditNote.Tax,
+		})
+	}
+
+	return newJournalEntry(creditNote.IssueDate, clientName+" 返品・値引き", debits, credits)
+}
+
+func paymentJournalEntry(payment *Payment, clientName string, accounts JournalAccounts) *JournalEntry {
+	debits := []*JournalPosting{{
+		Account:     accounts.Name(value.JournalAccountPayable),
+		TaxCategory: value.JournalTaxNone,
+		Amount:      payment.Amount,
+		Tax:         decimal.Zero,
+	}}
+	credits := []*JournalPosting{{
+		Account:     accounts.Name(value.JournalAccountBank),
+		TaxCategory: value.JournalTaxNone,
+		Amount:      payment.Amount,
+		Tax:         decimal.Zero,
+	}}
+
+	return newJournalEntry(payment.PaidDate, clientName+" 支払", debits, credits)
+}
+
+// newJournalEntry は借方と貸方を上の行から順に並べた仕訳を返します
+func newJournalEntry(date time.Time, description string, debits, credits []*JournalPosting) *JournalEntry {
+	lines := make([]*JournalLine, max(len(debits), len(credits)))
+	for i := range lines {
+		lines[i] = &JournalLine{}
+		if i < len(debits) {
+			lines[i].Debit = debits[i]
+		}
+		if i < len(credits) {
+			lines[i].Credit = credits[i]
+		}
+	}
+
+	return &JournalEntry{Date: date, Description: description, Lines: lines}
+}
+
+// includedTaxPosting は税込み金額に含まれる消費税（1円未満切り捨て）を計算します
+func includedTaxPosting(taxCategory value.JournalTaxCategory, rate, amount decimal.Decimal) *JournalPosting {
+	return &JournalPosting{
+		TaxCategory: taxCategory,
+		Amount:      amount,
+		Tax:         amount.Mul(rate).Div(decimal.NewFromInt(1).Add(rate)).Truncate(0),
+	}
+}
+
+// journalTaxCategoryOfRate は手数料の消費税の税率に対応する仕訳の税区分を返します
+func journalTaxCategoryOfRate(rate decimal.Decimal) value.JournalTaxCategory {
+	switch {
+	case rate.IsZero():
+		return value.JournalTaxNone
+	case rate.Equal(value.TaxCategoryReduced.Rate()):
+		return value.JournalTaxPurchaseReduced
+	}
+
+	return value.JournalTaxPurchaseStandard
+}
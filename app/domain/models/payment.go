@@ -0,0 +1,45 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// Payment は請求書に対する支払の記録です
+type Payment struct {
+	ID        string
+	InvoiceID string
+	Amount    decimal.Decimal
+	PaidDate  time.Time
+	Method    value.PaymentMethod
+	// Reference は振込の受付番号など、外部で支払を特定するための参照番号です
+	Reference string
+	CreatedAt time.Time
+}
+
+func (p *Payment) ToDAO() *entities.Payment {
+	return &entities.Payment{
+		ID:        p.ID,
+		InvoiceID: p.InvoiceID,
+		Amount:    p.Amount,
+		PaidDate:  p.PaidDate,
+		Method:    p.Method,
+		Reference: p.Reference,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+func PaymentFromDAO(daoPayment *entities.Payment) *Payment {
+	return &Payment{
+		ID:        daoPayment.ID,
+		InvoiceID: daoPayment.InvoiceID,
+		Amount:    daoPayment.Amount,
+		PaidDate:  daoPayment.PaidDate,
+		Method:    daoPayment.Method,
+		Reference: daoPayment.Reference,
+		CreatedAt: daoPayment.CreatedAt,
+	}
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// recurringPeriodLayout は定期請求の期間（発行する月）の表記です
+const recurringPeriodLayout = "2006-01"
+
+// RecurringInvoice は毎月同じ金額で請求書を作成する定期請求のテンプレートです
+type RecurringInvoice struct {
+	ID            string
+	CompanyID     string
+	ClientID      string
+	PaymentAmount decimal.Decimal
+	Cadence       value.RecurrenceCadence
+	// DayOfMonth は周期が monthly の場合の発行日（1〜31）です
+	DayOfMonth int
+	// PaymentDueDays は発行日から支払期日までの日数です
+	PaymentDueDays int
+	StartDate      time.Time
+	// EndDate は最後に発行できる日です（nil の場合は終了しない）
+	EndDate *time.Time
+	// NextIssueDate は次に請求書を発行する日です
+	NextIssueDate time.Time
+	// CreatedBy は作成したユーザーで、請求書はこのユーザーとして作成します
+	CreatedBy string
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RecurringInvoiceRun は定期請求で期間ごとに1回だけ請求書を作成したことの記録です
+type RecurringInvoiceRun struct {
+	ID                 string
+	RecurringInvoiceID string
+	// Period は発行した月（YYYY-MM）です
+	Period    string
+	IssueDate time.Time
+	InvoiceID string
+	CreatedAt time.Time
+}
+
+func (r *RecurringInvoice) ToDAO() *entities.RecurringInvoice {
+	return &entities.RecurringInvoice{
+		ID:             r.ID,
+		CompanyID:      r.CompanyID,
+		ClientID:       r.ClientID,
+		PaymentAmount:  r.PaymentAmount,
+		Cadence:        r.Cadence,
+		DayOfMonth:     r.DayOfMonth,
+		PaymentDueDays: r.PaymentDueDays,
+		StartDate:      r.StartDate,
+		EndDate:        r.EndDate,
+		NextIssueDate:  r.NextIssueDate,
+		CreatedBy:      r.CreatedBy,
+		Version:        r.Version,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func RecurringInvoiceFromDAO(daoRecurringInvoice *entities.RecurringInvoice) *RecurringInvoice {
+	return &RecurringInvoice{
+		ID:             daoRecurringInvoice.ID,
+		CompanyID:      daoRecurringInvoice.CompanyID,
+		ClientID:       daoRecurringInvoice.ClientID,
+		PaymentAmount:  daoRecurringInvoice.PaymentAmount,
+		Cadence:        daoRecurringInvoice.Cadence,
+		DayOfMonth:     daoRecurringInvoice.DayOfMonth,
+		PaymentDueDays: daoRecurringInvoice.PaymentDueDays,
+		StartDate:      daoRecurringInvoice.StartDate,
+		EndDate:        daoRecurringInvoice.EndDate,
+		NextIssueDate:  daoRecurringInvoice.NextIssueDate,
+		CreatedBy:      daoRecurringInvoice.CreatedBy,
+		Version:        daoRecurringInvoice.Version,
+		CreatedAt:      daoRecurringInvoice.CreatedAt,
+		UpdatedAt:      daoRecurringInvoice.UpdatedAt,
+	}
+}
+
+func (r *RecurringInvoiceRun) ToDAO() *entities.RecurringInvoiceRun {
+	return &entities.RecurringInvoiceRun{
+		ID:                 r.ID,
+		RecurringInvoiceID: r.RecurringInvoiceID,
+		Period:             r.Period,
+		IssueDate:          r.IssueDate,
+		InvoiceID:          r.InvoiceID,
+		CreatedAt:          r.CreatedAt,
+	}
+}
+
+// IssueDateIn は year 年 month 月の発行日を返します
+func (r *RecurringInvoice) IssueDateIn(year int, month time.Month) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	day := lastDay
+	if r.Cadence == value.RecurrenceCadenceMonthly && r.DayOfMonth < lastDay {
+		day = r.DayOfMonth
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// IssueDateOnOrAfter は from 以降で最初の発行日を返します
+func (r *RecurringInvoice) IssueDateOnOrAfter(from time.Time) time.Time {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	issueDate := r.IssueDateIn(from.Year(), from.Month())
+	if issueDate.Before(from) {
+		issueDate = r.IssueDateIn(from.Year(), from.Month()+1)
+	}
+
+	return issueDate
+}
+
+// IssueDateAfter は issueDate の次の期間の発行日を返します
+func (r *RecurringInvoice) IssueDateAfter(issueDate time.Time) time.Time {
+	return r.IssueDateIn(issueDate.Year(), issueDate.Month()+1)
+}
+
+// PaymentDueDate は issueDate に発行する請求書の支払期日を返します
+func (r *RecurringInvoice) PaymentDueDate(issueDate time.Time) time.Time {
+	return issueDate.AddDate(0, 0, r.PaymentDueDays)
+}
+
+// IsEnded は issueDate が終了日より後かを判定します
+func (r *RecurringInvoice) IsEnded(issueDate time.Time) bool {
+	return r.EndDate != nil && issueDate.After(*r.EndDate)
+}
+
+// RecurringPeriod は issueDate が属する期間（YYYY-MM）を返します
+func RecurringPeriod(issueDate time.Time) string {
+	return issueDate.Format(recurringPeriodLayout)
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+)
+
+// ReportLocation はレポートで日・週・月を区切るタイムゾーン（Asia/Tokyo）です。
+// 日本は夏時間がないため、タイムゾーンデータベースのない環境でも使えるよう固定のオフセットにします
+var ReportLocation = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// ReportCondition はレポートの集計条件です。From と To は年月日だけを使い、指定しない場合はレポートごとの既定の期間にします
+type ReportCondition struct {
+	From   *time.Time
+	To     *time.Time
+	Period value.ReportPeriod
+	Limit  int
+}
+
+// ReportRange はレポートの集計期間 [From, To) です
+type ReportRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// NewReportRange は from の日から to の日まで（両端を含む）の日本時間の期間を返します。日付は年月日だけを使います
+func NewReportRange(from, to time.Time) ReportRange {
+	return ReportRange{From: reportDate(from), To: reportDate(to).AddDate(0, 0, 1)}
+}
+
+// LastDate は期間の最終日（To の前日）の日本時間の0時を返します
+func (r ReportRange) LastDate() time.Time {
+	return r.To.AddDate(0, 0, -1)
+}
+
+// reportDate は t の年月日の日本時間の0時を返します
+func reportDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, ReportLocation)
+}
+
+// StartOfReportPeriod は日本時間の t を含む週（月曜日始まり）または月の初日の0時を返します
+func StartOfReportPeriod(t time.Time, period value.ReportPeriod) time.Time {
+	date := reportDate(t.In(ReportLocation))
+	if period == value.ReportPeriodMonth {
+		return date.AddDate(0, 0, 1-date.Day())
+	}
+
+	// 日曜日（0）は前の週の7日目にする
+	return date.AddDate(0, 0, -(int(date.Weekday())+6)%7)
+}
+
+// NextReportPeriod は start の次の週または月の初日を返します
+func NextReportPeriod(start time.Time, period value.ReportPeriod) time.Time {
+	if period == value.ReportPeriodMonth {
+		return start.AddDate(0, 1, 0)
+	}
+
+	return start.AddDate(0, 0, 7)
+}
+
+// Buckets は期間を週または月ごとに区切った区間を返します。最初と最後の区間は期間の端を含む週・月の全体です
+func (r ReportRange) Buckets(period value.ReportPeriod) []ReportRange {
+	var buckets []ReportRange
+	for start := StartOfReportPeriod(r.From, period); start.Before(r.To); start = NextReportPeriod(start, period) {
+		buckets = append(buckets, ReportRange{From: start, To: NextReportPeriod(start, period)})
+	}
+
+	return buckets
+}
+
+// AmountDueReport は支払期日が区間に入る未払いの請求書の件数と残高です
+type AmountDueReport struct {
+	PeriodStart  time.Time
+	InvoiceCount int64
+	// OutstandingAmount は支払うべき金額（請求金額 - 減額の合計）のうち未払いの残高の合計です
+	OutstandingAmount decimal.Decimal
+}
+
+// StatusReport はステータスごとの請求書の件数と請求金額の合計です
+type StatusReport struct {
+	Status        value.InvoiceStatus
+	InvoiceCount  int64
+	InvoiceAmount decimal.Decimal
+}
+
+// ClientReport は取引先ごとの請求書の件数と支払うべき金額の合計です
+type ClientReport struct {
+	ClientID      string
+	CorporateName string
+	InvoiceCount  int64
+	// NetAmount は請求金額から返品・値引きによる減額を差し引いた金額の合計です
+	NetAmount decimal.Decimal
+}
+
+// FeeReport は区間に発行した請求書の手数料と手数料の消費税の合計です。
+// 同じ区間に発行した返品・値引きで減額した手数料と消費税を差し引きます
+type FeeReport struct {
+	PeriodStart  time.Time
+	InvoiceCount int64
+	Fee          decimal.Decimal
+	Tax          decimal.Decimal
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// RetentionPolicy はエンティティの種類ごとの、論理削除したデータの保存期間と処理方法です
+type RetentionPolicy struct {
+	EntityType value.EntityType
+	// RetentionClass は請求書の保存期間の区分です。請求書以外のポリシーでは空です
+	RetentionClass value.RetentionClass
+	Action         value.RetentionAction
+	// RetentionYears は保存する年数です。請求書は発行日から、それ以外は削除日から数えます
+	RetentionYears int
+	// GracePeriod は削除後に復元できる期間です。この期間中は保存期間を過ぎていても処理しません
+	GracePeriod time.Duration
+}
+
+// NewRetentionPolicies は保存期間を過ぎたデータを処理する順にポリシーを返します。
+// 請求書は保存期間の区分ごとに年数を分け、取引先は請求書から参照されるため物理削除せず、個人情報を消去します
+func NewRetentionPolicies(invoiceYears, invoiceExtendedYears, clientYears int, gracePeriod time.Duration) []*RetentionPolicy {
+	return []*RetentionPolicy{
+		{EntityType: value.EntityTypeInvoice, RetentionClass: value.RetentionClassStandard, Action: value.RetentionActionPurge, RetentionYears: invoiceYears, GracePeriod: gracePeriod},
+		{EntityType: value.EntityTypeInvoice, RetentionClass: value.RetentionClassExtended, Action: value.RetentionActionPurge, RetentionYears: invoiceExtendedYears, GracePeriod: gracePeriod},
+		{EntityType: value.EntityTypeClientBankAccount, Action: value.RetentionActionPurge, RetentionYears: clientYears, GracePeriod: gracePeriod},
+		{EntityType: value.EntityTypeClient, Action: value.RetentionActionAnonymize, RetentionYears: clientYears, GracePeriod: gracePeriod},
+	}
+}
+
+// RetainedUntil は now の時点で保存期間の起算日がこの日時以前なら保存期間を過ぎていることを表す日時を返します
+func (p *RetentionPolicy) RetainedUntil(now time.Time) time.Time {
+	return now.AddDate(-p.RetentionYears, 0, 0)
+}
+
+// DeletedBefore は now の時点で削除日時がこの日時以前なら復元できる期間を過ぎていることを表す日時を返します
+func (p *RetentionPolicy) DeletedBefore(now time.Time) time.Time {
+	return now.Add(-p.GracePeriod)
+}
+
+// CanRestore は deletedAt に削除したデータを now の時点で復元できるかを判定します
+func (p *RetentionPolicy) CanRestore(deletedAt, now time.Time) bool {
+	return now.Before(deletedAt.Add(p.GracePeriod))
+}
+
+// DeletedRecord は論理削除されたデータです
+type DeletedRecord struct {
+	EntityType value.EntityType
+	ID         string
+	CompanyID  string
+	DeletedAt  time.Time
+}
+
+// PurgeResult はポリシーごとの、保存期間を過ぎて処理したデータの件数です
+type PurgeResult struct {
+	EntityType     value.EntityType
+	RetentionClass value.RetentionClass
+	Action         value.RetentionAction
+	Rows           int64
+}
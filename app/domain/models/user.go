@@ -1,8 +1,10 @@
 package models
 
 import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
 
+	"log/slog"
 	"time"
 )
 
@@ -12,6 +14,7 @@ type User struct {
 	Name      string
 	Email     string
 	Password  string
+	Role      value.UserRole
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -23,6 +26,7 @@ func (u *User) ToDAO() *entities.User {
 		Name:      u.Name,
 		Email:     u.Email,
 		Password:  u.Password,
+		Role:      u.Role,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
 	}
@@ -35,7 +39,21 @@ func UserFromDAO(daoUser *entities.User) *User {
 		Name:      daoUser.Name,
 		Email:     daoUser.Email,
 		Password:  daoUser.Password,
+		Role:      daoUser.Role,
 		CreatedAt: daoUser.CreatedAt,
 		UpdatedAt: daoUser.UpdatedAt,
 	}
 }
+
+// IsAdmin は削除したデータの復元などの管理操作ができるユーザーかを判定します
+func (u *User) IsAdmin() bool {
+	return u.Role == value.UserRoleAdmin
+}
+
+// LogValue はパスワードを除いたログ出力用の値を返します
+func (u *User) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", u.ID),
+		slog.String("company_id", u.CompanyID),
+	)
+}
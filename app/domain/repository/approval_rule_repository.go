@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type ApprovalRuleRepository interface {
+	// FindByCompanyID は会社の承認フローを段階の順に返します
+	FindByCompanyID(db *gorm.DB, companyID string) ([]*models.ApprovalRule, error)
+	// FindVersionByCompanyID は会社の承認フローのバージョンを返します
+	FindVersionByCompanyID(db *gorm.DB, companyID string) (int, error)
+	// ReplaceByCompanyID は会社の承認フローを rules で置き換え、バージョンを1つ進めます。
+	// version が一致しない場合は *VersionConflictError を返します
+	ReplaceByCompanyID(db *gorm.DB, companyID string, rules []*models.ApprovalRule, version int) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// BankStatementRepository は取り込んだ入出金明細と、明細を消し込む請求書を読み書きします
+type BankStatementRepository interface {
+	// Create は明細のファイルと、その明細をまとめて保存します
+	Create(db *gorm.DB, statement *models.BankStatement) error
+	// FindFingerprints は会社の取り込み済みの明細のうち、Fingerprint が一致するものの Fingerprint を返します
+	FindFingerprints(db *gorm.DB, companyID string, fingerprints []string) (map[string]bool, error)
+	FindLineByID(db *gorm.DB, id string) (*models.BankStatementLine, error)
+	// SearchLines は会社の明細を取引日・取り込んだ順に返します
+	SearchLines(db *gorm.DB, companyID string, condition *models.BankStatementLineCondition) ([]*models.BankStatementLine, error)
+	// UpdateLine は明細の消し込みの状態を更新します。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdateLine(db *gorm.DB, line *models.BankStatementLine, version int) error
+	// FindReconciliationTargets は会社の支払を記録できる、未払いの残高がある請求書を取引先名・口座名義と合わせて返します
+	FindReconciliationTargets(db *gorm.DB, companyID string) ([]*models.ReconciliationTarget, error)
+}
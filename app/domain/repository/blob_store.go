@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound はキーの内容が BlobStore に保存されていないことを表します
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore は添付ファイルなどの内容をキーで保存します。
+// 大きなファイルをメモリーに読み込まないよう、内容はストリームで受け渡します
+type BlobStore interface {
+	// Put は body の size バイトを key に保存します。同じキーの内容は上書きします
+	Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error
+	// Get は key の内容を返します。呼び出し側で Close してください。保存されていない場合は ErrBlobNotFound を返します
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete は key の内容を削除します。保存されていない場合も成功します
+	Delete(ctx context.Context, key string) error
+}
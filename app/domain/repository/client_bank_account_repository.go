@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/ijufumi/practice-202512/app/domain/models"
 
 	"gorm.io/gorm"
@@ -9,4 +11,9 @@ import (
 type ClientBankAccountRepository interface {
 	Create(db *gorm.DB, account *models.ClientBankAccount) error
 	FindByID(db *gorm.DB, id string) (*models.ClientBankAccount, error)
+	FindByClientID(db *gorm.DB, clientID string) ([]*models.ClientBankAccount, error)
+	// FindByAccountNumber は口座番号が完全一致する口座をブラインドインデックスで検索します
+	FindByAccountNumber(db *gorm.DB, accountNumber string) ([]*models.ClientBankAccount, error)
+	// DeleteByClientID は取引先の銀行口座をすべて論理削除します
+	DeleteByClientID(db *gorm.DB, clientID string, deletedAt time.Time) error
 }
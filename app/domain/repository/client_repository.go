@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/ijufumi/practice-202512/app/domain/models"
 
 	"gorm.io/gorm"
@@ -9,4 +11,7 @@ import (
 type ClientRepository interface {
 	Create(db *gorm.DB, client *models.Client) error
 	FindByID(db *gorm.DB, id string) (*models.Client, error)
+	// Delete は取引先を論理削除します。
+	// version が一致しない場合は *VersionConflictError を返します
+	Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error
 }
@@ -9,4 +9,7 @@ import (
 type CompanyRepository interface {
 	Create(db *gorm.DB, company *models.Company) error
 	FindByID(db *gorm.DB, id string) (*models.Company, error)
+	// UpdateInvoiceNumbering は会社の請求書番号の書式と会計年度の始まる月を更新し、バージョンを1つ進めます。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdateInvoiceNumbering(db *gorm.DB, id string, numbering models.InvoiceNumbering, version int) error
 }
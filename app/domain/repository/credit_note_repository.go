@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type CreditNoteRepository interface {
+	Create(db *gorm.DB, creditNote *models.CreditNote) error
+	// FindByInvoiceID は元の請求書の返品・値引きを発行日の順に返します
+	FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.CreditNote, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import "fmt"
+
+// VersionConflictError は楽観ロックによる更新で、データが他の更新によって既に変更されていたことを表します
+type VersionConflictError struct {
+	Entity string
+	ID     string
+	// Version は更新時に指定した（古い）バージョンです
+	Version int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s %s has been modified since version %d", e.Entity, e.ID, e.Version)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+
+	"gorm.io/gorm"
+)
+
+type ExchangeRateRepository interface {
+	// SaveAll は為替レートを保存します。同じ通貨・日付のレートがある場合はレートを置き換えます
+	SaveAll(db *gorm.DB, rates []*models.ExchangeRate) error
+	// FindLatest は date 以前で最も新しい通貨のレートを返します。ない場合は gorm.ErrRecordNotFound を返します
+	FindLatest(db *gorm.DB, currency value.Currency, date time.Time) (*models.ExchangeRate, error)
+}
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+)
+
+type HealthRepository interface {
+	Ping(db *gorm.DB) error
+	CheckMigrations(db *gorm.DB) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type InvoiceApprovalRepository interface {
+	CreateAll(db *gorm.DB, approvals []*models.InvoiceApproval) error
+	// FindByInvoiceID は請求書に必要な承認を段階の順に返します
+	FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.InvoiceApproval, error)
+	// Decide は段階の判断（状態・判断したユーザー・コメント・日時）を保存します
+	Decide(db *gorm.DB, approval *models.InvoiceApproval) error
+}
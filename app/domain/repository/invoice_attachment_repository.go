@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type InvoiceAttachmentRepository interface {
+	Create(db *gorm.DB, attachment *models.InvoiceAttachment) error
+	// FindByID は請求書の添付ファイルを返します。他の請求書の添付ファイルは存在しない場合と同じく gorm.ErrRecordNotFound を返します
+	FindByID(db *gorm.DB, invoiceID, id string) (*models.InvoiceAttachment, error)
+	// FindByInvoiceID は請求書の添付ファイルを添付した順に返します
+	FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.InvoiceAttachment, error)
+	// FindStorageKeysByInvoiceIDs は請求書の添付ファイルの BlobStore のキーを返します
+	FindStorageKeysByInvoiceIDs(db *gorm.DB, invoiceIDs []string) ([]string, error)
+	Delete(db *gorm.DB, id string) error
+}
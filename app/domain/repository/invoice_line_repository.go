@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type InvoiceLineRepository interface {
+	CreateAll(db *gorm.DB, lines []*models.InvoiceLine) error
+	// FindByInvoiceID は請求書の明細を順番に返します
+	FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.InvoiceLine, error)
+}
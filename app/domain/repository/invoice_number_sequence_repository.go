@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+)
+
+type InvoiceNumberSequenceRepository interface {
+	// Next は会社の会計年度の連番を1つ進めて返します。最初の呼び出しは1です。
+	// 連番の行は db のトランザクションが終わるまでロックされ、トランザクションを取り消すと連番も元に戻ります
+	Next(db *gorm.DB, companyID string, fiscalYear int) (int64, error)
+}
@@ -1,13 +1,39 @@
 package repository
 
 import (
-	"github.com/ijufumi/practice-202512/app/domain/models"
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
 	"gorm.io/gorm"
 )
 
 type InvoiceRepository interface {
 	Create(db *gorm.DB, invoice *models.Invoice) error
-	FindByPaymentDueDateRange(db *gorm.DB, startDate, endDate *time.Time, offset, limit int) ([]*models.Invoice, error)
+	FindByID(db *gorm.DB, id string) (*models.Invoice, error)
+	// FindByNumber は会社の請求書を請求書番号で探します
+	FindByNumber(db *gorm.DB, companyID, invoiceNumber string) (*models.Invoice, error)
+	Search(db *gorm.DB, condition *models.InvoiceSearchCondition) ([]*models.Invoice, error)
+	Count(db *gorm.DB, condition *models.InvoiceSearchCondition) (int64, error)
+	// FindDuplicateCandidates は invoice と同じ会社・取引先・通貨で、発行日と支払金額が rule の範囲に入る請求書を件数の上限なく返します。
+	// invoice 自身は含みません
+	FindDuplicateCandidates(db *gorm.DB, invoice *models.Invoice, rule models.DuplicateRule) ([]*models.Invoice, error)
+	// UpdatePayment は支払済みの合計とステータスを更新します。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdatePayment(db *gorm.DB, invoice *models.Invoice, version int) error
+	// UpdateCredit は返品・値引きによる減額の合計とステータスを更新します。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdateCredit(db *gorm.DB, invoice *models.Invoice, version int) error
+	// UpdateStatus は承認フローの判断を反映したステータスを更新します。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdateStatus(db *gorm.DB, invoice *models.Invoice, version int) error
+	// UpdateRetentionClass は保存期間の区分を更新します。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdateRetentionClass(db *gorm.DB, invoice *models.Invoice, version int) error
+	// UpdateAttachments は添付ファイルの変更を記録し、バージョンを1つ進めます。
+	// version が一致しない場合は *VersionConflictError を返します
+	UpdateAttachments(db *gorm.DB, invoice *models.Invoice, version int) error
+	// Delete は請求書を論理削除します。
+	// version が一致しない場合は *VersionConflictError を返します
+	Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type JournalAccountRepository interface {
+	// FindByCompanyID は会社が設定した勘定科目の名前を返します
+	FindByCompanyID(db *gorm.DB, companyID string) ([]*models.JournalAccount, error)
+	// FindVersionByCompanyID は会社の勘定科目の名前の設定のバージョンを返します
+	FindVersionByCompanyID(db *gorm.DB, companyID string) (int, error)
+	// ReplaceByCompanyID は会社の勘定科目の名前の設定を accounts で置き換え、バージョンを1つ進めます。
+	// version が一致しない場合は *VersionConflictError を返します
+	ReplaceByCompanyID(db *gorm.DB, companyID string, accounts []*models.JournalAccount, version int) error
+}
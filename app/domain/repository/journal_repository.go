@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// JournalRepository は仕訳のもとになる会社の取引を読み込みます
+type JournalRepository interface {
+	// FindSource は期間の請求書・返品・値引き・支払をそれぞれ日付の順に返します。
+	// 請求書は承認待ち・却下を除いた確定したものだけを返します
+	FindSource(db *gorm.DB, companyID string, period models.ReportRange) (*models.JournalSource, error)
+}
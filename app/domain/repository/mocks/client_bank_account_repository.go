@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	mock "github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -94,6 +96,205 @@ func (_c *MockClientBankAccountRepository_Create_Call) RunAndReturn(run func(db
 	return _c
 }
 
+// DeleteByClientID provides a mock function for the type MockClientBankAccountRepository
+func (_mock *MockClientBankAccountRepository) DeleteByClientID(db *gorm.DB, clientID string, deletedAt time.Time) error {
+	ret := _mock.Called(db, clientID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByClientID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string, time.Time) error); ok {
+		r0 = returnFunc(db, clientID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientBankAccountRepository_DeleteByClientID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByClientID'
+type MockClientBankAccountRepository_DeleteByClientID_Call struct {
+	*mock.Call
+}
+
+// DeleteByClientID is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - clientID string
+//   - deletedAt time.Time
+func (_e *MockClientBankAccountRepository_Expecter) DeleteByClientID(db interface{}, clientID interface{}, deletedAt interface{}) *MockClientBankAccountRepository_DeleteByClientID_Call {
+	return &MockClientBankAccountRepository_DeleteByClientID_Call{Call: _e.mock.On("DeleteByClientID", db, clientID, deletedAt)}
+}
+
+func (_c *MockClientBankAccountRepository_DeleteByClientID_Call) Run(run func(db *gorm.DB, clientID string, deletedAt time.Time)) *MockClientBankAccountRepository_DeleteByClientID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientBankAccountRepository_DeleteByClientID_Call) Return(err error) *MockClientBankAccountRepository_DeleteByClientID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientBankAccountRepository_DeleteByClientID_Call) RunAndReturn(run func(db *gorm.DB, clientID string, deletedAt time.Time) error) *MockClientBankAccountRepository_DeleteByClientID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByAccountNumber provides a mock function for the type MockClientBankAccountRepository
+func (_mock *MockClientBankAccountRepository) FindByAccountNumber(db *gorm.DB, accountNumber string) ([]*models.ClientBankAccount, error) {
+	ret := _mock.Called(db, accountNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByAccountNumber")
+	}
+
+	var r0 []*models.ClientBankAccount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string) ([]*models.ClientBankAccount, error)); ok {
+		return returnFunc(db, accountNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string) []*models.ClientBankAccount); ok {
+		r0 = returnFunc(db, accountNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ClientBankAccount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, string) error); ok {
+		r1 = returnFunc(db, accountNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientBankAccountRepository_FindByAccountNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByAccountNumber'
+type MockClientBankAccountRepository_FindByAccountNumber_Call struct {
+	*mock.Call
+}
+
+// FindByAccountNumber is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - accountNumber string
+func (_e *MockClientBankAccountRepository_Expecter) FindByAccountNumber(db interface{}, accountNumber interface{}) *MockClientBankAccountRepository_FindByAccountNumber_Call {
+	return &MockClientBankAccountRepository_FindByAccountNumber_Call{Call: _e.mock.On("FindByAccountNumber", db, accountNumber)}
+}
+
+func (_c *MockClientBankAccountRepository_FindByAccountNumber_Call) Run(run func(db *gorm.DB, accountNumber string)) *MockClientBankAccountRepository_FindByAccountNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientBankAccountRepository_FindByAccountNumber_Call) Return(clientBankAccounts []*models.ClientBankAccount, err error) *MockClientBankAccountRepository_FindByAccountNumber_Call {
+	_c.Call.Return(clientBankAccounts, err)
+	return _c
+}
+
+func (_c *MockClientBankAccountRepository_FindByAccountNumber_Call) RunAndReturn(run func(db *gorm.DB, accountNumber string) ([]*models.ClientBankAccount, error)) *MockClientBankAccountRepository_FindByAccountNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByClientID provides a mock function for the type MockClientBankAccountRepository
+func (_mock *MockClientBankAccountRepository) FindByClientID(db *gorm.DB, clientID string) ([]*models.ClientBankAccount, error) {
+	ret := _mock.Called(db, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByClientID")
+	}
+
+	var r0 []*models.ClientBankAccount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string) ([]*models.ClientBankAccount, error)); ok {
+		return returnFunc(db, clientID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string) []*models.ClientBankAccount); ok {
+		r0 = returnFunc(db, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ClientBankAccount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, string) error); ok {
+		r1 = returnFunc(db, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientBankAccountRepository_FindByClientID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByClientID'
+type MockClientBankAccountRepository_FindByClientID_Call struct {
+	*mock.Call
+}
+
+// FindByClientID is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - clientID string
+func (_e *MockClientBankAccountRepository_Expecter) FindByClientID(db interface{}, clientID interface{}) *MockClientBankAccountRepository_FindByClientID_Call {
+	return &MockClientBankAccountRepository_FindByClientID_Call{Call: _e.mock.On("FindByClientID", db, clientID)}
+}
+
+func (_c *MockClientBankAccountRepository_FindByClientID_Call) Run(run func(db *gorm.DB, clientID string)) *MockClientBankAccountRepository_FindByClientID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientBankAccountRepository_FindByClientID_Call) Return(clientBankAccounts []*models.ClientBankAccount, err error) *MockClientBankAccountRepository_FindByClientID_Call {
+	_c.Call.Return(clientBankAccounts, err)
+	return _c
+}
+
+func (_c *MockClientBankAccountRepository_FindByClientID_Call) RunAndReturn(run func(db *gorm.DB, clientID string) ([]*models.ClientBankAccount, error)) *MockClientBankAccountRepository_FindByClientID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function for the type MockClientBankAccountRepository
 func (_mock *MockClientBankAccountRepository) FindByID(db *gorm.DB, id string) (*models.ClientBankAccount, error) {
 	ret := _mock.Called(db, id)
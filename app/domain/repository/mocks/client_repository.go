@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	mock "github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -94,6 +96,75 @@ func (_c *MockClientRepository_Create_Call) RunAndReturn(run func(db *gorm.DB, c
 	return _c
 }
 
+// Delete provides a mock function for the type MockClientRepository
+func (_mock *MockClientRepository) Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error {
+	ret := _mock.Called(db, id, version, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string, int, time.Time) error); ok {
+		r0 = returnFunc(db, id, version, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClientRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockClientRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - id string
+//   - version int
+//   - deletedAt time.Time
+func (_e *MockClientRepository_Expecter) Delete(db interface{}, id interface{}, version interface{}, deletedAt interface{}) *MockClientRepository_Delete_Call {
+	return &MockClientRepository_Delete_Call{Call: _e.mock.On("Delete", db, id, version, deletedAt)}
+}
+
+func (_c *MockClientRepository_Delete_Call) Run(run func(db *gorm.DB, id string, version int, deletedAt time.Time)) *MockClientRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientRepository_Delete_Call) Return(err error) *MockClientRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClientRepository_Delete_Call) RunAndReturn(run func(db *gorm.DB, id string, version int, deletedAt time.Time) error) *MockClientRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function for the type MockClientRepository
 func (_mock *MockClientRepository) FindByID(db *gorm.DB, id string) (*models.Client, error) {
 	ret := _mock.Called(db, id)
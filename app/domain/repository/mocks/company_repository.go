@@ -161,3 +161,72 @@ func (_c *MockCompanyRepository_FindByID_Call) RunAndReturn(run func(db *gorm.DB
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateInvoiceNumbering provides a mock function for the type MockCompanyRepository
+func (_mock *MockCompanyRepository) UpdateInvoiceNumbering(db *gorm.DB, id string, numbering models.InvoiceNumbering, version int) error {
+	ret := _mock.Called(db, id, numbering, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInvoiceNumbering")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string, models.InvoiceNumbering, int) error); ok {
+		r0 = returnFunc(db, id, numbering, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCompanyRepository_UpdateInvoiceNumbering_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInvoiceNumbering'
+type MockCompanyRepository_UpdateInvoiceNumbering_Call struct {
+	*mock.Call
+}
+
+// UpdateInvoiceNumbering is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - id string
+//   - numbering models.InvoiceNumbering
+//   - version int
+func (_e *MockCompanyRepository_Expecter) UpdateInvoiceNumbering(db interface{}, id interface{}, numbering interface{}, version interface{}) *MockCompanyRepository_UpdateInvoiceNumbering_Call {
+	return &MockCompanyRepository_UpdateInvoiceNumbering_Call{Call: _e.mock.On("UpdateInvoiceNumbering", db, id, numbering, version)}
+}
+
+func (_c *MockCompanyRepository_UpdateInvoiceNumbering_Call) Run(run func(db *gorm.DB, id string, numbering models.InvoiceNumbering, version int)) *MockCompanyRepository_UpdateInvoiceNumbering_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 models.InvoiceNumbering
+		if args[2] != nil {
+			arg2 = args[2].(models.InvoiceNumbering)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCompanyRepository_UpdateInvoiceNumbering_Call) Return(err error) *MockCompanyRepository_UpdateInvoiceNumbering_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCompanyRepository_UpdateInvoiceNumbering_Call) RunAndReturn(run func(db *gorm.DB, id string, numbering models.InvoiceNumbering, version int) error) *MockCompanyRepository_UpdateInvoiceNumbering_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,6 +39,72 @@ func (_m *MockInvoiceRepository) EXPECT() *MockInvoiceRepository_Expecter {
 	return &MockInvoiceRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) Count(db *gorm.DB, condition *models.InvoiceSearchCondition) (int64, error) {
+	ret := _mock.Called(db, condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.InvoiceSearchCondition) (int64, error)); ok {
+		return returnFunc(db, condition)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.InvoiceSearchCondition) int64); ok {
+		r0 = returnFunc(db, condition)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, *models.InvoiceSearchCondition) error); ok {
+		r1 = returnFunc(db, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockInvoiceRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - condition *models.InvoiceSearchCondition
+func (_e *MockInvoiceRepository_Expecter) Count(db interface{}, condition interface{}) *MockInvoiceRepository_Count_Call {
+	return &MockInvoiceRepository_Count_Call{Call: _e.mock.On("Count", db, condition)}
+}
+
+func (_c *MockInvoiceRepository_Count_Call) Run(run func(db *gorm.DB, condition *models.InvoiceSearchCondition)) *MockInvoiceRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 *models.InvoiceSearchCondition
+		if args[1] != nil {
+			arg1 = args[1].(*models.InvoiceSearchCondition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_Count_Call) Return(n int64, err error) *MockInvoiceRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_Count_Call) RunAndReturn(run func(db *gorm.DB, condition *models.InvoiceSearchCondition) (int64, error)) *MockInvoiceRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockInvoiceRepository
 func (_mock *MockInvoiceRepository) Create(db *gorm.DB, invoice *models.Invoice) error {
 	ret := _mock.Called(db, invoice)
@@ -96,88 +162,670 @@ func (_c *MockInvoiceRepository_Create_Call) RunAndReturn(run func(db *gorm.DB,
 	return _c
 }
 
-// FindByPaymentDueDateRange provides a mock function for the type MockInvoiceRepository
-func (_mock *MockInvoiceRepository) FindByPaymentDueDateRange(db *gorm.DB, startDate *time.Time, endDate *time.Time, offset int, limit int) ([]*models.Invoice, error) {
-	ret := _mock.Called(db, startDate, endDate, offset, limit)
+// Delete provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error {
+	ret := _mock.Called(db, id, version, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string, int, time.Time) error); ok {
+		r0 = returnFunc(db, id, version, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockInvoiceRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - id string
+//   - version int
+//   - deletedAt time.Time
+func (_e *MockInvoiceRepository_Expecter) Delete(db interface{}, id interface{}, version interface{}, deletedAt interface{}) *MockInvoiceRepository_Delete_Call {
+	return &MockInvoiceRepository_Delete_Call{Call: _e.mock.On("Delete", db, id, version, deletedAt)}
+}
+
+func (_c *MockInvoiceRepository_Delete_Call) Run(run func(db *gorm.DB, id string, version int, deletedAt time.Time)) *MockInvoiceRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_Delete_Call) Return(err error) *MockInvoiceRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_Delete_Call) RunAndReturn(run func(db *gorm.DB, id string, version int, deletedAt time.Time) error) *MockInvoiceRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) FindByID(db *gorm.DB, id string) (*models.Invoice, error) {
+	ret := _mock.Called(db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByPaymentDueDateRange")
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *models.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string) (*models.Invoice, error)); ok {
+		return returnFunc(db, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string) *models.Invoice); ok {
+		r0 = returnFunc(db, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, string) error); ok {
+		r1 = returnFunc(db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockInvoiceRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - id string
+func (_e *MockInvoiceRepository_Expecter) FindByID(db interface{}, id interface{}) *MockInvoiceRepository_FindByID_Call {
+	return &MockInvoiceRepository_FindByID_Call{Call: _e.mock.On("FindByID", db, id)}
+}
+
+func (_c *MockInvoiceRepository_FindByID_Call) Run(run func(db *gorm.DB, id string)) *MockInvoiceRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_FindByID_Call) Return(invoice *models.Invoice, err error) *MockInvoiceRepository_FindByID_Call {
+	_c.Call.Return(invoice, err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_FindByID_Call) RunAndReturn(run func(db *gorm.DB, id string) (*models.Invoice, error)) *MockInvoiceRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByNumber provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) FindByNumber(db *gorm.DB, companyID string, invoiceNumber string) (*models.Invoice, error) {
+	ret := _mock.Called(db, companyID, invoiceNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByNumber")
+	}
+
+	var r0 *models.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string, string) (*models.Invoice, error)); ok {
+		return returnFunc(db, companyID, invoiceNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, string, string) *models.Invoice); ok {
+		r0 = returnFunc(db, companyID, invoiceNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, string, string) error); ok {
+		r1 = returnFunc(db, companyID, invoiceNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceRepository_FindByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByNumber'
+type MockInvoiceRepository_FindByNumber_Call struct {
+	*mock.Call
+}
+
+// FindByNumber is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - companyID string
+//   - invoiceNumber string
+func (_e *MockInvoiceRepository_Expecter) FindByNumber(db interface{}, companyID interface{}, invoiceNumber interface{}) *MockInvoiceRepository_FindByNumber_Call {
+	return &MockInvoiceRepository_FindByNumber_Call{Call: _e.mock.On("FindByNumber", db, companyID, invoiceNumber)}
+}
+
+func (_c *MockInvoiceRepository_FindByNumber_Call) Run(run func(db *gorm.DB, companyID string, invoiceNumber string)) *MockInvoiceRepository_FindByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_FindByNumber_Call) Return(invoice *models.Invoice, err error) *MockInvoiceRepository_FindByNumber_Call {
+	_c.Call.Return(invoice, err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_FindByNumber_Call) RunAndReturn(run func(db *gorm.DB, companyID string, invoiceNumber string) (*models.Invoice, error)) *MockInvoiceRepository_FindByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicateCandidates provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) FindDuplicateCandidates(db *gorm.DB, invoice *models.Invoice, rule models.DuplicateRule) ([]*models.Invoice, error) {
+	ret := _mock.Called(db, invoice, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicateCandidates")
 	}
 
 	var r0 []*models.Invoice
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *time.Time, *time.Time, int, int) ([]*models.Invoice, error)); ok {
-		return returnFunc(db, startDate, endDate, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, models.DuplicateRule) ([]*models.Invoice, error)); ok {
+		return returnFunc(db, invoice, rule)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *time.Time, *time.Time, int, int) []*models.Invoice); ok {
-		r0 = returnFunc(db, startDate, endDate, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, models.DuplicateRule) []*models.Invoice); ok {
+		r0 = returnFunc(db, invoice, rule)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Invoice)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, *time.Time, *time.Time, int, int) error); ok {
-		r1 = returnFunc(db, startDate, endDate, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, *models.Invoice, models.DuplicateRule) error); ok {
+		r1 = returnFunc(db, invoice, rule)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockInvoiceRepository_FindByPaymentDueDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPaymentDueDateRange'
-type MockInvoiceRepository_FindByPaymentDueDateRange_Call struct {
+// MockInvoiceRepository_FindDuplicateCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicateCandidates'
+type MockInvoiceRepository_FindDuplicateCandidates_Call struct {
 	*mock.Call
 }
 
-// FindByPaymentDueDateRange is a helper method to define mock.On call
+// FindDuplicateCandidates is a helper method to define mock.On call
 //   - db *gorm.DB
-//   - startDate *time.Time
-//   - endDate *time.Time
-//   - offset int
-//   - limit int
-func (_e *MockInvoiceRepository_Expecter) FindByPaymentDueDateRange(db interface{}, startDate interface{}, endDate interface{}, offset interface{}, limit interface{}) *MockInvoiceRepository_FindByPaymentDueDateRange_Call {
-	return &MockInvoiceRepository_FindByPaymentDueDateRange_Call{Call: _e.mock.On("FindByPaymentDueDateRange", db, startDate, endDate, offset, limit)}
+//   - invoice *models.Invoice
+//   - rule models.DuplicateRule
+func (_e *MockInvoiceRepository_Expecter) FindDuplicateCandidates(db interface{}, invoice interface{}, rule interface{}) *MockInvoiceRepository_FindDuplicateCandidates_Call {
+	return &MockInvoiceRepository_FindDuplicateCandidates_Call{Call: _e.mock.On("FindDuplicateCandidates", db, invoice, rule)}
 }
 
-func (_c *MockInvoiceRepository_FindByPaymentDueDateRange_Call) Run(run func(db *gorm.DB, startDate *time.Time, endDate *time.Time, offset int, limit int)) *MockInvoiceRepository_FindByPaymentDueDateRange_Call {
+func (_c *MockInvoiceRepository_FindDuplicateCandidates_Call) Run(run func(db *gorm.DB, invoice *models.Invoice, rule models.DuplicateRule)) *MockInvoiceRepository_FindDuplicateCandidates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *gorm.DB
 		if args[0] != nil {
 			arg0 = args[0].(*gorm.DB)
 		}
-		var arg1 *time.Time
+		var arg1 *models.Invoice
 		if args[1] != nil {
-			arg1 = args[1].(*time.Time)
+			arg1 = args[1].(*models.Invoice)
 		}
-		var arg2 *time.Time
+		var arg2 models.DuplicateRule
 		if args[2] != nil {
-			arg2 = args[2].(*time.Time)
+			arg2 = args[2].(models.DuplicateRule)
 		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_FindDuplicateCandidates_Call) Return(invoices []*models.Invoice, err error) *MockInvoiceRepository_FindDuplicateCandidates_Call {
+	_c.Call.Return(invoices, err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_FindDuplicateCandidates_Call) RunAndReturn(run func(db *gorm.DB, invoice *models.Invoice, rule models.DuplicateRule) ([]*models.Invoice, error)) *MockInvoiceRepository_FindDuplicateCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) Search(db *gorm.DB, condition *models.InvoiceSearchCondition) ([]*models.Invoice, error) {
+	ret := _mock.Called(db, condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*models.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.InvoiceSearchCondition) ([]*models.Invoice, error)); ok {
+		return returnFunc(db, condition)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.InvoiceSearchCondition) []*models.Invoice); ok {
+		r0 = returnFunc(db, condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*gorm.DB, *models.InvoiceSearchCondition) error); ok {
+		r1 = returnFunc(db, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockInvoiceRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - condition *models.InvoiceSearchCondition
+func (_e *MockInvoiceRepository_Expecter) Search(db interface{}, condition interface{}) *MockInvoiceRepository_Search_Call {
+	return &MockInvoiceRepository_Search_Call{Call: _e.mock.On("Search", db, condition)}
+}
+
+func (_c *MockInvoiceRepository_Search_Call) Run(run func(db *gorm.DB, condition *models.InvoiceSearchCondition)) *MockInvoiceRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
 		}
-		var arg4 int
-		if args[4] != nil {
-			arg4 = args[4].(int)
+		var arg1 *models.InvoiceSearchCondition
+		if args[1] != nil {
+			arg1 = args[1].(*models.InvoiceSearchCondition)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInvoiceRepository_FindByPaymentDueDateRange_Call) Return(invoices []*models.Invoice, err error) *MockInvoiceRepository_FindByPaymentDueDateRange_Call {
+func (_c *MockInvoiceRepository_Search_Call) Return(invoices []*models.Invoice, err error) *MockInvoiceRepository_Search_Call {
 	_c.Call.Return(invoices, err)
 	return _c
 }
 
-func (_c *MockInvoiceRepository_FindByPaymentDueDateRange_Call) RunAndReturn(run func(db *gorm.DB, startDate *time.Time, endDate *time.Time, offset int, limit int) ([]*models.Invoice, error)) *MockInvoiceRepository_FindByPaymentDueDateRange_Call {
+func (_c *MockInvoiceRepository_Search_Call) RunAndReturn(run func(db *gorm.DB, condition *models.InvoiceSearchCondition) ([]*models.Invoice, error)) *MockInvoiceRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAttachments provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) UpdateAttachments(db *gorm.DB, invoice *models.Invoice, version int) error {
+	ret := _mock.Called(db, invoice, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAttachments")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, int) error); ok {
+		r0 = returnFunc(db, invoice, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceRepository_UpdateAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAttachments'
+type MockInvoiceRepository_UpdateAttachments_Call struct {
+	*mock.Call
+}
+
+// UpdateAttachments is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - invoice *models.Invoice
+//   - version int
+func (_e *MockInvoiceRepository_Expecter) UpdateAttachments(db interface{}, invoice interface{}, version interface{}) *MockInvoiceRepository_UpdateAttachments_Call {
+	return &MockInvoiceRepository_UpdateAttachments_Call{Call: _e.mock.On("UpdateAttachments", db, invoice, version)}
+}
+
+func (_c *MockInvoiceRepository_UpdateAttachments_Call) Run(run func(db *gorm.DB, invoice *models.Invoice, version int)) *MockInvoiceRepository_UpdateAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 *models.Invoice
+		if args[1] != nil {
+			arg1 = args[1].(*models.Invoice)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateAttachments_Call) Return(err error) *MockInvoiceRepository_UpdateAttachments_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateAttachments_Call) RunAndReturn(run func(db *gorm.DB, invoice *models.Invoice, version int) error) *MockInvoiceRepository_UpdateAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCredit provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) UpdateCredit(db *gorm.DB, invoice *models.Invoice, version int) error {
+	ret := _mock.Called(db, invoice, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCredit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, int) error); ok {
+		r0 = returnFunc(db, invoice, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceRepository_UpdateCredit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCredit'
+type MockInvoiceRepository_UpdateCredit_Call struct {
+	*mock.Call
+}
+
+// UpdateCredit is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - invoice *models.Invoice
+//   - version int
+func (_e *MockInvoiceRepository_Expecter) UpdateCredit(db interface{}, invoice interface{}, version interface{}) *MockInvoiceRepository_UpdateCredit_Call {
+	return &MockInvoiceRepository_UpdateCredit_Call{Call: _e.mock.On("UpdateCredit", db, invoice, version)}
+}
+
+func (_c *MockInvoiceRepository_UpdateCredit_Call) Run(run func(db *gorm.DB, invoice *models.Invoice, version int)) *MockInvoiceRepository_UpdateCredit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 *models.Invoice
+		if args[1] != nil {
+			arg1 = args[1].(*models.Invoice)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateCredit_Call) Return(err error) *MockInvoiceRepository_UpdateCredit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateCredit_Call) RunAndReturn(run func(db *gorm.DB, invoice *models.Invoice, version int) error) *MockInvoiceRepository_UpdateCredit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePayment provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) UpdatePayment(db *gorm.DB, invoice *models.Invoice, version int) error {
+	ret := _mock.Called(db, invoice, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePayment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, int) error); ok {
+		r0 = returnFunc(db, invoice, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceRepository_UpdatePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePayment'
+type MockInvoiceRepository_UpdatePayment_Call struct {
+	*mock.Call
+}
+
+// UpdatePayment is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - invoice *models.Invoice
+//   - version int
+func (_e *MockInvoiceRepository_Expecter) UpdatePayment(db interface{}, invoice interface{}, version interface{}) *MockInvoiceRepository_UpdatePayment_Call {
+	return &MockInvoiceRepository_UpdatePayment_Call{Call: _e.mock.On("UpdatePayment", db, invoice, version)}
+}
+
+func (_c *MockInvoiceRepository_UpdatePayment_Call) Run(run func(db *gorm.DB, invoice *models.Invoice, version int)) *MockInvoiceRepository_UpdatePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 *models.Invoice
+		if args[1] != nil {
+			arg1 = args[1].(*models.Invoice)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdatePayment_Call) Return(err error) *MockInvoiceRepository_UpdatePayment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdatePayment_Call) RunAndReturn(run func(db *gorm.DB, invoice *models.Invoice, version int) error) *MockInvoiceRepository_UpdatePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRetentionClass provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) UpdateRetentionClass(db *gorm.DB, invoice *models.Invoice, version int) error {
+	ret := _mock.Called(db, invoice, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRetentionClass")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, int) error); ok {
+		r0 = returnFunc(db, invoice, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceRepository_UpdateRetentionClass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRetentionClass'
+type MockInvoiceRepository_UpdateRetentionClass_Call struct {
+	*mock.Call
+}
+
+// UpdateRetentionClass is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - invoice *models.Invoice
+//   - version int
+func (_e *MockInvoiceRepository_Expecter) UpdateRetentionClass(db interface{}, invoice interface{}, version interface{}) *MockInvoiceRepository_UpdateRetentionClass_Call {
+	return &MockInvoiceRepository_UpdateRetentionClass_Call{Call: _e.mock.On("UpdateRetentionClass", db, invoice, version)}
+}
+
+func (_c *MockInvoiceRepository_UpdateRetentionClass_Call) Run(run func(db *gorm.DB, invoice *models.Invoice, version int)) *MockInvoiceRepository_UpdateRetentionClass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 *models.Invoice
+		if args[1] != nil {
+			arg1 = args[1].(*models.Invoice)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateRetentionClass_Call) Return(err error) *MockInvoiceRepository_UpdateRetentionClass_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateRetentionClass_Call) RunAndReturn(run func(db *gorm.DB, invoice *models.Invoice, version int) error) *MockInvoiceRepository_UpdateRetentionClass_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) UpdateStatus(db *gorm.DB, invoice *models.Invoice, version int) error {
+	ret := _mock.Called(db, invoice, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB, *models.Invoice, int) error); ok {
+		r0 = returnFunc(db, invoice, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockInvoiceRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - db *gorm.DB
+//   - invoice *models.Invoice
+//   - version int
+func (_e *MockInvoiceRepository_Expecter) UpdateStatus(db interface{}, invoice interface{}, version interface{}) *MockInvoiceRepository_UpdateStatus_Call {
+	return &MockInvoiceRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", db, invoice, version)}
+}
+
+func (_c *MockInvoiceRepository_UpdateStatus_Call) Run(run func(db *gorm.DB, invoice *models.Invoice, version int)) *MockInvoiceRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		var arg1 *models.Invoice
+		if args[1] != nil {
+			arg1 = args[1].(*models.Invoice)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateStatus_Call) Return(err error) *MockInvoiceRepository_UpdateStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_UpdateStatus_Call) RunAndReturn(run func(db *gorm.DB, invoice *models.Invoice, version int) error) *MockInvoiceRepository_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type PaymentRepository interface {
+	Create(db *gorm.DB, payment *models.Payment) error
+	// FindByInvoiceID は請求書の支払を支払日の順に返します
+	FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.Payment, error)
+}
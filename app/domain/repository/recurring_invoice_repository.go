@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type RecurringInvoiceRepository interface {
+	Create(db *gorm.DB, recurringInvoice *models.RecurringInvoice) error
+	FindByID(db *gorm.DB, id string) (*models.RecurringInvoice, error)
+	FindByCompanyID(db *gorm.DB, companyID string) ([]*models.RecurringInvoice, error)
+	// FindDue は date までに発行日が来た、終了していない定期請求を発行日の古い順に最大 limit 件返します
+	FindDue(db *gorm.DB, date time.Time, limit int) ([]*models.RecurringInvoice, error)
+	// Update は定期請求の内容と次の発行日を更新します。
+	// version が一致しない場合は *VersionConflictError を返します
+	Update(db *gorm.DB, recurringInvoice *models.RecurringInvoice, version int) error
+	// Delete は定期請求を論理削除します。
+	// version が一致しない場合は *VersionConflictError を返します
+	Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error
+	// ExistsRun は定期請求の period の請求書を作成済みかを判定します
+	ExistsRun(db *gorm.DB, recurringInvoiceID string, period string) (bool, error)
+	// CreateRun は請求書を作成したことを記録します。同じ期間の記録がある場合はエラーを返します
+	CreateRun(db *gorm.DB, run *models.RecurringInvoiceRun) error
+}
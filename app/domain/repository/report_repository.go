@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// ReportRepository は会社の請求書を集計します。金額はすべて円建ての列を集計し、ステータスごとの件数以外は却下した請求書を含めません
+type ReportRepository interface {
+	// SumAmountDueByPeriod は支払期日が区間に入る未払い残高のある請求書を区間ごとに集計し、区間の順に返します（請求書のない区間は0件）
+	SumAmountDueByPeriod(db *gorm.DB, companyID string, buckets []models.ReportRange) ([]*models.AmountDueReport, error)
+	// CountByStatus は発行日が期間に入る請求書をステータスごとに集計します（請求書のないステータスは含めません）
+	CountByStatus(db *gorm.DB, companyID string, period models.ReportRange) ([]*models.StatusReport, error)
+	// SumByClient は発行日が期間に入る請求書を取引先ごとに集計し、支払うべき金額の合計の多い順に limit 件返します
+	SumByClient(db *gorm.DB, companyID string, period models.ReportRange, limit int) ([]*models.ClientReport, error)
+	// SumFeesByPeriod は区間に発行した請求書と返品・値引きの手数料を区間ごとに集計し、区間の順に返します（請求書のない区間は0件）
+	SumFeesByPeriod(db *gorm.DB, companyID string, buckets []models.ReportRange) ([]*models.FeeReport, error)
+}
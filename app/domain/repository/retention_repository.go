@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+
+	"gorm.io/gorm"
+)
+
+// RetentionRepository は論理削除したデータの復元と、保存期間を過ぎたデータの処理を行います
+type RetentionRepository interface {
+	// FindDeleted は論理削除された請求書または取引先を返します
+	FindDeleted(db *gorm.DB, entityType value.EntityType, id string) (*models.DeletedRecord, error)
+	// Restore は論理削除されたデータを復元します。取引先は同時に削除した銀行口座も復元します
+	Restore(db *gorm.DB, record *models.DeletedRecord) error
+	// FindExpired は policy の保存期間と復元できる期間を過ぎた、未処理のデータのIDを返します
+	FindExpired(db *gorm.DB, policy *models.RetentionPolicy, now time.Time, limit int) ([]string, error)
+	// Purge はデータを物理削除し、削除した件数を返します
+	Purge(db *gorm.DB, entityType value.EntityType, ids []string) (int64, error)
+	// Anonymize はデータの個人情報を消去し、処理した件数を返します
+	Anonymize(db *gorm.DB, entityType value.EntityType, ids []string, now time.Time) (int64, error)
+}
@@ -0,0 +1,118 @@
+package statement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+)
+
+// CSV の列。date と amount は必須で、name・description・reference は省略できます
+const (
+	csvDate        = "date"
+	csvAmount      = "amount"
+	csvName        = "name"
+	csvDescription = "description"
+	csvReference   = "reference"
+)
+
+// csvDateFormats は CSV の取引日として読み込める書式です
+var csvDateFormats = []string{time.DateOnly, "2006/01/02"}
+
+// parseCSV は見出し行付きの CSV（UTF-8）を読み込みます。列は見出しの名前で判定し、順番は問いません。
+// amount は出金を負、入金を正の数で指定します
+func parseCSV(r io.Reader) ([]*models.BankStatementLine, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, &ParseError{Line: 1, Err: errors.New("header is missing")}
+	}
+	if err != nil {
+		return nil, &ParseError{Line: 1, Err: err}
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{csvDate, csvAmount} {
+		if _, ok := columns[required]; !ok {
+			return nil, &ParseError{Line: 1, Err: fmt.Errorf("column %q is missing", required)}
+		}
+	}
+
+	var lines []*models.BankStatementLine
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		lineNo, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, &ParseError{Line: lineNo, Err: err}
+		}
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line, err := parseCSVRecord(field)
+		if err != nil {
+			return nil, &ParseError{Line: lineNo, Err: err}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+func parseCSVRecord(field func(string) string) (*models.BankStatementLine, error) {
+	date, err := parseCSVDate(field(csvDate))
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := decimal.NewFromString(strings.ReplaceAll(field(csvAmount), ",", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	direction := value.StatementDirectionDeposit
+	switch {
+	case amount.IsZero():
+		return nil, errors.New("amount must not be zero")
+	case amount.IsNegative():
+		direction = value.StatementDirectionWithdrawal
+	}
+
+	return &models.BankStatementLine{
+		TransactionDate:  date,
+		Direction:        direction,
+		Amount:           amount.Abs(),
+		CounterpartyName: field(csvName),
+		Description:      field(csvDescription),
+		Reference:        field(csvReference),
+	}, nil
+}
+
+func parseCSVDate(s string) (time.Time, error) {
+	for _, format := range csvDateFormats {
+		if date, err := time.Parse(format, s); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
@@ -0,0 +1,36 @@
+package statement
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// legalFormAbbreviations は口座名義の法人の略語（カ) や (ユ など）です
+var legalFormAbbreviations = []*regexp.Regexp{
+	regexp.MustCompile(`\([ァ-ヺ]{1,3}\)`),
+	regexp.MustCompile(`^[ァ-ヺ]{1,3}\)`),
+	regexp.MustCompile(`\([ァ-ヺ]{1,3}$`),
+}
+
+// kanaReplacer は小書きのカナを大きいカナに、長音に似た記号を長音にし、名義を区切る空白と記号を除きます
+var kanaReplacer = strings.NewReplacer(
+	"ァ", "ア", "ィ", "イ", "ゥ", "ウ", "ェ", "エ", "ォ", "オ",
+	"ッ", "ツ", "ャ", "ヤ", "ュ", "ユ", "ョ", "ヨ", "ヮ", "ワ",
+	"-", "ー", "‐", "ー", "−", "ー",
+	" ", "", "　", "", "・", "", ".", "", ",", "", "、", "", "/", "", "(", "", ")", "",
+)
+
+// NormalizeName は名義を比較できるよう正規化します。
+// 半角カナ・全角英数字を全角カナ・半角英数字にそろえて大文字にし、法人の略語・空白・記号を除きます
+func NormalizeName(name string) string {
+	// 半角カナの濁点・半濁点は全角にすると結合文字になるため、NFC で1文字にまとめる
+	normalized := strings.ToUpper(norm.NFC.String(width.Fold.String(name)))
+	for _, abbreviation := range legalFormAbbreviations {
+		normalized = abbreviation.ReplaceAllString(normalized, "")
+	}
+
+	return kanaReplacer.Replace(normalized)
+}
@@ -0,0 +1,169 @@
+package statement
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// 一致度の配点。合計が MaxScore の候補だけを自動で消し込みます
+const (
+	MaxScore = amountExactScore + dateNearScore + nameExactScore
+
+	amountExactScore   = 50
+	amountPartialScore = 10
+	dateNearScore      = 20
+	dateFarScore       = 10
+	nameExactScore     = 30
+	namePartialScore   = 15
+)
+
+const (
+	// dateNearDays と dateFarDays は取引日と支払期日の差の日数で、この日数以内を一致とします
+	dateNearDays = 3
+	dateFarDays  = 14
+	// maxCandidates は1件の明細に返す候補の件数の上限です
+	maxCandidates = 5
+)
+
+// Candidates は出金の明細に一致する可能性のある請求書を一致度の高い順に返します。
+//   - 金額: 未払いの残高と同じなら50点、残高より少ない（分割の支払）なら10点。残高より多い請求書は候補にしません
+//   - 日付: 支払期日の前後3日以内なら20点、14日以内なら10点。発行日より前の取引は候補にしません
+//   - 名義: 取引先の口座名義または取引先名と一致すれば30点、一方が他方を含めば15点
+//
+// 金額が残高と同じか、名義が一致する請求書だけを候補にします
+func Candidates(line *models.BankStatementLine, targets []*models.ReconciliationTarget) []*models.StatementCandidate {
+	if line.Direction != value.StatementDirectionWithdrawal {
+		return nil
+	}
+
+	name := NormalizeName(line.CounterpartyName)
+	var candidates []*models.StatementCandidate
+	for _, target := range targets {
+		candidate, ok := match(line, name, target)
+		if ok {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.Invoice.PaymentDueDate.Equal(b.Invoice.PaymentDueDate) {
+			return a.Invoice.PaymentDueDate.Before(b.Invoice.PaymentDueDate)
+		}
+		return a.Invoice.ID < b.Invoice.ID
+	})
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+
+	return candidates
+}
+
+// Score は明細と請求書の一致度を返します。候補にならない請求書は0です
+func Score(line *models.BankStatementLine, target *models.ReconciliationTarget) int {
+	candidate, ok := match(line, NormalizeName(line.CounterpartyName), target)
+	if !ok {
+		return 0
+	}
+
+	return candidate.Score
+}
+
+func match(line *models.BankStatementLine, name string, target *models.ReconciliationTarget) (*models.StatementCandidate, bool) {
+	invoice := target.Invoice
+	if line.TransactionDate.Before(truncateDay(invoice.IssueDate)) {
+		return nil, false
+	}
+
+	candidate := &models.StatementCandidate{Invoice: invoice, ClientName: target.ClientName}
+	outstanding := invoice.OutstandingAmount()
+	switch line.Amount.Cmp(outstanding) {
+	case 0:
+		candidate.AmountScore = amountExactScore
+	case -1:
+		candidate.AmountScore = amountPartialScore
+	default:
+		return nil, false
+	}
+
+	days := absDays(truncateDay(line.TransactionDate).Sub(truncateDay(invoice.PaymentDueDate)))
+	switch {
+	case days <= dateNearDays:
+		candidate.DateScore = dateNearScore
+	case days <= dateFarDays:
+		candidate.DateScore = dateFarScore
+	}
+
+	for _, targetName := range append(append([]string{}, target.AccountNames...), target.ClientName) {
+		candidate.NameScore = max(candidate.NameScore, nameScore(name, NormalizeName(targetName)))
+	}
+
+	if candidate.AmountScore != amountExactScore && candidate.NameScore == 0 {
+		return nil, false
+	}
+	candidate.Score = candidate.AmountScore + candidate.DateScore + candidate.NameScore
+
+	return candidate, true
+}
+
+func nameScore(a, b string) int {
+	switch {
+	case a == "" || b == "":
+		return 0
+	case a == b:
+		return nameExactScore
+	case strings.Contains(a, b) || strings.Contains(b, a):
+		return namePartialScore
+	}
+
+	return 0
+}
+
+// Reconcile は取り込んだ明細の状態と候補を決めます。
+// 入金は請求書の支払ではないため除外し、出金は確認待ちにして候補を付けます。
+// 一致度が満点の候補がちょうど1件で、その請求書を先の明細で消し込んでいない明細は、自動で消し込む候補として返します
+func Reconcile(lines []*models.BankStatementLine, targets []*models.ReconciliationTarget) (autoMatches map[*models.BankStatementLine]*models.StatementCandidate) {
+	autoMatches = make(map[*models.BankStatementLine]*models.StatementCandidate)
+	claimed := make(map[string]bool)
+	for _, line := range lines {
+		if line.Direction != value.StatementDirectionWithdrawal {
+			line.Status = value.StatementLineStatusIgnored
+			continue
+		}
+
+		line.Status = value.StatementLineStatusPending
+		line.Candidates = Candidates(line, targets)
+		var exact []*models.StatementCandidate
+		for _, candidate := range line.Candidates {
+			if candidate.Score == MaxScore {
+				exact = append(exact, candidate)
+			}
+		}
+		if len(exact) == 1 && !claimed[exact[0].Invoice.ID] {
+			claimed[exact[0].Invoice.ID] = true
+			autoMatches[line] = exact[0]
+		}
+	}
+
+	return autoMatches
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func absDays(d time.Duration) int {
+	days := int(d.Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+
+	return days
+}
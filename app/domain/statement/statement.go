@@ -0,0 +1,50 @@
+// Package statement は銀行の入出金明細を読み込み、請求書と照合します
+package statement
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// ParseError は読み込めない明細の位置と理由です。Line はファイルの行（全銀協の形式ではレコード）の番号です
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse は形式に従って明細を読み込み、ファイルの順に番号を付けて返します
+func Parse(format value.StatementFormat, r io.Reader) ([]*models.BankStatementLine, error) {
+	var (
+		lines []*models.BankStatementLine
+		err   error
+	)
+	switch format {
+	case value.StatementFormatZengin:
+		lines, err = parseZengin(r)
+	case value.StatementFormatCSV:
+		lines, err = parseCSV(r)
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, line := range lines {
+		line.LineNo = i + 1
+	}
+	models.SetFingerprints(lines)
+
+	return lines, nil
+}
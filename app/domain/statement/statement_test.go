@@ -0,0 +1,267 @@
+package statement
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	assert.NoError(t, err)
+	return data
+}
+
+func TestParse_Zengin(t *testing.T) {
+	t.Run("全銀協の入出金取引明細を読み込む", func(t *testing.T) {
+		lines, err := Parse(value.StatementFormatZengin, bytes.NewReader(readTestdata(t, "statement.zengin")))
+
+		assert.NoError(t, err)
+		assert.Len(t, lines, 3)
+		assert.Equal(t, 1, lines[0].LineNo)
+		assert.Equal(t, day(time.December, 26), lines[0].TransactionDate)
+		assert.Equal(t, value.StatementDirectionWithdrawal, lines[0].Direction)
+		assert.True(t, decimal.NewFromInt(10440).Equal(lines[0].Amount))
+		// 出金は振込依頼人名が空のため摘要を名義にする
+		assert.Equal(t, "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ", lines[0].CounterpartyName)
+		assert.Equal(t, "00000001", lines[0].Reference)
+		assert.Equal(t, value.StatementDirectionDeposit, lines[1].Direction)
+		assert.Equal(t, "ｶ)ﾃｽﾄｼｮｳｼﾞ", lines[1].CounterpartyName)
+		assert.Equal(t, "ﾌﾘｺﾐ", lines[1].Description)
+		assert.Len(t, lines[0].Fingerprint, 64)
+	})
+
+	t.Run("改行で区切らないレコードも読み込む", func(t *testing.T) {
+		data := bytes.ReplaceAll(readTestdata(t, "statement.zengin"), []byte("\r\n"), nil)
+		lines, err := Parse(value.StatementFormatZengin, bytes.NewReader(data))
+
+		assert.NoError(t, err)
+		assert.Len(t, lines, 3)
+	})
+
+	t.Run("読み込めないファイルはレコードの番号を返す", func(t *testing.T) {
+		records := bytes.Split(bytes.TrimSuffix(readTestdata(t, "statement.zengin"), []byte("\r\n")), []byte("\r\n"))
+		tests := []struct {
+			name     string
+			data     []byte
+			expected int
+		}{
+			{
+				name:     "トレーラの出金額合計がデータレコードと一致しない",
+				data:     bytes.Join(append(append(append([][]byte{}, records[:4]...), bytes.Replace(records[4], []byte("0000000015440"), []byte("0000000015441"), 1)), records[5]), nil),
+				expected: 5,
+			},
+			{
+				name:     "データレコードの金額が数字でない",
+				data:     bytes.Join(append(append(append([][]byte{}, records[:1]...), append(append([]byte{}, records[1][:24]...), append([]byte("ABCDEFGHIJKL"), records[1][36:]...)...)), records[2:]...), nil),
+				expected: 2,
+			},
+			{
+				name:     "レコードが200バイトでない",
+				data:     bytes.Join(records, nil)[:1100],
+				expected: 6,
+			},
+			{
+				name:     "エンドレコードがない",
+				data:     bytes.Join(records[:5], nil),
+				expected: 6,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := Parse(value.StatementFormatZengin, bytes.NewReader(tt.data))
+
+				var parseErr *ParseError
+				assert.ErrorAs(t, err, &parseErr)
+				assert.Equal(t, tt.expected, parseErr.Line)
+			})
+		}
+	})
+}
+
+func TestParse_CSV(t *testing.T) {
+	t.Run("見出し行付きのCSVを読み込む", func(t *testing.T) {
+		lines, err := Parse(value.StatementFormatCSV, bytes.NewReader(readTestdata(t, "statement.csv")))
+
+		assert.NoError(t, err)
+		assert.Len(t, lines, 3)
+		assert.Equal(t, day(time.December, 26), lines[0].TransactionDate)
+		assert.Equal(t, value.StatementDirectionWithdrawal, lines[0].Direction)
+		assert.True(t, decimal.NewFromInt(10440).Equal(lines[0].Amount))
+		assert.Equal(t, "カ）サンプルショウジ", lines[0].CounterpartyName)
+		assert.Equal(t, "REF-1", lines[0].Reference)
+		assert.Equal(t, day(time.December, 26), lines[1].TransactionDate)
+		assert.Equal(t, value.StatementDirectionDeposit, lines[1].Direction)
+	})
+
+	t.Run("同じ内容の明細も別の明細として区別する", func(t *testing.T) {
+		data := "date,amount,name\n2025-12-26,-1000,テスト\n2025-12-26,-1000,テスト\n"
+		lines, err := Parse(value.StatementFormatCSV, strings.NewReader(data))
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, lines[0].Fingerprint, lines[1].Fingerprint)
+	})
+
+	t.Run("読み込めないファイルは行の番号を返す", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			data     string
+			expected int
+		}{
+			{name: "金額の列がない", data: "date,name\n2025-12-26,テスト\n", expected: 1},
+			{name: "日付が不正", data: "date,amount\n2025-12-26,-1000\n2025-13-01,-1000\n", expected: 3},
+			{name: "金額が0", data: "date,amount\n2025-12-26,0\n", expected: 2},
+			{name: "空のファイル", data: "", expected: 1},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := Parse(value.StatementFormatCSV, strings.NewReader(tt.data))
+
+				var parseErr *ParseError
+				assert.ErrorAs(t, err, &parseErr)
+				assert.Equal(t, tt.expected, parseErr.Line)
+			})
+		}
+	})
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ", expected: "サンプルシヨウジ"},
+		{name: "カ）サンプルショウジ", expected: "サンプルシヨウジ"},
+		{name: "ｻﾝﾌﾟﾙｼｮｳｼﾞ(ｶ", expected: "サンプルシヨウジ"},
+		{name: "ﾃｽﾄ(ﾕ)ﾄｳｷｮｳｼﾃﾝ", expected: "テストトウキヨウシテン"},
+		{name: "ｴｰ･ﾋﾞｰ ｼｰ", expected: "エービーシー"},
+		{name: "ＡＢＣ-ｺｰﾎﾟﾚｰｼｮﾝ", expected: "ABCーコーポレーシヨン"},
+		{name: "abc corp.", expected: "ABCCORP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.name))
+		})
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	target := func(id string, outstanding int64, dueDate time.Time, clientName string, accountNames ...string) *models.ReconciliationTarget {
+		return &models.ReconciliationTarget{
+			Invoice: &models.Invoice{
+				ID:             id,
+				IssueDate:      day(time.December, 1),
+				PaymentDueDate: dueDate,
+				InvoiceAmount:  decimal.NewFromInt(outstanding),
+			},
+			ClientName:   clientName,
+			AccountNames: accountNames,
+		}
+	}
+	withdrawal := func(date time.Time, amount int64, name string) *models.BankStatementLine {
+		return &models.BankStatementLine{
+			TransactionDate:  date,
+			Direction:        value.StatementDirectionWithdrawal,
+			Amount:           decimal.NewFromInt(amount),
+			CounterpartyName: name,
+		}
+	}
+
+	t.Run("金額・日付・名義の一致度を合計して高い順に返す", func(t *testing.T) {
+		targets := []*models.ReconciliationTarget{
+			target("partial", 20000, day(time.December, 10), "株式会社サンプル商事", "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ"),
+			target("exact", 10440, day(time.December, 25), "株式会社サンプル商事", "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ"),
+			target("amount-only", 10440, day(time.December, 31), "テスト商店"),
+			target("too-small", 5000, day(time.December, 25), "株式会社サンプル商事", "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ"),
+			target("other", 3000, day(time.December, 25), "テスト商店"),
+		}
+		candidates := Candidates(withdrawal(day(time.December, 26), 10440, "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ"), targets)
+
+		assert.Len(t, candidates, 3)
+		assert.Equal(t, "exact", candidates[0].Invoice.ID)
+		assert.Equal(t, 100, candidates[0].Score)
+		assert.Equal(t, "amount-only", candidates[1].Invoice.ID)
+		assert.Equal(t, 60, candidates[1].Score)
+		assert.Equal(t, "partial", candidates[2].Invoice.ID)
+		assert.Equal(t, 10+0+30, candidates[2].Score)
+	})
+
+	t.Run("取引先名を含む名義は部分一致", func(t *testing.T) {
+		candidates := Candidates(withdrawal(day(time.December, 26), 10440, "ｻﾝﾌﾟﾙｼｮｳｼﾞ ﾄｳｷｮｳｼﾃﾝ"), []*models.ReconciliationTarget{
+			target("invoice", 10440, day(time.December, 26), "サンプル商事", "ｻﾝﾌﾟﾙｼｮｳｼﾞ"),
+		})
+
+		assert.Len(t, candidates, 1)
+		assert.Equal(t, 15, candidates[0].NameScore)
+	})
+
+	t.Run("発行日より前の取引と入金は候補にしない", func(t *testing.T) {
+		targets := []*models.ReconciliationTarget{target("invoice", 10440, day(time.December, 25), "サンプル商事")}
+
+		assert.Empty(t, Candidates(withdrawal(day(time.November, 30), 10440, "ｻﾝﾌﾟﾙｼｮｳｼﾞ"), targets))
+		deposit := withdrawal(day(time.December, 25), 10440, "ｻﾝﾌﾟﾙｼｮｳｼﾞ")
+		deposit.Direction = value.StatementDirectionDeposit
+		assert.Empty(t, Candidates(deposit, targets))
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	target := &models.ReconciliationTarget{
+		Invoice: &models.Invoice{
+			ID:             "invoice",
+			IssueDate:      day(time.December, 1),
+			PaymentDueDate: day(time.December, 25),
+			InvoiceAmount:  decimal.NewFromInt(10440),
+		},
+		ClientName:   "株式会社サンプル商事",
+		AccountNames: []string{"ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ"},
+	}
+	line := func(direction value.StatementDirection) *models.BankStatementLine {
+		return &models.BankStatementLine{
+			TransactionDate:  day(time.December, 25),
+			Direction:        direction,
+			Amount:           decimal.NewFromInt(10440),
+			CounterpartyName: "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ",
+		}
+	}
+
+	t.Run("満点の候補が1件の出金だけを自動で消し込む", func(t *testing.T) {
+		first, second, deposit := line(value.StatementDirectionWithdrawal), line(value.StatementDirectionWithdrawal), line(value.StatementDirectionDeposit)
+		autoMatches := Reconcile([]*models.BankStatementLine{first, second, deposit}, []*models.ReconciliationTarget{target})
+
+		assert.Len(t, autoMatches, 1)
+		assert.Equal(t, "invoice", autoMatches[first].Invoice.ID)
+		// 同じ請求書は2件目の明細では自動で消し込まない
+		assert.Equal(t, value.StatementLineStatusPending, second.Status)
+		assert.Len(t, second.Candidates, 1)
+		assert.Equal(t, value.StatementLineStatusIgnored, deposit.Status)
+	})
+
+	t.Run("満点の候補が複数ある場合は確認待ちにする", func(t *testing.T) {
+		other := *target
+		otherInvoice := *target.Invoice
+		otherInvoice.ID = "other"
+		other.Invoice = &otherInvoice
+		withdrawal := line(value.StatementDirectionWithdrawal)
+		autoMatches := Reconcile([]*models.BankStatementLine{withdrawal}, []*models.ReconciliationTarget{target, &other})
+
+		assert.Empty(t, autoMatches)
+		assert.Equal(t, value.StatementLineStatusPending, withdrawal.Status)
+		assert.Len(t, withdrawal.Candidates, 2)
+	})
+}
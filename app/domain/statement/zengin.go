@@ -0,0 +1,234 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// zenginRecordLength は全銀協の入出金取引明細の1レコードのバイト数です
+const zenginRecordLength = 200
+
+// 全銀協の入出金取引明細のデータ区分
+const (
+	zenginHeader  = '1'
+	zenginData    = '2'
+	zenginTrailer = '8'
+	zenginEnd     = '9'
+)
+
+// 入払区分
+const (
+	zenginDeposit    = "1"
+	zenginWithdrawal = "2"
+)
+
+// zenginField はレコードの項目の位置（1から数えたバイトの位置と長さ）です
+type zenginField struct {
+	start  int
+	length int
+}
+
+func (f zenginField) of(record []byte) []byte {
+	return record[f.start-1 : f.start-1+f.length]
+}
+
+// データレコードの項目
+var (
+	zenginInquiryNo      = zenginField{2, 8}
+	zenginValueDate      = zenginField{10, 6}
+	zenginInOut          = zenginField{22, 1}
+	zenginAmount         = zenginField{25, 12}
+	zenginRequesterName  = zenginField{82, 48}
+	zenginDescription    = zenginField{160, 20}
+	zenginDepositCount   = zenginField{2, 6}
+	zenginDepositTotal   = zenginField{8, 13}
+	zenginWithdrawCount  = zenginField{21, 6}
+	zenginWithdrawTotal  = zenginField{27, 13}
+	zenginDataRecordsNum = zenginField{55, 7}
+)
+
+// parseZengin は全銀協の入出金取引明細を読み込みます。
+// レコードは改行で区切っていても区切っていなくても読み込めます。日付は西暦の下2桁（YYMMDD）とし、
+// トレーラレコードの件数・合計額がデータレコードと一致しない場合はエラーにします
+func parseZengin(r io.Reader) ([]*models.BankStatementLine, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = stripRecordSeparators(data)
+	if len(data) == 0 {
+		return nil, &ParseError{Line: 1, Err: errors.New("no records")}
+	}
+	if len(data)%zenginRecordLength != 0 {
+		return nil, &ParseError{Line: len(data)/zenginRecordLength + 1, Err: fmt.Errorf("record is not %d bytes", zenginRecordLength)}
+	}
+
+	var (
+		lines   []*models.BankStatementLine
+		section []*models.BankStatementLine
+		inside  bool
+	)
+	for i := 0; i < len(data)/zenginRecordLength; i++ {
+		record := data[i*zenginRecordLength : (i+1)*zenginRecordLength]
+		recordNo := i + 1
+		switch record[0] {
+		case zenginHeader:
+			inside = true
+			section = nil
+		case zenginData:
+			if !inside {
+				return nil, &ParseError{Line: recordNo, Err: errors.New("data record without header")}
+			}
+			line, err := parseZenginData(record)
+			if err != nil {
+				return nil, &ParseError{Line: recordNo, Err: err}
+			}
+			section = append(section, line)
+		case zenginTrailer:
+			if !inside {
+				return nil, &ParseError{Line: recordNo, Err: errors.New("trailer record without header")}
+			}
+			if err := checkZenginTrailer(record, section); err != nil {
+				return nil, &ParseError{Line: recordNo, Err: err}
+			}
+			lines = append(lines, section...)
+			inside = false
+		case zenginEnd:
+			if inside {
+				return nil, &ParseError{Line: recordNo, Err: errors.New("end record without trailer")}
+			}
+			return lines, nil
+		default:
+			return nil, &ParseError{Line: recordNo, Err: fmt.Errorf("unknown record type %q", record[0])}
+		}
+	}
+
+	return nil, &ParseError{Line: len(data)/zenginRecordLength + 1, Err: errors.New("end record is missing")}
+}
+
+func parseZenginData(record []byte) (*models.BankStatementLine, error) {
+	date, err := time.Parse("060102", string(zenginValueDate.of(record)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value date: %w", err)
+	}
+
+	var direction value.StatementDirection
+	switch string(zenginInOut.of(record)) {
+	case zenginDeposit:
+		direction = value.StatementDirectionDeposit
+	case zenginWithdrawal:
+		direction = value.StatementDirectionWithdrawal
+	default:
+		return nil, fmt.Errorf("invalid deposit/withdrawal type %q", zenginInOut.of(record))
+	}
+
+	amount, err := parseZenginNumber(zenginAmount.of(record))
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	name, err := decodeZenginText(zenginRequesterName.of(record))
+	if err != nil {
+		return nil, err
+	}
+	description, err := decodeZenginText(zenginDescription.of(record))
+	if err != nil {
+		return nil, err
+	}
+	// 出金では振込依頼人名が空で、摘要に受取人名が入ることが多い
+	if name == "" {
+		name = description
+	}
+
+	return &models.BankStatementLine{
+		TransactionDate:  date,
+		Direction:        direction,
+		Amount:           amount,
+		CounterpartyName: name,
+		Description:      description,
+		Reference:        strings.TrimSpace(string(zenginInquiryNo.of(record))),
+	}, nil
+}
+
+// checkZenginTrailer はトレーラレコードの入金・出金の件数と合計額、データレコードの件数を確認します
+func checkZenginTrailer(record []byte, lines []*models.BankStatementLine) error {
+	var (
+		depositCount, withdrawCount int64
+		depositTotal, withdrawTotal decimal.Decimal
+	)
+	for _, line := range lines {
+		if line.Direction == value.StatementDirectionDeposit {
+			depositCount++
+			depositTotal = depositTotal.Add(line.Amount)
+		} else {
+			withdrawCount++
+			withdrawTotal = withdrawTotal.Add(line.Amount)
+		}
+	}
+
+	checks := []struct {
+		name     string
+		field    zenginField
+		expected decimal.Decimal
+	}{
+		{name: "deposit count", field: zenginDepositCount, expected: decimal.NewFromInt(depositCount)},
+		{name: "deposit total", field: zenginDepositTotal, expected: depositTotal},
+		{name: "withdrawal count", field: zenginWithdrawCount, expected: decimal.NewFromInt(withdrawCount)},
+		{name: "withdrawal total", field: zenginWithdrawTotal, expected: withdrawTotal},
+		{name: "data record count", field: zenginDataRecordsNum, expected: decimal.NewFromInt(int64(len(lines)))},
+	}
+	for _, check := range checks {
+		actual, err := parseZenginNumber(check.field.of(record))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", check.name, err)
+		}
+		if !actual.Equal(check.expected) {
+			return fmt.Errorf("%s is %s but data records have %s", check.name, actual, check.expected)
+		}
+	}
+
+	return nil
+}
+
+// stripRecordSeparators はレコードを区切る改行と、末尾の EOF（0x1A）を除きます。
+// Shift_JIS の2バイト目にこれらのバイトは現れないため、バイトのまま除けます
+func stripRecordSeparators(data []byte) []byte {
+	stripped := make([]byte, 0, len(data))
+	for _, b := range data {
+		if b != '\r' && b != '\n' && b != 0x1a {
+			stripped = append(stripped, b)
+		}
+	}
+
+	return stripped
+}
+
+// parseZenginNumber は前ゼロ詰めの数字の項目を読み込みます
+func parseZenginNumber(field []byte) (decimal.Decimal, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(string(field)), 10, 64)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	return decimal.NewFromInt(n), nil
+}
+
+// decodeZenginText は Shift_JIS（半角カナ）の文字の項目を読み込み、後ろの空白を除きます
+func decodeZenginText(field []byte) (string, error) {
+	decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(field)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(decoded)), nil
+}
@@ -0,0 +1,10 @@
+package value
+
+// ApprovalStatus は承認フローの各段階の状態です
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPending  ApprovalStatus = "pending"
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+)
@@ -0,0 +1,40 @@
+package value
+
+import "bytes"
+
+// AttachmentContentType は請求書に添付できるファイルの形式です
+type AttachmentContentType string
+
+const (
+	AttachmentContentTypePDF  AttachmentContentType = "application/pdf"
+	AttachmentContentTypeJPEG AttachmentContentType = "image/jpeg"
+	AttachmentContentTypePNG  AttachmentContentType = "image/png"
+	AttachmentContentTypeTIFF AttachmentContentType = "image/tiff"
+)
+
+// AttachmentSniffLength は形式の判定に使う先頭のバイト数です
+const AttachmentSniffLength = 8
+
+// attachmentSignatures はファイルの先頭のバイト列（マジックナンバー）と形式の対応です
+var attachmentSignatures = []struct {
+	prefix      []byte
+	contentType AttachmentContentType
+}{
+	{[]byte("%PDF-"), AttachmentContentTypePDF},
+	{[]byte{0xff, 0xd8, 0xff}, AttachmentContentTypeJPEG},
+	{[]byte("\x89PNG\r\n\x1a\n"), AttachmentContentTypePNG},
+	{[]byte("II*\x00"), AttachmentContentTypeTIFF},
+	{[]byte("MM\x00*"), AttachmentContentTypeTIFF},
+}
+
+// DetectAttachmentContentType はファイルの先頭のバイト列から形式を判定します。
+// クライアントが申告した Content-Type は信用せず、添付できない形式の場合は false を返します
+func DetectAttachmentContentType(head []byte) (AttachmentContentType, bool) {
+	for _, signature := range attachmentSignatures {
+		if bytes.HasPrefix(head, signature.prefix) {
+			return signature.contentType, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,21 @@
+package value
+
+// ClientType は取引先が法人か個人かの種別です
+type ClientType string
+
+const (
+	// ClientTypeCorporation は法人の取引先です
+	ClientTypeCorporation ClientType = "corporation"
+	// ClientTypeIndividual は個人事業主・フリーランスなど個人の取引先です
+	ClientTypeIndividual ClientType = "individual"
+)
+
+// IsValid は取引先の種別が定義済みの値かを判定します
+func (t ClientType) IsValid() bool {
+	switch t {
+	case ClientTypeCorporation, ClientTypeIndividual:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,21 @@
+package value
+
+// CreditNoteReason は請求書を減額する理由です
+type CreditNoteReason string
+
+const (
+	// CreditNoteReasonReturn は返品による減額です
+	CreditNoteReasonReturn CreditNoteReason = "return"
+	// CreditNoteReasonDiscount は値引きによる減額です
+	CreditNoteReasonDiscount CreditNoteReason = "discount"
+)
+
+// IsValid は減額の理由が定義済みの値かを判定します
+func (r CreditNoteReason) IsValid() bool {
+	switch r {
+	case CreditNoteReasonReturn, CreditNoteReasonDiscount:
+		return true
+	}
+
+	return false
+}
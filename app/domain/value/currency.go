@@ -0,0 +1,29 @@
+package value
+
+// Currency は ISO 4217 の通貨コードです
+type Currency string
+
+const (
+	CurrencyJPY Currency = "JPY"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+)
+
+// minorUnits は ISO 4217 の補助単位の桁数です（円は補助単位がないため0桁）
+var minorUnits = map[Currency]int32{
+	CurrencyJPY: 0,
+	CurrencyUSD: 2,
+	CurrencyEUR: 2,
+}
+
+// IsValid は通貨が取り扱い可能な値かを判定します
+func (c Currency) IsValid() bool {
+	_, ok := minorUnits[c]
+
+	return ok
+}
+
+// MinorUnits は通貨の補助単位の桁数を返します（ドル・ユーロはセントまでの2桁）
+func (c Currency) MinorUnits() int32 {
+	return minorUnits[c]
+}
@@ -0,0 +1,23 @@
+package value
+
+// DueDatePolicy は支払期日が銀行の休業日に当たる場合の調整方法です
+type DueDatePolicy string
+
+const (
+	// DueDatePolicyNone は支払期日を調整しません
+	DueDatePolicyNone DueDatePolicy = "none"
+	// DueDatePolicyPrevious は支払期日を前営業日に繰り上げます
+	DueDatePolicyPrevious DueDatePolicy = "previous"
+	// DueDatePolicyNext は支払期日を翌営業日に繰り下げます
+	DueDatePolicyNext DueDatePolicy = "next"
+)
+
+// IsValid は調整方法が定義済みの値かを判定します
+func (p DueDatePolicy) IsValid() bool {
+	switch p {
+	case DueDatePolicyNone, DueDatePolicyPrevious, DueDatePolicyNext:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,13 @@
+package value
+
+// DuplicateCheck は請求書を作成したときの重複の確認の結果です
+type DuplicateCheck string
+
+const (
+	// DuplicateCheckUnchecked は重複を確認する前に作成した請求書です
+	DuplicateCheckUnchecked DuplicateCheck = "unchecked"
+	// DuplicateCheckClear は重複の疑いのある請求書がなかったことを表します
+	DuplicateCheckClear DuplicateCheck = "clear"
+	// DuplicateCheckOverridden は重複の疑いのある請求書があったが、作成者が force を指定して作成したことを表します
+	DuplicateCheckOverridden DuplicateCheck = "overridden"
+)
@@ -0,0 +1,42 @@
+package value
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// InvoiceCursor は請求書一覧のページ位置を表します（ソートキーの値 + ULID）
+type InvoiceCursor struct {
+	SortKey   InvoiceSortKey `json:"k"`
+	SortOrder SortOrder      `json:"o"`
+	Value     string         `json:"v"`
+	ID        string         `json:"id"`
+}
+
+// Encode はカーソルをクライアントに返す不透明な文字列に変換します
+func (c *InvoiceCursor) Encode() string {
+	b, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeInvoiceCursor は Encode で生成された文字列をカーソルに戻します
+func DecodeInvoiceCursor(s string) (*InvoiceCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor InvoiceCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if !cursor.SortKey.IsValid() || !cursor.SortOrder.IsValid() || cursor.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
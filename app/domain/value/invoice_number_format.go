@@ -0,0 +1,78 @@
+package value
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// InvoiceNumberFormat は請求書番号の書式です。
+// {YYYY} を会計年度の西暦4桁、{YY} を下2桁、{SEQ} を年度内の連番、{SEQ:n} を n 桁に0埋めした連番に置き換えます
+type InvoiceNumberFormat string
+
+const (
+	// DefaultInvoiceNumberFormat は書式を設定していない会社の請求書番号の書式です（例: INV-2025-000123）
+	DefaultInvoiceNumberFormat InvoiceNumberFormat = "INV-{YYYY}-{SEQ:6}"
+	// MaxInvoiceNumberFormatLength は書式の文字数の上限です
+	MaxInvoiceNumberFormatLength = 30
+	// MaxInvoiceNumberSeqWidth は連番を0埋めする桁数の上限です
+	MaxInvoiceNumberSeqWidth = 10
+)
+
+var (
+	invoiceNumberTokenPattern   = regexp.MustCompile(`\{([A-Z]+)(?::([0-9]+))?\}`)
+	invoiceNumberLiteralPattern = regexp.MustCompile(`^[A-Za-z0-9._-]*$`)
+)
+
+// IsValid は書式が連番をちょうど1つ、会計年度を1つ以上含み、それ以外は英数字と . _ - だけで書かれているかを判定します。
+// 請求書番号は URL のパスで指定するため、区切りに / は使えません
+func (f InvoiceNumberFormat) IsValid() bool {
+	if f == "" || len(f) > MaxInvoiceNumberFormatLength {
+		return false
+	}
+
+	s := string(f)
+	var seqs, years int
+	for _, m := range invoiceNumberTokenPattern.FindAllStringSubmatchIndex(s, -1) {
+		name := s[m[2]:m[3]]
+		hasWidth := m[4] >= 0
+		switch name {
+		case "YYYY", "YY":
+			if hasWidth {
+				return false
+			}
+			years++
+		case "SEQ":
+			if hasWidth {
+				width, err := strconv.Atoi(s[m[4]:m[5]])
+				if err != nil || width < 1 || width > MaxInvoiceNumberSeqWidth {
+					return false
+				}
+			}
+			seqs++
+		default:
+			return false
+		}
+	}
+	literal := invoiceNumberTokenPattern.ReplaceAllString(s, "")
+
+	return seqs == 1 && years > 0 && invoiceNumberLiteralPattern.MatchString(literal)
+}
+
+// Format は会計年度 fiscalYear の seq 番目の請求書番号を返します
+func (f InvoiceNumberFormat) Format(fiscalYear int, seq int64) string {
+	return invoiceNumberTokenPattern.ReplaceAllStringFunc(string(f), func(token string) string {
+		m := invoiceNumberTokenPattern.FindStringSubmatch(token)
+		switch m[1] {
+		case "YYYY":
+			return fmt.Sprintf("%04d", fiscalYear)
+		case "YY":
+			return fmt.Sprintf("%02d", fiscalYear%100)
+		case "SEQ":
+			width, _ := strconv.Atoi(m[2])
+			return fmt.Sprintf("%0*d", width, seq)
+		}
+
+		return token
+	})
+}
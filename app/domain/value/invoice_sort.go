@@ -0,0 +1,32 @@
+package value
+
+type InvoiceSortKey string
+
+const (
+	InvoiceSortKeyPaymentDueDate InvoiceSortKey = "payment_due_date"
+	InvoiceSortKeyIssueDate      InvoiceSortKey = "issue_date"
+	InvoiceSortKeyPaymentAmount  InvoiceSortKey = "payment_amount"
+	InvoiceSortKeyCreatedAt      InvoiceSortKey = "created_at"
+)
+
+// IsValid はソートキーが許可された値かを判定します
+func (k InvoiceSortKey) IsValid() bool {
+	switch k {
+	case InvoiceSortKeyPaymentDueDate, InvoiceSortKeyIssueDate, InvoiceSortKeyPaymentAmount, InvoiceSortKeyCreatedAt:
+		return true
+	}
+
+	return false
+}
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// IsValid はソート順が許可された値かを判定します
+func (o SortOrder) IsValid() bool {
+	return o == SortOrderAsc || o == SortOrderDesc
+}
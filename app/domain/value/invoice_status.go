@@ -9,6 +9,14 @@ const (
 	InvoiceStatusProcessing  InvoiceStatus = "処理中"
 	InvoiceStatusError       InvoiceStatus = "エラー"
 	InvoiceStatusProcessed   InvoiceStatus = "処理済"
+	// InvoiceStatusPartiallyPaid は請求金額の一部が支払われた状態です
+	InvoiceStatusPartiallyPaid InvoiceStatus = "一部支払済"
+	// InvoiceStatusPaid は請求金額の全額が支払われた状態です
+	InvoiceStatusPaid InvoiceStatus = "支払済"
+	// InvoiceStatusPendingApproval は承認フローの承認を待っている状態です。承認されるまで支払えません
+	InvoiceStatusPendingApproval InvoiceStatus = "承認待ち"
+	// InvoiceStatusRejected は承認フローで却下された状態です
+	InvoiceStatusRejected InvoiceStatus = "却下"
 )
 
 func (s *InvoiceStatus) String() string {
@@ -24,3 +32,19 @@ func (s *InvoiceStatus) UnmarshalJSON(b []byte) error {
 func (s *InvoiceStatus) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%s"`, s.String())), nil
 }
+
+// IsValid はステータスが定義済みの値かを判定します
+func (s InvoiceStatus) IsValid() bool {
+	switch s {
+	case InvoiceStatusUnprocessed, InvoiceStatusProcessing, InvoiceStatusError, InvoiceStatusProcessed,
+		InvoiceStatusPartiallyPaid, InvoiceStatusPaid, InvoiceStatusPendingApproval, InvoiceStatusRejected:
+		return true
+	}
+
+	return false
+}
+
+// IsPayable は支払を記録できるステータスか（承認待ち・却下でないか）を判定します
+func (s InvoiceStatus) IsPayable() bool {
+	return s != InvoiceStatusPendingApproval && s != InvoiceStatusRejected
+}
@@ -0,0 +1,92 @@
+package value
+
+// JournalFormat は仕訳を書き出す会計ソフトのインポート形式です
+type JournalFormat string
+
+const (
+	// JournalFormatFreee は freee会計の仕訳のインポート形式（UTF-8）です
+	JournalFormatFreee JournalFormat = "freee"
+	// JournalFormatMoneyForward はマネーフォワード クラウド会計の仕訳帳のインポート形式（UTF-8）です
+	JournalFormatMoneyForward JournalFormat = "moneyforward"
+	// JournalFormatYayoi は弥生会計の仕訳日記帳のインポート形式（Shift_JIS）です
+	JournalFormatYayoi JournalFormat = "yayoi"
+)
+
+// JournalFormats は書き出せる形式を返します
+func JournalFormats() []JournalFormat {
+	return []JournalFormat{JournalFormatFreee, JournalFormatMoneyForward, JournalFormatYayoi}
+}
+
+// IsValid は形式が定義済みの値かを判定します
+func (f JournalFormat) IsValid() bool {
+	switch f {
+	case JournalFormatFreee, JournalFormatMoneyForward, JournalFormatYayoi:
+		return true
+	}
+
+	return false
+}
+
+// JournalAccountType は仕訳に使う勘定科目の役割です。科目の名前は会社ごとに設定できます
+type JournalAccountType string
+
+const (
+	// JournalAccountExpense は取引先への支払金額を計上する科目です
+	JournalAccountExpense JournalAccountType = "expense"
+	// JournalAccountFee は手数料と手数料の消費税を計上する科目です
+	JournalAccountFee JournalAccountType = "fee"
+	// JournalAccountPayable は請求金額のうち支払前の債務を計上する科目です
+	JournalAccountPayable JournalAccountType = "payable"
+	// JournalAccountBank は請求金額の支払に使う口座の科目です
+	JournalAccountBank JournalAccountType = "bank"
+)
+
+// JournalAccountTypes は勘定科目の役割を設定画面に表示する順に返します
+func JournalAccountTypes() []JournalAccountType {
+	return []JournalAccountType{JournalAccountExpense, JournalAccountFee, JournalAccountPayable, JournalAccountBank}
+}
+
+// IsValid は勘定科目の役割が定義済みの値かを判定します
+func (t JournalAccountType) IsValid() bool {
+	return t.DefaultName() != ""
+}
+
+// DefaultName は会社が設定していない場合の勘定科目の名前を返します
+func (t JournalAccountType) DefaultName() string {
+	switch t {
+	case JournalAccountExpense:
+		return "仕入高"
+	case JournalAccountFee:
+		return "支払手数料"
+	case JournalAccountPayable:
+		return "未払金"
+	case JournalAccountBank:
+		return "普通預金"
+	}
+
+	return ""
+}
+
+// JournalTaxCategory は仕訳の消費税の区分です。会計ソフトごとの税区分の名前には書き出すときに変換します
+type JournalTaxCategory string
+
+const (
+	// JournalTaxPurchaseStandard は標準税率（10%）の課税仕入れです
+	JournalTaxPurchaseStandard JournalTaxCategory = "purchase_standard"
+	// JournalTaxPurchaseReduced は軽減税率（8%）の課税仕入れです
+	JournalTaxPurchaseReduced JournalTaxCategory = "purchase_reduced"
+	// JournalTaxNone は消費税の対象外です
+	JournalTaxNone JournalTaxCategory = "none"
+)
+
+// JournalTaxCategoryOf は明細の税区分に対応する仕訳の税区分を返します
+func JournalTaxCategoryOf(category TaxCategory) JournalTaxCategory {
+	switch category {
+	case TaxCategoryStandard:
+		return JournalTaxPurchaseStandard
+	case TaxCategoryReduced:
+		return JournalTaxPurchaseReduced
+	}
+
+	return JournalTaxNone
+}
@@ -0,0 +1,38 @@
+package value
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Money は通貨と、その通貨での金額です
+type Money struct {
+	Amount   decimal.Decimal
+	Currency Currency
+}
+
+// NewMoney は通貨 currency の金額 amount を返します
+func NewMoney(amount decimal.Decimal, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Truncate は通貨の補助単位未満を切り捨てた金額を返します
+func (m Money) Truncate() Money {
+	return Money{Amount: m.Amount.Truncate(m.Currency.MinorUnits()), Currency: m.Currency}
+}
+
+// HasValidPrecision は金額が通貨の補助単位までの桁数で表されているかを判定します
+func (m Money) HasValidPrecision() bool {
+	return m.Amount.Equal(m.Truncate().Amount)
+}
+
+// Convert は1単位あたり rate の為替レートで通貨 to に換算し、to の補助単位未満を切り捨てます
+func (m Money) Convert(rate decimal.Decimal, to Currency) Money {
+	return Money{Amount: m.Amount.Mul(rate), Currency: to}.Truncate()
+}
+
+// String は補助単位の桁数で金額を表します（例: USD 1200.50）
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", m.Currency, m.Amount.StringFixed(m.Currency.MinorUnits()))
+}
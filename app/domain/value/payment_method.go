@@ -0,0 +1,22 @@
+package value
+
+// PaymentMethod は請求書の支払方法です
+type PaymentMethod string
+
+const (
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodDirectDebit  PaymentMethod = "direct_debit"
+	PaymentMethodCreditCard   PaymentMethod = "credit_card"
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodOther        PaymentMethod = "other"
+)
+
+// IsValid は支払方法が定義済みの値かを判定します
+func (m PaymentMethod) IsValid() bool {
+	switch m {
+	case PaymentMethodBankTransfer, PaymentMethodDirectDebit, PaymentMethodCreditCard, PaymentMethodCash, PaymentMethodOther:
+		return true
+	}
+
+	return false
+}
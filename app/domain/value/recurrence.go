@@ -0,0 +1,16 @@
+package value
+
+// RecurrenceCadence は定期請求で請求書を発行する周期です
+type RecurrenceCadence string
+
+const (
+	// RecurrenceCadenceMonthly は毎月 N 日に発行します（N 日がない月は月末）
+	RecurrenceCadenceMonthly RecurrenceCadence = "monthly"
+	// RecurrenceCadenceEndOfMonth は毎月末日に発行します
+	RecurrenceCadenceEndOfMonth RecurrenceCadence = "end_of_month"
+)
+
+// IsValid は周期が定義済みの値かを判定します
+func (c RecurrenceCadence) IsValid() bool {
+	return c == RecurrenceCadenceMonthly || c == RecurrenceCadenceEndOfMonth
+}
@@ -0,0 +1,16 @@
+package value
+
+// ReportPeriod はレポートで金額を集計する期間の単位です
+type ReportPeriod string
+
+const (
+	// ReportPeriodWeek は月曜日から日曜日までの1週間です
+	ReportPeriodWeek ReportPeriod = "week"
+	// ReportPeriodMonth は1日から月末までの1か月です
+	ReportPeriodMonth ReportPeriod = "month"
+)
+
+// IsValid は期間の単位が定義済みの値かを判定します
+func (p ReportPeriod) IsValid() bool {
+	return p == ReportPeriodWeek || p == ReportPeriodMonth
+}
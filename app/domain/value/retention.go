@@ -0,0 +1,39 @@
+package value
+
+// EntityType は保存期間を管理するエンティティの種類です
+type EntityType string
+
+const (
+	EntityTypeInvoice           EntityType = "invoice"
+	EntityTypeClient            EntityType = "client"
+	EntityTypeClientBankAccount EntityType = "client_bank_account"
+)
+
+// RetentionAction は保存期間を過ぎたデータの処理方法です
+type RetentionAction string
+
+const (
+	// RetentionActionPurge はデータを物理削除します
+	RetentionActionPurge RetentionAction = "purge"
+	// RetentionActionAnonymize は個人情報を消去し、他のデータから参照される行は残します
+	RetentionActionAnonymize RetentionAction = "anonymize"
+)
+
+// RetentionClass は請求書の保存期間の区分です
+type RetentionClass string
+
+const (
+	// RetentionClassStandard は法定の保存期間（7年）の請求書です
+	RetentionClassStandard RetentionClass = "standard"
+	// RetentionClassExtended は欠損金の繰越控除を受ける事業年度など、長い保存期間（10年）が必要な請求書です
+	RetentionClassExtended RetentionClass = "extended"
+)
+
+func (c RetentionClass) IsValid() bool {
+	switch c {
+	case RetentionClassStandard, RetentionClassExtended:
+		return true
+	}
+
+	return false
+}
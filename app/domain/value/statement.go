@@ -0,0 +1,61 @@
+package value
+
+// StatementFormat は取り込む銀行の入出金明細の形式です
+type StatementFormat string
+
+const (
+	// StatementFormatZengin は全銀協の入出金取引明細（固定長200バイト、Shift_JIS）です
+	StatementFormatZengin StatementFormat = "zengin"
+	// StatementFormatCSV は見出し行付きの簡易な CSV（UTF-8）です
+	StatementFormatCSV StatementFormat = "csv"
+)
+
+// IsValid は形式が定義済みの値かを判定します
+func (f StatementFormat) IsValid() bool {
+	switch f {
+	case StatementFormatZengin, StatementFormatCSV:
+		return true
+	}
+
+	return false
+}
+
+// StatementDirection は明細の入金・出金の区分です
+type StatementDirection string
+
+const (
+	StatementDirectionDeposit    StatementDirection = "deposit"
+	StatementDirectionWithdrawal StatementDirection = "withdrawal"
+)
+
+// IsValid は区分が定義済みの値かを判定します
+func (d StatementDirection) IsValid() bool {
+	switch d {
+	case StatementDirectionDeposit, StatementDirectionWithdrawal:
+		return true
+	}
+
+	return false
+}
+
+// StatementLineStatus は明細の消し込みの状態です
+type StatementLineStatus string
+
+const (
+	// StatementLineStatusPending は請求書との照合を確認待ちの出金です
+	StatementLineStatusPending StatementLineStatus = "pending"
+	// StatementLineStatusConfirmed は請求書の支払として記録した出金です
+	StatementLineStatusConfirmed StatementLineStatus = "confirmed"
+	// StatementLineStatusIgnored は請求書の支払ではない明細です。入金は取り込み時にこの状態にします
+	StatementLineStatusIgnored StatementLineStatus = "ignored"
+)
+
+// IsValid は状態が定義済みの値かを判定します
+func (s StatementLineStatus) IsValid() bool {
+	switch s {
+	case StatementLineStatusPending, StatementLineStatusConfirmed, StatementLineStatusIgnored:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+package value
+
+import "github.com/shopspring/decimal"
+
+// TaxCategory は請求書の明細に適用する消費税の区分です
+type TaxCategory string
+
+const (
+	// TaxCategoryStandard は標準税率（10%）の明細です
+	TaxCategoryStandard TaxCategory = "standard"
+	// TaxCategoryReduced は軽減税率（8%）の明細です
+	TaxCategoryReduced TaxCategory = "reduced"
+	// TaxCategoryExempt は非課税・不課税の明細です
+	TaxCategoryExempt TaxCategory = "exempt"
+)
+
+// TaxCategories は税区分を請求書に記載する順に返します
+func TaxCategories() []TaxCategory {
+	return []TaxCategory{TaxCategoryStandard, TaxCategoryReduced, TaxCategoryExempt}
+}
+
+// IsValid は税区分が定義済みの値かを判定します
+func (c TaxCategory) IsValid() bool {
+	switch c {
+	case TaxCategoryStandard, TaxCategoryReduced, TaxCategoryExempt:
+		return true
+	}
+
+	return false
+}
+
+// Rate は税区分の税率を返します
+func (c TaxCategory) Rate() decimal.Decimal {
+	switch c {
+	case TaxCategoryStandard:
+		return decimal.NewFromFloat(0.10)
+	case TaxCategoryReduced:
+		return decimal.NewFromFloat(0.08)
+	}
+
+	return decimal.Zero
+}
@@ -0,0 +1,15 @@
+package value
+
+type UserRole string
+
+const (
+	UserRoleMember UserRole = "member"
+	// UserRoleApprover は承認フローで請求書を承認できるロールです
+	UserRoleApprover UserRole = "approver"
+	UserRoleAdmin    UserRole = "admin"
+)
+
+// IsValid はロールが定義済みの値かを判定します
+func (r UserRole) IsValid() bool {
+	return r == UserRoleMember || r == UserRoleApprover || r == UserRoleAdmin
+}
@@ -0,0 +1,23 @@
+package value
+
+// WithholdingCategory は源泉徴収の対象となる報酬・料金の区分（所得税法第204条第1項）です
+type WithholdingCategory string
+
+const (
+	// WithholdingCategoryManuscript は原稿料・講演料などです（第1号）
+	WithholdingCategoryManuscript WithholdingCategory = "manuscript"
+	// WithholdingCategoryDesign はデザイン料です（第1号）
+	WithholdingCategoryDesign WithholdingCategory = "design"
+	// WithholdingCategoryProfessional は弁護士・税理士・社会保険労務士などの報酬です（第2号）
+	WithholdingCategoryProfessional WithholdingCategory = "professional"
+)
+
+// IsValid は源泉徴収の区分が定義済みの値かを判定します
+func (c WithholdingCategory) IsValid() bool {
+	switch c {
+	case WithholdingCategoryManuscript, WithholdingCategoryDesign, WithholdingCategoryProfessional:
+		return true
+	}
+
+	return false
+}
@@ -4,13 +4,13 @@ import (
 	"fmt"
 
 	"github.com/ijufumi/practice-202512/app/config"
-	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/tracing"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	gormlogger "gorm.io/gorm/logger"
 )
 
-func NewConnection(cfg *config.Config) (*gorm.DB, error) {
+func NewConnection(cfg *config.Config, logger gormlogger.Interface) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 		cfg.DBUser,
 		cfg.DBPassword,
@@ -20,23 +20,22 @@ func NewConnection(cfg *config.Config) (*gorm.DB, error) {
 	)
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: logger,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	// SQLのトレース
+	if err := db.Use(tracing.NewGormPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+	}
+
 	// テーブル作成オプション設定
 	db = db.Set("gorm:table_options", "ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin")
 
 	// マイグレーション
-	if err := db.AutoMigrate(
-		&entities.Company{},
-		&entities.User{},
-		&entities.Client{},
-		&entities.ClientBankAccount{},
-		&entities.Invoice{},
-	); err != nil {
+	if err := Migrate(db); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+// ApprovalRule は会社の承認フローの1段階です。請求金額が MinAmount 以上の請求書に Role の承認を求めます
+type ApprovalRule struct {
+	ID        string          `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID string          `gorm:"type:char(26);not null;uniqueIndex:idx_approval_rules_company_step" json:"company_id"`
+	Step      int             `gorm:"not null;uniqueIndex:idx_approval_rules_company_step" json:"step"`
+	MinAmount decimal.Decimal `gorm:"type:decimal(20,2);not null" json:"min_amount"`
+	Role      value.UserRole  `gorm:"size:20;not null" json:"role"`
+	CreatedAt time.Time       `gorm:"autoCreateTime" json:"created_at"`
+
+	Company Company `gorm:"foreignKey:CompanyID"`
+}
+
+func (a *ApprovalRule) TableName() string {
+	return "approval_rules"
+}
+
+func (a *ApprovalRule) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = util.GenerateULID()
+	}
+
+	return nil
+}
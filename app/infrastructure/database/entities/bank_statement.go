@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+// BankStatement は取り込んだ銀行の入出金明細のファイルです
+type BankStatement struct {
+	ID         string                `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID  string                `gorm:"type:char(26);not null;index" json:"company_id"`
+	Format     value.StatementFormat `gorm:"size:20;not null" json:"format"`
+	ImportedBy string                `gorm:"type:char(26);not null" json:"imported_by"`
+	CreatedAt  time.Time             `gorm:"autoCreateTime" json:"created_at"`
+
+	Company Company `gorm:"foreignKey:CompanyID"`
+}
+
+func (s *BankStatement) TableName() string {
+	return "bank_statements"
+}
+
+func (s *BankStatement) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = util.GenerateULID()
+	}
+
+	return nil
+}
+
+// BankStatementLine は入出金明細の1件の取引と、請求書との消し込みの状態です。
+// 同じ明細を2回取り込まないよう、会社ごとに Fingerprint を一意にします
+type BankStatementLine struct {
+	ID               string                    `gorm:"primaryKey;type:char(26)" json:"id"`
+	StatementID      string                    `gorm:"type:char(26);not null;index" json:"statement_id"`
+	CompanyID        string                    `gorm:"type:char(26);not null;uniqueIndex:idx_bank_statement_lines_company_fingerprint;index:idx_bank_statement_lines_company_status" json:"company_id"`
+	LineNo           int                       `gorm:"not null" json:"line_no"`
+	Fingerprint      string                    `gorm:"type:char(64);not null;uniqueIndex:idx_bank_statement_lines_company_fingerprint" json:"fingerprint"`
+	TransactionDate  time.Time                 `gorm:"not null" json:"transaction_date"`
+	Direction        value.StatementDirection  `gorm:"size:20;not null" json:"direction"`
+	Amount           decimal.Decimal           `gorm:"type:decimal(20,2);not null" json:"amount"`
+	CounterpartyName string                    `gorm:"size:100;not null;default:''" json:"counterparty_name"`
+	Description      string                    `gorm:"size:100;not null;default:''" json:"description"`
+	Reference        string                    `gorm:"size:100;not null;default:''" json:"reference"`
+	Status           value.StatementLineStatus `gorm:"size:20;not null;index:idx_bank_statement_lines_company_status" json:"status"`
+	InvoiceID        *string                   `gorm:"type:char(26)" json:"invoice_id"`
+	PaymentID        *string                   `gorm:"type:char(26)" json:"payment_id"`
+	Score            int                       `gorm:"not null;default:0" json:"score"`
+	AutoConfirmed    bool                      `gorm:"not null;default:false" json:"auto_confirmed"`
+	DecidedBy        *string                   `gorm:"type:char(26)" json:"decided_by"`
+	DecidedAt        *time.Time                `json:"decided_at"`
+	Version          int                       `gorm:"not null;default:1" json:"version"`
+	CreatedAt        time.Time                 `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time                 `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Statement BankStatement `gorm:"foreignKey:StatementID"`
+}
+
+func (l *BankStatementLine) TableName() string {
+	return "bank_statement_lines"
+}
+
+func (l *BankStatementLine) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == "" {
+		l.ID = util.GenerateULID()
+	}
+	if l.Version == 0 {
+		l.Version = 1
+	}
+
+	return nil
+}
@@ -3,21 +3,29 @@ package entities
 import (
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/util"
 
 	"gorm.io/gorm"
 )
 
 type Client struct {
-	ID                 string    `gorm:"primaryKey;type:char(26)" json:"id"`
-	CompanyID          string    `gorm:"type:char(26);not null;index" json:"company_id"`
-	CorporateName      string    `gorm:"size:200;not null" json:"corporate_name"`
-	RepresentativeName string    `gorm:"size:100;not null" json:"representative_name"`
-	PhoneNumber        string    `gorm:"size:20;not null" json:"phone_number"`
-	PostalCode         string    `gorm:"size:10;not null" json:"postal_code"`
-	Address            string    `gorm:"size:500;not null" json:"address"`
-	CreatedAt          time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt          time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID                 string           `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID          string           `gorm:"type:char(26);not null;index" json:"company_id"`
+	CorporateName      string           `gorm:"size:200;not null" json:"corporate_name"`
+	RepresentativeName string           `gorm:"size:100;not null" json:"representative_name"`
+	PhoneNumber        string           `gorm:"type:text;not null;serializer:encrypted" json:"phone_number"`
+	PostalCode         string           `gorm:"size:10;not null" json:"postal_code"`
+	Address            string           `gorm:"type:text;not null;serializer:encrypted" json:"address"`
+	ClientType         value.ClientType `gorm:"size:20;not null;default:'corporation'" json:"client_type"`
+	// Withholding は支払から源泉徴収する取引先かどうかです
+	Withholding         bool                      `gorm:"not null;default:false" json:"withholding"`
+	WithholdingCategory value.WithholdingCategory `gorm:"size:20;not null;default:''" json:"withholding_category"`
+	Version             int                       `gorm:"not null;default:1" json:"version"`
+	CreatedAt           time.Time                 `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt           time.Time                 `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt           gorm.DeletedAt            `gorm:"index" json:"-"`
+	AnonymizedAt        *time.Time                `json:"-"`
 
 	Company Company `gorm:"foreignKey:CompanyID"`
 }
@@ -30,6 +38,12 @@ func (c *Client) BeforeCreate(tx *gorm.DB) error {
 	if c.ID == "" {
 		c.ID = util.GenerateULID()
 	}
+	if c.ClientType == "" {
+		c.ClientType = value.ClientTypeCorporation
+	}
+	if c.Version == 0 {
+		c.Version = 1
+	}
 
 	return nil
 }
@@ -3,20 +3,24 @@ package entities
 import (
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
 	"github.com/ijufumi/practice-202512/app/util"
 
 	"gorm.io/gorm"
 )
 
 type ClientBankAccount struct {
-	ID            string    `gorm:"primaryKey;type:char(26)" json:"id"`
-	ClientID      string    `gorm:"type:char(26);not null;index" json:"client_id"`
-	BankName      string    `gorm:"size:100;not null" json:"bank_name"`
-	BranchName    string    `gorm:"size:100;not null" json:"branch_name"`
-	AccountNumber string    `gorm:"size:20;not null" json:"account_number"`
-	AccountName   string    `gorm:"size:100;not null" json:"account_name"`
-	CreatedAt     time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt     time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID                 string         `gorm:"primaryKey;type:char(26)" json:"id"`
+	ClientID           string         `gorm:"type:char(26);not null;index" json:"client_id"`
+	BankName           string         `gorm:"size:100;not null" json:"bank_name"`
+	BranchName         string         `gorm:"size:100;not null" json:"branch_name"`
+	AccountNumber      string         `gorm:"type:text;not null;serializer:encrypted" json:"account_number"`
+	AccountName        string         `gorm:"type:text;not null;serializer:encrypted" json:"account_name"`
+	AccountNumberIndex string         `gorm:"type:char(64);not null;default:'';index" json:"-" encryption:"blind_index"`
+	Version            int            `gorm:"not null;default:1" json:"version"`
+	CreatedAt          time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `gorm:"index" json:"-"`
 
 	Client Client `gorm:"foreignKey:ClientID"`
 }
@@ -25,10 +29,28 @@ func (c *ClientBankAccount) TableName() string {
 	return "client_bank_accounts"
 }
 
+// AccountNumberIndexPurpose は口座番号のブラインドインデックス（AccountNumberIndex）の用途名です。
+// 暗号化した口座番号は完全一致で検索できないため、AccountNumberIndex で検索します
+const AccountNumberIndexPurpose = "client_bank_accounts.account_number"
+
+// BeforeSave は暗号化前の口座番号からブラインドインデックスを計算します
+func (c *ClientBankAccount) BeforeSave(tx *gorm.DB) error {
+	index, err := encryption.BlindIndex(AccountNumberIndexPurpose, c.AccountNumber)
+	if err != nil {
+		return err
+	}
+	c.AccountNumberIndex = index
+
+	return nil
+}
+
 func (c *ClientBankAccount) BeforeCreate(tx *gorm.DB) error {
 	if c.ID == "" {
 		c.ID = util.GenerateULID()
 	}
+	if c.Version == 0 {
+		c.Version = 1
+	}
 
 	return nil
 }
@@ -3,20 +3,36 @@ package entities
 import (
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/util"
 
 	"gorm.io/gorm"
 )
 
+// DefaultFiscalYearStartMonth は会計年度の始まる月の既定値です（4月）
+const DefaultFiscalYearStartMonth = 4
+
 type Company struct {
-	ID                 string    `gorm:"primaryKey;type:char(26)" json:"id"`
-	CorporateName      string    `gorm:"size:200;not null" json:"corporate_name"`
-	RepresentativeName string    `gorm:"size:100;not null" json:"representative_name"`
-	PhoneNumber        string    `gorm:"size:20;not null" json:"phone_number"`
-	PostalCode         string    `gorm:"size:10;not null" json:"postal_code"`
-	Address            string    `gorm:"size:500;not null" json:"address"`
-	CreatedAt          time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt          time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID                 string `gorm:"primaryKey;type:char(26)" json:"id"`
+	CorporateName      string `gorm:"size:200;not null" json:"corporate_name"`
+	RepresentativeName string `gorm:"size:100;not null" json:"representative_name"`
+	PhoneNumber        string `gorm:"size:20;not null" json:"phone_number"`
+	PostalCode         string `gorm:"size:10;not null" json:"postal_code"`
+	Address            string `gorm:"size:500;not null" json:"address"`
+	// DueDatePolicy は支払期日が銀行の休業日に当たる場合の調整方法です
+	DueDatePolicy value.DueDatePolicy `gorm:"size:20;not null;default:'previous'" json:"due_date_policy"`
+	// InvoiceNumberFormat と FiscalYearStartMonth は請求書番号の書式と、番号を振り直す会計年度の始まる月です
+	InvoiceNumberFormat  value.InvoiceNumberFormat `gorm:"size:30;not null;default:'INV-{YYYY}-{SEQ:6}'" json:"invoice_number_format"`
+	FiscalYearStartMonth int                       `gorm:"not null;default:4" json:"fiscal_year_start_month"`
+	// InvoiceNumberingVersion は請求書番号の振り方の楽観ロック用のバージョンです
+	InvoiceNumberingVersion int `gorm:"not null;default:1" json:"invoice_numbering_version"`
+	// ApprovalWorkflowVersion は承認フロー（approval_rules）全体の楽観ロック用のバージョンです
+	ApprovalWorkflowVersion int `gorm:"not null;default:1" json:"approval_workflow_version"`
+	// JournalAccountsVersion は勘定科目の名前の設定（journal_accounts）全体の楽観ロック用のバージョンです
+	JournalAccountsVersion int            `gorm:"not null;default:1" json:"journal_accounts_version"`
+	CreatedAt              time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt              time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt              gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 func (c *Company) TableName() string {
@@ -27,6 +43,24 @@ func (c *Company) BeforeCreate(tx *gorm.DB) error {
 	if c.ID == "" {
 		c.ID = util.GenerateULID()
 	}
+	if c.DueDatePolicy == "" {
+		c.DueDatePolicy = value.DueDatePolicyPrevious
+	}
+	if c.InvoiceNumberFormat == "" {
+		c.InvoiceNumberFormat = value.DefaultInvoiceNumberFormat
+	}
+	if c.FiscalYearStartMonth == 0 {
+		c.FiscalYearStartMonth = DefaultFiscalYearStartMonth
+	}
+	if c.InvoiceNumberingVersion == 0 {
+		c.InvoiceNumberingVersion = 1
+	}
+	if c.ApprovalWorkflowVersion == 0 {
+		c.ApprovalWorkflowVersion = 1
+	}
+	if c.JournalAccountsVersion == 0 {
+		c.JournalAccountsVersion = 1
+	}
 
 	return nil
 }
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+// CreditNote は請求書を減額する返品・値引きの記録です。発行後は変更しません
+type CreditNote struct {
+	ID            string                 `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID     string                 `gorm:"type:char(26);not null;index" json:"company_id"`
+	InvoiceID     string                 `gorm:"type:char(26);not null;index" json:"invoice_id"`
+	IssueDate     time.Time              `gorm:"not null" json:"issue_date"`
+	Reason        value.CreditNoteReason `gorm:"size:20;not null" json:"reason"`
+	Note          string                 `gorm:"size:200;not null;default:''" json:"note"`
+	PaymentAmount decimal.Decimal        `gorm:"type:decimal(20,2);not null" json:"payment_amount"`
+	Fee           decimal.Decimal        `gorm:"type:decimal(20,2);not null" json:"fee"`
+	FeeRate       decimal.Decimal        `gorm:"type:decimal(5,4);not null" json:"fee_rate"`
+	Tax           decimal.Decimal        `gorm:"type:decimal(20,2);not null" json:"tax"`
+	TaxRate       decimal.Decimal        `gorm:"type:decimal(5,4);not null" json:"tax_rate"`
+	CreditAmount  decimal.Decimal        `gorm:"type:decimal(20,2);not null" json:"credit_amount"`
+	CreatedAt     time.Time              `gorm:"autoCreateTime" json:"created_at"`
+
+	Company Company `gorm:"foreignKey:CompanyID"`
+	Invoice Invoice `gorm:"foreignKey:InvoiceID"`
+}
+
+func (c *CreditNote) TableName() string {
+	return "credit_notes"
+}
+
+func (c *CreditNote) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = util.GenerateULID()
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package entities
+
+// Models はマイグレーション対象のエンティティを依存関係の順に返します
+func Models() []interface{} {
+	return []interface{}{
+		&Company{},
+		&User{},
+		&Client{},
+		&ClientBankAccount{},
+		&InvoiceNumberSequence{},
+		&Invoice{},
+		&InvoiceLine{},
+		&Payment{},
+		&CreditNote{},
+		&InvoiceAttachment{},
+		&ApprovalRule{},
+		&InvoiceApproval{},
+		&RecurringInvoice{},
+		&RecurringInvoiceRun{},
+		&ExchangeRate{},
+		&JournalAccount{},
+		&BankStatement{},
+		&BankStatementLine{},
+	}
+}
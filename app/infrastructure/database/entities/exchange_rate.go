@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+// ExchangeRate は外貨1単位あたりの円の為替レートです。通貨と日付ごとに1件です
+type ExchangeRate struct {
+	ID        string          `gorm:"primaryKey;type:char(26)" json:"id"`
+	Currency  value.Currency  `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_currency_rate_date" json:"currency"`
+	RateDate  time.Time       `gorm:"not null;uniqueIndex:idx_exchange_rates_currency_rate_date" json:"rate_date"`
+	Rate      decimal.Decimal `gorm:"type:decimal(20,6);not null" json:"rate"`
+	CreatedAt time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (r *ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+func (r *ExchangeRate) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = util.GenerateULID()
+	}
+
+	return nil
+}
@@ -11,20 +11,33 @@ import (
 )
 
 type Invoice struct {
-	ID             string              `gorm:"primaryKey;type:char(26)" json:"id"`
-	CompanyID      string              `gorm:"type:char(26);not null;index" json:"company_id"`
-	ClientID       string              `gorm:"type:char(26);not null;index" json:"client_id"`
-	IssueDate      time.Time           `gorm:"not null" json:"issue_date"`
-	PaymentAmount  decimal.Decimal     `gorm:"type:decimal(20,2);not null" json:"payment_amount"`
-	Fee            decimal.Decimal     `gorm:"type:decimal(20,2);not null" json:"fee"`
-	FeeRate        decimal.Decimal     `gorm:"type:decimal(5,4);not null" json:"fee_rate"`
-	Tax            decimal.Decimal     `gorm:"type:decimal(20,2);not null" json:"tax"`
-	TaxRate        decimal.Decimal     `gorm:"type:decimal(5,4);not null" json:"tax_rate"`
-	InvoiceAmount  decimal.Decimal     `gorm:"type:decimal(20,2);not null" json:"invoice_amount"`
-	PaymentDueDate time.Time           `gorm:"not null;index" json:"payment_due_date"`
-	Status         value.InvoiceStatus `gorm:"size:20;not null;index" json:"status"`
-	CreatedAt      time.Time           `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt      time.Time           `gorm:"autoUpdateTime" json:"updated_at"`
+	ID                   string               `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID            string               `gorm:"type:char(26);not null;index;uniqueIndex:idx_invoices_company_number" json:"company_id"`
+	InvoiceNumber        *string              `gorm:"size:50;uniqueIndex:idx_invoices_company_number" json:"invoice_number"`
+	ClientID             string               `gorm:"type:char(26);not null;index" json:"client_id"`
+	IssueDate            time.Time            `gorm:"not null" json:"issue_date"`
+	Currency             value.Currency       `gorm:"type:char(3);not null;default:'JPY'" json:"currency"`
+	PaymentAmount        decimal.Decimal      `gorm:"type:decimal(20,2);not null" json:"payment_amount"`
+	ExchangeRate         decimal.Decimal      `gorm:"type:decimal(20,6);not null;default:1" json:"exchange_rate"`
+	PaymentAmountJPY     decimal.Decimal      `gorm:"type:decimal(20,2);not null;default:0" json:"payment_amount_jpy"`
+	Fee                  decimal.Decimal      `gorm:"type:decimal(20,2);not null" json:"fee"`
+	FeeRate              decimal.Decimal      `gorm:"type:decimal(5,4);not null" json:"fee_rate"`
+	Tax                  decimal.Decimal      `gorm:"type:decimal(20,2);not null" json:"tax"`
+	TaxRate              decimal.Decimal      `gorm:"type:decimal(5,4);not null" json:"tax_rate"`
+	InvoiceAmount        decimal.Decimal      `gorm:"type:decimal(20,2);not null" json:"invoice_amount"`
+	WithholdingTax       decimal.Decimal      `gorm:"type:decimal(20,2);not null;default:0" json:"withholding_tax"`
+	CreditedAmount       decimal.Decimal      `gorm:"type:decimal(20,2);not null;default:0" json:"credited_amount"`
+	PaidAmount           decimal.Decimal      `gorm:"type:decimal(20,2);not null;default:0" json:"paid_amount"`
+	PaymentDueDate       time.Time            `gorm:"not null;index" json:"payment_due_date"`
+	Status               value.InvoiceStatus  `gorm:"size:20;not null;index" json:"status"`
+	Version              int                  `gorm:"not null;default:1" json:"version"`
+	CreatedBy            string               `gorm:"type:char(26);not null;default:''" json:"created_by"`
+	DuplicateCheck       value.DuplicateCheck `gorm:"size:20;not null;default:'unchecked'" json:"duplicate_check"`
+	SuspectedDuplicateID *string              `gorm:"type:char(26)" json:"suspected_duplicate_id"`
+	RetentionClass       value.RetentionClass `gorm:"size:20;not null;default:'standard'" json:"retention_class"`
+	CreatedAt            time.Time            `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt            time.Time            `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt            gorm.DeletedAt       `gorm:"index" json:"-"`
 
 	Company Company `gorm:"foreignKey:CompanyID"`
 	Client  Client  `gorm:"foreignKey:ClientID"`
@@ -38,6 +51,15 @@ func (i *Invoice) BeforeCreate(tx *gorm.DB) error {
 	if i.ID == "" {
 		i.ID = util.GenerateULID()
 	}
+	if i.Currency == "" {
+		i.Currency = value.CurrencyJPY
+	}
+	if i.Version == 0 {
+		i.Version = 1
+	}
+	if i.RetentionClass == "" {
+		i.RetentionClass = value.RetentionClassStandard
+	}
 
 	return nil
 }
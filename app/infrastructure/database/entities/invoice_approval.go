@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// InvoiceApproval は請求書の作成時に承認フローから決めた承認の段階と、その判断の記録です
+type InvoiceApproval struct {
+	ID        string               `gorm:"primaryKey;type:char(26)" json:"id"`
+	InvoiceID string               `gorm:"type:char(26);not null;uniqueIndex:idx_invoice_approvals_invoice_step" json:"invoice_id"`
+	Step      int                  `gorm:"not null;uniqueIndex:idx_invoice_approvals_invoice_step" json:"step"`
+	Role      value.UserRole       `gorm:"size:20;not null" json:"role"`
+	Status    value.ApprovalStatus `gorm:"size:20;not null" json:"status"`
+	DecidedBy *string              `gorm:"type:char(26)" json:"decided_by"`
+	Comment   string               `gorm:"size:500;not null;default:''" json:"comment"`
+	DecidedAt *time.Time           `json:"decided_at"`
+	CreatedAt time.Time            `gorm:"autoCreateTime" json:"created_at"`
+
+	Invoice Invoice `gorm:"foreignKey:InvoiceID"`
+}
+
+func (a *InvoiceApproval) TableName() string {
+	return "invoice_approvals"
+}
+
+func (a *InvoiceApproval) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = util.GenerateULID()
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// InvoiceAttachment は請求書に添付した原本のファイルの情報です。内容は BlobStore に保存します
+type InvoiceAttachment struct {
+	ID          string                      `gorm:"primaryKey;type:char(26)" json:"id"`
+	InvoiceID   string                      `gorm:"type:char(26);not null;index" json:"invoice_id"`
+	FileName    string                      `gorm:"size:255;not null" json:"file_name"`
+	ContentType value.AttachmentContentType `gorm:"size:50;not null" json:"content_type"`
+	Size        int64                       `gorm:"not null" json:"size"`
+	SHA256      string                      `gorm:"column:sha256;type:char(64);not null" json:"sha256"`
+	StorageKey  string                      `gorm:"size:255;not null;uniqueIndex" json:"storage_key"`
+	CreatedBy   string                      `gorm:"type:char(26);not null" json:"created_by"`
+	CreatedAt   time.Time                   `gorm:"autoCreateTime" json:"created_at"`
+
+	Invoice Invoice `gorm:"foreignKey:InvoiceID"`
+	User    User    `gorm:"foreignKey:CreatedBy"`
+}
+
+func (a *InvoiceAttachment) TableName() string {
+	return "invoice_attachments"
+}
+
+func (a *InvoiceAttachment) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = util.GenerateULID()
+	}
+
+	return nil
+}
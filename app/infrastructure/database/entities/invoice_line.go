@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+// InvoiceLine は請求書の明細です。明細のない請求書は支払金額だけを持ちます
+type InvoiceLine struct {
+	ID          string            `gorm:"primaryKey;type:char(26)" json:"id"`
+	InvoiceID   string            `gorm:"type:char(26);not null;uniqueIndex:idx_invoice_lines_invoice_line_no" json:"invoice_id"`
+	LineNo      int               `gorm:"not null;uniqueIndex:idx_invoice_lines_invoice_line_no" json:"line_no"`
+	Description string            `gorm:"size:200;not null" json:"description"`
+	Quantity    decimal.Decimal   `gorm:"type:decimal(15,3);not null" json:"quantity"`
+	UnitPrice   decimal.Decimal   `gorm:"type:decimal(20,2);not null" json:"unit_price"`
+	TaxCategory value.TaxCategory `gorm:"size:20;not null" json:"tax_category"`
+	Amount      decimal.Decimal   `gorm:"type:decimal(20,2);not null" json:"amount"`
+	CreatedAt   time.Time         `gorm:"autoCreateTime" json:"created_at"`
+
+	Invoice Invoice `gorm:"foreignKey:InvoiceID"`
+}
+
+func (l *InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
+
+func (l *InvoiceLine) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == "" {
+		l.ID = util.GenerateULID()
+	}
+
+	return nil
+}
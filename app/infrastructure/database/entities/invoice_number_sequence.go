@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// InvoiceNumberSequence は会社の会計年度ごとに最後に振った請求書番号の連番です。
+// 請求書を作成するトランザクションの中で増やすため、作成を取り消した連番は次の請求書に振り直されます
+type InvoiceNumberSequence struct {
+	CompanyID  string    `gorm:"primaryKey;type:char(26)" json:"company_id"`
+	FiscalYear int       `gorm:"primaryKey;autoIncrement:false" json:"fiscal_year"`
+	LastValue  int64     `gorm:"not null" json:"last_value"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Company Company `gorm:"foreignKey:CompanyID"`
+}
+
+func (s *InvoiceNumberSequence) TableName() string {
+	return "invoice_number_sequences"
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// JournalAccount は会社が仕訳に使う勘定科目の名前です。設定のない役割は既定の名前を使います
+type JournalAccount struct {
+	ID        string                   `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID string                   `gorm:"type:char(26);not null;uniqueIndex:idx_journal_accounts_company_type" json:"company_id"`
+	Type      value.JournalAccountType `gorm:"size:20;not null;uniqueIndex:idx_journal_accounts_company_type" json:"type"`
+	Name      string                   `gorm:"size:50;not null" json:"name"`
+	CreatedAt time.Time                `gorm:"autoCreateTime" json:"created_at"`
+
+	Company Company `gorm:"foreignKey:CompanyID"`
+}
+
+func (a *JournalAccount) TableName() string {
+	return "journal_accounts"
+}
+
+func (a *JournalAccount) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = util.GenerateULID()
+	}
+
+	return nil
+}
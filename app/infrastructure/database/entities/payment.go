@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+// Payment は請求書に対する支払の記録です。記録後は変更しません
+type Payment struct {
+	ID        string              `gorm:"primaryKey;type:char(26)" json:"id"`
+	InvoiceID string              `gorm:"type:char(26);not null;index" json:"invoice_id"`
+	Amount    decimal.Decimal     `gorm:"type:decimal(20,2);not null" json:"amount"`
+	PaidDate  time.Time           `gorm:"not null" json:"paid_date"`
+	Method    value.PaymentMethod `gorm:"size:20;not null" json:"method"`
+	Reference string              `gorm:"size:100;not null;default:''" json:"reference"`
+	CreatedAt time.Time           `gorm:"autoCreateTime" json:"created_at"`
+
+	Invoice Invoice `gorm:"foreignKey:InvoiceID"`
+}
+
+func (p *Payment) TableName() string {
+	return "payments"
+}
+
+func (p *Payment) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = util.GenerateULID()
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+type RecurringInvoice struct {
+	ID             string                  `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID      string                  `gorm:"type:char(26);not null;index" json:"company_id"`
+	ClientID       string                  `gorm:"type:char(26);not null;index" json:"client_id"`
+	PaymentAmount  decimal.Decimal         `gorm:"type:decimal(20,2);not null" json:"payment_amount"`
+	Cadence        value.RecurrenceCadence `gorm:"size:20;not null" json:"cadence"`
+	DayOfMonth     int                     `gorm:"not null;default:0" json:"day_of_month"`
+	PaymentDueDays int                     `gorm:"not null" json:"payment_due_days"`
+	StartDate      time.Time               `gorm:"not null" json:"start_date"`
+	EndDate        *time.Time              `json:"end_date"`
+	NextIssueDate  time.Time               `gorm:"not null;index" json:"next_issue_date"`
+	CreatedBy      string                  `gorm:"type:char(26);not null" json:"created_by"`
+	Version        int                     `gorm:"not null;default:1" json:"version"`
+	CreatedAt      time.Time               `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time               `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt      gorm.DeletedAt          `gorm:"index" json:"-"`
+
+	Company Company `gorm:"foreignKey:CompanyID"`
+	Client  Client  `gorm:"foreignKey:ClientID"`
+	User    User    `gorm:"foreignKey:CreatedBy"`
+}
+
+func (r *RecurringInvoice) TableName() string {
+	return "recurring_invoices"
+}
+
+func (r *RecurringInvoice) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = util.GenerateULID()
+	}
+	if r.Version == 0 {
+		r.Version = 1
+	}
+
+	return nil
+}
+
+// RecurringInvoiceRun は定期請求の期間ごとの作成記録です。
+// 定期請求と期間の組み合わせを一意にし、再起動や複数台での実行でも請求書を1回だけ作成します
+type RecurringInvoiceRun struct {
+	ID                 string    `gorm:"primaryKey;type:char(26)" json:"id"`
+	RecurringInvoiceID string    `gorm:"type:char(26);not null;uniqueIndex:idx_recurring_invoice_runs_period" json:"recurring_invoice_id"`
+	Period             string    `gorm:"type:char(7);not null;uniqueIndex:idx_recurring_invoice_runs_period" json:"period"`
+	IssueDate          time.Time `gorm:"not null" json:"issue_date"`
+	InvoiceID          string    `gorm:"type:char(26);not null;index" json:"invoice_id"`
+	CreatedAt          time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	RecurringInvoice RecurringInvoice `gorm:"foreignKey:RecurringInvoiceID"`
+}
+
+func (r *RecurringInvoiceRun) TableName() string {
+	return "recurring_invoice_runs"
+}
+
+func (r *RecurringInvoiceRun) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = util.GenerateULID()
+	}
+
+	return nil
+}
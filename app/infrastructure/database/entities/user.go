@@ -3,19 +3,22 @@ package entities
 import (
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/util"
 
 	"gorm.io/gorm"
 )
 
 type User struct {
-	ID        string    `gorm:"primaryKey;type:char(26)" json:"id"`
-	CompanyID string    `gorm:"type:char(26);not null;index" json:"company_id"`
-	Name      string    `gorm:"size:100;not null" json:"name"`
-	Email     string    `gorm:"size:100;not null;uniqueIndex" json:"email"`
-	Password  string    `gorm:"size:255;not null" json:"password"`
-	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID        string         `gorm:"primaryKey;type:char(26)" json:"id"`
+	CompanyID string         `gorm:"type:char(26);not null;index" json:"company_id"`
+	Name      string         `gorm:"size:100;not null" json:"name"`
+	Email     string         `gorm:"size:100;not null;uniqueIndex" json:"email"`
+	Password  string         `gorm:"size:255;not null" json:"password"`
+	Role      value.UserRole `gorm:"size:20;not null;default:'member'" json:"role"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 
 	Company Company `gorm:"foreignKey:CompanyID"`
 }
@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type approvalRuleRepository struct{}
+
+func NewApprovalRuleRepository() repository.ApprovalRuleRepository {
+	return &approvalRuleRepository{}
+}
+
+func (r *approvalRuleRepository) FindByCompanyID(db *gorm.DB, companyID string) ([]*models.ApprovalRule, error) {
+	var daoRules []*entities.ApprovalRule
+	if err := db.Where("company_id = ?", companyID).Order("step").Find(&daoRules).Error; err != nil {
+		return nil, err
+	}
+
+	rules := make([]*models.ApprovalRule, len(daoRules))
+	for i, daoRule := range daoRules {
+		rules[i] = models.ApprovalRuleFromDAO(daoRule)
+	}
+
+	return rules, nil
+}
+
+func (r *approvalRuleRepository) FindVersionByCompanyID(db *gorm.DB, companyID string) (int, error) {
+	var daoCompany entities.Company
+	if err := db.Select("approval_workflow_version").First(&daoCompany, "id = ?", companyID).Error; err != nil {
+		return 0, err
+	}
+
+	return daoCompany.ApprovalWorkflowVersion, nil
+}
+
+func (r *approvalRuleRepository) ReplaceByCompanyID(db *gorm.DB, companyID string, rules []*models.ApprovalRule, version int) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// 段階は行ごとに置き換えるため、会社の行のバージョンで承認フロー全体の更新を直列にする
+		if err := updateWithVersionColumn(tx, &entities.Company{}, "approval_workflow", companyID, "approval_workflow_version", version, nil); err != nil {
+			return err
+		}
+		if err := tx.Where("company_id = ?", companyID).Delete(&entities.ApprovalRule{}).Error; err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			daoRule := rule.ToDAO()
+			daoRule.CompanyID = companyID
+			if err := tx.Create(daoRule).Error; err != nil {
+				return err
+			}
+			rule.ID = daoRule.ID
+			rule.CompanyID = daoRule.CompanyID
+			rule.CreatedAt = daoRule.CreatedAt
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalRuleRepository_ReplaceByCompanyID(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewApprovalRuleRepository()
+	companyID := client.CompanyID
+
+	t.Run("承認フローがない場合は空", func(t *testing.T) {
+		rules, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Empty(t, rules)
+
+		version, err := repo.FindVersionByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, version)
+	})
+
+	t.Run("承認フローを置き換えて段階の順に返す", func(t *testing.T) {
+		assert.NoError(t, repo.ReplaceByCompanyID(db, companyID, []*models.ApprovalRule{
+			{Step: 1, MinAmount: decimal.NewFromInt(100000), Role: value.UserRoleApprover},
+		}, 1))
+
+		rules := []*models.ApprovalRule{
+			{Step: 2, MinAmount: decimal.NewFromInt(1000000), Role: value.UserRoleAdmin},
+			{Step: 1, MinAmount: decimal.NewFromInt(50000), Role: value.UserRoleApprover},
+		}
+		assert.NoError(t, repo.ReplaceByCompanyID(db, companyID, rules, 2))
+		assert.NotEmpty(t, rules[0].ID)
+		assert.Equal(t, companyID, rules[0].CompanyID)
+
+		found, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, 1, found[0].Step)
+		assert.True(t, decimal.NewFromInt(50000).Equal(found[0].MinAmount))
+		assert.Equal(t, value.UserRoleApprover, found[0].Role)
+		assert.Equal(t, 2, found[1].Step)
+		assert.Equal(t, value.UserRoleAdmin, found[1].Role)
+
+		version, err := repo.FindVersionByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, version)
+	})
+
+	t.Run("バージョンが一致しない場合は置き換えない", func(t *testing.T) {
+		err := repo.ReplaceByCompanyID(db, companyID, nil, 2)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+
+		rules, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Len(t, rules, 2)
+	})
+
+	t.Run("空で置き換えると承認フローをなくす", func(t *testing.T) {
+		assert.NoError(t, repo.ReplaceByCompanyID(db, companyID, nil, 3))
+
+		rules, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+}
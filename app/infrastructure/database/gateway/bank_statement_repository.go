@@ -0,0 +1,163 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type bankStatementRepository struct{}
+
+func NewBankStatementRepository() repository.BankStatementRepository {
+	return &bankStatementRepository{}
+}
+
+func (r *bankStatementRepository) Create(db *gorm.DB, statement *models.BankStatement) error {
+	daoStatement := statement.ToDAO()
+	if err := db.Create(&daoStatement).Error; err != nil {
+		return err
+	}
+	statement.ID = daoStatement.ID
+	statement.CreatedAt = daoStatement.CreatedAt
+
+	if len(statement.Lines) == 0 {
+		return nil
+	}
+	daoLines := make([]*entities.BankStatementLine, len(statement.Lines))
+	for i, line := range statement.Lines {
+		line.StatementID = statement.ID
+		line.CompanyID = statement.CompanyID
+		daoLines[i] = line.ToDAO()
+	}
+	if err := db.Create(&daoLines).Error; err != nil {
+		return err
+	}
+	for i, line := range statement.Lines {
+		line.ID = daoLines[i].ID
+		line.Version = daoLines[i].Version
+		line.CreatedAt = daoLines[i].CreatedAt
+		line.UpdatedAt = daoLines[i].UpdatedAt
+	}
+
+	return nil
+}
+
+func (r *bankStatementRepository) FindFingerprints(db *gorm.DB, companyID string, fingerprints []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	if len(fingerprints) == 0 {
+		return found, nil
+	}
+
+	var existing []string
+	if err := db.Model(&entities.BankStatementLine{}).
+		Where("company_id = ? AND fingerprint IN ?", companyID, fingerprints).
+		Pluck("fingerprint", &existing).Error; err != nil {
+		return nil, err
+	}
+	for _, fingerprint := range existing {
+		found[fingerprint] = true
+	}
+
+	return found, nil
+}
+
+func (r *bankStatementRepository) FindLineByID(db *gorm.DB, id string) (*models.BankStatementLine, error) {
+	var daoLine entities.BankStatementLine
+	if err := db.First(&daoLine, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+
+	return models.BankStatementLineFromDAO(&daoLine), nil
+}
+
+func (r *bankStatementRepository) SearchLines(db *gorm.DB, companyID string, condition *models.BankStatementLineCondition) ([]*models.BankStatementLine, error) {
+	query := db.Where("company_id = ?", companyID)
+	if condition.Status != "" {
+		query = query.Where("status = ?", condition.Status)
+	}
+
+	var daoLines []*entities.BankStatementLine
+	if err := query.
+		Order("transaction_date").Order("statement_id").Order("line_no").
+		Offset(condition.Offset).
+		Limit(condition.Limit).
+		Find(&daoLines).Error; err != nil {
+		return nil, err
+	}
+
+	lines := make([]*models.BankStatementLine, len(daoLines))
+	for i, daoLine := range daoLines {
+		lines[i] = models.BankStatementLineFromDAO(daoLine)
+	}
+
+	return lines, nil
+}
+
+func (r *bankStatementRepository) UpdateLine(db *gorm.DB, line *models.BankStatementLine, version int) error {
+	if err := updateWithVersion(db, &entities.BankStatementLine{}, "bank statement line", line.ID, version, map[string]interface{}{
+		"status":         line.Status,
+		"invoice_id":     line.InvoiceID,
+		"payment_id":     line.PaymentID,
+		"score":          line.Score,
+		"auto_confirmed": line.AutoConfirmed,
+		"decided_by":     line.DecidedBy,
+		"decided_at":     line.DecidedAt,
+		"updated_at":     line.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	line.Version = version + 1
+
+	return nil
+}
+
+func (r *bankStatementRepository) FindReconciliationTargets(db *gorm.DB, companyID string) ([]*models.ReconciliationTarget, error) {
+	var daoInvoices []*entities.Invoice
+	if err := db.Where("company_id = ?", companyID).
+		Where("status NOT IN ?", []value.InvoiceStatus{value.InvoiceStatusPendingApproval, value.InvoiceStatusRejected}).
+		Where("invoice_amount - credited_amount - paid_amount > 0").
+		Order("payment_due_date").Order("id").
+		Find(&daoInvoices).Error; err != nil {
+		return nil, err
+	}
+	if len(daoInvoices) == 0 {
+		return []*models.ReconciliationTarget{}, nil
+	}
+
+	clientIDs := make([]string, 0, len(daoInvoices))
+	for _, daoInvoice := range daoInvoices {
+		clientIDs = append(clientIDs, daoInvoice.ClientID)
+	}
+	var daoClients []*entities.Client
+	if err := db.Where("id IN ?", clientIDs).Find(&daoClients).Error; err != nil {
+		return nil, err
+	}
+	clientNames := make(map[string]string, len(daoClients))
+	for _, daoClient := range daoClients {
+		clientNames[daoClient.ID] = daoClient.CorporateName
+	}
+
+	// 口座名義は暗号化しているため、読み込んでから復号した値を使う
+	var daoAccounts []*entities.ClientBankAccount
+	if err := db.Where("client_id IN ?", clientIDs).Order("id").Find(&daoAccounts).Error; err != nil {
+		return nil, err
+	}
+	accountNames := make(map[string][]string)
+	for _, daoAccount := range daoAccounts {
+		accountNames[daoAccount.ClientID] = append(accountNames[daoAccount.ClientID], daoAccount.AccountName)
+	}
+
+	targets := make([]*models.ReconciliationTarget, len(daoInvoices))
+	for i, daoInvoice := range daoInvoices {
+		targets[i] = &models.ReconciliationTarget{
+			Invoice:      models.InvoiceFromDAO(daoInvoice),
+			ClientName:   clientNames[daoInvoice.ClientID],
+			AccountNames: accountNames[daoInvoice.ClientID],
+		}
+	}
+
+	return targets, nil
+}
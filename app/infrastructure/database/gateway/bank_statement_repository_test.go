@@ -0,0 +1,118 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBankStatementRepository(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewBankStatementRepository()
+	companyID := client.CompanyID
+	date := time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)
+
+	newLine := func(lineNo int, fingerprint string, status value.StatementLineStatus) *models.BankStatementLine {
+		return &models.BankStatementLine{
+			LineNo:           lineNo,
+			Fingerprint:      fingerprint,
+			TransactionDate:  date,
+			Direction:        value.StatementDirectionWithdrawal,
+			Amount:           decimal.NewFromInt(10440),
+			CounterpartyName: "ﾃｽﾄｸﾗｲｱﾝﾄ",
+			Status:           status,
+		}
+	}
+	statement := &models.BankStatement{
+		CompanyID:  companyID,
+		Format:     value.StatementFormatCSV,
+		ImportedBy: "userID",
+		Lines: []*models.BankStatementLine{
+			newLine(1, "fingerprint1", value.StatementLineStatusPending),
+			newLine(2, "fingerprint2", value.StatementLineStatusIgnored),
+		},
+	}
+
+	t.Run("明細のファイルと明細を保存する", func(t *testing.T) {
+		assert.NoError(t, repo.Create(db, statement))
+		assert.NotEmpty(t, statement.ID)
+		assert.NotEmpty(t, statement.Lines[0].ID)
+		assert.Equal(t, statement.ID, statement.Lines[0].StatementID)
+		assert.Equal(t, companyID, statement.Lines[0].CompanyID)
+		assert.Equal(t, 1, statement.Lines[0].Version)
+	})
+
+	t.Run("同じ会社で同じFingerprintの明細は保存できない", func(t *testing.T) {
+		duplicate := &models.BankStatement{
+			CompanyID:  companyID,
+			Format:     value.StatementFormatCSV,
+			ImportedBy: "userID",
+			Lines:      []*models.BankStatementLine{newLine(1, "fingerprint1", value.StatementLineStatusPending)},
+		}
+		assert.Error(t, repo.Create(db, duplicate))
+	})
+
+	t.Run("取り込み済みのFingerprintを返す", func(t *testing.T) {
+		found, err := repo.FindFingerprints(db, companyID, []string{"fingerprint1", "fingerprint3"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]bool{"fingerprint1": true}, found)
+
+		found, err = repo.FindFingerprints(db, "otherCompany", []string{"fingerprint1"})
+		assert.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("状態で明細を絞り込む", func(t *testing.T) {
+		lines, err := repo.SearchLines(db, companyID, &models.BankStatementLineCondition{Status: value.StatementLineStatusPending, Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, statement.Lines[0].ID, lines[0].ID)
+		assert.True(t, decimal.NewFromInt(10440).Equal(lines[0].Amount))
+
+		lines, err = repo.SearchLines(db, companyID, &models.BankStatementLineCondition{Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, lines, 2)
+	})
+
+	t.Run("消し込みの状態をバージョンを確認して更新する", func(t *testing.T) {
+		line, err := repo.FindLineByID(db, statement.Lines[0].ID)
+		assert.NoError(t, err)
+
+		line.Confirm("invoiceID", "paymentID", 100, false, "userID", date)
+		assert.NoError(t, repo.UpdateLine(db, line, 1))
+		assert.Equal(t, 2, line.Version)
+
+		found, err := repo.FindLineByID(db, line.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.StatementLineStatusConfirmed, found.Status)
+		assert.Equal(t, "invoiceID", *found.InvoiceID)
+		assert.Equal(t, 100, found.Score)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, repo.UpdateLine(db, line, 1), &conflict)
+	})
+
+	t.Run("未払いの残高がある支払える請求書を取引先名・口座名義と返す", func(t *testing.T) {
+		accountRepo := NewClientBankAccountRepository()
+		assert.NoError(t, accountRepo.Create(db, &models.ClientBankAccount{ClientID: client.ID, BankName: "Bank", BranchName: "Branch", AccountNumber: "1234567", AccountName: "ﾃｽﾄｸﾗｲｱﾝﾄ"}))
+
+		unpaid := createTestInvoice(t, db, client, date)
+		paid := createTestInvoice(t, db, client, date)
+		assert.NoError(t, db.Model(&entities.Invoice{}).Where("id = ?", paid.ID).Updates(map[string]interface{}{"paid_amount": paid.InvoiceAmount, "status": value.InvoiceStatusPaid}).Error)
+		pending := createTestInvoice(t, db, client, date)
+		assert.NoError(t, db.Model(&entities.Invoice{}).Where("id = ?", pending.ID).Update("status", value.InvoiceStatusPendingApproval).Error)
+
+		targets, err := repo.FindReconciliationTargets(db, companyID)
+		assert.NoError(t, err)
+		assert.Len(t, targets, 1)
+		assert.Equal(t, unpaid.ID, targets[0].Invoice.ID)
+		assert.Equal(t, "Test Client", targets[0].ClientName)
+		assert.Equal(t, []string{"ﾃｽﾄｸﾗｲｱﾝﾄ"}, targets[0].AccountNames)
+	})
+}
@@ -1,9 +1,12 @@
 package gateway
 
 import (
+	"time"
+
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/domain/repository"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
 
 	"gorm.io/gorm"
 )
@@ -22,6 +25,7 @@ func (r *clientBankAccountRepository) Create(db *gorm.DB, account *models.Client
 	account.ID = daoAccount.ID
 	account.CreatedAt = daoAccount.CreatedAt
 	account.UpdatedAt = daoAccount.UpdatedAt
+	account.Version = daoAccount.Version
 
 	return nil
 }
@@ -35,3 +39,45 @@ func (r *clientBankAccountRepository) FindByID(db *gorm.DB, id string) (*models.
 
 	return account, nil
 }
+
+func (r *clientBankAccountRepository) FindByClientID(db *gorm.DB, clientID string) ([]*models.ClientBankAccount, error) {
+	var daoAccounts []*entities.ClientBankAccount
+	if err := db.Where("client_id = ?", clientID).Order("id").Find(&daoAccounts).Error; err != nil {
+		return nil, err
+	}
+
+	return clientBankAccountsFromDAO(daoAccounts), nil
+}
+
+func (r *clientBankAccountRepository) FindByAccountNumber(db *gorm.DB, accountNumber string) ([]*models.ClientBankAccount, error) {
+	index, err := encryption.BlindIndex(entities.AccountNumberIndexPurpose, accountNumber)
+	if err != nil {
+		return nil, err
+	}
+	if index == "" {
+		return []*models.ClientBankAccount{}, nil
+	}
+
+	var daoAccounts []*entities.ClientBankAccount
+	if err := db.Where("account_number_index = ?", index).Order("id").Find(&daoAccounts).Error; err != nil {
+		return nil, err
+	}
+
+	return clientBankAccountsFromDAO(daoAccounts), nil
+}
+
+func (r *clientBankAccountRepository) DeleteByClientID(db *gorm.DB, clientID string, deletedAt time.Time) error {
+	return db.Model(&entities.ClientBankAccount{}).Where("client_id = ?", clientID).UpdateColumns(map[string]interface{}{
+		"deleted_at": deletedAt,
+		"version":    gorm.Expr("version + 1"),
+	}).Error
+}
+
+func clientBankAccountsFromDAO(daoAccounts []*entities.ClientBankAccount) []*models.ClientBankAccount {
+	accounts := make([]*models.ClientBankAccount, len(daoAccounts))
+	for i, daoAccount := range daoAccounts {
+		accounts[i] = models.ClientBankAccountFromDAO(daoAccount)
+	}
+
+	return accounts
+}
@@ -5,12 +5,17 @@ import (
 
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 func setupClientBankAccountTestDB(t *testing.T) *gorm.DB {
+	// 暗号化列の読み書きに使う鍵
+	encryptiontest.Setup()
+
 	db, err := gorm.Open(sqlite.Open(":memory:?_foreign_keys=on"), &gorm.Config{})
 	assert.NoError(t, err)
 
@@ -121,3 +126,88 @@ func TestClientBankAccountRepository_FindByID(t *testing.T) {
 		assert.Equal(t, gorm.ErrRecordNotFound, err)
 	})
 }
+
+func TestClientBankAccountRepository_Encryption(t *testing.T) {
+	db := setupClientBankAccountTestDB(t)
+	repo := NewClientBankAccountRepository()
+
+	// テストデータ準備
+	company := &entities.Company{
+		ID:            "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CorporateName: "Test Company",
+	}
+	err := db.Create(company).Error
+	assert.NoError(t, err)
+	client := &entities.Client{
+		ID:                 "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CompanyID:          company.ID,
+		CorporateName:      "Test Client",
+		RepresentativeName: "Test Rep",
+		PhoneNumber:        "03-1234-5678",
+		PostalCode:         "000-0000",
+		Address:            "東京都千代田区1-1-1",
+	}
+	err = db.Create(client).Error
+	assert.NoError(t, err)
+
+	account := &models.ClientBankAccount{
+		ClientID:      client.ID,
+		BankName:      "Test Bank",
+		BranchName:    "Test Branch",
+		AccountNumber: "1234567890",
+		AccountName:   "Test Account",
+	}
+	err = repo.Create(db, account)
+	assert.NoError(t, err)
+
+	t.Run("口座番号と個人情報は暗号化して保存する", func(t *testing.T) {
+		var stored struct {
+			AccountNumber      string
+			AccountName        string
+			AccountNumberIndex string
+		}
+		err := db.Raw("SELECT account_number, account_name, account_number_index FROM client_bank_accounts WHERE id = ?", account.ID).Scan(&stored).Error
+		assert.NoError(t, err)
+		assert.True(t, encryption.IsEncrypted(stored.AccountNumber))
+		assert.NotContains(t, stored.AccountNumber, "1234567890")
+		assert.True(t, encryption.IsEncrypted(stored.AccountName))
+		assert.Len(t, stored.AccountNumberIndex, 64)
+
+		var phoneNumber, address string
+		err = db.Raw("SELECT phone_number, address FROM clients WHERE id = ?", client.ID).Row().Scan(&phoneNumber, &address)
+		assert.NoError(t, err)
+		assert.True(t, encryption.IsEncrypted(phoneNumber))
+		assert.True(t, encryption.IsEncrypted(address))
+	})
+
+	t.Run("取引先IDで検索すると復号した値を返す", func(t *testing.T) {
+		accounts, err := repo.FindByClientID(db, client.ID)
+		assert.NoError(t, err)
+		assert.Len(t, accounts, 1)
+		assert.Equal(t, "1234567890", accounts[0].AccountNumber)
+		assert.Equal(t, "Test Account", accounts[0].AccountName)
+	})
+
+	t.Run("口座番号の完全一致で検索できる", func(t *testing.T) {
+		accounts, err := repo.FindByAccountNumber(db, "1234567890")
+		assert.NoError(t, err)
+		assert.Len(t, accounts, 1)
+		assert.Equal(t, account.ID, accounts[0].ID)
+
+		accounts, err = repo.FindByAccountNumber(db, "1234567891")
+		assert.NoError(t, err)
+		assert.Empty(t, accounts)
+	})
+
+	t.Run("暗号化前に保存された平文の値も読み込める", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		err := tx.Exec("UPDATE client_bank_accounts SET account_name = ? WHERE id = ?", "Legacy Account", account.ID).Error
+		assert.NoError(t, err)
+
+		found, err := repo.FindByID(tx, account.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Legacy Account", found.AccountName)
+	})
+}
@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"time"
+
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/domain/repository"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
@@ -22,6 +24,7 @@ func (r *clientRepository) Create(db *gorm.DB, client *models.Client) error {
 	client.ID = daoClient.ID
 	client.CreatedAt = daoClient.CreatedAt
 	client.UpdatedAt = daoClient.UpdatedAt
+	client.Version = daoClient.Version
 
 	return nil
 }
@@ -35,3 +38,7 @@ func (r *clientRepository) FindByID(db *gorm.DB, id string) (*models.Client, err
 
 	return client, nil
 }
+
+func (r *clientRepository) Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error {
+	return updateWithVersion(db, &entities.Client{}, "client", id, version, map[string]interface{}{"deleted_at": deletedAt})
+}
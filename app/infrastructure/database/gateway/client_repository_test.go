@@ -5,12 +5,16 @@ import (
 
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 func setupClientTestDB(t *testing.T) *gorm.DB {
+	// 暗号化列の読み書きに使う鍵
+	encryptiontest.Setup()
+
 	db, err := gorm.Open(sqlite.Open(":memory:?_foreign_keys=on"), &gorm.Config{})
 	assert.NoError(t, err)
 
@@ -35,3 +35,10 @@ func (r *companyRepository) FindByID(db *gorm.DB, id string) (*models.Company, e
 
 	return company, nil
 }
+
+func (r *companyRepository) UpdateInvoiceNumbering(db *gorm.DB, id string, numbering models.InvoiceNumbering, version int) error {
+	return updateWithVersionColumn(db, &entities.Company{}, "invoice_numbering", id, "invoice_numbering_version", version, map[string]interface{}{
+		"invoice_number_format":   numbering.Format,
+		"fiscal_year_start_month": numbering.FiscalYearStartMonth,
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
@@ -83,3 +85,41 @@ func TestCompanyRepository_FindByID(t *testing.T) {
 		assert.Equal(t, gorm.ErrRecordNotFound, err)
 	})
 }
+
+func TestCompanyRepository_UpdateInvoiceNumbering(t *testing.T) {
+	db := setupCompanyTestDB(t)
+	repo := NewCompanyRepository()
+
+	company := &models.Company{CorporateName: "Numbering Test Corporation"}
+	assert.NoError(t, repo.Create(db, company))
+
+	t.Run("設定していない会社は既定の書式と4月始まり", func(t *testing.T) {
+		found, err := repo.FindByID(db, company.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvoiceNumbering{
+			Format:               value.DefaultInvoiceNumberFormat,
+			FiscalYearStartMonth: 4,
+			Version:              1,
+		}, found.InvoiceNumbering)
+	})
+
+	t.Run("書式と会計年度の始まる月を更新してバージョンを進める", func(t *testing.T) {
+		numbering := models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1}
+		assert.NoError(t, repo.UpdateInvoiceNumbering(db, company.ID, numbering, 1))
+
+		found, err := repo.FindByID(db, company.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1, Version: 2}, found.InvoiceNumbering)
+	})
+
+	t.Run("バージョンが一致しない場合は更新しない", func(t *testing.T) {
+		err := repo.UpdateInvoiceNumbering(db, company.ID, models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4}, 1)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+
+		found, err := repo.FindByID(db, company.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceNumberFormat("B{YY}-{SEQ:4}"), found.InvoiceNumbering.Format)
+	})
+}
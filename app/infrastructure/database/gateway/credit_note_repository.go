@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type creditNoteRepository struct{}
+
+func NewCreditNoteRepository() repository.CreditNoteRepository {
+	return &creditNoteRepository{}
+}
+
+func (r *creditNoteRepository) Create(db *gorm.DB, creditNote *models.CreditNote) error {
+	daoCreditNote := creditNote.ToDAO()
+	if err := db.Create(&daoCreditNote).Error; err != nil {
+		return err
+	}
+	creditNote.ID = daoCreditNote.ID
+	creditNote.CreatedAt = daoCreditNote.CreatedAt
+
+	return nil
+}
+
+func (r *creditNoteRepository) FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.CreditNote, error) {
+	var daoCreditNotes []*entities.CreditNote
+	if err := db.Where("invoice_id = ?", invoiceID).Order("issue_date").Order("id").Find(&daoCreditNotes).Error; err != nil {
+		return nil, err
+	}
+
+	creditNotes := make([]*models.CreditNote, len(daoCreditNotes))
+	for i, daoCreditNote := range daoCreditNotes {
+		creditNotes[i] = models.CreditNoteFromDAO(daoCreditNote)
+	}
+
+	return creditNotes, nil
+}
@@ -0,0 +1,60 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditNoteRepository_FindByInvoiceID(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewCreditNoteRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	invoice := createTestInvoice(t, db, client, issueDate)
+	other := createTestInvoice(t, db, client, issueDate)
+
+	newCreditNote := func(invoice *entities.Invoice, issueDate time.Time, reason value.CreditNoteReason) *models.CreditNote {
+		return &models.CreditNote{
+			CompanyID:     invoice.CompanyID,
+			InvoiceID:     invoice.ID,
+			IssueDate:     issueDate,
+			Reason:        reason,
+			PaymentAmount: decimal.NewFromInt(1000),
+			Fee:           decimal.NewFromInt(40),
+			FeeRate:       decimal.NewFromFloat(0.04),
+			Tax:           decimal.NewFromInt(4),
+			TaxRate:       decimal.NewFromFloat(0.10),
+			CreditAmount:  decimal.NewFromInt(1044),
+		}
+	}
+	second := newCreditNote(invoice, time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC), value.CreditNoteReasonDiscount)
+	first := newCreditNote(invoice, time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC), value.CreditNoteReasonReturn)
+	first.Note = "検品不良による返品"
+	for _, creditNote := range []*models.CreditNote{second, first, newCreditNote(other, issueDate, value.CreditNoteReasonReturn)} {
+		assert.NoError(t, repo.Create(db, creditNote))
+		assert.NotEmpty(t, creditNote.ID)
+	}
+
+	t.Run("元の請求書の返品・値引きを発行日の順に返す", func(t *testing.T) {
+		creditNotes, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Len(t, creditNotes, 2)
+		assert.Equal(t, first.ID, creditNotes[0].ID)
+		assert.Equal(t, value.CreditNoteReasonReturn, creditNotes[0].Reason)
+		assert.Equal(t, "検品不良による返品", creditNotes[0].Note)
+		assert.True(t, decimal.NewFromInt(1044).Equal(creditNotes[0].CreditAmount))
+		assert.Equal(t, second.ID, creditNotes[1].ID)
+	})
+
+	t.Run("返品・値引きがない場合は空", func(t *testing.T) {
+		creditNotes, err := repo.FindByInvoiceID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+		assert.Empty(t, creditNotes)
+	})
+}
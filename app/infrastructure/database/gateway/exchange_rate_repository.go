@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type exchangeRateRepository struct{}
+
+func NewExchangeRateRepository() repository.ExchangeRateRepository {
+	return &exchangeRateRepository{}
+}
+
+func (r *exchangeRateRepository) SaveAll(db *gorm.DB, rates []*models.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	daoRates := make([]*entities.ExchangeRate, len(rates))
+	for i, rate := range rates {
+		daoRates[i] = rate.ToDAO()
+	}
+	// 公表後に訂正されたレートを読み込み直せるよう、同じ通貨・日付のレートは置き換える
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "currency"}, {Name: "rate_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&daoRates).Error; err != nil {
+		return err
+	}
+	for i, daoRate := range daoRates {
+		rates[i].ID = daoRate.ID
+		rates[i].CreatedAt = daoRate.CreatedAt
+		rates[i].UpdatedAt = daoRate.UpdatedAt
+	}
+
+	return nil
+}
+
+func (r *exchangeRateRepository) FindLatest(db *gorm.DB, currency value.Currency, date time.Time) (*models.ExchangeRate, error) {
+	var daoRate entities.ExchangeRate
+	if err := db.Where("currency = ? AND rate_date <= ?", currency, date).Order("rate_date DESC").First(&daoRate).Error; err != nil {
+		return nil, err
+	}
+
+	return models.ExchangeRateFromDAO(&daoRate), nil
+}
@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestExchangeRateRepository(t *testing.T) {
+	db, _ := setupRetentionTestDB(t)
+	repo := NewExchangeRateRepository()
+	day := func(d int) time.Time { return time.Date(2025, 12, d, 0, 0, 0, 0, time.UTC) }
+
+	assert.NoError(t, repo.SaveAll(db, []*models.ExchangeRate{
+		{Currency: value.CurrencyUSD, RateDate: day(1), Rate: decimal.RequireFromString("155.20")},
+		{Currency: value.CurrencyUSD, RateDate: day(3), Rate: decimal.RequireFromString("155.80")},
+		{Currency: value.CurrencyEUR, RateDate: day(2), Rate: decimal.RequireFromString("180.10")},
+	}))
+
+	t.Run("指定日以前で最も新しいレートを返す", func(t *testing.T) {
+		rate, err := repo.FindLatest(db, value.CurrencyUSD, day(2))
+		assert.NoError(t, err)
+		assert.True(t, day(1).Equal(rate.RateDate))
+		assert.True(t, decimal.RequireFromString("155.20").Equal(rate.Rate))
+
+		rate, err = repo.FindLatest(db, value.CurrencyUSD, day(5))
+		assert.NoError(t, err)
+		assert.True(t, decimal.RequireFromString("155.80").Equal(rate.Rate))
+	})
+
+	t.Run("指定日以前のレートがない場合はErrRecordNotFound", func(t *testing.T) {
+		_, err := repo.FindLatest(db, value.CurrencyEUR, day(1))
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("同じ通貨・日付のレートは置き換える", func(t *testing.T) {
+		assert.NoError(t, repo.SaveAll(db, []*models.ExchangeRate{
+			{Currency: value.CurrencyUSD, RateDate: day(3), Rate: decimal.RequireFromString("156.00")},
+		}))
+
+		rate, err := repo.FindLatest(db, value.CurrencyUSD, day(3))
+		assert.NoError(t, err)
+		assert.True(t, decimal.RequireFromString("156.00").Equal(rate.Rate))
+
+		var count int64
+		assert.NoError(t, db.Model(&entities.ExchangeRate{}).Where("currency = ?", value.CurrencyUSD).Count(&count).Error)
+		assert.Equal(t, int64(2), count)
+	})
+}
@@ -0,0 +1,49 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type healthRepository struct{}
+
+func NewHealthRepository() repository.HealthRepository {
+	return &healthRepository{}
+}
+
+func (r *healthRepository) Ping(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.PingContext(db.Statement.Context)
+}
+
+// CheckMigrations は全エンティティのテーブルとカラムが作成済みかを確認します
+func (r *healthRepository) CheckMigrations(db *gorm.DB) error {
+	migrator := db.Migrator()
+	for _, model := range entities.Models() {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		if !migrator.HasTable(model) {
+			return fmt.Errorf("table %s is not migrated", stmt.Schema.Table)
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			if !migrator.HasColumn(model, field.DBName) {
+				return fmt.Errorf("column %s.%s is not migrated", stmt.Schema.Table, field.DBName)
+			}
+		}
+	}
+
+	return nil
+}
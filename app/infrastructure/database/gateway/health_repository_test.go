@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthRepository_Ping(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHealthRepository()
+
+	t.Run("DB接続確認成功", func(t *testing.T) {
+		err := repo.Ping(db)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DB接続を閉じた後はエラー", func(t *testing.T) {
+		db := setupTestDB(t)
+		sqlDB, err := db.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, sqlDB.Close())
+
+		err = repo.Ping(db)
+		assert.Error(t, err)
+	})
+}
+
+func TestHealthRepository_CheckMigrations(t *testing.T) {
+	repo := NewHealthRepository()
+
+	t.Run("未作成のテーブルがある場合はエラー", func(t *testing.T) {
+		db := setupTestDB(t)
+
+		err := repo.CheckMigrations(db)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "clients")
+	})
+
+	t.Run("未作成のカラムがある場合はエラー", func(t *testing.T) {
+		db := setupTestDB(t)
+		err := db.AutoMigrate(entities.Models()...)
+		assert.NoError(t, err)
+		err = db.Migrator().DropColumn(&entities.Invoice{}, "status")
+		assert.NoError(t, err)
+
+		err = repo.CheckMigrations(db)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invoices.status")
+	})
+
+	t.Run("全テーブル作成済みの場合は成功", func(t *testing.T) {
+		db := setupTestDB(t)
+		err := db.AutoMigrate(entities.Models()...)
+		assert.NoError(t, err)
+
+		err = repo.CheckMigrations(db)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type invoiceApprovalRepository struct{}
+
+func NewInvoiceApprovalRepository() repository.InvoiceApprovalRepository {
+	return &invoiceApprovalRepository{}
+}
+
+func (r *invoiceApprovalRepository) CreateAll(db *gorm.DB, approvals []*models.InvoiceApproval) error {
+	for _, approval := range approvals {
+		daoApproval := approval.ToDAO()
+		if err := db.Create(daoApproval).Error; err != nil {
+			return err
+		}
+		approval.ID = daoApproval.ID
+		approval.CreatedAt = daoApproval.CreatedAt
+	}
+
+	return nil
+}
+
+func (r *invoiceApprovalRepository) FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.InvoiceApproval, error) {
+	var daoApprovals []*entities.InvoiceApproval
+	if err := db.Where("invoice_id = ?", invoiceID).Order("step").Find(&daoApprovals).Error; err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*models.InvoiceApproval, len(daoApprovals))
+	for i, daoApproval := range daoApprovals {
+		approvals[i] = models.InvoiceApprovalFromDAO(daoApproval)
+	}
+
+	return approvals, nil
+}
+
+func (r *invoiceApprovalRepository) Decide(db *gorm.DB, approval *models.InvoiceApproval) error {
+	return db.Model(&entities.InvoiceApproval{}).Where("id = ?", approval.ID).Updates(map[string]interface{}{
+		"status":     approval.Status,
+		"decided_by": approval.DecidedBy,
+		"comment":    approval.Comment,
+		"decided_at": approval.DecidedAt,
+	}).Error
+}
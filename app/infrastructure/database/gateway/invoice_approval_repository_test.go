@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoiceApprovalRepository(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceApprovalRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	invoice := createTestInvoice(t, db, client, issueDate)
+	approvals := []*models.InvoiceApproval{
+		{InvoiceID: invoice.ID, Step: 1, Role: value.UserRoleApprover, Status: value.ApprovalStatusPending},
+		{InvoiceID: invoice.ID, Step: 2, Role: value.UserRoleAdmin, Status: value.ApprovalStatusPending},
+	}
+	assert.NoError(t, repo.CreateAll(db, approvals))
+	assert.NotEmpty(t, approvals[0].ID)
+
+	t.Run("請求書の承認を段階の順に返す", func(t *testing.T) {
+		found, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, approvals[0].ID, found[0].ID)
+		assert.Equal(t, value.UserRoleApprover, found[0].Role)
+		assert.Nil(t, found[0].DecidedBy)
+		assert.Nil(t, found[0].DecidedAt)
+		assert.Equal(t, 2, found[1].Step)
+	})
+
+	t.Run("判断を保存する", func(t *testing.T) {
+		decidedAt := time.Date(2025, 12, 2, 9, 0, 0, 0, time.UTC)
+		approvals[0].Decide(value.ApprovalStatusApproved, "01HQZXFG0PJ9K8QXW7YM1N2ZXU", "金額を確認しました", decidedAt)
+		assert.NoError(t, repo.Decide(db, approvals[0]))
+
+		found, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.ApprovalStatusApproved, found[0].Status)
+		assert.Equal(t, "01HQZXFG0PJ9K8QXW7YM1N2ZXU", *found[0].DecidedBy)
+		assert.Equal(t, "金額を確認しました", found[0].Comment)
+		assert.True(t, decidedAt.Equal(*found[0].DecidedAt))
+		assert.Equal(t, value.ApprovalStatusPending, found[1].Status)
+	})
+
+	t.Run("承認が不要な請求書は空", func(t *testing.T) {
+		found, err := repo.FindByInvoiceID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+		assert.Empty(t, found)
+	})
+}
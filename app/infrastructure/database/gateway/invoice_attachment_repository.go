@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type invoiceAttachmentRepository struct{}
+
+func NewInvoiceAttachmentRepository() repository.InvoiceAttachmentRepository {
+	return &invoiceAttachmentRepository{}
+}
+
+func (r *invoiceAttachmentRepository) Create(db *gorm.DB, attachment *models.InvoiceAttachment) error {
+	daoAttachment := attachment.ToDAO()
+	if err := db.Create(&daoAttachment).Error; err != nil {
+		return err
+	}
+	attachment.ID = daoAttachment.ID
+	attachment.CreatedAt = daoAttachment.CreatedAt
+
+	return nil
+}
+
+func (r *invoiceAttachmentRepository) FindByID(db *gorm.DB, invoiceID, id string) (*models.InvoiceAttachment, error) {
+	var daoAttachment entities.InvoiceAttachment
+	if err := db.Where("invoice_id = ? AND id = ?", invoiceID, id).Take(&daoAttachment).Error; err != nil {
+		return nil, err
+	}
+
+	return models.InvoiceAttachmentFromDAO(&daoAttachment), nil
+}
+
+func (r *invoiceAttachmentRepository) FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.InvoiceAttachment, error) {
+	var daoAttachments []*entities.InvoiceAttachment
+	if err := db.Where("invoice_id = ?", invoiceID).Order("id").Find(&daoAttachments).Error; err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*models.InvoiceAttachment, len(daoAttachments))
+	for i, daoAttachment := range daoAttachments {
+		attachments[i] = models.InvoiceAttachmentFromDAO(daoAttachment)
+	}
+
+	return attachments, nil
+}
+
+func (r *invoiceAttachmentRepository) FindStorageKeysByInvoiceIDs(db *gorm.DB, invoiceIDs []string) ([]string, error) {
+	var keys []string
+	if err := db.Model(&entities.InvoiceAttachment{}).Where("invoice_id IN ?", invoiceIDs).Order("id").Pluck("storage_key", &keys).Error; err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (r *invoiceAttachmentRepository) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&entities.InvoiceAttachment{}).Error
+}
@@ -0,0 +1,100 @@
+package gateway
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createTestUser(t *testing.T, db *gorm.DB, companyID string) *entities.User {
+	user := &entities.User{
+		CompanyID: companyID,
+		Name:      "Test User",
+		Email:     util.GenerateULID() + "@example.com",
+		Password:  "password",
+		Role:      value.UserRoleMember,
+	}
+	assert.NoError(t, db.Create(user).Error)
+
+	return user
+}
+
+func newTestAttachment(id, invoiceID, userID string) *models.InvoiceAttachment {
+	return &models.InvoiceAttachment{
+		ID:          id,
+		InvoiceID:   invoiceID,
+		FileName:    "請求書.pdf",
+		ContentType: value.AttachmentContentTypePDF,
+		Size:        1024,
+		SHA256:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		StorageKey:  models.InvoiceAttachmentStorageKey(invoiceID, id),
+		CreatedBy:   userID,
+	}
+}
+
+func TestInvoiceAttachmentRepository(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceAttachmentRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	user := createTestUser(t, db, client.CompanyID)
+
+	invoice := createTestInvoice(t, db, client, issueDate)
+	other := createTestInvoice(t, db, client, issueDate)
+
+	first := newTestAttachment("01KAAAAAAAAAAAAAAAAAAAAAA1", invoice.ID, user.ID)
+	second := newTestAttachment("01KAAAAAAAAAAAAAAAAAAAAAA2", invoice.ID, user.ID)
+	second.FileName = "scan.png"
+	second.ContentType = value.AttachmentContentTypePNG
+	otherAttachment := newTestAttachment("01KAAAAAAAAAAAAAAAAAAAAAA3", other.ID, user.ID)
+	for _, attachment := range []*models.InvoiceAttachment{first, second, otherAttachment} {
+		assert.NoError(t, repo.Create(db, attachment))
+		assert.False(t, attachment.CreatedAt.IsZero())
+	}
+
+	t.Run("請求書の添付ファイルを添付した順に返す", func(t *testing.T) {
+		attachments, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Len(t, attachments, 2)
+		assert.Equal(t, first.ID, attachments[0].ID)
+		assert.Equal(t, "請求書.pdf", attachments[0].FileName)
+		assert.Equal(t, value.AttachmentContentTypePDF, attachments[0].ContentType)
+		assert.Equal(t, int64(1024), attachments[0].Size)
+		assert.Equal(t, first.SHA256, attachments[0].SHA256)
+		assert.Equal(t, first.StorageKey, attachments[0].StorageKey)
+		assert.Equal(t, user.ID, attachments[0].CreatedBy)
+		assert.Equal(t, second.ID, attachments[1].ID)
+	})
+
+	t.Run("請求書の添付ファイルを取得する", func(t *testing.T) {
+		attachment, err := repo.FindByID(db, invoice.ID, second.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "scan.png", attachment.FileName)
+	})
+
+	t.Run("他の請求書の添付ファイルは見つからない", func(t *testing.T) {
+		_, err := repo.FindByID(db, invoice.ID, otherAttachment.ID)
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	})
+
+	t.Run("請求書の添付ファイルのキーを返す", func(t *testing.T) {
+		keys, err := repo.FindStorageKeysByInvoiceIDs(db, []string{invoice.ID, other.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{first.StorageKey, second.StorageKey, otherAttachment.StorageKey}, keys)
+	})
+
+	t.Run("添付ファイルを削除する", func(t *testing.T) {
+		assert.NoError(t, repo.Delete(db, first.ID))
+
+		attachments, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Len(t, attachments, 1)
+		assert.Equal(t, second.ID, attachments[0].ID)
+	})
+}
@@ -0,0 +1,42 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type invoiceLineRepository struct{}
+
+func NewInvoiceLineRepository() repository.InvoiceLineRepository {
+	return &invoiceLineRepository{}
+}
+
+func (r *invoiceLineRepository) CreateAll(db *gorm.DB, lines []*models.InvoiceLine) error {
+	for _, line := range lines {
+		daoLine := line.ToDAO()
+		if err := db.Create(daoLine).Error; err != nil {
+			return err
+		}
+		line.ID = daoLine.ID
+		line.CreatedAt = daoLine.CreatedAt
+	}
+
+	return nil
+}
+
+func (r *invoiceLineRepository) FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.InvoiceLine, error) {
+	var daoLines []*entities.InvoiceLine
+	if err := db.Where("invoice_id = ?", invoiceID).Order("line_no").Find(&daoLines).Error; err != nil {
+		return nil, err
+	}
+
+	lines := make([]*models.InvoiceLine, len(daoLines))
+	for i, daoLine := range daoLines {
+		lines[i] = models.InvoiceLineFromDAO(daoLine)
+	}
+
+	return lines, nil
+}
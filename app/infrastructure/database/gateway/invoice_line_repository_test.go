@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoiceLineRepository(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceLineRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	invoice := createTestInvoice(t, db, client, issueDate)
+	lines := []*models.InvoiceLine{
+		{InvoiceID: invoice.ID, LineNo: 2, Description: "お茶", Quantity: decimal.NewFromInt(24), UnitPrice: decimal.NewFromInt(150), TaxCategory: value.TaxCategoryReduced, Amount: decimal.NewFromInt(3600)},
+		{InvoiceID: invoice.ID, LineNo: 1, Description: "保守作業", Quantity: decimal.RequireFromString("1.5"), UnitPrice: decimal.NewFromInt(8000), TaxCategory: value.TaxCategoryStandard, Amount: decimal.NewFromInt(12000)},
+	}
+	assert.NoError(t, repo.CreateAll(db, lines))
+	assert.NotEmpty(t, lines[0].ID)
+
+	t.Run("請求書の明細を順番に返す", func(t *testing.T) {
+		found, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, lines[1].ID, found[0].ID)
+		assert.Equal(t, "保守作業", found[0].Description)
+		assert.True(t, decimal.RequireFromString("1.5").Equal(found[0].Quantity))
+		assert.Equal(t, value.TaxCategoryStandard, found[0].TaxCategory)
+		assert.Equal(t, 2, found[1].LineNo)
+		assert.True(t, decimal.NewFromInt(3600).Equal(found[1].Amount))
+	})
+
+	t.Run("同じ請求書に同じ順番の明細は登録できない", func(t *testing.T) {
+		err := repo.CreateAll(db, []*models.InvoiceLine{
+			{InvoiceID: invoice.ID, LineNo: 1, Description: "重複", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(1), TaxCategory: value.TaxCategoryExempt, Amount: decimal.NewFromInt(1)},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("明細のない請求書は空", func(t *testing.T) {
+		found, err := repo.FindByInvoiceID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+		assert.Empty(t, found)
+	})
+}
@@ -0,0 +1,44 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type invoiceNumberSequenceRepository struct{}
+
+func NewInvoiceNumberSequenceRepository() repository.InvoiceNumberSequenceRepository {
+	return &invoiceNumberSequenceRepository{}
+}
+
+// Next は行の作成と加算を1つの文で行います。MySQL は ON DUPLICATE KEY UPDATE、SQLite は ON CONFLICT DO UPDATE になり、
+// 同時に呼び出しても先の書き込みがコミットされるまで待つため、同じ連番を返すことはありません
+func (r *invoiceNumberSequenceRepository) Next(db *gorm.DB, companyID string, fiscalYear int) (int64, error) {
+	sequence := &entities.InvoiceNumberSequence{
+		CompanyID:  companyID,
+		FiscalYear: fiscalYear,
+		LastValue:  1,
+	}
+	if err := db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "company_id"}, {Name: "fiscal_year"}},
+		DoUpdates: append(
+			clause.Set{{Column: clause.Column{Name: "last_value"}, Value: gorm.Expr("last_value + 1")}},
+			clause.AssignmentColumns([]string{"updated_at"})...,
+		),
+	}).Create(sequence).Error; err != nil {
+		return 0, err
+	}
+
+	// 加算した行は同じトランザクションのロックの中で読み直す
+	var lastValue int64
+	if err := db.Model(&entities.InvoiceNumberSequence{}).
+		Where("company_id = ? AND fiscal_year = ?", companyID, fiscalYear).
+		Pluck("last_value", &lastValue).Error; err != nil {
+		return 0, err
+	}
+
+	return lastValue, nil
+}
@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestInvoiceNumberSequenceRepository_Next(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceNumberSequenceRepository()
+	companyID := client.CompanyID
+
+	t.Run("会計年度ごとに1から連番を振る", func(t *testing.T) {
+		for _, want := range []int64{1, 2, 3} {
+			seq, err := repo.Next(db, companyID, 2025)
+			assert.NoError(t, err)
+			assert.Equal(t, want, seq)
+		}
+
+		seq, err := repo.Next(db, companyID, 2026)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), seq)
+	})
+
+	t.Run("会社ごとに連番を振る", func(t *testing.T) {
+		other := &entities.Company{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD", CorporateName: "Other Company"}
+		assert.NoError(t, db.Create(other).Error)
+
+		seq, err := repo.Next(db, other.ID, 2025)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), seq)
+	})
+
+	t.Run("トランザクションを取り消した連番は次に振り直す", func(t *testing.T) {
+		errRollback := errors.New("rollback")
+		err := db.Transaction(func(tx *gorm.DB) error {
+			seq, err := repo.Next(tx, companyID, 2025)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(4), seq)
+
+			return errRollback
+		})
+		assert.ErrorIs(t, err, errRollback)
+
+		seq, err := repo.Next(db, companyID, 2025)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), seq)
+	})
+}
+
+func TestInvoiceNumberSequenceRepository_Next_Concurrent(t *testing.T) {
+	// 複数の接続から同時に書き込むため、接続ごとに別のデータベースになる :memory: ではなくファイルを使う
+	dsn := filepath.Join(t.TempDir(), "sequence.db") + "?_foreign_keys=on&_busy_timeout=10000"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&entities.Company{}, &entities.InvoiceNumberSequence{}))
+	company := &entities.Company{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXC", CorporateName: "Test Company"}
+	assert.NoError(t, db.Create(company).Error)
+	repo := NewInvoiceNumberSequenceRepository()
+
+	t.Run("同時に請求書を作成しても連番は重複も欠番もない", func(t *testing.T) {
+		const workers = 20
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			seqs []int64
+		)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(rollback bool) {
+				defer wg.Done()
+				errRollback := errors.New("rollback")
+				var seq int64
+				err := db.Transaction(func(tx *gorm.DB) error {
+					var err error
+					seq, err = repo.Next(tx, company.ID, 2025)
+					if err != nil {
+						return err
+					}
+					// 作成に失敗した請求書の連番は使わない
+					if rollback {
+						return errRollback
+					}
+					return nil
+				})
+				if rollback {
+					assert.ErrorIs(t, err, errRollback)
+					return
+				}
+				assert.NoError(t, err)
+
+				mu.Lock()
+				defer mu.Unlock()
+				seqs = append(seqs, seq)
+			}(i%4 == 0)
+		}
+		wg.Wait()
+
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+		want := make([]int64, len(seqs))
+		for i := range want {
+			want[i] = int64(i + 1)
+		}
+		assert.Equal(t, want, seqs)
+	})
+}
@@ -1,11 +1,14 @@
 package gateway
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
 
 	"gorm.io/gorm"
 )
@@ -24,27 +27,55 @@ func (r *invoiceRepository) Create(db *gorm.DB, invoice *models.Invoice) error {
 	invoice.ID = daoInvoice.ID
 	invoice.CreatedAt = daoInvoice.CreatedAt
 	invoice.UpdatedAt = daoInvoice.UpdatedAt
+	invoice.Version = daoInvoice.Version
 
 	return nil
 }
 
-func (r *invoiceRepository) FindByPaymentDueDateRange(db *gorm.DB, startDate, endDate *time.Time, offset, limit int) ([]*models.Invoice, error) {
+func (r *invoiceRepository) FindByID(db *gorm.DB, id string) (*models.Invoice, error) {
+	var daoInvoice entities.Invoice
+	if err := db.First(&daoInvoice, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+
+	return models.InvoiceFromDAO(&daoInvoice), nil
+}
+
+func (r *invoiceRepository) FindByNumber(db *gorm.DB, companyID, invoiceNumber string) (*models.Invoice, error) {
+	var daoInvoice entities.Invoice
+	if err := db.First(&daoInvoice, "company_id = ? AND invoice_number = ?", companyID, invoiceNumber).Error; err != nil {
+		return nil, err
+	}
+
+	return models.InvoiceFromDAO(&daoInvoice), nil
+}
+
+func (r *invoiceRepository) Search(db *gorm.DB, condition *models.InvoiceSearchCondition) ([]*models.Invoice, error) {
+	column := invoiceSortColumn(condition.SortKey)
+	direction, operator := "ASC", ">"
+	if condition.SortOrder == value.SortOrderDesc {
+		direction, operator = "DESC", "<"
+	}
+
+	query := applyInvoiceSearchCondition(db, condition)
+	if condition.Cursor != nil {
+		cursorValue, err := parseInvoiceCursorValue(condition.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// (ソートキー, ID) の組で比較し、同値のソートキーでもページが安定するようにする
+		query = query.Where(
+			fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))", column, operator),
+			cursorValue, cursorValue, condition.Cursor.ID,
+		)
+	}
+
 	var daoInvoices []*entities.Invoice
-	var daoStartDate, daoEndDate time.Time
-	if startDate != nil {
-		daoStartDate = *startDate
-	} else {
-		daoStartDate = time.Date(1970, 1, 1, 0, 0, 0, 0, time.Local)
-	}
-	if endDate != nil {
-		daoEndDate = *endDate
-	} else {
-		daoEndDate = time.Date(9999, 12, 31, 23, 59, 59, 999999, time.Local)
-	}
-	if err := db.Where("payment_due_date BETWEEN ? AND ?", daoStartDate, daoEndDate).
-		Order("payment_due_date ASC").
-		Offset(offset).
-		Limit(limit).
+	if err := query.
+		Order(fmt.Sprintf("%s %s", column, direction)).
+		Order(fmt.Sprintf("id %s", direction)).
+		Offset(condition.Offset).
+		Limit(condition.Limit).
 		Find(&daoInvoices).Error; err != nil {
 		return nil, err
 	}
@@ -56,3 +87,166 @@ func (r *invoiceRepository) FindByPaymentDueDateRange(db *gorm.DB, startDate, en
 
 	return invoices, nil
 }
+
+func (r *invoiceRepository) Count(db *gorm.DB, condition *models.InvoiceSearchCondition) (int64, error) {
+	var count int64
+	if err := applyInvoiceSearchCondition(db, condition).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *invoiceRepository) FindDuplicateCandidates(db *gorm.DB, invoice *models.Invoice, rule models.DuplicateRule) ([]*models.Invoice, error) {
+	issueDateFrom, issueDateTo := rule.IssueDateRange(invoice.IssueDate)
+	minAmount, maxAmount := rule.PaymentAmountRange(invoice.PaymentAmount)
+	query := db.
+		Where("company_id = ? AND client_id = ? AND currency = ?", invoice.CompanyID, invoice.ClientID, invoice.Currency).
+		Where("issue_date BETWEEN ? AND ?", issueDateFrom, issueDateTo).
+		Where("payment_amount BETWEEN ? AND ?", minAmount, maxAmount)
+	if invoice.ID != "" {
+		query = query.Where("id <> ?", invoice.ID)
+	}
+
+	var daoInvoices []*entities.Invoice
+	if err := query.Order("issue_date ASC").Order("id ASC").Find(&daoInvoices).Error; err != nil {
+		return nil, err
+	}
+
+	invoices := make([]*models.Invoice, len(daoInvoices))
+	for i, daoInvoice := range daoInvoices {
+		invoices[i] = models.InvoiceFromDAO(daoInvoice)
+	}
+
+	return invoices, nil
+}
+
+func (r *invoiceRepository) UpdatePayment(db *gorm.DB, invoice *models.Invoice, version int) error {
+	if err := updateWithVersion(db, &entities.Invoice{}, "invoice", invoice.ID, version, map[string]interface{}{
+		"paid_amount": invoice.PaidAmount,
+		"status":      invoice.Status,
+		"updated_at":  invoice.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	invoice.Version = version + 1
+
+	return nil
+}
+
+func (r *invoiceRepository) UpdateCredit(db *gorm.DB, invoice *models.Invoice, version int) error {
+	if err := updateWithVersion(db, &entities.Invoice{}, "invoice", invoice.ID, version, map[string]interface{}{
+		"credited_amount": invoice.CreditedAmount,
+		"status":          invoice.Status,
+		"updated_at":      invoice.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	invoice.Version = version + 1
+
+	return nil
+}
+
+func (r *invoiceRepository) UpdateStatus(db *gorm.DB, invoice *models.Invoice, version int) error {
+	if err := updateWithVersion(db, &entities.Invoice{}, "invoice", invoice.ID, version, map[string]interface{}{
+		"status":     invoice.Status,
+		"updated_at": invoice.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	invoice.Version = version + 1
+
+	return nil
+}
+
+func (r *invoiceRepository) UpdateRetentionClass(db *gorm.DB, invoice *models.Invoice, version int) error {
+	if err := updateWithVersion(db, &entities.Invoice{}, "invoice", invoice.ID, version, map[string]interface{}{
+		"retention_class": invoice.RetentionClass,
+		"updated_at":      invoice.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	invoice.Version = version + 1
+
+	return nil
+}
+
+func (r *invoiceRepository) UpdateAttachments(db *gorm.DB, invoice *models.Invoice, version int) error {
+	if err := updateWithVersion(db, &entities.Invoice{}, "invoice", invoice.ID, version, map[string]interface{}{
+		"updated_at": invoice.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+	invoice.Version = version + 1
+
+	return nil
+}
+
+func (r *invoiceRepository) Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error {
+	return updateWithVersion(db, &entities.Invoice{}, "invoice", id, version, map[string]interface{}{"deleted_at": deletedAt})
+}
+
+// applyInvoiceSearchCondition はカーソルとページング以外の絞り込み条件を適用します
+func applyInvoiceSearchCondition(db *gorm.DB, condition *models.InvoiceSearchCondition) *gorm.DB {
+	query := db.Model(&entities.Invoice{})
+	if condition.CompanyID != "" {
+		query = query.Where("company_id = ?", condition.CompanyID)
+	}
+	if condition.ClientID != "" {
+		query = query.Where("client_id = ?", condition.ClientID)
+	}
+	if len(condition.Statuses) > 0 {
+		query = query.Where("status IN ?", condition.Statuses)
+	}
+	if condition.PaymentDueDateFrom != nil {
+		query = query.Where("payment_due_date >= ?", *condition.PaymentDueDateFrom)
+	}
+	if condition.PaymentDueDateTo != nil {
+		query = query.Where("payment_due_date <= ?", *condition.PaymentDueDateTo)
+	}
+	if condition.IssueDateFrom != nil {
+		query = query.Where("issue_date >= ?", *condition.IssueDateFrom)
+	}
+	if condition.IssueDateTo != nil {
+		query = query.Where("issue_date <= ?", *condition.IssueDateTo)
+	}
+	if condition.MinPaymentAmount != nil {
+		query = query.Where("payment_amount_jpy >= ?", *condition.MinPaymentAmount)
+	}
+	if condition.MaxPaymentAmount != nil {
+		query = query.Where("payment_amount_jpy <= ?", *condition.MaxPaymentAmount)
+	}
+
+	return query
+}
+
+func invoiceSortColumn(key value.InvoiceSortKey) string {
+	switch key {
+	case value.InvoiceSortKeyIssueDate:
+		return "issue_date"
+	case value.InvoiceSortKeyPaymentAmount:
+		return "payment_amount_jpy"
+	case value.InvoiceSortKeyCreatedAt:
+		return "created_at"
+	default:
+		return "payment_due_date"
+	}
+}
+
+func parseInvoiceCursorValue(cursor *value.InvoiceCursor) (interface{}, error) {
+	if cursor.SortKey == value.InvoiceSortKeyPaymentAmount {
+		amount, err := decimal.NewFromString(cursor.Value)
+		if err != nil {
+			return nil, value.ErrInvalidCursor
+		}
+
+		return amount, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+	if err != nil {
+		return nil, value.ErrInvalidCursor
+	}
+
+	return t, nil
+}
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
 	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
@@ -14,6 +16,9 @@ import (
 )
 
 func setupInvoiceTestDB(t *testing.T) *gorm.DB {
+	// 暗号化列の読み書きに使う鍵
+	encryptiontest.Setup()
+
 	db, err := gorm.Open(sqlite.Open(":memory:?_foreign_keys=on"), &gorm.Config{})
 	assert.NoError(t, err)
 
@@ -52,17 +57,18 @@ func TestInvoiceRepository_Create(t *testing.T) {
 		defer tx.Rollback()
 
 		invoice := &models.Invoice{
-			CompanyID:      company.ID,
-			ClientID:       client.ID,
-			IssueDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
-			PaymentAmount:  decimal.NewFromInt(100000),
-			Fee:            decimal.NewFromInt(2000),
-			FeeRate:        decimal.NewFromFloat(0.02),
-			Tax:            decimal.NewFromInt(10000),
-			TaxRate:        decimal.NewFromFloat(0.10),
-			InvoiceAmount:  decimal.NewFromInt(112000),
-			PaymentDueDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
-			Status:         value.InvoiceStatusUnprocessed,
+			CompanyID:        company.ID,
+			ClientID:         client.ID,
+			IssueDate:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			PaymentAmount:    decimal.NewFromInt(100000),
+			PaymentAmountJPY: decimal.NewFromInt(100000),
+			Fee:              decimal.NewFromInt(2000),
+			FeeRate:          decimal.NewFromFloat(0.02),
+			Tax:              decimal.NewFromInt(10000),
+			TaxRate:          decimal.NewFromFloat(0.10),
+			InvoiceAmount:    decimal.NewFromInt(112000),
+			PaymentDueDate:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			Status:           value.InvoiceStatusUnprocessed,
 		}
 
 		err := repo.Create(tx, invoice)
@@ -70,10 +76,297 @@ func TestInvoiceRepository_Create(t *testing.T) {
 		assert.NotEmpty(t, invoice.ID)
 		assert.NotZero(t, invoice.CreatedAt)
 		assert.NotZero(t, invoice.UpdatedAt)
+		assert.Equal(t, 1, invoice.Version)
+	})
+
+	t.Run("重複の確認の結果を保存し、確認していない請求書はuncheckedにする", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		newInvoice := func() *models.Invoice {
+			return &models.Invoice{
+				CompanyID:      company.ID,
+				ClientID:       client.ID,
+				IssueDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				PaymentAmount:  decimal.NewFromInt(100000),
+				PaymentDueDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+				Status:         value.InvoiceStatusUnprocessed,
+			}
+		}
+		unchecked := newInvoice()
+		assert.NoError(t, repo.Create(tx, unchecked))
+		overridden := newInvoice()
+		overridden.DuplicateCheck = value.DuplicateCheckOverridden
+		overridden.SuspectedDuplicateID = &unchecked.ID
+		assert.NoError(t, repo.Create(tx, overridden))
+
+		found, err := repo.FindByID(tx, unchecked.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.DuplicateCheckUnchecked, found.DuplicateCheck)
+		assert.Nil(t, found.SuspectedDuplicateID)
+		found, err = repo.FindByID(tx, overridden.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.DuplicateCheckOverridden, found.DuplicateCheck)
+		assert.Equal(t, unchecked.ID, *found.SuspectedDuplicateID)
+	})
+}
+
+func TestInvoiceRepository_FindByNumber(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	number := "INV-2025-000001"
+	invoice := createTestInvoice(t, db, client, issueDate)
+	assert.NoError(t, db.Model(invoice).Update("invoice_number", number).Error)
+	// 番号付けを導入する前の請求書は番号を持たない
+	createTestInvoice(t, db, client, issueDate)
+	createTestInvoice(t, db, client, issueDate)
+
+	t.Run("会社の請求書を請求書番号で探す", func(t *testing.T) {
+		found, err := repo.FindByNumber(db, client.CompanyID, number)
+		assert.NoError(t, err)
+		assert.Equal(t, invoice.ID, found.ID)
+		assert.Equal(t, number, *found.InvoiceNumber)
+	})
+
+	t.Run("他社の請求書番号は見つからない", func(t *testing.T) {
+		_, err := repo.FindByNumber(db, "01HQZXFG0PJ9K8QXW7YM1N2ZXD", number)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("会社の中で同じ請求書番号は保存できない", func(t *testing.T) {
+		duplicate := createTestInvoice(t, db, client, issueDate)
+		assert.Error(t, db.Model(duplicate).Update("invoice_number", number).Error)
+	})
+}
+
+func TestInvoiceRepository_Delete(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	deletedAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("バージョンが一致しない場合は削除せずに競合エラー", func(t *testing.T) {
+		invoice := createTestInvoice(t, db, client, deletedAt)
+
+		err := repo.Delete(db, invoice.ID, 2, deletedAt)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		assert.Equal(t, invoice.ID, conflict.ID)
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, found.Version)
+	})
+
+	t.Run("バージョンが一致すれば削除してバージョンを進める", func(t *testing.T) {
+		invoice := createTestInvoice(t, db, client, deletedAt)
+
+		assert.NoError(t, repo.Delete(db, invoice.ID, 1, deletedAt))
+
+		var deleted entities.Invoice
+		assert.NoError(t, db.Unscoped().First(&deleted, "id = ?", invoice.ID).Error)
+		assert.Equal(t, 2, deleted.Version)
+		assert.True(t, deleted.DeletedAt.Valid)
+
+		// 削除済みの請求書は見つからない
+		assert.ErrorIs(t, repo.Delete(db, invoice.ID, 2, deletedAt), gorm.ErrRecordNotFound)
+	})
+}
+
+func TestInvoiceRepository_UpdatePayment(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("支払済みの合計とステータスを更新してバージョンを進める", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.ApplyPayment(decimal.NewFromInt(6000))
+		assert.NoError(t, repo.UpdatePayment(db, invoice, 1))
+		assert.Equal(t, 2, invoice.Version)
+
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(6000).Equal(found.PaidAmount))
+		assert.True(t, decimal.NewFromInt(4440).Equal(found.OutstandingAmount()))
+		assert.Equal(t, value.InvoiceStatusPartiallyPaid, found.Status)
+		assert.Equal(t, 2, found.Version)
+	})
+
+	t.Run("バージョンが一致しない場合は更新せずに競合エラー", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.ApplyPayment(decimal.NewFromInt(10440))
+		err = repo.UpdatePayment(db, invoice, 2)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.True(t, found.PaidAmount.IsZero())
+		assert.Equal(t, value.InvoiceStatusUnprocessed, found.Status)
+	})
+}
+
+func TestInvoiceRepository_UpdateCredit(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("減額の合計とステータスを更新してバージョンを進める", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.ApplyCreditNote(&models.CreditNote{CreditAmount: decimal.NewFromInt(1044)})
+		assert.NoError(t, repo.UpdateCredit(db, invoice, 1))
+		assert.Equal(t, 2, invoice.Version)
+
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(1044).Equal(found.CreditedAmount))
+		assert.True(t, decimal.NewFromInt(9396).Equal(found.NetAmount()))
+		assert.True(t, decimal.NewFromInt(9396).Equal(found.OutstandingAmount()))
+		assert.Equal(t, value.InvoiceStatusUnprocessed, found.Status)
+		assert.Equal(t, 2, found.Version)
+	})
+
+	t.Run("バージョンが一致しない場合は更新せずに競合エラー", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.ApplyCreditNote(&models.CreditNote{CreditAmount: decimal.NewFromInt(1044)})
+		err = repo.UpdateCredit(db, invoice, 2)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.True(t, found.CreditedAmount.IsZero())
+	})
+}
+
+func TestInvoiceRepository_UpdateStatus(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ステータスを更新してバージョンを進める", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.Status = value.InvoiceStatusRejected
+		assert.NoError(t, repo.UpdateStatus(db, invoice, 1))
+		assert.Equal(t, 2, invoice.Version)
+
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusRejected, found.Status)
+		assert.Equal(t, 2, found.Version)
+	})
+
+	t.Run("バージョンが一致しない場合は更新せずに競合エラー", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.Status = value.InvoiceStatusRejected
+		err = repo.UpdateStatus(db, invoice, 2)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusUnprocessed, found.Status)
+	})
+}
+
+func TestInvoiceRepository_FindDuplicateCandidates(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	issueDate := time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC)
+	rule := models.DuplicateRule{WindowDays: 3, AmountTolerance: decimal.RequireFromString("0.01")}
+	createInvoice := func(issueDate time.Time, amount string, currency value.Currency) *entities.Invoice {
+		invoice := createTestInvoice(t, db, client, issueDate)
+		assert.NoError(t, db.Model(invoice).Updates(map[string]interface{}{
+			"payment_amount": decimal.RequireFromString(amount),
+			"currency":       currency,
+		}).Error)
+
+		return invoice
+	}
+
+	invoice, err := repo.FindByID(db, createInvoice(issueDate, "10000", value.CurrencyJPY).ID)
+	assert.NoError(t, err)
+	// 発行日の範囲の先頭に、支払金額の差が許容範囲を超える請求書が100件より多くある
+	for i := 0; i < 150; i++ {
+		createInvoice(issueDate.AddDate(0, 0, -3), "20000", value.CurrencyJPY)
+	}
+	nearAmount := createInvoice(issueDate.AddDate(0, 0, 3), "10100", value.CurrencyJPY)
+	lowerAmount := createInvoice(issueDate.AddDate(0, 0, -3), "9900", value.CurrencyJPY)
+	// 支払金額の差が許容範囲を超える、通貨が違う、発行日が範囲外、削除済みの請求書は候補にしない
+	createInvoice(issueDate, "10101", value.CurrencyJPY)
+	createInvoice(issueDate, "10000", value.CurrencyUSD)
+	createInvoice(issueDate.AddDate(0, 0, 4), "10000", value.CurrencyJPY)
+	deleted := createInvoice(issueDate, "10000", value.CurrencyJPY)
+	assert.NoError(t, db.Delete(deleted).Error)
+
+	t.Run("件数に関係なく取引先・通貨・発行日・支払金額の範囲に入る請求書を全て返す", func(t *testing.T) {
+		candidates, err := repo.FindDuplicateCandidates(db, invoice, rule)
+
+		assert.NoError(t, err)
+		ids := make([]string, len(candidates))
+		for i, candidate := range candidates {
+			ids[i] = candidate.ID
+		}
+		assert.Equal(t, []string{lowerAmount.ID, nearAmount.ID}, ids)
+	})
+
+	t.Run("作成前の請求書は自身を除かずに検索する", func(t *testing.T) {
+		newInvoice := &models.Invoice{CompanyID: invoice.CompanyID, ClientID: invoice.ClientID, Currency: value.CurrencyJPY, IssueDate: issueDate, PaymentAmount: decimal.NewFromInt(10000)}
+
+		candidates, err := repo.FindDuplicateCandidates(db, newInvoice, rule)
+
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 3)
 	})
 }
 
-func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
+func TestInvoiceRepository_UpdateAttachments(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewInvoiceRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("更新日時を記録してバージョンを進める", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		invoice.UpdatedAt = time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+		assert.NoError(t, repo.UpdateAttachments(db, invoice, 1))
+		assert.Equal(t, 2, invoice.Version)
+
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, found.Version)
+		assert.True(t, invoice.UpdatedAt.Equal(found.UpdatedAt))
+	})
+
+	t.Run("バージョンが一致しない場合は更新せずに競合エラー", func(t *testing.T) {
+		invoice, err := repo.FindByID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+
+		err = repo.UpdateAttachments(db, invoice, 2)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+		found, err := repo.FindByID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, found.Version)
+	})
+}
+
+func TestInvoiceRepository_Search(t *testing.T) {
 	db := setupInvoiceTestDB(t)
 	repo := NewInvoiceRepository()
 
@@ -99,46 +392,49 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 	// 複数の請求書を作成
 	invoices := []*entities.Invoice{
 		{
-			ID:             "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
-			CompanyID:      company.ID,
-			ClientID:       client.ID,
-			IssueDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
-			PaymentAmount:  decimal.NewFromInt(100000),
-			Fee:            decimal.NewFromInt(2000),
-			FeeRate:        decimal.NewFromFloat(0.02),
-			Tax:            decimal.NewFromInt(10000),
-			TaxRate:        decimal.NewFromFloat(0.10),
-			InvoiceAmount:  decimal.NewFromInt(112000),
-			PaymentDueDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
-			Status:         value.InvoiceStatusUnprocessed,
+			ID:               "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
+			CompanyID:        company.ID,
+			ClientID:         client.ID,
+			IssueDate:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			PaymentAmount:    decimal.NewFromInt(100000),
+			PaymentAmountJPY: decimal.NewFromInt(100000),
+			Fee:              decimal.NewFromInt(2000),
+			FeeRate:          decimal.NewFromFloat(0.02),
+			Tax:              decimal.NewFromInt(10000),
+			TaxRate:          decimal.NewFromFloat(0.10),
+			InvoiceAmount:    decimal.NewFromInt(112000),
+			PaymentDueDate:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			Status:           value.InvoiceStatusUnprocessed,
 		},
 		{
-			ID:             "01HQZXFG0PJ9K8QXW7YM1N2ZXE",
-			CompanyID:      company.ID,
-			ClientID:       client.ID,
-			IssueDate:      time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
-			PaymentAmount:  decimal.NewFromInt(200000),
-			Fee:            decimal.NewFromInt(4000),
-			FeeRate:        decimal.NewFromFloat(0.02),
-			Tax:            decimal.NewFromInt(20000),
-			TaxRate:        decimal.NewFromFloat(0.10),
-			InvoiceAmount:  decimal.NewFromInt(224000),
-			PaymentDueDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
-			Status:         value.InvoiceStatusUnprocessed,
+			ID:               "01HQZXFG0PJ9K8QXW7YM1N2ZXE",
+			CompanyID:        company.ID,
+			ClientID:         client.ID,
+			IssueDate:        time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			PaymentAmount:    decimal.NewFromInt(200000),
+			PaymentAmountJPY: decimal.NewFromInt(200000),
+			Fee:              decimal.NewFromInt(4000),
+			FeeRate:          decimal.NewFromFloat(0.02),
+			Tax:              decimal.NewFromInt(20000),
+			TaxRate:          decimal.NewFromFloat(0.10),
+			InvoiceAmount:    decimal.NewFromInt(224000),
+			PaymentDueDate:   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			Status:           value.InvoiceStatusUnprocessed,
 		},
 		{
-			ID:             "01HQZXFG0PJ9K8QXW7YM1N2ZXF",
-			CompanyID:      company.ID,
-			ClientID:       client.ID,
-			IssueDate:      time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
-			PaymentAmount:  decimal.NewFromInt(300000),
-			Fee:            decimal.NewFromInt(6000),
-			FeeRate:        decimal.NewFromFloat(0.02),
-			Tax:            decimal.NewFromInt(30000),
-			TaxRate:        decimal.NewFromFloat(0.10),
-			InvoiceAmount:  decimal.NewFromInt(336000),
-			PaymentDueDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
-			Status:         value.InvoiceStatusUnprocessed,
+			ID:               "01HQZXFG0PJ9K8QXW7YM1N2ZXF",
+			CompanyID:        company.ID,
+			ClientID:         client.ID,
+			IssueDate:        time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			PaymentAmount:    decimal.NewFromInt(300000),
+			PaymentAmountJPY: decimal.NewFromInt(300000),
+			Fee:              decimal.NewFromInt(6000),
+			FeeRate:          decimal.NewFromFloat(0.02),
+			Tax:              decimal.NewFromInt(30000),
+			TaxRate:          decimal.NewFromFloat(0.10),
+			InvoiceAmount:    decimal.NewFromInt(336000),
+			PaymentDueDate:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			Status:           value.InvoiceStatusUnprocessed,
 		},
 	}
 
@@ -147,16 +443,28 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	var nilDate *time.Time
+	dateOf := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	defaultCondition := func() *models.InvoiceSearchCondition {
+		return &models.InvoiceSearchCondition{
+			CompanyID: company.ID,
+			SortKey:   value.InvoiceSortKeyPaymentDueDate,
+			SortOrder: value.SortOrderAsc,
+			Limit:     100,
+		}
+	}
 
 	t.Run("日付範囲で検索成功", func(t *testing.T) {
 		tx := db.Begin()
 		defer tx.Rollback()
 
-		startDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
-		endDate := time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)
+		cond := defaultCondition()
+		cond.PaymentDueDateFrom = dateOf(2025, 2, 1)
+		cond.PaymentDueDateTo = dateOf(2025, 3, 31)
 
-		result, err := repo.FindByPaymentDueDateRange(tx, &startDate, &endDate, 0, 100)
+		result, err := repo.Search(tx, cond)
 		assert.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, invoices[0].ID, result[0].ID)
@@ -167,9 +475,10 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		tx := db.Begin()
 		defer tx.Rollback()
 
-		startDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		cond := defaultCondition()
+		cond.PaymentDueDateFrom = dateOf(2025, 3, 1)
 
-		result, err := repo.FindByPaymentDueDateRange(tx, &startDate, nilDate, 0, 100)
+		result, err := repo.Search(tx, cond)
 		assert.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, invoices[1].ID, result[0].ID)
@@ -180,9 +489,10 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		tx := db.Begin()
 		defer tx.Rollback()
 
-		endDate := time.Date(2025, 2, 28, 23, 59, 59, 0, time.UTC)
+		cond := defaultCondition()
+		cond.PaymentDueDateTo = dateOf(2025, 2, 28)
 
-		result, err := repo.FindByPaymentDueDateRange(tx, nilDate, &endDate, 0, 100)
+		result, err := repo.Search(tx, cond)
 		assert.NoError(t, err)
 		assert.Len(t, result, 1)
 		assert.Equal(t, invoices[0].ID, result[0].ID)
@@ -192,7 +502,7 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		tx := db.Begin()
 		defer tx.Rollback()
 
-		result, err := repo.FindByPaymentDueDateRange(tx, nilDate, nilDate, 0, 100)
+		result, err := repo.Search(tx, defaultCondition())
 		assert.NoError(t, err)
 		assert.Len(t, result, 3)
 	})
@@ -201,10 +511,11 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		tx := db.Begin()
 		defer tx.Rollback()
 
-		startDate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
-		endDate := time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)
+		cond := defaultCondition()
+		cond.PaymentDueDateFrom = dateOf(2026, 1, 1)
+		cond.PaymentDueDateTo = dateOf(2026, 12, 31)
 
-		result, err := repo.FindByPaymentDueDateRange(tx, &startDate, &endDate, 0, 100)
+		result, err := repo.Search(tx, cond)
 		assert.NoError(t, err)
 		assert.Len(t, result, 0)
 	})
@@ -214,7 +525,11 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		defer tx.Rollback()
 
 		// offset=1, limit=1で2件目のみ取得
-		result, err := repo.FindByPaymentDueDateRange(tx, nilDate, nilDate, 1, 1)
+		cond := defaultCondition()
+		cond.Offset = 1
+		cond.Limit = 1
+
+		result, err := repo.Search(tx, cond)
 		assert.NoError(t, err)
 		assert.Len(t, result, 1)
 		assert.Equal(t, invoices[1].ID, result[0].ID)
@@ -225,10 +540,144 @@ func TestInvoiceRepository_FindByPaymentDueDateRange(t *testing.T) {
 		defer tx.Rollback()
 
 		// limit=2で最初の2件のみ取得
-		result, err := repo.FindByPaymentDueDateRange(tx, nilDate, nilDate, 0, 2)
+		cond := defaultCondition()
+		cond.Limit = 2
+
+		result, err := repo.Search(tx, cond)
 		assert.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, invoices[0].ID, result[0].ID)
 		assert.Equal(t, invoices[1].ID, result[1].ID)
 	})
+
+	t.Run("発行日・金額・ステータスで絞り込み", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		minAmount := decimal.NewFromInt(150000)
+		cond := defaultCondition()
+		cond.IssueDateFrom = dateOf(2025, 2, 1)
+		cond.MinPaymentAmount = &minAmount
+		cond.Statuses = []value.InvoiceStatus{value.InvoiceStatusUnprocessed}
+
+		result, err := repo.Search(tx, cond)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, invoices[1].ID, result[0].ID)
+
+		cond.Statuses = []value.InvoiceStatus{value.InvoiceStatusProcessed}
+		result, err = repo.Search(tx, cond)
+		assert.NoError(t, err)
+		assert.Len(t, result, 0)
+	})
+
+	t.Run("他社の請求書は取得しない", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cond := defaultCondition()
+		cond.CompanyID = "01HQZXFG0PJ9K8QXW7YM1N2ZZZ"
+
+		result, err := repo.Search(tx, cond)
+		assert.NoError(t, err)
+		assert.Len(t, result, 0)
+	})
+
+	t.Run("降順ソート", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		cond := defaultCondition()
+		cond.SortKey = value.InvoiceSortKeyPaymentAmount
+		cond.SortOrder = value.SortOrderDesc
+
+		result, err := repo.Search(tx, cond)
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+		assert.Equal(t, invoices[2].ID, result[0].ID)
+		assert.Equal(t, invoices[0].ID, result[2].ID)
+	})
+
+	t.Run("カーソルで同じ支払期日の請求書も漏れなく取得", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		// 支払期日が同じ請求書を追加
+		tie := *invoices[0]
+		tie.ID = "01HQZXFG0PJ9K8QXW7YM1N2ZXG"
+		assert.NoError(t, tx.Create(&tie).Error)
+
+		cond := defaultCondition()
+		cond.Limit = 1
+
+		var ids []string
+		for {
+			result, err := repo.Search(tx, cond)
+			assert.NoError(t, err)
+			if len(result) == 0 {
+				break
+			}
+			ids = append(ids, result[0].ID)
+			cond.Cursor = result[0].CursorAfter(cond.SortKey, cond.SortOrder)
+		}
+
+		assert.Equal(t, []string{invoices[0].ID, tie.ID, invoices[1].ID, invoices[2].ID}, ids)
+	})
+}
+
+func TestInvoiceRepository_Count(t *testing.T) {
+	db := setupInvoiceTestDB(t)
+	repo := NewInvoiceRepository()
+
+	company := &entities.Company{
+		ID:            "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CorporateName: "Test Company",
+	}
+	err := db.Create(company).Error
+	assert.NoError(t, err)
+	client := &entities.Client{
+		ID:                 "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CompanyID:          company.ID,
+		CorporateName:      "Test Client",
+		RepresentativeName: "Test Rep",
+		PhoneNumber:        "000-0000-0000",
+		PostalCode:         "000-0000",
+		Address:            "Test Address",
+	}
+	err = db.Create(client).Error
+	assert.NoError(t, err)
+
+	for i, id := range []string{"01HQZXFG0PJ9K8QXW7YM1N2ZXD", "01HQZXFG0PJ9K8QXW7YM1N2ZXE"} {
+		err := db.Create(&entities.Invoice{
+			ID:               id,
+			CompanyID:        company.ID,
+			ClientID:         client.ID,
+			IssueDate:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			PaymentAmount:    decimal.NewFromInt(int64(100000 * (i + 1))),
+			PaymentAmountJPY: decimal.NewFromInt(int64(100000 * (i + 1))),
+			PaymentDueDate:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			Status:           value.InvoiceStatusUnprocessed,
+		}).Error
+		assert.NoError(t, err)
+	}
+
+	t.Run("ページングに関係なく条件に一致する件数を返す", func(t *testing.T) {
+		count, err := repo.Count(db, &models.InvoiceSearchCondition{
+			CompanyID: company.ID,
+			Offset:    1,
+			Limit:     1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("金額で絞り込み", func(t *testing.T) {
+		maxAmount := decimal.NewFromInt(100000)
+		count, err := repo.Count(db, &models.InvoiceSearchCondition{
+			CompanyID:        company.ID,
+			MaxPaymentAmount: &maxAmount,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
 }
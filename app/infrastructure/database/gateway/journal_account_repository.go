@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type journalAccountRepository struct{}
+
+func NewJournalAccountRepository() repository.JournalAccountRepository {
+	return &journalAccountRepository{}
+}
+
+func (r *journalAccountRepository) FindByCompanyID(db *gorm.DB, companyID string) ([]*models.JournalAccount, error) {
+	var daoAccounts []*entities.JournalAccount
+	if err := db.Where("company_id = ?", companyID).Order("type").Find(&daoAccounts).Error; err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*models.JournalAccount, len(daoAccounts))
+	for i, daoAccount := range daoAccounts {
+		accounts[i] = models.JournalAccountFromDAO(daoAccount)
+	}
+
+	return accounts, nil
+}
+
+func (r *journalAccountRepository) FindVersionByCompanyID(db *gorm.DB, companyID string) (int, error) {
+	var daoCompany entities.Company
+	if err := db.Select("journal_accounts_version").First(&daoCompany, "id = ?", companyID).Error; err != nil {
+		return 0, err
+	}
+
+	return daoCompany.JournalAccountsVersion, nil
+}
+
+func (r *journalAccountRepository) ReplaceByCompanyID(db *gorm.DB, companyID string, accounts []*models.JournalAccount, version int) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// 役割ごとの行を置き換えるため、会社の行のバージョンで設定全体の更新を直列にする
+		if err := updateWithVersionColumn(tx, &entities.Company{}, "journal_accounts", companyID, "journal_accounts_version", version, nil); err != nil {
+			return err
+		}
+		if err := tx.Where("company_id = ?", companyID).Delete(&entities.JournalAccount{}).Error; err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			daoAccount := account.ToDAO()
+			daoAccount.CompanyID = companyID
+			if err := tx.Create(daoAccount).Error; err != nil {
+				return err
+			}
+			account.ID = daoAccount.ID
+			account.CompanyID = daoAccount.CompanyID
+			account.CreatedAt = daoAccount.CreatedAt
+		}
+
+		return nil
+	})
+}
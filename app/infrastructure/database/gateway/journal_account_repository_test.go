@@ -0,0 +1,72 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalAccountRepository_ReplaceByCompanyID(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewJournalAccountRepository()
+	companyID := client.CompanyID
+
+	t.Run("設定がない場合は空", func(t *testing.T) {
+		accounts, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Empty(t, accounts)
+
+		version, err := repo.FindVersionByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, version)
+	})
+
+	t.Run("設定を置き換えて返す", func(t *testing.T) {
+		assert.NoError(t, repo.ReplaceByCompanyID(db, companyID, []*models.JournalAccount{
+			{Type: value.JournalAccountBank, Name: "当座預金"},
+		}, 1))
+
+		accounts := []*models.JournalAccount{
+			{Type: value.JournalAccountExpense, Name: "外注費"},
+			{Type: value.JournalAccountFee, Name: "販売手数料"},
+		}
+		assert.NoError(t, repo.ReplaceByCompanyID(db, companyID, accounts, 2))
+		assert.NotEmpty(t, accounts[0].ID)
+		assert.Equal(t, companyID, accounts[0].CompanyID)
+
+		found, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Equal(t, models.JournalAccounts{
+			value.JournalAccountExpense: "外注費",
+			value.JournalAccountFee:     "販売手数料",
+			value.JournalAccountPayable: "未払金",
+			value.JournalAccountBank:    "普通預金",
+		}, models.NewJournalAccounts(found))
+
+		version, err := repo.FindVersionByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, version)
+	})
+
+	t.Run("バージョンが一致しない場合は置き換えない", func(t *testing.T) {
+		err := repo.ReplaceByCompanyID(db, companyID, nil, 2)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+
+		accounts, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Len(t, accounts, 2)
+	})
+
+	t.Run("空で置き換えると設定をなくす", func(t *testing.T) {
+		assert.NoError(t, repo.ReplaceByCompanyID(db, companyID, nil, 3))
+
+		accounts, err := repo.FindByCompanyID(db, companyID)
+		assert.NoError(t, err)
+		assert.Empty(t, accounts)
+	})
+}
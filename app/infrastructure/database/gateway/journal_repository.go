@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type journalRepository struct{}
+
+func NewJournalRepository() repository.JournalRepository {
+	return &journalRepository{}
+}
+
+func (r *journalRepository) FindSource(db *gorm.DB, companyID string, period models.ReportRange) (*models.JournalSource, error) {
+	from, to := reportTime(period.From), reportTime(period.To)
+
+	var daoInvoices []*entities.Invoice
+	if err := db.Where("company_id = ? AND issue_date >= ? AND issue_date < ?", companyID, from, to).
+		Where("status NOT IN ?", []value.InvoiceStatus{value.InvoiceStatusPendingApproval, value.InvoiceStatusRejected}).
+		Order("issue_date").Order("id").
+		Find(&daoInvoices).Error; err != nil {
+		return nil, err
+	}
+
+	var daoCreditNotes []*entities.CreditNote
+	if err := db.Where("company_id = ? AND issue_date >= ? AND issue_date < ?", companyID, from, to).
+		Order("issue_date").Order("id").
+		Find(&daoCreditNotes).Error; err != nil {
+		return nil, err
+	}
+
+	// 支払は会社の列を持たないため、論理削除していない会社の請求書の支払に絞り込む
+	var daoPayments []*entities.Payment
+	if err := db.Joins("Invoice").
+		Where("Invoice.company_id = ? AND payments.paid_date >= ? AND payments.paid_date < ?", companyID, from, to).
+		Order("payments.paid_date").Order("payments.id").
+		Find(&daoPayments).Error; err != nil {
+		return nil, err
+	}
+
+	source := &models.JournalSource{
+		Invoices:         make([]*models.Invoice, len(daoInvoices)),
+		CreditNotes:      make([]*models.CreditNote, len(daoCreditNotes)),
+		CreditedInvoices: make(map[string]*models.Invoice),
+		Payments:         make([]*models.Payment, len(daoPayments)),
+	}
+	invoiceIDs := make([]string, 0, len(daoInvoices)+len(daoCreditNotes)+len(daoPayments))
+	for i, daoInvoice := range daoInvoices {
+		source.Invoices[i] = models.InvoiceFromDAO(daoInvoice)
+		invoiceIDs = append(invoiceIDs, daoInvoice.ID)
+	}
+	creditedIDs := make([]string, len(daoCreditNotes))
+	for i, daoCreditNote := range daoCreditNotes {
+		source.CreditNotes[i] = models.CreditNoteFromDAO(daoCreditNote)
+		creditedIDs[i] = daoCreditNote.InvoiceID
+		invoiceIDs = append(invoiceIDs, daoCreditNote.InvoiceID)
+	}
+	for i, daoPayment := range daoPayments {
+		source.Payments[i] = models.PaymentFromDAO(daoPayment)
+		invoiceIDs = append(invoiceIDs, daoPayment.InvoiceID)
+	}
+
+	// 返品・値引きの元の請求書は期間の前に発行したものもあるため、明細と合わせて読み込む
+	if len(creditedIDs) > 0 {
+		var daoCredited []*entities.Invoice
+		if err := db.Unscoped().Where("id IN ?", creditedIDs).Find(&daoCredited).Error; err != nil {
+			return nil, err
+		}
+		for _, daoInvoice := range daoCredited {
+			source.CreditedInvoices[daoInvoice.ID] = models.InvoiceFromDAO(daoInvoice)
+		}
+	}
+	if err := r.loadLines(db, append(source.Invoices, creditedInvoices(source)...)); err != nil {
+		return nil, err
+	}
+
+	clientNames, err := r.findClientNames(db, invoiceIDs)
+	if err != nil {
+		return nil, err
+	}
+	source.ClientNames = clientNames
+
+	return source, nil
+}
+
+// loadLines は請求書の明細をまとめて読み込み、請求書ごとに順番に設定します
+func (r *journalRepository) loadLines(db *gorm.DB, invoices []*models.Invoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+	byID := make(map[string][]*models.Invoice, len(invoices))
+	ids := make([]string, 0, len(invoices))
+	for _, invoice := range invoices {
+		byID[invoice.ID] = append(byID[invoice.ID], invoice)
+		ids = append(ids, invoice.ID)
+	}
+
+	var daoLines []*entities.InvoiceLine
+	if err := db.Where("invoice_id IN ?", ids).Order("invoice_id").Order("line_no").Find(&daoLines).Error; err != nil {
+		return err
+	}
+	for _, daoLine := range daoLines {
+		for _, invoice := range byID[daoLine.InvoiceID] {
+			invoice.Lines = append(invoice.Lines, models.InvoiceLineFromDAO(daoLine))
+		}
+	}
+
+	return nil
+}
+
+// findClientNames は請求書の取引先名を請求書の ID ごとに返します。
+// 取引先を削除しても計上済みの取引の摘要は変わらないよう、論理削除した取引先も対象にします
+func (r *journalRepository) findClientNames(db *gorm.DB, invoiceIDs []string) (map[string]string, error) {
+	names := make(map[string]string)
+	if len(invoiceIDs) == 0 {
+		return names, nil
+	}
+
+	var rows []*struct {
+		InvoiceID     string
+		CorporateName string
+	}
+	if err := db.Table("invoices").
+		Select("invoices.id AS invoice_id, clients.corporate_name").
+		Joins("JOIN clients ON clients.id = invoices.client_id").
+		Where("invoices.id IN ?", invoiceIDs).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		names[row.InvoiceID] = row.CorporateName
+	}
+
+	return names, nil
+}
+
+func creditedInvoices(source *models.JournalSource) []*models.Invoice {
+	invoices := make([]*models.Invoice, 0, len(source.CreditedInvoices))
+	for _, invoice := range source.CreditedInvoices {
+		invoices = append(invoices, invoice)
+	}
+
+	return invoices
+}
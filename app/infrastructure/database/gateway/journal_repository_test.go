@@ -0,0 +1,94 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalRepository_FindSource(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewJournalRepository()
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	otherCompany := &entities.Company{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZZZ", CorporateName: "Other Company"}
+	assert.NoError(t, db.Create(otherCompany).Error)
+	otherClient := &entities.Client{
+		CompanyID:          otherCompany.ID,
+		CorporateName:      "Other Company Client",
+		RepresentativeName: "Other Rep",
+		PhoneNumber:        "222-2222-2222",
+		PostalCode:         "222-2222",
+		Address:            "Other Address",
+	}
+	assert.NoError(t, db.Create(otherClient).Error)
+
+	// 11月に発行し、12月に返品・値引きと支払のあった請求書
+	november := createTestInvoice(t, db, client, date(time.November, 20))
+	assert.NoError(t, db.Create(&entities.InvoiceLine{
+		InvoiceID: november.ID, LineNo: 1, Description: "弁当", Quantity: decimal.NewFromInt(10),
+		UnitPrice: decimal.NewFromInt(1000), TaxCategory: value.TaxCategoryReduced, Amount: decimal.NewFromInt(10000),
+	}).Error)
+	assert.NoError(t, db.Create(&entities.CreditNote{
+		CompanyID: client.CompanyID, InvoiceID: november.ID, IssueDate: date(time.December, 3), Reason: value.CreditNoteReasonReturn,
+		PaymentAmount: decimal.NewFromInt(1000), Fee: decimal.NewFromInt(40), FeeRate: decimal.NewFromFloat(0.04),
+		Tax: decimal.NewFromInt(4), TaxRate: decimal.NewFromFloat(0.10), CreditAmount: decimal.NewFromInt(1044),
+	}).Error)
+	assert.NoError(t, db.Create(&entities.Payment{
+		InvoiceID: november.ID, Amount: decimal.NewFromInt(9396), PaidDate: date(time.December, 26), Method: value.PaymentMethodBankTransfer,
+	}).Error)
+
+	december := createTestInvoice(t, db, client, date(time.December, 10))
+	first := createTestInvoice(t, db, client, date(time.December, 1))
+	// 承認待ち・却下・削除した請求書、期間外・他社の請求書は含めない
+	pending := createTestInvoice(t, db, client, date(time.December, 5))
+	assert.NoError(t, db.Model(pending).UpdateColumn("status", value.InvoiceStatusPendingApproval).Error)
+	rejected := createTestInvoice(t, db, client, date(time.December, 5))
+	assert.NoError(t, db.Model(rejected).UpdateColumn("status", value.InvoiceStatusRejected).Error)
+	deleted := createTestInvoice(t, db, client, date(time.December, 5))
+	assert.NoError(t, db.Delete(deleted).Error)
+	createTestInvoice(t, db, client, date(time.January, 1).AddDate(1, 0, 0))
+	other := createTestInvoice(t, db, otherClient, date(time.December, 5))
+	assert.NoError(t, db.Create(&entities.Payment{
+		InvoiceID: other.ID, Amount: decimal.NewFromInt(10440), PaidDate: date(time.December, 26), Method: value.PaymentMethodBankTransfer,
+	}).Error)
+
+	source, err := repo.FindSource(db, client.CompanyID, models.NewReportRange(date(time.December, 1), date(time.December, 31)))
+	assert.NoError(t, err)
+
+	t.Run("期間に発行した確定した請求書を発行日の順に返す", func(t *testing.T) {
+		assert.Len(t, source.Invoices, 2)
+		assert.Equal(t, first.ID, source.Invoices[0].ID)
+		assert.Equal(t, december.ID, source.Invoices[1].ID)
+	})
+
+	t.Run("返品・値引きと元の請求書を明細と合わせて返す", func(t *testing.T) {
+		assert.Len(t, source.CreditNotes, 1)
+		assert.Equal(t, november.ID, source.CreditNotes[0].InvoiceID)
+		credited := source.CreditedInvoices[november.ID]
+		assert.NotNil(t, credited)
+		assert.Len(t, credited.Lines, 1)
+		assert.Equal(t, value.TaxCategoryReduced, credited.Lines[0].TaxCategory)
+	})
+
+	t.Run("会社の請求書の支払だけを返す", func(t *testing.T) {
+		assert.Len(t, source.Payments, 1)
+		assert.Equal(t, november.ID, source.Payments[0].InvoiceID)
+		assert.True(t, decimal.NewFromInt(9396).Equal(source.Payments[0].Amount))
+	})
+
+	t.Run("取引先名を請求書ごとに返す", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			first.ID:    "Test Client",
+			december.ID: "Test Client",
+			november.ID: "Test Client",
+		}, source.ClientNames)
+	})
+}
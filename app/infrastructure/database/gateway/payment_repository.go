@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type paymentRepository struct{}
+
+func NewPaymentRepository() repository.PaymentRepository {
+	return &paymentRepository{}
+}
+
+func (r *paymentRepository) Create(db *gorm.DB, payment *models.Payment) error {
+	daoPayment := payment.ToDAO()
+	if err := db.Create(&daoPayment).Error; err != nil {
+		return err
+	}
+	payment.ID = daoPayment.ID
+	payment.CreatedAt = daoPayment.CreatedAt
+
+	return nil
+}
+
+func (r *paymentRepository) FindByInvoiceID(db *gorm.DB, invoiceID string) ([]*models.Payment, error) {
+	var daoPayments []*entities.Payment
+	if err := db.Where("invoice_id = ?", invoiceID).Order("paid_date").Order("id").Find(&daoPayments).Error; err != nil {
+		return nil, err
+	}
+
+	payments := make([]*models.Payment, len(daoPayments))
+	for i, daoPayment := range daoPayments {
+		payments[i] = models.PaymentFromDAO(daoPayment)
+	}
+
+	return payments, nil
+}
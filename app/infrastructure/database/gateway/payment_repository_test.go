@@ -0,0 +1,60 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentRepository_FindByInvoiceID(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewPaymentRepository()
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	invoice := createTestInvoice(t, db, client, issueDate)
+	other := createTestInvoice(t, db, client, issueDate)
+
+	second := &models.Payment{
+		InvoiceID: invoice.ID,
+		Amount:    decimal.NewFromInt(4440),
+		PaidDate:  time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC),
+		Method:    value.PaymentMethodBankTransfer,
+		Reference: "TRX-0002",
+	}
+	first := &models.Payment{
+		InvoiceID: invoice.ID,
+		Amount:    decimal.NewFromInt(6000),
+		PaidDate:  time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC),
+		Method:    value.PaymentMethodBankTransfer,
+		Reference: "TRX-0001",
+	}
+	for _, payment := range []*models.Payment{second, first, {
+		InvoiceID: other.ID,
+		Amount:    decimal.NewFromInt(10440),
+		PaidDate:  issueDate,
+		Method:    value.PaymentMethodCash,
+	}} {
+		assert.NoError(t, repo.Create(db, payment))
+		assert.NotEmpty(t, payment.ID)
+	}
+
+	t.Run("請求書の支払を支払日の順に返す", func(t *testing.T) {
+		payments, err := repo.FindByInvoiceID(db, invoice.ID)
+		assert.NoError(t, err)
+		assert.Len(t, payments, 2)
+		assert.Equal(t, first.ID, payments[0].ID)
+		assert.Equal(t, "TRX-0001", payments[0].Reference)
+		assert.True(t, decimal.NewFromInt(6000).Equal(payments[0].Amount))
+		assert.Equal(t, second.ID, payments[1].ID)
+	})
+
+	t.Run("支払がない場合は空", func(t *testing.T) {
+		payments, err := repo.FindByInvoiceID(db, createTestInvoice(t, db, client, issueDate).ID)
+		assert.NoError(t, err)
+		assert.Empty(t, payments)
+	})
+}
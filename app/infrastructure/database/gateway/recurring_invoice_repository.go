@@ -0,0 +1,119 @@
+package gateway
+
+import (
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+type recurringInvoiceRepository struct{}
+
+func NewRecurringInvoiceRepository() repository.RecurringInvoiceRepository {
+	return &recurringInvoiceRepository{}
+}
+
+func (r *recurringInvoiceRepository) Create(db *gorm.DB, recurringInvoice *models.RecurringInvoice) error {
+	daoRecurringInvoice := recurringInvoice.ToDAO()
+	if err := db.Create(&daoRecurringInvoice).Error; err != nil {
+		return err
+	}
+	recurringInvoice.ID = daoRecurringInvoice.ID
+	recurringInvoice.CreatedAt = daoRecurringInvoice.CreatedAt
+	recurringInvoice.UpdatedAt = daoRecurringInvoice.UpdatedAt
+	recurringInvoice.Version = daoRecurringInvoice.Version
+
+	return nil
+}
+
+func (r *recurringInvoiceRepository) FindByID(db *gorm.DB, id string) (*models.RecurringInvoice, error) {
+	var daoRecurringInvoice entities.RecurringInvoice
+	if err := db.First(&daoRecurringInvoice, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+
+	return models.RecurringInvoiceFromDAO(&daoRecurringInvoice), nil
+}
+
+func (r *recurringInvoiceRepository) FindByCompanyID(db *gorm.DB, companyID string) ([]*models.RecurringInvoice, error) {
+	var daoRecurringInvoices []entities.RecurringInvoice
+	if err := db.Where("company_id = ?", companyID).Order("id").Find(&daoRecurringInvoices).Error; err != nil {
+		return nil, err
+	}
+
+	return toRecurringInvoiceModels(daoRecurringInvoices), nil
+}
+
+func (r *recurringInvoiceRepository) FindDue(db *gorm.DB, date time.Time, limit int) ([]*models.RecurringInvoice, error) {
+	var daoRecurringInvoices []entities.RecurringInvoice
+	err := db.
+		Where("next_issue_date <= ?", date).
+		Where("end_date IS NULL OR next_issue_date <= end_date").
+		Order("next_issue_date, id").
+		Limit(limit).
+		Find(&daoRecurringInvoices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecurringInvoiceModels(daoRecurringInvoices), nil
+}
+
+func (r *recurringInvoiceRepository) Update(db *gorm.DB, recurringInvoice *models.RecurringInvoice, version int) error {
+	err := updateWithVersion(db, &entities.RecurringInvoice{}, "recurring_invoice", recurringInvoice.ID, version, map[string]interface{}{
+		"client_id":        recurringInvoice.ClientID,
+		"payment_amount":   recurringInvoice.PaymentAmount,
+		"cadence":          recurringInvoice.Cadence,
+		"day_of_month":     recurringInvoice.DayOfMonth,
+		"payment_due_days": recurringInvoice.PaymentDueDays,
+		"start_date":       recurringInvoice.StartDate,
+		"end_date":         recurringInvoice.EndDate,
+		"next_issue_date":  recurringInvoice.NextIssueDate,
+		"updated_at":       recurringInvoice.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	recurringInvoice.Version = version + 1
+
+	return nil
+}
+
+func (r *recurringInvoiceRepository) Delete(db *gorm.DB, id string, version int, deletedAt time.Time) error {
+	return updateWithVersion(db, &entities.RecurringInvoice{}, "recurring_invoice", id, version, map[string]interface{}{"deleted_at": deletedAt})
+}
+
+func (r *recurringInvoiceRepository) ExistsRun(db *gorm.DB, recurringInvoiceID string, period string) (bool, error) {
+	var count int64
+	err := db.Model(&entities.RecurringInvoiceRun{}).
+		Where("recurring_invoice_id = ? AND period = ?", recurringInvoiceID, period).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *recurringInvoiceRepository) CreateRun(db *gorm.DB, run *models.RecurringInvoiceRun) error {
+	daoRun := run.ToDAO()
+	if err := db.Create(&daoRun).Error; err != nil {
+		return err
+	}
+	run.ID = daoRun.ID
+	run.CreatedAt = daoRun.CreatedAt
+
+	return nil
+}
+
+func toRecurringInvoiceModels(daoRecurringInvoices []entities.RecurringInvoice) []*models.RecurringInvoice {
+	recurringInvoices := make([]*models.RecurringInvoice, len(daoRecurringInvoices))
+	for i := range daoRecurringInvoices {
+		recurringInvoices[i] = models.RecurringInvoiceFromDAO(&daoRecurringInvoices[i])
+	}
+
+	return recurringInvoices
+}
@@ -0,0 +1,146 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupRecurringInvoiceTestDB(t *testing.T) (*gorm.DB, *entities.Client, *entities.User) {
+	db, client := setupRetentionTestDB(t)
+
+	user := &entities.User{
+		CompanyID: client.CompanyID,
+		Name:      "Test User",
+		Email:     "test@example.com",
+		Password:  "password",
+		Role:      value.UserRoleMember,
+	}
+	assert.NoError(t, db.Create(user).Error)
+
+	return db, client, user
+}
+
+func createTestRecurringInvoice(t *testing.T, db *gorm.DB, client *entities.Client, user *entities.User, nextIssueDate time.Time, endDate *time.Time) *models.RecurringInvoice {
+	recurringInvoice := &models.RecurringInvoice{
+		CompanyID:      client.CompanyID,
+		ClientID:       client.ID,
+		PaymentAmount:  decimal.NewFromInt(50000),
+		Cadence:        value.RecurrenceCadenceEndOfMonth,
+		PaymentDueDays: 30,
+		StartDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:        endDate,
+		NextIssueDate:  nextIssueDate,
+		CreatedBy:      user.ID,
+	}
+	assert.NoError(t, NewRecurringInvoiceRepository().Create(db, recurringInvoice))
+
+	return recurringInvoice
+}
+
+func TestRecurringInvoiceRepository_FindDue(t *testing.T) {
+	db, client, user := setupRecurringInvoiceTestDB(t)
+	repo := NewRecurringInvoiceRepository()
+
+	today := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+	due := createTestRecurringInvoice(t, db, client, user, today, nil)
+	overdue := createTestRecurringInvoice(t, db, client, user, today.AddDate(0, -1, 0), nil)
+	// 発行日が来ていない
+	createTestRecurringInvoice(t, db, client, user, today.AddDate(0, 0, 1), nil)
+	// 終了日を過ぎている
+	createTestRecurringInvoice(t, db, client, user, today, &endDate)
+	// 削除済み
+	deleted := createTestRecurringInvoice(t, db, client, user, today, nil)
+	assert.NoError(t, repo.Delete(db, deleted.ID, 1, today))
+
+	t.Run("発行日が来た定期請求を発行日の古い順に返す", func(t *testing.T) {
+		recurringInvoices, err := repo.FindDue(db, today, 10)
+		assert.NoError(t, err)
+		assert.Len(t, recurringInvoices, 2)
+		assert.Equal(t, overdue.ID, recurringInvoices[0].ID)
+		assert.Equal(t, due.ID, recurringInvoices[1].ID)
+	})
+
+	t.Run("件数を制限する", func(t *testing.T) {
+		recurringInvoices, err := repo.FindDue(db, today, 1)
+		assert.NoError(t, err)
+		assert.Len(t, recurringInvoices, 1)
+	})
+}
+
+func TestRecurringInvoiceRepository_Update(t *testing.T) {
+	db, client, user := setupRecurringInvoiceTestDB(t)
+	repo := NewRecurringInvoiceRepository()
+
+	recurringInvoice := createTestRecurringInvoice(t, db, client, user, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil)
+
+	t.Run("バージョンが一致すれば更新してバージョンを進める", func(t *testing.T) {
+		recurringInvoice.PaymentAmount = decimal.NewFromInt(60000)
+		recurringInvoice.NextIssueDate = time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+		assert.NoError(t, repo.Update(db, recurringInvoice, 1))
+		assert.Equal(t, 2, recurringInvoice.Version)
+
+		found, err := repo.FindByID(db, recurringInvoice.ID)
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(60000).Equal(found.PaymentAmount))
+		assert.True(t, recurringInvoice.NextIssueDate.Equal(found.NextIssueDate))
+		assert.Equal(t, 2, found.Version)
+	})
+
+	t.Run("バージョンが一致しない場合はVersionConflictError", func(t *testing.T) {
+		err := repo.Update(db, recurringInvoice, 1)
+
+		var conflict *repository.VersionConflictError
+		assert.ErrorAs(t, err, &conflict)
+	})
+}
+
+func TestRecurringInvoiceRepository_Run(t *testing.T) {
+	db, client, user := setupRecurringInvoiceTestDB(t)
+	repo := NewRecurringInvoiceRepository()
+
+	issueDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	recurringInvoice := createTestRecurringInvoice(t, db, client, user, issueDate, nil)
+	invoice := createTestInvoice(t, db, client, issueDate)
+
+	exists, err := repo.ExistsRun(db, recurringInvoice.ID, "2025-01")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	run := &models.RecurringInvoiceRun{
+		RecurringInvoiceID: recurringInvoice.ID,
+		Period:             "2025-01",
+		IssueDate:          issueDate,
+		InvoiceID:          invoice.ID,
+	}
+	assert.NoError(t, repo.CreateRun(db, run))
+	assert.NotEmpty(t, run.ID)
+
+	t.Run("作成済みの期間を判定する", func(t *testing.T) {
+		exists, err := repo.ExistsRun(db, recurringInvoice.ID, "2025-01")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = repo.ExistsRun(db, recurringInvoice.ID, "2025-02")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("同じ期間は2回記録できない", func(t *testing.T) {
+		err := repo.CreateRun(db, &models.RecurringInvoiceRun{
+			RecurringInvoiceID: recurringInvoice.ID,
+			Period:             "2025-01",
+			IssueDate:          issueDate,
+			InvoiceID:          invoice.ID,
+		})
+		assert.Error(t, err)
+	})
+}
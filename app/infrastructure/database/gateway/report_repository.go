@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+type reportRepository struct{}
+
+func NewReportRepository() repository.ReportRepository {
+	return &reportRepository{}
+}
+
+// bucketRow は区間ごとの集計結果の行です
+type bucketRow struct {
+	Bucket       int
+	InvoiceCount int64
+	Amount       decimal.Decimal
+	Tax          decimal.Decimal
+}
+
+func (r *reportRepository) SumAmountDueByPeriod(db *gorm.DB, companyID string, buckets []models.ReportRange) ([]*models.AmountDueReport, error) {
+	reports := make([]*models.AmountDueReport, len(buckets))
+	for i, bucket := range buckets {
+		reports[i] = &models.AmountDueReport{PeriodStart: bucket.From, OutstandingAmount: decimal.Zero}
+	}
+	if len(buckets) == 0 {
+		return reports, nil
+	}
+
+	expression, args := bucketExpression("payment_due_date", buckets)
+	var rows []*bucketRow
+	if err := inBuckets(db.Model(&entities.Invoice{}), "payment_due_date", buckets).
+		Select(expression+" AS bucket, COUNT(*) AS invoice_count, SUM(invoice_amount - credited_amount - paid_amount) AS amount", args...).
+		Where("company_id = ? AND status <> ?", companyID, value.InvoiceStatusRejected).
+		Where("invoice_amount - credited_amount - paid_amount > 0").
+		Group("bucket").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		reports[row.Bucket].InvoiceCount = row.InvoiceCount
+		reports[row.Bucket].OutstandingAmount = row.Amount.Round(2)
+	}
+
+	return reports, nil
+}
+
+func (r *reportRepository) CountByStatus(db *gorm.DB, companyID string, period models.ReportRange) ([]*models.StatusReport, error) {
+	var rows []*struct {
+		Status        value.InvoiceStatus
+		InvoiceCount  int64
+		InvoiceAmount decimal.Decimal
+	}
+	if err := inBuckets(db.Model(&entities.Invoice{}), "issue_date", []models.ReportRange{period}).
+		Select("status, COUNT(*) AS invoice_count, SUM(invoice_amount) AS invoice_amount").
+		Where("company_id = ?", companyID).
+		Group("status").
+		Order("status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	reports := make([]*models.StatusReport, len(rows))
+	for i, row := range rows {
+		reports[i] = &models.StatusReport{Status: row.Status, InvoiceCount: row.InvoiceCount, InvoiceAmount: row.InvoiceAmount.Round(2)}
+	}
+
+	return reports, nil
+}
+
+func (r *reportRepository) SumByClient(db *gorm.DB, companyID string, period models.ReportRange, limit int) ([]*models.ClientReport, error) {
+	var rows []*struct {
+		ClientID      string
+		CorporateName string
+		InvoiceCount  int64
+		NetAmount     decimal.Decimal
+	}
+	// 取引先を削除・匿名化しても請求書の実績は残すため、論理削除した取引先も結合する
+	if err := inBuckets(db.Model(&entities.Invoice{}), "invoices.issue_date", []models.ReportRange{period}).
+		Select("invoices.client_id, clients.corporate_name, COUNT(*) AS invoice_count, SUM(invoices.invoice_amount - invoices.credited_amount) AS net_amount").
+		Joins("JOIN clients ON clients.id = invoices.client_id").
+		Where("invoices.company_id = ? AND invoices.status <> ?", companyID, value.InvoiceStatusRejected).
+		Group("invoices.client_id, clients.corporate_name").
+		Order("net_amount DESC").
+		Order("invoices.client_id").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	reports := make([]*models.ClientReport, len(rows))
+	for i, row := range rows {
+		reports[i] = &models.ClientReport{ClientID: row.ClientID, CorporateName: row.CorporateName, InvoiceCount: row.InvoiceCount, NetAmount: row.NetAmount.Round(2)}
+	}
+
+	return reports, nil
+}
+
+func (r *reportRepository) SumFeesByPeriod(db *gorm.DB, companyID string, buckets []models.ReportRange) ([]*models.FeeReport, error) {
+	reports := make([]*models.FeeReport, len(buckets))
+	for i, bucket := range buckets {
+		reports[i] = &models.FeeReport{PeriodStart: bucket.From, Fee: decimal.Zero, Tax: decimal.Zero}
+	}
+	if len(buckets) == 0 {
+		return reports, nil
+	}
+
+	expression, args := bucketExpression("issue_date", buckets)
+	var invoiceRows []*bucketRow
+	if err := inBuckets(db.Model(&entities.Invoice{}), "issue_date", buckets).
+		Select(expression+" AS bucket, COUNT(*) AS invoice_count, SUM(fee) AS amount, SUM(tax) AS tax", args...).
+		Where("company_id = ? AND status <> ?", companyID, value.InvoiceStatusRejected).
+		Group("bucket").
+		Scan(&invoiceRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range invoiceRows {
+		reports[row.Bucket].InvoiceCount = row.InvoiceCount
+		reports[row.Bucket].Fee = row.Amount.Round(2)
+		reports[row.Bucket].Tax = row.Tax.Round(2)
+	}
+
+	// 返品・値引きで減額した手数料は、元の請求書ではなく返品・値引きを発行した区間から差し引く
+	var creditRows []*bucketRow
+	if err := inBuckets(db.Model(&entities.CreditNote{}), "issue_date", buckets).
+		Select(expression+" AS bucket, SUM(fee) AS amount, SUM(tax) AS tax", args...).
+		Where("company_id = ?", companyID).
+		Group("bucket").
+		Scan(&creditRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range creditRows {
+		reports[row.Bucket].Fee = reports[row.Bucket].Fee.Sub(row.Amount.Round(2))
+		reports[row.Bucket].Tax = reports[row.Bucket].Tax.Sub(row.Tax.Round(2))
+	}
+
+	return reports, nil
+}
+
+// inBuckets は列の値が最初の区間の開始から最後の区間の終了までに入る行に絞り込みます
+func inBuckets(query *gorm.DB, column string, buckets []models.ReportRange) *gorm.DB {
+	return query.Where(fmt.Sprintf("%[1]s >= ? AND %[1]s < ?", column), reportTime(buckets[0].From), reportTime(buckets[len(buckets)-1].To))
+}
+
+// bucketExpression は列の値が入る区間の番号（buckets の添字）を返す CASE 式とその引数を返します。
+// 区間の境界はアプリケーションで日本時間から求めるため、データベースのタイムゾーンや日付関数の違いに左右されません
+func bucketExpression(column string, buckets []models.ReportRange) (string, []interface{}) {
+	var expression strings.Builder
+	args := make([]interface{}, 0, len(buckets)*2)
+	expression.WriteString("CASE")
+	for i, bucket := range buckets {
+		fmt.Fprintf(&expression, " WHEN %[1]s >= ? AND %[1]s < ? THEN %[2]d", column, i)
+		args = append(args, reportTime(bucket.From), reportTime(bucket.To))
+	}
+	expression.WriteString(" END")
+
+	return expression.String(), args
+}
+
+// reportTime は区間の境界を UTC にします。保存済みの日時と同じ表記で比べるためです
+func reportTime(t time.Time) time.Time {
+	return t.UTC()
+}
@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportRepository(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewReportRepository()
+
+	otherClient := &entities.Client{
+		CompanyID:          client.CompanyID,
+		CorporateName:      "Second Client",
+		RepresentativeName: "Second Rep",
+		PhoneNumber:        "111-1111-1111",
+		PostalCode:         "111-1111",
+		Address:            "Second Address",
+	}
+	assert.NoError(t, db.Create(otherClient).Error)
+	otherCompany := &entities.Company{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZZZ", CorporateName: "Other Company"}
+	assert.NoError(t, db.Create(otherCompany).Error)
+	otherCompanyClient := &entities.Client{
+		CompanyID:          otherCompany.ID,
+		CorporateName:      "Other Company Client",
+		RepresentativeName: "Other Rep",
+		PhoneNumber:        "222-2222-2222",
+		PostalCode:         "222-2222",
+		Address:            "Other Address",
+	}
+	assert.NoError(t, db.Create(otherCompanyClient).Error)
+
+	// 支払期日・ステータス・支払済みの合計を指定して請求書を作成する
+	createInvoice := func(t *testing.T, client *entities.Client, issueDate, dueDate time.Time, status value.InvoiceStatus, paid int64) *entities.Invoice {
+		invoice := createTestInvoice(t, db, client, issueDate)
+		assert.NoError(t, db.Model(invoice).UpdateColumns(map[string]interface{}{
+			"payment_due_date": dueDate,
+			"status":           status,
+			"paid_amount":      decimal.NewFromInt(paid),
+		}).Error)
+		return invoice
+	}
+	date := func(month time.Month, day int) time.Time {
+		year := 2025
+		if month < time.June {
+			year = 2026
+		}
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	createInvoice(t, client, date(time.November, 1), date(time.December, 1), value.InvoiceStatusUnprocessed, 0)
+	createInvoice(t, client, date(time.November, 10), date(time.December, 30), value.InvoiceStatusPartiallyPaid, 440)
+	createInvoice(t, otherClient, date(time.November, 20), date(time.December, 31), value.InvoiceStatusPaid, 10440)
+	createInvoice(t, otherClient, date(time.November, 30), date(time.January, 1), value.InvoiceStatusRejected, 0)
+	createInvoice(t, otherClient, date(time.December, 5), date(time.January, 5), value.InvoiceStatusUnprocessed, 0)
+	// 日本時間の12月1日0時と、その1秒前（日本時間では11月30日）
+	createInvoice(t, otherClient, date(time.December, 1), time.Date(2025, 11, 30, 15, 0, 0, 0, time.UTC), value.InvoiceStatusUnprocessed, 0)
+	createInvoice(t, otherClient, date(time.December, 1), time.Date(2025, 11, 30, 14, 59, 59, 0, time.UTC), value.InvoiceStatusUnprocessed, 0)
+	// 削除した請求書と他社の請求書は集計しない
+	deleted := createInvoice(t, client, date(time.November, 1), date(time.December, 1), value.InvoiceStatusUnprocessed, 0)
+	assert.NoError(t, db.Delete(deleted).Error)
+	createInvoice(t, otherCompanyClient, date(time.November, 1), date(time.December, 1), value.InvoiceStatusUnprocessed, 0)
+
+	months := models.NewReportRange(date(time.December, 1), date(time.January, 31)).Buckets(value.ReportPeriodMonth)
+
+	t.Run("支払期日の日本時間の月ごとに未払い残高を集計する", func(t *testing.T) {
+		reports, err := repo.SumAmountDueByPeriod(db, client.CompanyID, months)
+		assert.NoError(t, err)
+		assert.Len(t, reports, 2)
+		assert.True(t, time.Date(2025, 12, 1, 0, 0, 0, 0, models.ReportLocation).Equal(reports[0].PeriodStart))
+		// 12/1・12/30（一部支払済）・日本時間の12/1。支払済は含めない
+		assert.Equal(t, int64(3), reports[0].InvoiceCount)
+		assert.True(t, decimal.NewFromInt(10440+10000+10440).Equal(reports[0].OutstandingAmount))
+		// 却下した請求書は含めない
+		assert.Equal(t, int64(1), reports[1].InvoiceCount)
+		assert.True(t, decimal.NewFromInt(10440).Equal(reports[1].OutstandingAmount))
+	})
+
+	t.Run("請求書のない区間は0件", func(t *testing.T) {
+		reports, err := repo.SumAmountDueByPeriod(db, client.CompanyID, models.NewReportRange(date(time.March, 1), date(time.March, 31)).Buckets(value.ReportPeriodWeek))
+		assert.NoError(t, err)
+		assert.Len(t, reports, 6)
+		for _, report := range reports {
+			assert.Zero(t, report.InvoiceCount)
+			assert.True(t, report.OutstandingAmount.IsZero())
+		}
+	})
+
+	t.Run("発行日が期間に入る請求書をステータスごとに集計する", func(t *testing.T) {
+		reports, err := repo.CountByStatus(db, client.CompanyID, models.NewReportRange(date(time.November, 1), date(time.November, 30)))
+		assert.NoError(t, err)
+		counts := map[value.InvoiceStatus]int64{}
+		for _, report := range reports {
+			counts[report.Status] = report.InvoiceCount
+		}
+		assert.Equal(t, map[value.InvoiceStatus]int64{
+			value.InvoiceStatusUnprocessed:   1,
+			value.InvoiceStatusPartiallyPaid: 1,
+			value.InvoiceStatusPaid:          1,
+			value.InvoiceStatusRejected:      1,
+		}, counts)
+	})
+
+	t.Run("取引先ごとに支払うべき金額の多い順に返す", func(t *testing.T) {
+		period := models.NewReportRange(date(time.November, 1), date(time.December, 31))
+		reports, err := repo.SumByClient(db, client.CompanyID, period, 10)
+		assert.NoError(t, err)
+		assert.Len(t, reports, 2)
+		// 却下した請求書を除く4件
+		assert.Equal(t, otherClient.ID, reports[0].ClientID)
+		assert.Equal(t, "Second Client", reports[0].CorporateName)
+		assert.Equal(t, int64(4), reports[0].InvoiceCount)
+		assert.True(t, decimal.NewFromInt(10440*4).Equal(reports[0].NetAmount))
+		assert.Equal(t, client.ID, reports[1].ClientID)
+
+		reports, err = repo.SumByClient(db, client.CompanyID, period, 1)
+		assert.NoError(t, err)
+		assert.Len(t, reports, 1)
+	})
+
+	t.Run("発行した月ごとに返品・値引きを差し引いた手数料を集計する", func(t *testing.T) {
+		invoice := createInvoice(t, client, date(time.January, 10), date(time.February, 10), value.InvoiceStatusUnprocessed, 0)
+		assert.NoError(t, NewCreditNoteRepository().Create(db, &models.CreditNote{
+			CompanyID:     client.CompanyID,
+			InvoiceID:     invoice.ID,
+			IssueDate:     date(time.January, 20),
+			Reason:        value.CreditNoteReasonDiscount,
+			PaymentAmount: decimal.NewFromInt(1000),
+			Fee:           decimal.NewFromInt(40),
+			FeeRate:       decimal.NewFromFloat(0.04),
+			Tax:           decimal.NewFromInt(4),
+			TaxRate:       decimal.NewFromFloat(0.10),
+			CreditAmount:  decimal.NewFromInt(1044),
+		}))
+
+		reports, err := repo.SumFeesByPeriod(db, client.CompanyID, months)
+		assert.NoError(t, err)
+		assert.Len(t, reports, 2)
+		assert.Equal(t, int64(3), reports[0].InvoiceCount)
+		assert.True(t, decimal.NewFromInt(1200).Equal(reports[0].Fee))
+		assert.True(t, decimal.NewFromInt(120).Equal(reports[0].Tax))
+		assert.Equal(t, int64(1), reports[1].InvoiceCount)
+		assert.True(t, decimal.NewFromInt(360).Equal(reports[1].Fee))
+		assert.True(t, decimal.NewFromInt(36).Equal(reports[1].Tax))
+	})
+
+	t.Run("区間がない場合は空", func(t *testing.T) {
+		reports, err := repo.SumFeesByPeriod(db, client.CompanyID, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, reports)
+	})
+}
@@ -0,0 +1,190 @@
+package gateway
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"gorm.io/gorm"
+)
+
+// retentionTable はエンティティの種類ごとのテーブルの情報です
+type retentionTable struct {
+	model func() interface{}
+	// basisColumn は保存期間の起算日の列です
+	basisColumn string
+	// restorable は会社に属し、管理者が復元できるテーブルかどうかです
+	restorable bool
+	// anonymize は個人情報を消去するときに更新する列と値です
+	anonymize func(now time.Time) map[string]interface{}
+}
+
+var retentionTables = map[value.EntityType]retentionTable{
+	value.EntityTypeInvoice: {
+		model:       func() interface{} { return &entities.Invoice{} },
+		basisColumn: "issue_date",
+		restorable:  true,
+	},
+	value.EntityTypeClient: {
+		model:       func() interface{} { return &entities.Client{} },
+		basisColumn: "deleted_at",
+		restorable:  true,
+		anonymize: func(now time.Time) map[string]interface{} {
+			return map[string]interface{}{
+				"representative_name": "",
+				"phone_number":        "",
+				"postal_code":         "",
+				"address":             "",
+				"anonymized_at":       now,
+			}
+		},
+	},
+	value.EntityTypeClientBankAccount: {
+		model:       func() interface{} { return &entities.ClientBankAccount{} },
+		basisColumn: "deleted_at",
+	},
+}
+
+type retentionRepository struct{}
+
+func NewRetentionRepository() repository.RetentionRepository {
+	return &retentionRepository{}
+}
+
+func lookupRetentionTable(entityType value.EntityType) (retentionTable, error) {
+	table, ok := retentionTables[entityType]
+	if !ok {
+		return retentionTable{}, fmt.Errorf("unsupported entity type %q", entityType)
+	}
+
+	return table, nil
+}
+
+func (r *retentionRepository) FindDeleted(db *gorm.DB, entityType value.EntityType, id string) (*models.DeletedRecord, error) {
+	table, err := lookupRetentionTable(entityType)
+	if err != nil {
+		return nil, err
+	}
+	if !table.restorable {
+		return nil, fmt.Errorf("entity type %q cannot be restored", entityType)
+	}
+
+	var row struct {
+		ID        string
+		CompanyID string
+		DeletedAt time.Time
+	}
+	if err := db.Unscoped().
+		Model(table.model()).
+		Select("id", "company_id", "deleted_at").
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Take(&row).Error; err != nil {
+		return nil, err
+	}
+
+	return &models.DeletedRecord{
+		EntityType: entityType,
+		ID:         row.ID,
+		CompanyID:  row.CompanyID,
+		DeletedAt:  row.DeletedAt,
+	}, nil
+}
+
+func (r *retentionRepository) Restore(db *gorm.DB, record *models.DeletedRecord) error {
+	table, err := lookupRetentionTable(record.EntityType)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Unscoped().
+		Model(table.model()).
+		Where("id = ?", record.ID).
+		UpdateColumns(restoreColumns()).Error; err != nil {
+		return err
+	}
+	if record.EntityType == value.EntityTypeClient {
+		// 取引先と同時に削除した銀行口座だけを復元し、それ以前に削除した口座は削除したままにする
+		return db.Unscoped().
+			Model(&entities.ClientBankAccount{}).
+			Where("client_id = ? AND deleted_at = ?", record.ID, record.DeletedAt).
+			UpdateColumns(restoreColumns()).Error
+	}
+
+	return nil
+}
+
+// restoreColumns は復元時に更新する列です。削除前に取得した ETag では更新できないよう、バージョンも進めます
+func restoreColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"deleted_at": nil,
+		"version":    gorm.Expr("version + 1"),
+	}
+}
+
+func (r *retentionRepository) FindExpired(db *gorm.DB, policy *models.RetentionPolicy, now time.Time, limit int) ([]string, error) {
+	table, err := lookupRetentionTable(policy.EntityType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.Unscoped().
+		Model(table.model()).
+		Where("deleted_at IS NOT NULL AND deleted_at <= ?", policy.DeletedBefore(now)).
+		Where(table.basisColumn+" <= ?", policy.RetainedUntil(now))
+	if policy.RetentionClass != "" {
+		query = query.Where("retention_class = ?", policy.RetentionClass)
+	}
+	if policy.Action == value.RetentionActionAnonymize {
+		query = query.Where("anonymized_at IS NULL")
+	}
+
+	var ids []string
+	if err := query.Order("id").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *retentionRepository) Purge(db *gorm.DB, entityType value.EntityType, ids []string) (int64, error) {
+	table, err := lookupRetentionTable(entityType)
+	if err != nil {
+		return 0, err
+	}
+
+	if entityType == value.EntityTypeInvoice {
+		// 明細と支払・返品・値引き・承認・添付ファイルの記録は請求書と一緒に保存期間を過ぎるため、請求書より先に削除する
+		for _, model := range []interface{}{&entities.InvoiceLine{}, &entities.Payment{}, &entities.CreditNote{}, &entities.InvoiceApproval{}, &entities.InvoiceAttachment{}} {
+			if err := db.Where("invoice_id IN ?", ids).Delete(model).Error; err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	result := db.Unscoped().Where("id IN ?", ids).Delete(table.model())
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *retentionRepository) Anonymize(db *gorm.DB, entityType value.EntityType, ids []string, now time.Time) (int64, error) {
+	table, err := lookupRetentionTable(entityType)
+	if err != nil {
+		return 0, err
+	}
+	if table.anonymize == nil {
+		return 0, fmt.Errorf("entity type %q cannot be anonymized", entityType)
+	}
+
+	columns := table.anonymize(now)
+	columns["version"] = gorm.Expr("version + 1")
+	result := db.Unscoped().
+		Model(table.model()).
+		Where("id IN ?", ids).
+		UpdateColumns(columns)
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,273 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupRetentionTestDB(t *testing.T) (*gorm.DB, *entities.Client) {
+	// 暗号化列の読み書きに使う鍵
+	encryptiontest.Setup()
+
+	db, err := gorm.Open(sqlite.Open(":memory:?_foreign_keys=on"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// マイグレーション
+	err = db.AutoMigrate(entities.Models()...)
+	assert.NoError(t, err)
+
+	// テストデータ準備
+	company := &entities.Company{
+		ID:            "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CorporateName: "Test Company",
+	}
+	assert.NoError(t, db.Create(company).Error)
+	client := &entities.Client{
+		ID:                 "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CompanyID:          company.ID,
+		CorporateName:      "Test Client",
+		RepresentativeName: "Test Rep",
+		PhoneNumber:        "000-0000-0000",
+		PostalCode:         "000-0000",
+		Address:            "Test Address",
+	}
+	assert.NoError(t, db.Create(client).Error)
+
+	return db, client
+}
+
+func createTestInvoice(t *testing.T, db *gorm.DB, client *entities.Client, issueDate time.Time) *entities.Invoice {
+	invoice := &entities.Invoice{
+		CompanyID:      client.CompanyID,
+		ClientID:       client.ID,
+		IssueDate:      issueDate,
+		PaymentAmount:  decimal.NewFromInt(10000),
+		Fee:            decimal.NewFromInt(400),
+		FeeRate:        decimal.NewFromFloat(0.04),
+		Tax:            decimal.NewFromInt(40),
+		TaxRate:        decimal.NewFromFloat(0.10),
+		InvoiceAmount:  decimal.NewFromInt(10440),
+		PaymentDueDate: issueDate.AddDate(0, 1, 0),
+		Status:         value.InvoiceStatusUnprocessed,
+	}
+	assert.NoError(t, db.Create(invoice).Error)
+
+	return invoice
+}
+
+func TestRetentionRepository_Restore(t *testing.T) {
+	db, client := setupRetentionTestDB(t)
+	repo := NewRetentionRepository()
+	clientRepo := NewClientRepository()
+	accountRepo := NewClientBankAccountRepository()
+
+	deletedAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+	oldAccount := &models.ClientBankAccount{ClientID: client.ID, BankName: "Old Bank", BranchName: "Branch", AccountNumber: "1111111111", AccountName: "Old"}
+	account := &models.ClientBankAccount{ClientID: client.ID, BankName: "Bank", BranchName: "Branch", AccountNumber: "2222222222", AccountName: "Current"}
+	assert.NoError(t, accountRepo.Create(db, oldAccount))
+	assert.NoError(t, accountRepo.Create(db, account))
+	// 取引先より前に削除した口座
+	assert.NoError(t, db.Model(&entities.ClientBankAccount{}).Where("id = ?", oldAccount.ID).UpdateColumn("deleted_at", deletedAt.AddDate(0, 0, -1)).Error)
+	assert.NoError(t, clientRepo.Delete(db, client.ID, 1, deletedAt))
+	assert.NoError(t, accountRepo.DeleteByClientID(db, client.ID, deletedAt))
+
+	t.Run("論理削除したデータは通常の検索で見つからない", func(t *testing.T) {
+		_, err := clientRepo.FindByID(db, client.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		accounts, err := accountRepo.FindByClientID(db, client.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, accounts)
+
+		// 削除済みのデータは再度削除できない
+		assert.ErrorIs(t, clientRepo.Delete(db, client.ID, 1, deletedAt), gorm.ErrRecordNotFound)
+	})
+
+	t.Run("取引先と同時に削除した銀行口座だけを復元する", func(t *testing.T) {
+		tx := db.Begin()
+		defer tx.Rollback()
+
+		record, err := repo.FindDeleted(tx, value.EntityTypeClient, client.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, client.CompanyID, record.CompanyID)
+		assert.True(t, deletedAt.Equal(record.DeletedAt))
+
+		assert.NoError(t, repo.Restore(tx, record))
+
+		restored, err := clientRepo.FindByID(tx, client.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Test Address", restored.Address)
+		accounts, err := accountRepo.FindByClientID(tx, client.ID)
+		assert.NoError(t, err)
+		assert.Len(t, accounts, 1)
+		assert.Equal(t, account.ID, accounts[0].ID)
+	})
+
+	t.Run("削除されていないデータは見つからない", func(t *testing.T) {
+		invoice := createTestInvoice(t, db, client, deletedAt)
+
+		_, err := repo.FindDeleted(db, value.EntityTypeInvoice, invoice.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+func TestRetentionRepository_FindExpired(t *testing.T) {
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+	policies := models.NewRetentionPolicies(7, 10, 7, 30*24*time.Hour)
+	invoicePolicy, extendedInvoicePolicy, clientPolicy := policies[0], policies[1], policies[3]
+
+	t.Run("保存期間を過ぎた請求書を物理削除する", func(t *testing.T) {
+		db, client := setupRetentionTestDB(t)
+		repo := NewRetentionRepository()
+		invoiceRepo := NewInvoiceRepository()
+
+		expired := createTestInvoice(t, db, client, now.AddDate(-8, 0, 0))
+		// 発行日から7年経っていない
+		retained := createTestInvoice(t, db, client, now.AddDate(-6, 0, 0))
+		// 削除していない
+		active := createTestInvoice(t, db, client, now.AddDate(-8, 0, 0))
+		// 復元できる期間内
+		recent := createTestInvoice(t, db, client, now.AddDate(-8, 0, 0))
+		// 明細・支払・返品・値引き・承認・添付ファイルを記録した請求書はそれらの記録も削除する
+		lineRepo := NewInvoiceLineRepository()
+		paymentRepo := NewPaymentRepository()
+		creditNoteRepo := NewCreditNoteRepository()
+		approvalRepo := NewInvoiceApprovalRepository()
+		attachmentRepo := NewInvoiceAttachmentRepository()
+		user := createTestUser(t, db, client.CompanyID)
+		for _, invoice := range []*entities.Invoice{expired, retained} {
+			assert.NoError(t, lineRepo.CreateAll(db, []*models.InvoiceLine{{
+				InvoiceID:   invoice.ID,
+				LineNo:      1,
+				Description: "保守作業",
+				Quantity:    decimal.NewFromInt(1),
+				UnitPrice:   decimal.NewFromInt(10000),
+				TaxCategory: value.TaxCategoryExempt,
+				Amount:      decimal.NewFromInt(10000),
+			}}))
+			assert.NoError(t, paymentRepo.Create(db, &models.Payment{
+				InvoiceID: invoice.ID,
+				Amount:    decimal.NewFromInt(10440),
+				PaidDate:  invoice.PaymentDueDate,
+				Method:    value.PaymentMethodBankTransfer,
+			}))
+			assert.NoError(t, creditNoteRepo.Create(db, &models.CreditNote{
+				CompanyID:     invoice.CompanyID,
+				InvoiceID:     invoice.ID,
+				IssueDate:     invoice.IssueDate,
+				Reason:        value.CreditNoteReasonDiscount,
+				PaymentAmount: decimal.NewFromInt(1000),
+				Fee:           decimal.NewFromInt(40),
+				FeeRate:       decimal.NewFromFloat(0.04),
+				Tax:           decimal.NewFromInt(4),
+				TaxRate:       decimal.NewFromFloat(0.10),
+				CreditAmount:  decimal.NewFromInt(1044),
+			}))
+			assert.NoError(t, approvalRepo.CreateAll(db, []*models.InvoiceApproval{
+				{InvoiceID: invoice.ID, Step: 1, Role: value.UserRoleApprover, Status: value.ApprovalStatusApproved},
+			}))
+			assert.NoError(t, attachmentRepo.Create(db, newTestAttachment(util.GenerateULID(), invoice.ID, user.ID)))
+		}
+		for _, invoice := range []*entities.Invoice{expired, retained} {
+			assert.NoError(t, invoiceRepo.Delete(db, invoice.ID, 1, now.AddDate(0, -2, 0)))
+		}
+		assert.NoError(t, invoiceRepo.Delete(db, recent.ID, 1, now.AddDate(0, 0, -1)))
+
+		ids, err := repo.FindExpired(db, invoicePolicy, now, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{expired.ID}, ids)
+
+		rows, err := repo.Purge(db, value.EntityTypeInvoice, ids)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rows)
+
+		var remaining []string
+		assert.NoError(t, db.Unscoped().Model(&entities.Invoice{}).Order("id").Pluck("id", &remaining).Error)
+		assert.ElementsMatch(t, []string{retained.ID, active.ID, recent.ID}, remaining)
+
+		var lineInvoiceIDs []string
+		assert.NoError(t, db.Model(&entities.InvoiceLine{}).Pluck("invoice_id", &lineInvoiceIDs).Error)
+		assert.Equal(t, []string{retained.ID}, lineInvoiceIDs)
+
+		var paidInvoiceIDs []string
+		assert.NoError(t, db.Model(&entities.Payment{}).Pluck("invoice_id", &paidInvoiceIDs).Error)
+		assert.Equal(t, []string{retained.ID}, paidInvoiceIDs)
+
+		var creditedInvoiceIDs []string
+		assert.NoError(t, db.Model(&entities.CreditNote{}).Pluck("invoice_id", &creditedInvoiceIDs).Error)
+		assert.Equal(t, []string{retained.ID}, creditedInvoiceIDs)
+
+		var approvedInvoiceIDs []string
+		assert.NoError(t, db.Model(&entities.InvoiceApproval{}).Pluck("invoice_id", &approvedInvoiceIDs).Error)
+		assert.Equal(t, []string{retained.ID}, approvedInvoiceIDs)
+
+		var attachedInvoiceIDs []string
+		assert.NoError(t, db.Model(&entities.InvoiceAttachment{}).Pluck("invoice_id", &attachedInvoiceIDs).Error)
+		assert.Equal(t, []string{retained.ID}, attachedInvoiceIDs)
+	})
+
+	t.Run("保存期間の区分が extended の請求書は長い保存期間を過ぎるまで残す", func(t *testing.T) {
+		db, client := setupRetentionTestDB(t)
+		repo := NewRetentionRepository()
+		invoiceRepo := NewInvoiceRepository()
+
+		standard := createTestInvoice(t, db, client, now.AddDate(-8, 0, 0))
+		// 発行日から7年は過ぎたが10年経っていない
+		extended := createTestInvoice(t, db, client, now.AddDate(-8, 0, 0))
+		expiredExtended := createTestInvoice(t, db, client, now.AddDate(-11, 0, 0))
+		for _, invoice := range []*entities.Invoice{extended, expiredExtended} {
+			assert.NoError(t, invoiceRepo.UpdateRetentionClass(db, &models.Invoice{ID: invoice.ID, RetentionClass: value.RetentionClassExtended, UpdatedAt: now}, 1))
+		}
+		for _, invoice := range []*entities.Invoice{standard, extended, expiredExtended} {
+			assert.NoError(t, db.Model(&entities.Invoice{}).Where("id = ?", invoice.ID).Update("deleted_at", now.AddDate(0, -2, 0)).Error)
+		}
+
+		ids, err := repo.FindExpired(db, invoicePolicy, now, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{standard.ID}, ids)
+
+		ids, err = repo.FindExpired(db, extendedInvoicePolicy, now, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{expiredExtended.ID}, ids)
+	})
+
+	t.Run("保存期間を過ぎた取引先の個人情報を消去する", func(t *testing.T) {
+		db, client := setupRetentionTestDB(t)
+		repo := NewRetentionRepository()
+
+		// 請求書から参照されていても匿名化できる
+		createTestInvoice(t, db, client, now.AddDate(-9, 0, 0))
+		assert.NoError(t, NewClientRepository().Delete(db, client.ID, 1, now.AddDate(-8, 0, 0)))
+
+		ids, err := repo.FindExpired(db, clientPolicy, now, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{client.ID}, ids)
+
+		rows, err := repo.Anonymize(db, value.EntityTypeClient, ids, now)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), rows)
+
+		var anonymized entities.Client
+		assert.NoError(t, db.Unscoped().First(&anonymized, "id = ?", client.ID).Error)
+		assert.Equal(t, "Test Client", anonymized.CorporateName)
+		assert.Empty(t, anonymized.RepresentativeName)
+		assert.Empty(t, anonymized.PhoneNumber)
+		assert.Empty(t, anonymized.Address)
+		assert.NotNil(t, anonymized.AnonymizedAt)
+
+		// 匿名化済みの取引先は対象にしない
+		ids, err = repo.FindExpired(db, clientPolicy, now, 100)
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
@@ -0,0 +1,44 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+// updateWithVersion は id と version が一致する行だけを更新し、バージョンを1つ進めます（compare-and-swap）。
+// 更新できなかった場合、行が存在しなければ gorm.ErrRecordNotFound を、
+// 存在すれば *repository.VersionConflictError を返します
+func updateWithVersion(db *gorm.DB, model interface{}, entity string, id string, version int, columns map[string]interface{}) error {
+	return updateWithVersionColumn(db, model, entity, id, "version", version, columns)
+}
+
+// updateWithVersionColumn は updateWithVersion と同じ compare-and-swap を、versionColumn の列で行います。
+// 1つの行が複数の設定のバージョンを持つ場合に使います
+func updateWithVersionColumn(db *gorm.DB, model interface{}, entity string, id string, versionColumn string, version int, columns map[string]interface{}) error {
+	values := make(map[string]interface{}, len(columns)+1)
+	for column, value := range columns {
+		values[column] = value
+	}
+	values[versionColumn] = gorm.Expr(fmt.Sprintf("%s + 1", versionColumn))
+
+	result := db.Model(model).Where(fmt.Sprintf("id = ? AND %s = ?", versionColumn), id, version).UpdateColumns(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return &repository.VersionConflictError{Entity: entity, ID: id, Version: version}
+}
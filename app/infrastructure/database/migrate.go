@@ -0,0 +1,19 @@
+package database
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"gorm.io/gorm"
+)
+
+// Migrate はテーブルを作成・変更し、後から追加した列に既存の行の値を設定します
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(entities.Models()...); err != nil {
+		return err
+	}
+
+	// 通貨を導入する前の請求書は円建てのため、円換算額は支払金額と同じ
+	return db.Model(&entities.Invoice{}).Unscoped().
+		Where("currency = ? AND payment_amount_jpy = 0 AND payment_amount <> 0", value.CurrencyJPY).
+		UpdateColumn("payment_amount_jpy", gorm.Expr("payment_amount")).Error
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestMigrate(t *testing.T) {
+	encryptiontest.Setup()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, Migrate(db))
+
+	company := &entities.Company{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXC", CorporateName: "Test Company"}
+	assert.NoError(t, db.Create(company).Error)
+	client := &entities.Client{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXC", CompanyID: company.ID, CorporateName: "Test Client"}
+	assert.NoError(t, db.Create(client).Error)
+	newInvoice := func(currency value.Currency, paymentAmount, paymentAmountJPY int64) *entities.Invoice {
+		invoice := &entities.Invoice{
+			CompanyID:        company.ID,
+			ClientID:         client.ID,
+			IssueDate:        time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			Currency:         currency,
+			PaymentAmount:    decimal.NewFromInt(paymentAmount),
+			PaymentAmountJPY: decimal.NewFromInt(paymentAmountJPY),
+			PaymentDueDate:   time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+			Status:           value.InvoiceStatusUnprocessed,
+		}
+		assert.NoError(t, db.Create(invoice).Error)
+
+		return invoice
+	}
+
+	t.Run("円換算額のない円建ての請求書に支払金額を設定する", func(t *testing.T) {
+		// 通貨を導入する前に作成した請求書
+		legacy := newInvoice(value.CurrencyJPY, 100000, 0)
+		converted := newInvoice(value.CurrencyUSD, 1000, 155200)
+
+		assert.NoError(t, Migrate(db))
+
+		var amounts []decimal.Decimal
+		assert.NoError(t, db.Model(&entities.Invoice{}).Where("id IN ?", []string{legacy.ID, converted.ID}).
+			Order("currency").Pluck("payment_amount_jpy", &amounts).Error)
+		assert.Len(t, amounts, 2)
+		assert.True(t, decimal.NewFromInt(100000).Equal(amounts[0]))
+		assert.True(t, decimal.NewFromInt(155200).Equal(amounts[1]))
+	})
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ReencryptResult はテーブルごとの再暗号化の対象行数です
+type ReencryptResult struct {
+	Table string
+	Rows  int64
+}
+
+// Reencrypt は暗号化列がアクティブなマスターキー以外で暗号化されている行（暗号化前の平文の行を含む）を、
+// アクティブなマスターキーで暗号化し直します。ブラインドインデックスの列も計算し直します。
+// 暗号化には encryption.SetDefault で設定した Keyring を使います。
+// 更新日時は変更しません。dryRun が true の場合は対象行数を数えるだけで更新しません
+func Reencrypt(ctx context.Context, db *gorm.DB, batchSize int, dryRun bool) ([]ReencryptResult, error) {
+	keyring, err := encryption.Default()
+	if err != nil {
+		return nil, err
+	}
+	db = db.WithContext(ctx)
+
+	var results []ReencryptResult
+	for _, model := range entities.Models() {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return results, err
+		}
+		encrypted, derived := encryptedColumns(stmt.Schema)
+		if len(encrypted) == 0 {
+			continue
+		}
+
+		rows, err := reencryptTable(db, model, stmt.Schema, keyring, encrypted, derived, batchSize, dryRun)
+		if err != nil {
+			return results, fmt.Errorf("failed to re-encrypt %s: %w", stmt.Schema.Table, err)
+		}
+		results = append(results, ReencryptResult{Table: stmt.Schema.Table, Rows: rows})
+	}
+
+	return results, nil
+}
+
+// encryptedColumns は暗号化列と、暗号化列から計算するブラインドインデックスの列を返します
+func encryptedColumns(s *schema.Schema) (encrypted, derived []string) {
+	for _, field := range s.Fields {
+		if strings.EqualFold(field.TagSettings["SERIALIZER"], encryption.SerializerName) {
+			encrypted = append(encrypted, field.DBName)
+		}
+		if field.Tag.Get("encryption") == "blind_index" {
+			derived = append(derived, field.DBName)
+		}
+	}
+
+	return encrypted, derived
+}
+
+func reencryptTable(db *gorm.DB, model interface{}, s *schema.Schema, keyring *encryption.Keyring, encrypted, derived []string, batchSize int, dryRun bool) (int64, error) {
+	// 空でなく、アクティブなキーの接頭辞で始まらない値を持つ行が対象
+	prefix := escapeLike(keyring.ActivePrefix()) + "%"
+	conditions := make([]string, len(encrypted))
+	args := make([]interface{}, len(encrypted))
+	for i, column := range encrypted {
+		conditions[i] = fmt.Sprintf("(%s <> '' AND %s NOT LIKE ? ESCAPE '!')", column, column)
+		args[i] = prefix
+	}
+	// 論理削除した行も保存期間中は残るため、あわせて暗号化し直す
+	stale := db.Unscoped().Model(model).Where(strings.Join(conditions, " OR "), args...)
+
+	if dryRun {
+		var count int64
+		err := stale.Count(&count).Error
+
+		return count, err
+	}
+
+	columns := append(append([]string{}, encrypted...), derived...)
+	primaryKey := s.PrioritizedPrimaryField.DBName
+	sliceType := reflect.SliceOf(reflect.PointerTo(s.ModelType))
+
+	var total int64
+	lastID := ""
+	for {
+		batch := reflect.New(sliceType)
+		if err := stale.Session(&gorm.Session{}).
+			Where(primaryKey+" > ?", lastID).
+			Order(primaryKey).
+			Limit(batchSize).
+			Find(batch.Interface()).Error; err != nil {
+			return total, err
+		}
+		rows := batch.Elem()
+		if rows.Len() == 0 {
+			return total, nil
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			// 更新日時を変えないようフックを止めるため、ブラインドインデックスを計算する BeforeSave は直接呼ぶ
+			tx = tx.Unscoped().Session(&gorm.Session{SkipHooks: true})
+			for i := 0; i < rows.Len(); i++ {
+				row := rows.Index(i).Interface()
+				if hook, ok := row.(beforeSaveHook); ok {
+					if err := hook.BeforeSave(tx); err != nil {
+						return err
+					}
+				}
+				// 読み込み時に復号した値を、保存時にアクティブなキーで暗号化し直す
+				if err := tx.Model(row).Select(columns).Updates(row).Error; err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += int64(rows.Len())
+		lastID = s.PrioritizedPrimaryField.ReflectValueOf(db.Statement.Context, rows.Index(rows.Len()-1)).String()
+	}
+}
+
+type beforeSaveHook interface {
+	BeforeSave(tx *gorm.DB) error
+}
+
+// escapeLike は LIKE のワイルドカードを ESCAPE '!' 用にエスケープします
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
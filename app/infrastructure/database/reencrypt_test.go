@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type storedBankAccount struct {
+	AccountNumber      string
+	AccountName        string
+	AccountNumberIndex string
+	UpdatedAt          time.Time
+}
+
+func setupReencryptTestDB(t *testing.T) *gorm.DB {
+	encryption.SetDefault(encryptiontest.NewKeyring("k1", "k1", "k2"))
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// マイグレーション
+	err = db.AutoMigrate(entities.Models()...)
+	assert.NoError(t, err)
+
+	company := &entities.Company{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXC", CorporateName: "Test Company"}
+	assert.NoError(t, db.Create(company).Error)
+	client := &entities.Client{
+		ID:                 "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CompanyID:          company.ID,
+		CorporateName:      "Test Client",
+		RepresentativeName: "Test Rep",
+		PhoneNumber:        "03-1234-5678",
+		PostalCode:         "000-0000",
+		Address:            "東京都千代田区1-1-1",
+	}
+	assert.NoError(t, db.Create(client).Error)
+
+	// k1 で暗号化した口座を3件と、暗号化を導入する前の平文の口座を1件用意する
+	for _, accountNumber := range []string{"1111111111", "2222222222", "3333333333", "4444444444"} {
+		account := &entities.ClientBankAccount{
+			ClientID:      client.ID,
+			BankName:      "Test Bank",
+			BranchName:    "Test Branch",
+			AccountNumber: accountNumber,
+			AccountName:   "Test Account",
+		}
+		assert.NoError(t, db.Create(account).Error)
+	}
+	err = db.Exec(
+		"UPDATE client_bank_accounts SET account_number = ?, account_name = ?, account_number_index = '' WHERE account_number_index = ?",
+		"4444444444", "Legacy Account", encryptiontest.NewKeyring("k1", "k1").BlindIndex(entities.AccountNumberIndexPurpose, "4444444444"),
+	).Error
+	assert.NoError(t, err)
+	// 論理削除した行も対象にする
+	err = db.Exec("UPDATE client_bank_accounts SET deleted_at = ? WHERE id = (SELECT MIN(id) FROM client_bank_accounts)", time.Now()).Error
+	assert.NoError(t, err)
+
+	return db
+}
+
+func loadStoredBankAccounts(t *testing.T, db *gorm.DB) []storedBankAccount {
+	var stored []storedBankAccount
+	err := db.Raw("SELECT account_number, account_name, account_number_index, updated_at FROM client_bank_accounts ORDER BY id").Scan(&stored).Error
+	assert.NoError(t, err)
+
+	return stored
+}
+
+func TestReencrypt(t *testing.T) {
+	t.Run("ローテーション後に古いキーと平文の値をアクティブなキーで暗号化し直す", func(t *testing.T) {
+		db := setupReencryptTestDB(t)
+		before := loadStoredBankAccounts(t, db)
+
+		rotated := encryptiontest.NewKeyring("k2", "k1", "k2")
+		encryption.SetDefault(rotated)
+
+		results, err := Reencrypt(context.Background(), db, 2, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []ReencryptResult{
+			{Table: "clients", Rows: 1},
+			{Table: "client_bank_accounts", Rows: 4},
+		}, results)
+
+		after := loadStoredBankAccounts(t, db)
+		assert.Len(t, after, 4)
+		for i, stored := range after {
+			assert.True(t, strings.HasPrefix(stored.AccountNumber, rotated.ActivePrefix()))
+			assert.True(t, strings.HasPrefix(stored.AccountName, rotated.ActivePrefix()))
+			assert.Len(t, stored.AccountNumberIndex, 64)
+			assert.True(t, before[i].UpdatedAt.Equal(stored.UpdatedAt))
+		}
+
+		var accounts []entities.ClientBankAccount
+		assert.NoError(t, db.Unscoped().Where("account_number_index = ?", rotated.BlindIndex(entities.AccountNumberIndexPurpose, "4444444444")).Find(&accounts).Error)
+		assert.Len(t, accounts, 1)
+		assert.Equal(t, "Legacy Account", accounts[0].AccountName)
+
+		// 対象の行がなくなるため、2回目は何も更新しない
+		results, err = Reencrypt(context.Background(), db, 2, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []ReencryptResult{
+			{Table: "clients", Rows: 0},
+			{Table: "client_bank_accounts", Rows: 0},
+		}, results)
+	})
+
+	t.Run("ドライランでは対象行数を数えるだけで更新しない", func(t *testing.T) {
+		db := setupReencryptTestDB(t)
+		before := loadStoredBankAccounts(t, db)
+
+		encryption.SetDefault(encryptiontest.NewKeyring("k2", "k1", "k2"))
+
+		results, err := Reencrypt(context.Background(), db, 100, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []ReencryptResult{
+			{Table: "clients", Rows: 1},
+			{Table: "client_bank_accounts", Rows: 4},
+		}, results)
+		assert.Equal(t, before, loadStoredBankAccounts(t, db))
+	})
+}
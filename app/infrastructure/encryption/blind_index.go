@@ -0,0 +1,36 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// BlindIndex は暗号化した値を完全一致で検索するためのブラインドインデックスを返します。
+// HMAC-SHA256 の鍵付きハッシュのため、インデックスから元の値は推測できません。
+// purpose（例: "client_bank_accounts.account_number"）ごとに異なる値になるため、
+// 別の列のインデックスと突き合わせることもできません
+func (k *Keyring) BlindIndex(purpose, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, k.blindIndexKey)
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BlindIndex は SetDefault で設定した Keyring でブラインドインデックスを計算します
+func BlindIndex(purpose, value string) (string, error) {
+	k, err := Default()
+	if err != nil {
+		return "", err
+	}
+
+	return k.BlindIndex(purpose, value), nil
+}
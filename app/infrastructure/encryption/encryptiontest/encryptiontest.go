@@ -0,0 +1,34 @@
+// Package encryptiontest はテストで暗号化列を扱うための Keyring を提供します
+package encryptiontest
+
+import (
+	"bytes"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+)
+
+// KeyID はテスト用のマスターキーのIDです
+const KeyID = "test"
+
+// NewKeyring はキーIDごとに固定の鍵を持つ Keyring を返します。
+// activeKeyID は keyIDs のいずれかを指定します
+func NewKeyring(activeKeyID string, keyIDs ...string) *encryption.Keyring {
+	masterKeys := make(map[string][]byte, len(keyIDs))
+	for i, id := range keyIDs {
+		masterKeys[id] = bytes.Repeat([]byte{byte(i + 1)}, encryption.KeySize)
+	}
+	keyring, err := encryption.NewKeyring(activeKeyID, masterKeys, bytes.Repeat([]byte{0xff}, encryption.KeySize))
+	if err != nil {
+		panic(err)
+	}
+
+	return keyring
+}
+
+// Setup はテスト用の Keyring を暗号化列の読み書きに使うよう設定して返します
+func Setup() *encryption.Keyring {
+	keyring := NewKeyring(KeyID, KeyID)
+	encryption.SetDefault(keyring)
+
+	return keyring
+}
@@ -0,0 +1,194 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ijufumi/practice-202512/app/config"
+)
+
+const (
+	// KeySize はマスターキーとデータキーのバイト数です（AES-256）
+	KeySize = 32
+
+	// formatVersion は暗号文の形式のバージョンです
+	formatVersion = "v1"
+)
+
+var (
+	ErrUnknownKeyID     = errors.New("unknown encryption key id")
+	ErrMalformedMessage = errors.New("malformed encrypted value")
+)
+
+var encoding = base64.RawURLEncoding
+
+// Keyring はマスターキーの一覧と、新しく暗号化するときに使うマスターキーを保持します。
+//
+// 値ごとにランダムなデータキーを生成して AES-GCM で暗号化し、データキー自体をマスターキーで
+// 暗号化（ラップ）して暗号文と一緒に保存します（エンベロープ暗号化）。
+// 暗号文にはマスターキーのIDを含めるため、キーのローテーション後も古いキーで復号できます。
+type Keyring struct {
+	activeKeyID   string
+	masterKeys    map[string]cipher.AEAD
+	blindIndexKey []byte
+}
+
+// NewKeyring は masterKeys のうち activeKeyID のキーで暗号化する Keyring を返します。
+// blindIndexKey はブラインドインデックスの計算に使い、マスターキーとは別の値にします
+func NewKeyring(activeKeyID string, masterKeys map[string][]byte, blindIndexKey []byte) (*Keyring, error) {
+	if _, ok := masterKeys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q: %w", activeKeyID, ErrUnknownKeyID)
+	}
+	if len(blindIndexKey) < KeySize {
+		return nil, fmt.Errorf("blind index key must be at least %d bytes", KeySize)
+	}
+
+	k := &Keyring{
+		activeKeyID:   activeKeyID,
+		masterKeys:    make(map[string]cipher.AEAD, len(masterKeys)),
+		blindIndexKey: blindIndexKey,
+	}
+	for id, key := range masterKeys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid key id %q", id)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("master key %q must be %d bytes, got %d", id, KeySize, len(key))
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.masterKeys[id] = aead
+	}
+
+	return k, nil
+}
+
+// NewKeyringFromConfig は設定のマスターキーとブラインドインデックスの鍵から Keyring を作成します
+func NewKeyringFromConfig(cfg *config.Config) (*Keyring, error) {
+	masterKeys, err := cfg.MasterKeys()
+	if err != nil {
+		return nil, err
+	}
+	blindIndexKey, err := cfg.BlindIndexKeyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyring(cfg.EncryptionActiveKeyID, masterKeys, blindIndexKey)
+}
+
+// ActiveKeyID は新しく暗号化するときに使うマスターキーのIDを返します
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeKeyID
+}
+
+// ActivePrefix はアクティブなマスターキーで暗号化した値の先頭に付く文字列を返します
+func (k *Keyring) ActivePrefix() string {
+	return formatVersion + ":" + k.activeKeyID + ":"
+}
+
+// Encrypt は plaintext をアクティブなマスターキーで暗号化し、
+// `v1:<キーID>:<ラップしたデータキー>:<暗号文>` 形式の文字列を返します。
+// aad は暗号文を別の用途（列）に流用できないよう認証に含める付加データです
+func (k *Keyring) Encrypt(plaintext, aad []byte) (string, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := seal(k.masterKeys[k.activeKeyID], dataKey, []byte(k.activeKeyID))
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataAEAD, plaintext, aad)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		formatVersion,
+		k.activeKeyID,
+		encoding.EncodeToString(wrappedKey),
+		encoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+// Decrypt は Encrypt で暗号化した値を復号します
+func (k *Keyring) Decrypt(value string, aad []byte) ([]byte, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 4 || parts[0] != formatVersion {
+		return nil, ErrMalformedMessage
+	}
+	masterAEAD, ok := k.masterKeys[parts[1]]
+	if !ok {
+		return nil, fmt.Errorf("key %q: %w", parts[1], ErrUnknownKeyID)
+	}
+	wrappedKey, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedMessage
+	}
+	ciphertext, err := encoding.DecodeString(parts[3])
+	if err != nil {
+		return nil, ErrMalformedMessage
+	}
+
+	dataKey, err := open(masterAEAD, wrappedKey, []byte(parts[1]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(dataAEAD, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// IsEncrypted は value が Encrypt で暗号化した形式かどうかを返します。
+// 暗号化を導入する前に保存された平文の値を見分けるために使います
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, formatVersion+":")
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal は nonce を先頭に付けた暗号文を返します
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, aad []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrMalformedMessage
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, sealed, aad)
+}
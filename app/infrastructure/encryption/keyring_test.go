@@ -0,0 +1,127 @@
+package encryption_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	aad := []byte("client_bank_accounts.account_number")
+
+	t.Run("暗号化した値を復号できる", func(t *testing.T) {
+		keyring := encryptiontest.NewKeyring("k1", "k1")
+
+		encrypted, err := keyring.Encrypt([]byte("1234567890"), aad)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encrypted, keyring.ActivePrefix()))
+		assert.NotContains(t, encrypted, "1234567890")
+		assert.True(t, encryption.IsEncrypted(encrypted))
+
+		decrypted, err := keyring.Decrypt(encrypted, aad)
+		assert.NoError(t, err)
+		assert.Equal(t, "1234567890", string(decrypted))
+	})
+
+	t.Run("同じ値でも暗号文は毎回異なる", func(t *testing.T) {
+		keyring := encryptiontest.NewKeyring("k1", "k1")
+
+		first, err := keyring.Encrypt([]byte("1234567890"), aad)
+		assert.NoError(t, err)
+		second, err := keyring.Encrypt([]byte("1234567890"), aad)
+		assert.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("ローテーション後も古いキーで暗号化した値を復号できる", func(t *testing.T) {
+		old := encryptiontest.NewKeyring("k1", "k1", "k2")
+		rotated := encryptiontest.NewKeyring("k2", "k1", "k2")
+
+		encrypted, err := old.Encrypt([]byte("1234567890"), aad)
+		assert.NoError(t, err)
+		assert.False(t, strings.HasPrefix(encrypted, rotated.ActivePrefix()))
+
+		decrypted, err := rotated.Decrypt(encrypted, aad)
+		assert.NoError(t, err)
+		assert.Equal(t, "1234567890", string(decrypted))
+	})
+
+	t.Run("付加データが異なると復号できない", func(t *testing.T) {
+		keyring := encryptiontest.NewKeyring("k1", "k1")
+
+		encrypted, err := keyring.Encrypt([]byte("1234567890"), aad)
+		assert.NoError(t, err)
+
+		_, err = keyring.Decrypt(encrypted, []byte("clients.phone_number"))
+		assert.Error(t, err)
+	})
+
+	t.Run("未知のキーIDは復号できない", func(t *testing.T) {
+		encrypted, err := encryptiontest.NewKeyring("k2", "k1", "k2").Encrypt([]byte("1234567890"), aad)
+		assert.NoError(t, err)
+
+		_, err = encryptiontest.NewKeyring("k1", "k1").Decrypt(encrypted, aad)
+		assert.ErrorIs(t, err, encryption.ErrUnknownKeyID)
+	})
+
+	t.Run("不正な形式は復号できない", func(t *testing.T) {
+		keyring := encryptiontest.NewKeyring("k1", "k1")
+
+		_, err := keyring.Decrypt("v1:k1:invalid", aad)
+		assert.ErrorIs(t, err, encryption.ErrMalformedMessage)
+		_, err = keyring.Decrypt("1234567890", aad)
+		assert.ErrorIs(t, err, encryption.ErrMalformedMessage)
+	})
+}
+
+func TestNewKeyring(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, encryption.KeySize)
+	indexKey := bytes.Repeat([]byte{2}, encryption.KeySize)
+
+	t.Run("アクティブなキーIDが一覧にない場合はエラー", func(t *testing.T) {
+		_, err := encryption.NewKeyring("k2", map[string][]byte{"k1": key}, indexKey)
+		assert.ErrorIs(t, err, encryption.ErrUnknownKeyID)
+	})
+
+	t.Run("鍵の長さが不正な場合はエラー", func(t *testing.T) {
+		_, err := encryption.NewKeyring("k1", map[string][]byte{"k1": key[:16]}, indexKey)
+		assert.Error(t, err)
+
+		_, err = encryption.NewKeyring("k1", map[string][]byte{"k1": key}, indexKey[:16])
+		assert.Error(t, err)
+	})
+}
+
+func TestKeyring_BlindIndex(t *testing.T) {
+	keyring := encryptiontest.NewKeyring("k1", "k1")
+
+	t.Run("同じ値は同じインデックスになる", func(t *testing.T) {
+		index := keyring.BlindIndex("client_bank_accounts.account_number", "1234567890")
+		assert.Len(t, index, 64)
+		assert.Equal(t, index, keyring.BlindIndex("client_bank_accounts.account_number", " 1234567890 "))
+		assert.NotEqual(t, index, keyring.BlindIndex("client_bank_accounts.account_number", "1234567891"))
+	})
+
+	t.Run("用途が異なると別のインデックスになる", func(t *testing.T) {
+		assert.NotEqual(t,
+			keyring.BlindIndex("client_bank_accounts.account_number", "1234567890"),
+			keyring.BlindIndex("clients.phone_number", "1234567890"),
+		)
+	})
+
+	t.Run("マスターキーをローテーションしてもインデックスは変わらない", func(t *testing.T) {
+		rotated := encryptiontest.NewKeyring("k2", "k1", "k2")
+		assert.Equal(t,
+			keyring.BlindIndex("client_bank_accounts.account_number", "1234567890"),
+			rotated.BlindIndex("client_bank_accounts.account_number", "1234567890"),
+		)
+	})
+
+	t.Run("空の値は空のインデックス", func(t *testing.T) {
+		assert.Empty(t, keyring.BlindIndex("client_bank_accounts.account_number", ""))
+	})
+}
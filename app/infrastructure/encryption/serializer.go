@@ -0,0 +1,94 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName はエンティティの `serializer:encrypted` タグで指定する gorm のシリアライザー名です
+const SerializerName = "encrypted"
+
+var ErrKeyringNotConfigured = errors.New("encryption keyring is not configured")
+
+// gorm のシリアライザーはグローバルに登録するため、使用する Keyring もプロセスで1つにする
+var defaultKeyring atomic.Pointer[Keyring]
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// SetDefault は暗号化列の読み書きとブラインドインデックスに使う Keyring を設定します
+func SetDefault(k *Keyring) {
+	defaultKeyring.Store(k)
+}
+
+// Default は SetDefault で設定した Keyring を返します
+func Default() (*Keyring, error) {
+	k := defaultKeyring.Load()
+	if k == nil {
+		return nil, ErrKeyringNotConfigured
+	}
+
+	return k, nil
+}
+
+// Serializer は文字列の列を保存時に暗号化し、読み込み時に復号する gorm のシリアライザーです。
+// 空文字は暗号化せずに保存し、暗号化を導入する前の平文の値はそのまま読み込みます
+type Serializer struct{}
+
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var stored string
+	switch v := dbValue.(type) {
+	case nil:
+	case []byte:
+		stored = string(v)
+	case string:
+		stored = v
+	default:
+		return fmt.Errorf("unsupported type %T for encrypted field %s", dbValue, field.Name)
+	}
+
+	plaintext := stored
+	if IsEncrypted(stored) {
+		k, err := Default()
+		if err != nil {
+			return err
+		}
+		decrypted, err := k.Decrypt(stored, fieldAAD(field))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", field.Name, err)
+		}
+		plaintext = string(decrypted)
+	}
+
+	field.ReflectValueOf(ctx, dst).SetString(plaintext)
+
+	return nil
+}
+
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	plaintext, ok := fieldValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %T for encrypted field %s", fieldValue, field.Name)
+	}
+	if plaintext == "" {
+		return "", nil
+	}
+
+	k, err := Default()
+	if err != nil {
+		return nil, err
+	}
+
+	return k.Encrypt([]byte(plaintext), fieldAAD(field))
+}
+
+// fieldAAD は暗号文を別の列にコピーしても復号できないよう、テーブル名と列名を付加データにします
+func fieldAAD(field *schema.Field) []byte {
+	return []byte(field.Schema.Table + "." + field.DBName)
+}
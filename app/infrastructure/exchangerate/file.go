@@ -0,0 +1,77 @@
+package exchangerate
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"gopkg.in/yaml.v3"
+)
+
+const dateLayout = "2006-01-02"
+
+// ratesFile は為替レートファイルの形式です
+type ratesFile struct {
+	Rates []struct {
+		Date     string `yaml:"date"`
+		Currency string `yaml:"currency"`
+		// Rate は通貨1単位あたりの円です。浮動小数点の誤差を避けるため文字列で読み込みます
+		Rate string `yaml:"rate"`
+	} `yaml:"rates"`
+}
+
+// LoadFile は YAML の為替レートファイルを読み込みます。日付は YYYY-MM-DD、通貨は円以外の ISO 4217 の通貨コードで指定します
+//
+//	rates:
+//	  - date: 2025-01-06
+//	    currency: USD
+//	    rate: "157.32"
+func LoadFile(path string) ([]*models.ExchangeRate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rate file: %w", err)
+	}
+
+	var file ratesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rate file %s: %w", path, err)
+	}
+	rates, err := file.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid exchange rate file %s: %w", path, err)
+	}
+
+	return rates, nil
+}
+
+func (f ratesFile) parse() ([]*models.ExchangeRate, error) {
+	rates := make([]*models.ExchangeRate, 0, len(f.Rates))
+	seen := map[string]bool{}
+	for _, r := range f.Rates {
+		rateDate, err := time.Parse(dateLayout, r.Date)
+		if err != nil {
+			return nil, fmt.Errorf("rate date %q must be YYYY-MM-DD", r.Date)
+		}
+		currency := value.Currency(r.Currency)
+		if !currency.IsValid() || currency == value.CurrencyJPY {
+			return nil, fmt.Errorf("currency %q on %s is not supported", r.Currency, r.Date)
+		}
+		rate, err := decimal.NewFromString(r.Rate)
+		if err != nil || !rate.IsPositive() {
+			return nil, fmt.Errorf("rate %q for %s on %s must be a positive number", r.Rate, r.Currency, r.Date)
+		}
+		key := r.Currency + " " + r.Date
+		if seen[key] {
+			return nil, fmt.Errorf("rate for %s on %s is duplicated", r.Currency, r.Date)
+		}
+		seen[key] = true
+
+		rates = append(rates, &models.ExchangeRate{Currency: currency, RateDate: rateDate, Rate: rate})
+	}
+
+	return rates, nil
+}
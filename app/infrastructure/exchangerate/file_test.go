@@ -0,0 +1,57 @@
+package exchangerate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadFile(t *testing.T) {
+	writeFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "rates.yaml")
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("為替レートを読み込む", func(t *testing.T) {
+		rates, err := LoadFile(writeFile(t, `
+rates:
+  - date: 2025-01-06
+    currency: USD
+    rate: "157.32"
+  - date: 2025-01-06
+    currency: EUR
+    rate: "162.505"
+`))
+		assert.NoError(t, err)
+		assert.Len(t, rates, 2)
+		assert.Equal(t, value.CurrencyUSD, rates[0].Currency)
+		assert.Equal(t, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), rates[0].RateDate)
+		assert.True(t, decimal.RequireFromString("157.32").Equal(rates[0].Rate))
+		assert.True(t, decimal.RequireFromString("162.505").Equal(rates[1].Rate))
+	})
+
+	t.Run("不正な日付・通貨・レートや重複はエラー", func(t *testing.T) {
+		for _, content := range []string{
+			"rates:\n  - date: 2025/01/06\n    currency: USD\n    rate: \"157.32\"\n",
+			"rates:\n  - date: 2025-01-06\n    currency: GBP\n    rate: \"190.10\"\n",
+			"rates:\n  - date: 2025-01-06\n    currency: JPY\n    rate: \"1\"\n",
+			"rates:\n  - date: 2025-01-06\n    currency: USD\n    rate: \"-1\"\n",
+			"rates:\n  - date: 2025-01-06\n    currency: USD\n",
+			"rates:\n  - date: 2025-01-06\n    currency: USD\n    rate: \"157.32\"\n  - date: 2025-01-06\n    currency: USD\n    rate: \"157.40\"\n",
+		} {
+			_, err := LoadFile(writeFile(t, content))
+			assert.Error(t, err, content)
+		}
+	})
+
+	t.Run("ファイルがない場合はエラー", func(t *testing.T) {
+		_, err := LoadFile(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+package logging
+
+import (
+	"log/slog"
+	"time"
+
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold を超えたSQLは警告として出力します
+const slowQueryThreshold = 200 * time.Millisecond
+
+// NewGormLogger は gorm のログを slog に出力するロガーを生成します。
+// SQLのバインド値は出力せず、全SQLの出力は debug レベルの場合のみ行います
+func NewGormLogger(logger *slog.Logger, level slog.Level) gormlogger.Interface {
+	logLevel := gormlogger.Warn
+	if level <= slog.LevelDebug {
+		logLevel = gormlogger.Info
+	}
+
+	return gormlogger.NewSlogLogger(logger, gormlogger.Config{
+		LogLevel:                  logLevel,
+		SlowThreshold:             slowQueryThreshold,
+		ParameterizedQueries:      true,
+		IgnoreRecordNotFoundError: true,
+	})
+}
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys はキー名に含まれていれば値をマスクする語です（小文字・区切り文字なしで比較）
+var sensitiveKeys = []string{
+	"password",
+	"token",
+	"secret",
+	"authorization",
+	"accountnumber",
+	"apikey",
+}
+
+var (
+	bearerPattern = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`)
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// redact は秘匿情報を示すキーの値と、文字列・エラー中の Bearer トークン・JWT をマスクします
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if isSensitiveKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, RedactString(a.Value.String()))
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, RedactString(err.Error()))
+		}
+	}
+
+	return a
+}
+
+// RedactString は文字列中の Bearer トークンと JWT をマスクします
+func RedactString(s string) string {
+	s = bearerPattern.ReplaceAllString(s, "Bearer "+redacted)
+
+	return jwtPattern.ReplaceAllString(s, redacted)
+}
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(normalized, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+var (
+	invoicesDesc = prometheus.NewDesc(
+		"invoices",
+		"Current number of invoices by status.",
+		[]string{"status"}, nil,
+	)
+	invoiceAmountDesc = prometheus.NewDesc(
+		"invoice_amount",
+		"Current total invoice amount by status.",
+		[]string{"status"}, nil,
+	)
+)
+
+// invoiceStatusCollector はスクレイプ時にステータス別の請求書件数と請求金額を集計します
+type invoiceStatusCollector struct {
+	db *gorm.DB
+}
+
+func newInvoiceStatusCollector(db *gorm.DB) prometheus.Collector {
+	return &invoiceStatusCollector{db: db}
+}
+
+func (c *invoiceStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- invoicesDesc
+	ch <- invoiceAmountDesc
+}
+
+func (c *invoiceStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	var rows []struct {
+		Status string
+		Count  int64
+		Amount decimal.Decimal
+	}
+	if err := c.db.Model(&entities.Invoice{}).
+		Select("status, COUNT(*) AS count, COALESCE(SUM(invoice_amount), 0) AS amount").
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		ch <- prometheus.NewInvalidMetric(invoicesDesc, err)
+		ch <- prometheus.NewInvalidMetric(invoiceAmountDesc, err)
+		return
+	}
+
+	for _, row := range rows {
+		ch <- prometheus.MustNewConstMetric(invoicesDesc, prometheus.GaugeValue, float64(row.Count), row.Status)
+		ch <- prometheus.MustNewConstMetric(invoiceAmountDesc, prometheus.GaugeValue, row.Amount.InexactFloat64(), row.Status)
+	}
+}
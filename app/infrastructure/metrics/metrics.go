@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
+)
+
+// Metrics はアプリケーションの Prometheus メトリクスを保持します
+type Metrics struct {
+	registry             *prometheus.Registry
+	httpRequests         *prometheus.CounterVec
+	httpRequestDuration  *prometheus.HistogramVec
+	invoicesCreated      prometheus.Counter
+	invoiceCreatedAmount prometheus.Counter
+}
+
+// New はメトリクスを生成し、DBコネクションプールと請求書ステータスのコレクターを登録します
+func New(db *gorm.DB) (*Metrics, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by method, route and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		invoicesCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "invoices_created_total",
+			Help: "Total number of invoices created.",
+		}),
+		invoiceCreatedAmount: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "invoice_created_amount_total",
+			Help: "Total invoice amount of created invoices.",
+		}),
+	}
+
+	if err := registerAll(m.registry,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(sqlDB, db.Dialector.Name()),
+		newInvoiceStatusCollector(db),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.invoicesCreated,
+		m.invoiceCreatedAmount,
+	); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func registerAll(registry *prometheus.Registry, cs ...prometheus.Collector) error {
+	for _, c := range cs {
+		if err := registry.Register(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Handler は /metrics で公開する HTTP ハンドラーを返します
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry:      m.registry,
+		ErrorHandling: promhttp.ContinueOnError,
+	})
+}
+
+// ObserveHTTPRequest はHTTPリクエストの件数とレイテンシを記録します
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	labels := []string{method, route, strconv.Itoa(status)}
+	m.httpRequests.WithLabelValues(labels...).Inc()
+	m.httpRequestDuration.WithLabelValues(labels...).Observe(duration.Seconds())
+}
+
+// InvoiceCreated は作成された請求書の件数と請求金額を記録します
+func (m *Metrics) InvoiceCreated(invoice *models.Invoice) {
+	m.invoicesCreated.Inc()
+	m.invoiceCreatedAmount.Add(invoice.InvoiceAmount.InexactFloat64())
+}
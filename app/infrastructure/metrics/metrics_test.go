@@ -0,0 +1,153 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/entities"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupMetricsTestDB(t *testing.T) (*gorm.DB, *entities.Client) {
+	// 暗号化列の読み書きに使う鍵
+	encryptiontest.Setup()
+
+	db, err := gorm.Open(sqlite.Open(":memory:?_foreign_keys=on"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(entities.Models()...))
+
+	company := &entities.Company{
+		ID:            "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CorporateName: "Test Company",
+	}
+	assert.NoError(t, db.Create(company).Error)
+	client := &entities.Client{
+		ID:                 "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+		CompanyID:          company.ID,
+		CorporateName:      "Test Client",
+		RepresentativeName: "Test Rep",
+		PhoneNumber:        "000-0000-0000",
+		PostalCode:         "000-0000",
+		Address:            "Test Address",
+	}
+	assert.NoError(t, db.Create(client).Error)
+
+	return db, client
+}
+
+func createTestInvoice(t *testing.T, db *gorm.DB, client *entities.Client, status value.InvoiceStatus, invoiceAmount int64) *entities.Invoice {
+	issueDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	invoice := &entities.Invoice{
+		CompanyID:      client.CompanyID,
+		ClientID:       client.ID,
+		IssueDate:      issueDate,
+		PaymentAmount:  decimal.NewFromInt(invoiceAmount),
+		InvoiceAmount:  decimal.NewFromInt(invoiceAmount),
+		PaymentDueDate: issueDate.AddDate(0, 1, 0),
+		Status:         status,
+	}
+	assert.NoError(t, db.Create(invoice).Error)
+
+	return invoice
+}
+
+func TestInvoiceStatusCollector(t *testing.T) {
+	db, client := setupMetricsTestDB(t)
+
+	createTestInvoice(t, db, client, value.InvoiceStatusUnprocessed, 10000)
+	createTestInvoice(t, db, client, value.InvoiceStatusUnprocessed, 20000)
+	createTestInvoice(t, db, client, value.InvoiceStatusPaid, 5000)
+	// 削除した請求書は集計しない
+	deleted := createTestInvoice(t, db, client, value.InvoiceStatusPaid, 7000)
+	assert.NoError(t, db.Delete(deleted).Error)
+
+	t.Run("ステータス別の件数と請求金額を返す", func(t *testing.T) {
+		expected := `
+# HELP invoice_amount Current total invoice amount by status.
+# TYPE invoice_amount gauge
+invoice_amount{status="支払済"} 5000
+invoice_amount{status="未処理"} 30000
+# HELP invoices Current number of invoices by status.
+# TYPE invoices gauge
+invoices{status="支払済"} 1
+invoices{status="未処理"} 2
+`
+
+		err := testutil.CollectAndCompare(newInvoiceStatusCollector(db), strings.NewReader(expected), "invoices", "invoice_amount")
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	db, client := setupMetricsTestDB(t)
+	createTestInvoice(t, db, client, value.InvoiceStatusUnprocessed, 10000)
+
+	m, err := New(db)
+	assert.NoError(t, err)
+
+	t.Run("作成した請求書の件数と請求金額を加算する", func(t *testing.T) {
+		m.InvoiceCreated(&models.Invoice{InvoiceAmount: decimal.NewFromInt(10440)})
+		m.InvoiceCreated(&models.Invoice{InvoiceAmount: decimal.RequireFromString("2088.5")})
+
+		expected := `
+# HELP invoice_created_amount_total Total invoice amount of created invoices.
+# TYPE invoice_created_amount_total counter
+invoice_created_amount_total 12528.5
+# HELP invoices_created_total Total number of invoices created.
+# TYPE invoices_created_total counter
+invoices_created_total 2
+`
+		err := testutil.GatherAndCompare(m.registry, strings.NewReader(expected), "invoices_created_total", "invoice_created_amount_total")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("HTTPリクエストをメソッド・ルート・ステータスのラベルで記録する", func(t *testing.T) {
+		m.ObserveHTTPRequest(http.MethodGet, "/api/invoices/:id", http.StatusOK, 120*time.Millisecond)
+		m.ObserveHTTPRequest(http.MethodGet, "/api/invoices/:id", http.StatusOK, 80*time.Millisecond)
+		m.ObserveHTTPRequest(http.MethodPost, "/api/invoices", http.StatusConflict, 50*time.Millisecond)
+
+		expected := `
+# HELP http_requests_total Total number of HTTP requests by method, route and status.
+# TYPE http_requests_total counter
+http_requests_total{method="GET",route="/api/invoices/:id",status="200"} 2
+http_requests_total{method="POST",route="/api/invoices",status="409"} 1
+`
+		err := testutil.GatherAndCompare(m.registry, strings.NewReader(expected), "http_requests_total")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, testutil.CollectAndCount(m.httpRequestDuration))
+	})
+
+	t.Run("/metricsで業務メトリクスとDBコネクションプールを公開する", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		body, err := io.ReadAll(rec.Body)
+		assert.NoError(t, err)
+		for _, series := range []string{
+			`invoices{status="未処理"} 1`,
+			`invoice_amount{status="未処理"} 10000`,
+			`invoices_created_total 2`,
+			`invoice_created_amount_total 12528.5`,
+			`http_requests_total{method="GET",route="/api/invoices/:id",status="200"} 2`,
+			`http_request_duration_seconds_count{method="POST",route="/api/invoices",status="409"} 1`,
+			`go_sql_open_connections{db_name="sqlite"}`,
+		} {
+			assert.Contains(t, string(body), series)
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/ijufumi/practice-202512/app/config"
+)
+
+// Worker はHTTPサーバーと同じライフサイクルで動くバックグラウンド処理です
+type Worker interface {
+	// Run は ctx がキャンセルされるまで処理を続け、実行中の処理を終えてから戻ります
+	Run(ctx context.Context) error
+}
+
+// WorkerFunc は関数を Worker として扱うためのアダプターです
+type WorkerFunc func(ctx context.Context) error
+
+func (f WorkerFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+type namedWorker struct {
+	name   string
+	worker Worker
+}
+
+// Server はHTTPサーバーとバックグラウンド処理の起動・停止をまとめて管理します
+type Server struct {
+	httpServer *http.Server
+	cfg        *config.Config
+	workers    []namedWorker
+}
+
+func New(handler http.Handler, cfg *config.Config) *Server {
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              cfg.ServerAddr,
+			Handler:           handler,
+			ReadTimeout:       cfg.ServerReadTimeout,
+			ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+			WriteTimeout:      cfg.ServerWriteTimeout,
+			IdleTimeout:       cfg.ServerIdleTimeout,
+			ErrorLog:          slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn),
+		},
+		cfg: cfg,
+	}
+}
+
+// AddWorker はサーバーの起動時に開始し、停止時に終了を待つバックグラウンド処理を登録します
+func (s *Server) AddWorker(name string, worker Worker) {
+	s.workers = append(s.workers, namedWorker{name: name, worker: worker})
+}
+
+// Run は設定されたアドレスで待ち受け、ctx がキャンセルされるまでリクエストを処理します
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.httpServer.Addr, err)
+	}
+
+	return s.Serve(ctx, ln)
+}
+
+// Serve は ln で待ち受けます。ctx がキャンセルされると新規の受付を止め、
+// 処理中のリクエストとバックグラウンド処理の終了を ShutdownTimeout まで待ってから戻ります
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	// サーバーやワーカーが異常終了した場合も全体を停止する
+	ctx, stop := context.WithCancelCause(ctx)
+	defer stop(nil)
+
+	slog.InfoContext(ctx, "Starting server",
+		slog.String("addr", ln.Addr().String()),
+		slog.Bool("tls", s.cfg.TLSCertFile != ""),
+	)
+
+	serveDone := make(chan struct{})
+	go func() {
+		defer close(serveDone)
+		var err error
+		if s.cfg.TLSCertFile != "" {
+			err = s.httpServer.ServeTLS(ln, s.cfg.TLSCertFile, s.cfg.TLSKeyFile)
+		} else {
+			err = s.httpServer.Serve(ln)
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			stop(fmt.Errorf("server stopped: %w", err))
+		}
+	}()
+
+	// ワーカーはHTTPリクエストの処理が終わるまで動かし続けるため、別のコンテキストで止める
+	workerCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorkers()
+
+	var wg sync.WaitGroup
+	for _, w := range s.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := w.worker.Run(workerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				stop(fmt.Errorf("worker %s stopped: %w", w.name, err))
+			}
+		}()
+	}
+	workersDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(workersDone)
+	}()
+
+	<-ctx.Done()
+	cause := context.Cause(ctx)
+	if errors.Is(cause, context.Canceled) {
+		cause = nil
+	}
+	slog.InfoContext(ctx, "Shutting down server", slog.Duration("timeout", s.cfg.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if cause != nil {
+		errs = append(errs, cause)
+	}
+
+	// 新規の受付を止め、処理中のリクエストを待つ
+	if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to drain requests: %w", err))
+		_ = s.httpServer.Close()
+	}
+	<-serveDone
+
+	// リクエストの処理が終わってからワーカーを止める
+	stopWorkers()
+	select {
+	case <-workersDone:
+	case <-shutdownCtx.Done():
+		errs = append(errs, fmt.Errorf("workers did not stop in time: %w", shutdownCtx.Err()))
+	}
+
+	slog.InfoContext(ctx, "Server stopped")
+
+	return errors.Join(errs...)
+}
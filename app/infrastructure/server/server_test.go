@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestConfig() *config.Config {
+	return &config.Config{
+		ServerAddr:      "127.0.0.1:0",
+		ShutdownTimeout: 5 * time.Second,
+	}
+}
+
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("リッスンに失敗しました: %v", err)
+	}
+
+	return ln
+}
+
+func TestServer_Serve(t *testing.T) {
+	t.Run("停止時に処理中のリクエストを完了させる", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			_, _ = w.Write([]byte("done"))
+		})
+
+		srv := New(handler, newTestConfig())
+		ln := listen(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		serveErr := make(chan error, 1)
+		go func() { serveErr <- srv.Serve(ctx, ln) }()
+
+		type result struct {
+			status int
+			body   string
+			err    error
+		}
+		resCh := make(chan result, 1)
+		go func() {
+			resp, err := http.Get("http://" + ln.Addr().String())
+			if err != nil {
+				resCh <- result{err: err}
+				return
+			}
+			defer func() { _ = resp.Body.Close() }()
+			body, _ := io.ReadAll(resp.Body)
+			resCh <- result{status: resp.StatusCode, body: string(body)}
+		}()
+
+		<-started
+		cancel()
+
+		// 処理中のリクエストがある間は停止しない
+		select {
+		case err := <-serveErr:
+			t.Fatalf("処理中のリクエストを待たずに停止しました: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		close(release)
+		res := <-resCh
+		assert.NoError(t, res.err)
+		assert.Equal(t, http.StatusOK, res.status)
+		assert.Equal(t, "done", res.body)
+		assert.NoError(t, <-serveErr)
+
+		// 停止後は新規の接続を受け付けない
+		_, err := net.DialTimeout("tcp", ln.Addr().String(), time.Second)
+		assert.Error(t, err)
+	})
+
+	t.Run("ドレインがタイムアウトした場合はエラー", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+		})
+
+		cfg := newTestConfig()
+		cfg.ShutdownTimeout = 50 * time.Millisecond
+		srv := New(handler, cfg)
+		ln := listen(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		serveErr := make(chan error, 1)
+		go func() { serveErr <- srv.Serve(ctx, ln) }()
+
+		go func() {
+			resp, err := http.Get("http://" + ln.Addr().String())
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+
+		<-started
+		cancel()
+
+		err := <-serveErr
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("リクエストの処理が終わってからワーカーを停止する", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+		})
+
+		srv := New(handler, newTestConfig())
+		requestDone := make(chan struct{})
+		workerStopped := make(chan bool, 1)
+		srv.AddWorker("test", WorkerFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			select {
+			case <-requestDone:
+				workerStopped <- true
+			default:
+				workerStopped <- false
+			}
+			return ctx.Err()
+		}))
+
+		ln := listen(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		serveErr := make(chan error, 1)
+		go func() { serveErr <- srv.Serve(ctx, ln) }()
+
+		go func() {
+			defer close(requestDone)
+			resp, err := http.Get("http://" + ln.Addr().String())
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+
+		<-started
+		cancel()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+
+		assert.NoError(t, <-serveErr)
+		assert.True(t, <-workerStopped)
+	})
+
+	t.Run("ワーカーが異常終了した場合はサーバーを停止してエラーを返す", func(t *testing.T) {
+		srv := New(http.NotFoundHandler(), newTestConfig())
+		workerErr := errors.New("worker failed")
+		srv.AddWorker("broken", WorkerFunc(func(ctx context.Context) error {
+			return workerErr
+		}))
+
+		err := srv.Serve(context.Background(), listen(t))
+		assert.ErrorIs(t, err, workerErr)
+		assert.ErrorContains(t, err, "worker broken stopped")
+	})
+
+	t.Run("停止しないワーカーはタイムアウトでエラー", func(t *testing.T) {
+		cfg := newTestConfig()
+		cfg.ShutdownTimeout = 50 * time.Millisecond
+		srv := New(http.NotFoundHandler(), cfg)
+		block := make(chan struct{})
+		defer close(block)
+		srv.AddWorker("stuck", WorkerFunc(func(ctx context.Context) error {
+			<-block
+			return nil
+		}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := srv.Serve(ctx, listen(t))
+		assert.ErrorContains(t, err, "workers did not stop in time")
+	})
+}
+
+func TestServer_Run(t *testing.T) {
+	t.Run("待ち受けアドレスが不正な場合はエラー", func(t *testing.T) {
+		cfg := newTestConfig()
+		cfg.ServerAddr = "invalid-address"
+		srv := New(http.NotFoundHandler(), cfg)
+
+		err := srv.Run(context.Background())
+		assert.ErrorContains(t, err, "failed to listen")
+	})
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+)
+
+// localBlobStore はローカルのディレクトリの下に、キーをパスとしてファイルを保存します
+type localBlobStore struct {
+	dir string
+}
+
+// NewLocalBlobStore は dir に保存する BlobStore を返します。dir がなければ作成します
+func NewLocalBlobStore(dir string) (repository.BlobStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create attachment directory: %w", err)
+	}
+
+	return &localBlobStore{dir: dir}, nil
+}
+
+// path はキーのファイルのパスを返します。dir の外を指すキーはエラーにします
+func (s *localBlobStore) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// Put は同じディレクトリの一時ファイルに書き込んでから名前を変えるため、書き込み途中の内容を Get で返すことはありません
+func (s *localBlobStore) Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// 名前を変えた後は存在しないため、失敗した場合だけ削除される
+		_ = os.Remove(tmp.Name())
+	}()
+
+	written, err := io.Copy(tmp, io.LimitReader(body, size))
+	if err == nil && written != size {
+		err = fmt.Errorf("blob %s is %d bytes, expected %d", key, written, size)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, repository.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
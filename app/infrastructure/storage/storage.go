@@ -0,0 +1,27 @@
+// Package storage は添付ファイルの内容をローカルのディレクトリまたは S3 に保存する BlobStore を提供します
+package storage
+
+import (
+	"fmt"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+)
+
+// New は設定の保存先の BlobStore を返します
+func New(cfg *config.Config) (repository.BlobStore, error) {
+	switch cfg.AttachmentStorage {
+	case "local":
+		return NewLocalBlobStore(cfg.AttachmentDir)
+	case "s3":
+		return NewS3BlobStore(S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+		}), nil
+	}
+
+	return nil, fmt.Errorf("unsupported attachment storage %q", cfg.AttachmentStorage)
+}
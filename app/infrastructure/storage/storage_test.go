@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/infrastructure/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBlobStore は保存先によらず BlobStore が満たすべき振る舞いを確認します
+func testBlobStore(t *testing.T, store repository.BlobStore) {
+	ctx := context.Background()
+	key := "invoices/01HQZXFG0PJ9K8QXW7YM1N2ZXC/attachments/01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+	content := []byte("%PDF-1.7\n原本\n")
+
+	t.Run("保存した内容を取得する", func(t *testing.T) {
+		assert.NoError(t, store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "application/pdf"))
+
+		body, err := store.Get(ctx, key)
+		assert.NoError(t, err)
+		defer body.Close()
+		got, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+
+	t.Run("同じキーは上書きする", func(t *testing.T) {
+		updated := []byte("%PDF-1.7\nupdated\n")
+		assert.NoError(t, store.Put(ctx, key, bytes.NewReader(updated), int64(len(updated)), "application/pdf"))
+
+		body, err := store.Get(ctx, key)
+		assert.NoError(t, err)
+		defer body.Close()
+		got, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, updated, got)
+	})
+
+	t.Run("削除した内容はErrBlobNotFound", func(t *testing.T) {
+		assert.NoError(t, store.Delete(ctx, key))
+
+		_, err := store.Get(ctx, key)
+		assert.ErrorIs(t, err, repository.ErrBlobNotFound)
+	})
+
+	t.Run("保存されていないキーの削除は成功する", func(t *testing.T) {
+		assert.NoError(t, store.Delete(ctx, "invoices/missing"))
+	})
+}
+
+func TestLocalBlobStore(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	testBlobStore(t, store)
+
+	t.Run("ディレクトリの外を指すキーはエラー", func(t *testing.T) {
+		ctx := context.Background()
+		for _, key := range []string{"../escape", "/etc/passwd", ""} {
+			assert.Error(t, store.Put(ctx, key, bytes.NewReader(nil), 0, "application/pdf"), key)
+			_, err := store.Get(ctx, key)
+			assert.Error(t, err, key)
+		}
+	})
+
+	t.Run("内容がサイズより短い場合はエラーで、保存しない", func(t *testing.T) {
+		ctx := context.Background()
+		err := store.Put(ctx, "short", bytes.NewReader([]byte("%PDF")), 10, "application/pdf")
+		assert.Error(t, err)
+
+		_, err = store.Get(ctx, "short")
+		assert.ErrorIs(t, err, repository.ErrBlobNotFound)
+	})
+}
+
+func TestS3BlobStore(t *testing.T) {
+	server := storagetest.NewS3Server(t)
+	store := NewS3BlobStore(S3Options{
+		Endpoint:        server.URL,
+		Region:          "ap-northeast-1",
+		Bucket:          "attachments",
+		AccessKeyID:     "test",
+		SecretAccessKey: "test",
+	})
+
+	testBlobStore(t, store)
+
+	t.Run("パス形式でバケットに保存する", func(t *testing.T) {
+		content := []byte("\x89PNG\r\n\x1a\n")
+		assert.NoError(t, store.Put(context.Background(), "invoices/scan", bytes.NewReader(content), int64(len(content)), "image/png"))
+
+		body, contentType, ok := server.Object("attachments", "invoices/scan")
+		assert.True(t, ok)
+		assert.Equal(t, content, body)
+		assert.Equal(t, "image/png", contentType)
+	})
+}
@@ -0,0 +1,90 @@
+// Package storagetest はテストで S3 互換ストレージの代わりに使う HTTP サーバーを提供します
+package storagetest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// S3Server はパス形式の PutObject・GetObject・DeleteObject だけに応答する、メモリー上の S3 互換サーバーです。
+// 署名は検証しません
+type S3Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]object
+}
+
+type object struct {
+	body        []byte
+	contentType string
+}
+
+// NewS3Server はサーバーを起動し、テストの終了時に停止します
+func NewS3Server(t testing.TB) *S3Server {
+	s := &S3Server{objects: map[string]object{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Object はバケットのオブジェクトの内容と Content-Type を返します
+func (s *S3Server) Object(bucket, key string) ([]byte, string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.objects[bucket+"/"+key]
+
+	return obj.body, obj.contentType, ok
+}
+
+func (s *S3Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	if !strings.Contains(name, "/") {
+		writeError(w, http.StatusBadRequest, "InvalidRequest")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		s.mu.Lock()
+		s.objects[name] = object{body: body, contentType: r.Header.Get("Content-Type")}
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		s.mu.Lock()
+		obj, ok := s.objects[name]
+		s.mu.Unlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", fmt.Sprint(len(obj.body)))
+		_, _ = w.Write(obj.body)
+	case http.MethodDelete:
+		s.mu.Lock()
+		delete(s.objects, name)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
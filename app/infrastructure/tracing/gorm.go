@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormTracerName = "github.com/ijufumi/practice-202512/app/infrastructure/tracing/gorm"
+
+// gormSpanKey は実行中のSQLのスパンを gorm.DB のインスタンスに保持するキーです
+const gormSpanKey = "tracing:span"
+
+type gormPlugin struct {
+	tracer trace.Tracer
+}
+
+// NewGormPlugin はSQLの実行ごとにスパンを記録する gorm プラグインを生成します。
+// SQLはバインド値を含まない形で記録します
+func NewGormPlugin() gorm.Plugin {
+	return &gormPlugin{
+		tracer: otel.Tracer(gormTracerName),
+	}
+}
+
+func (p *gormPlugin) Name() string {
+	return "tracing"
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	registrations := []struct {
+		operation string
+		before    func(string, func(*gorm.DB)) error
+		after     func(string, func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"select", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, r := range registrations {
+		if err := r.before("tracing:before_"+r.operation, p.before(r.operation)); err != nil {
+			return err
+		}
+		if err := r.after("tracing:after_"+r.operation, p.after); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *gormPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		if ctx == nil || !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			// 親スパンのないSQL（起動時のマイグレーションなど）は記録しない
+			return
+		}
+
+		_, span := p.tracer.Start(ctx, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemNameKey.String(db.Dialector.Name()),
+				semconv.DBOperationName(operation),
+			),
+		)
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func (p *gormPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	attrs := []attribute.KeyValue{
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	}
+	if db.Statement.Table != "" {
+		attrs = append(attrs, semconv.DBCollectionName(db.Statement.Table))
+	}
+	span.SetAttributes(attrs...)
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ijufumi/practice-202512/app/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// ServiceName は OTEL_SERVICE_NAME が未指定の場合のサービス名です
+const ServiceName = "practice-202512"
+
+// エクスポーターの種類
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Setup は設定に応じたエクスポーターでグローバルな TracerProvider を登録し、W3C Trace Context の伝播を有効にします。
+// 戻り値の関数は未送信のスパンを送信してから TracerProvider を終了します
+func Setup(ctx context.Context, cfg *config.Config, w io.Writer) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporter, err := newExporter(ctx, cfg.TraceExporter, w)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName)),
+		resource.Environment(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewTracerProvider(exporter, res, cfg.TraceSampleRatio)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider は親スパンのサンプリング判定を引き継ぎ、ルートスパンは ratio の割合でサンプリングする TracerProvider を生成します
+func NewTracerProvider(exporter sdktrace.SpanExporter, res *resource.Resource, ratio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+}
+
+// newExporter はエクスポーターを生成します。OTLP の送信先は OTEL_EXPORTER_OTLP_* 環境変数で指定します
+func newExporter(ctx context.Context, name string, w io.Writer) (sdktrace.SpanExporter, error) {
+	switch name {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(w))
+	case ExporterOTLP:
+		return otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %s", name)
+	}
+}
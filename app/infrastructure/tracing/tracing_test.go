@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupRecorder はスパンをメモリに記録する TracerProvider をグローバルに登録します
+func setupRecorder() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	return recorder
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("stdoutエクスポーター", func(t *testing.T) {
+		var buf bytes.Buffer
+		shutdown, err := Setup(context.Background(), &config.Config{TraceExporter: ExporterStdout, TraceSampleRatio: 1}, &buf)
+		assert.NoError(t, err)
+
+		_, span := otel.Tracer("test").Start(context.Background(), "test-span")
+		span.End()
+		assert.NoError(t, shutdown(context.Background()))
+
+		assert.Contains(t, buf.String(), `"Name":"test-span"`)
+		assert.Contains(t, buf.String(), ServiceName)
+	})
+
+	t.Run("W3C Trace Contextの伝播を登録", func(t *testing.T) {
+		shutdown, err := Setup(context.Background(), &config.Config{TraceExporter: ExporterNone}, &bytes.Buffer{})
+		assert.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+
+		carrier := propagation.MapCarrier{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+		injected := propagation.MapCarrier{}
+		otel.GetTextMapPropagator().Inject(ctx, injected)
+		assert.Equal(t, carrier["traceparent"], injected["traceparent"])
+	})
+
+	t.Run("不明なエクスポーター", func(t *testing.T) {
+		_, err := Setup(context.Background(), &config.Config{TraceExporter: "zipkin"}, &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+}
+
+func TestGormPlugin(t *testing.T) {
+	type record struct {
+		ID   int
+		Name string
+	}
+
+	setup := func(t *testing.T) (*gorm.DB, *tracetest.SpanRecorder) {
+		recorder := setupRecorder()
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		assert.NoError(t, err)
+		assert.NoError(t, db.Use(NewGormPlugin()))
+		assert.NoError(t, db.AutoMigrate(&record{}))
+
+		return db, recorder
+	}
+
+	t.Run("親スパンの子としてSQLのスパンを記録", func(t *testing.T) {
+		db, recorder := setup(t)
+
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+		err := db.WithContext(ctx).Create(&record{Name: "secret-name"}).Error
+		assert.NoError(t, err)
+		var found []record
+		err = db.WithContext(ctx).Where("name = ?", "secret-name").Find(&found).Error
+		assert.NoError(t, err)
+		parent.End()
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 3)
+		assert.Equal(t, "gorm.create", spans[0].Name())
+		assert.Equal(t, "gorm.select", spans[1].Name())
+		for _, span := range spans[:2] {
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			for _, attr := range span.Attributes() {
+				assert.NotContains(t, attr.Value.Emit(), "secret-name")
+			}
+		}
+
+		attrs := map[string]string{}
+		for _, attr := range spans[1].Attributes() {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+		assert.Equal(t, "sqlite", attrs["db.system.name"])
+		assert.Equal(t, "records", attrs["db.collection.name"])
+		assert.Contains(t, attrs["db.query.text"], "SELECT")
+	})
+
+	t.Run("SQLエラーをスパンに記録", func(t *testing.T) {
+		db, recorder := setup(t)
+
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+		err := db.WithContext(ctx).Exec("SELECT * FROM not_exists").Error
+		assert.Error(t, err)
+		parent.End()
+
+		spans := recorder.Ended()
+		assert.Equal(t, "gorm.raw", spans[0].Name())
+		assert.Equal(t, "Error", spans[0].Status().Code.String())
+	})
+
+	t.Run("親スパンがない場合は記録しない", func(t *testing.T) {
+		db, recorder := setup(t)
+
+		var found []record
+		err := db.Find(&found).Error
+		assert.NoError(t, err)
+
+		assert.Empty(t, recorder.Ended())
+	})
+}
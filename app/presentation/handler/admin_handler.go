@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminHandler は管理者向けのAPIのハンドラーです
+type AdminHandler struct {
+	retentionUsecase usecase.RetentionUsecase
+}
+
+func NewAdminHandler(retentionUsecase usecase.RetentionUsecase) *AdminHandler {
+	return &AdminHandler{
+		retentionUsecase: retentionUsecase,
+	}
+}
+
+func (h *AdminHandler) RestoreInvoice(c echo.Context) error {
+	return h.restore(c, value.EntityTypeInvoice)
+}
+
+func (h *AdminHandler) RestoreClient(c echo.Context) error {
+	return h.restore(c, value.EntityTypeClient)
+}
+
+func (h *AdminHandler) restore(c echo.Context, entityType value.EntityType) error {
+	ctx := c.Request().Context()
+
+	if err := h.retentionUsecase.Restore(ctx, entityType, c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
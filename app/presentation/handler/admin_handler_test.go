@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAdminHandler_Restore(t *testing.T) {
+	t.Run("削除した取引先を復元する", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockRetentionUsecase(t)
+
+		clientID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+		mockUsecase.EXPECT().Restore(mock.Anything, value.EntityTypeClient, clientID).Return(nil)
+
+		handler := NewAdminHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/clients/"+clientID+"/restore", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(clientID)
+
+		serve(e, c, handler.RestoreClient)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("復元できる期間を過ぎた場合は409", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockRetentionUsecase(t)
+
+		invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+		mockUsecase.EXPECT().Restore(mock.Anything, value.EntityTypeInvoice, invoiceID).
+			Return(apperror.NewConflict(apperror.CodeRestoreWindowExpired, "restore window has expired"))
+
+		handler := NewAdminHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/invoices/"+invoiceID+"/restore", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		serve(e, c, handler.RestoreInvoice)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		var problem models.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &problem)
+		assert.NoError(t, err)
+		assert.Equal(t, "RESTORE_WINDOW_EXPIRED", problem.Code)
+	})
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ApprovalHandler struct {
+	approvalUsecase usecase.ApprovalUsecase
+}
+
+func NewApprovalHandler(approvalUsecase usecase.ApprovalUsecase) *ApprovalHandler {
+	return &ApprovalHandler{
+		approvalUsecase: approvalUsecase,
+	}
+}
+
+func (h *ApprovalHandler) GetWorkflow(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	workflow, err := h.approvalUsecase.GetWorkflow(ctx)
+	if err != nil {
+		return err
+	}
+
+	response := models.FromApprovalWorkflowDomainModel(workflow)
+	setETag(c, workflow.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateWorkflow は承認フローを置き換えます。If-Match には取得時の ETag（承認フローのバージョン）が必要です
+func (h *ApprovalHandler) UpdateWorkflow(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.ApprovalWorkflowRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	rules := make([]*domainModels.ApprovalRule, len(req.Steps))
+	for i, step := range req.Steps {
+		rules[i] = &domainModels.ApprovalRule{
+			MinAmount: step.MinAmount,
+			Role:      value.UserRole(step.Role),
+		}
+	}
+	workflow, err := h.approvalUsecase.UpdateWorkflow(ctx, rules, version)
+	if err != nil {
+		return err
+	}
+
+	response := models.FromApprovalWorkflowDomainModel(workflow)
+	setETag(c, workflow.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *ApprovalHandler) GetApprovals(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	approvals, err := h.approvalUsecase.ListApprovals(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromInvoiceApprovalDomainModels(approvals)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// Approve は請求書の次の段階を承認します。ETag には判断を反映した請求書のバージョンを返します
+func (h *ApprovalHandler) Approve(c echo.Context) error {
+	return h.decide(c, h.approvalUsecase.Approve)
+}
+
+// Reject は請求書の次の段階で却下します。ETag には判断を反映した請求書のバージョンを返します
+func (h *ApprovalHandler) Reject(c echo.Context) error {
+	return h.decide(c, h.approvalUsecase.Reject)
+}
+
+type decideFunc func(ctx context.Context, invoiceID, comment string, version int) (*domainModels.Invoice, []*domainModels.InvoiceApproval, error)
+
+func (h *ApprovalHandler) decide(c echo.Context, decide decideFunc) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.ApprovalDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	invoice, approvals, err := decide(ctx, c.Param("id"), req.Comment, version)
+	if err != nil {
+		return err
+	}
+
+	response := &models.ApprovalDecisionResponse{
+		Invoice:   models.FromInvoiceDomainModel(invoice),
+		Approvals: models.FromInvoiceApprovalDomainModels(approvals).Items,
+	}
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
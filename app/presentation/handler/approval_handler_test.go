@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApprovalHandler_GetWorkflow(t *testing.T) {
+	t.Run("承認フローのバージョンをETagで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockApprovalUsecase(t)
+
+		mockUsecase.EXPECT().GetWorkflow(mock.Anything).Return(&domainModels.ApprovalWorkflow{
+			Rules:   []*domainModels.ApprovalRule{{Step: 1, MinAmount: decimal.Zero, Role: value.UserRoleApprover}},
+			Version: 3,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/approval-workflow", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewApprovalHandler(mockUsecase).GetWorkflow)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+		var response models.ApprovalWorkflowResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Steps, 1)
+		assert.Equal(t, 3, response.Version)
+	})
+}
+
+func TestApprovalHandler_UpdateWorkflow(t *testing.T) {
+	newContext := func(e *echo.Echo, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/approval-workflow", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("段階を並び順のまま渡して承認フローを返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockApprovalUsecase(t)
+
+		mockUsecase.EXPECT().UpdateWorkflow(mock.Anything, mock.MatchedBy(func(rules []*domainModels.ApprovalRule) bool {
+			return len(rules) == 2 &&
+				rules[0].MinAmount.IsZero() && rules[0].Role == value.UserRoleApprover &&
+				rules[1].MinAmount.Equal(decimal.NewFromInt(1000000)) && rules[1].Role == value.UserRoleAdmin
+		}), 3).RunAndReturn(func(_ context.Context, rules []*domainModels.ApprovalRule, version int) (*domainModels.ApprovalWorkflow, error) {
+			for i, rule := range rules {
+				rule.Step = i + 1
+			}
+			return &domainModels.ApprovalWorkflow{Rules: rules, Version: version + 1}, nil
+		})
+
+		c, rec := newContext(e, `"3"`, `{"steps": [{"min_amount": 0, "role": "approver"}, {"min_amount": 1000000, "role": "admin"}]}`)
+		serve(e, c, NewApprovalHandler(mockUsecase).UpdateWorkflow)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+
+		var response models.ApprovalWorkflowResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Steps, 2)
+		assert.Equal(t, 2, response.Steps[1].Step)
+		assert.Equal(t, value.UserRoleAdmin, response.Steps[1].Role)
+		assert.Equal(t, 4, response.Version)
+	})
+
+	t.Run("ロールが不正な場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, `"1"`, `{"steps": [{"min_amount": 0, "role": "owner"}]}`)
+		serve(e, c, NewApprovalHandler(usecase.NewMockApprovalUsecase(t)).UpdateWorkflow)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "", `{"steps": []}`)
+		serve(e, c, NewApprovalHandler(usecase.NewMockApprovalUsecase(t)).UpdateWorkflow)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("If-Matchの形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, "*", "3", `"0"`, `"abc"`} {
+			e := setupEcho()
+
+			c, rec := newContext(e, ifMatch, `{"steps": []}`)
+			serve(e, c, NewApprovalHandler(usecase.NewMockApprovalUsecase(t)).UpdateWorkflow)
+
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, ifMatch)
+		}
+	})
+}
+
+func TestApprovalHandler_Approve(t *testing.T) {
+	invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+
+	newContext := func(e *echo.Echo, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/invoices/"+invoiceID+"/approve", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		return c, rec
+	}
+
+	t.Run("承認して請求書の新しいバージョンをETagで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockApprovalUsecase(t)
+		decidedBy := "01HQZXFG0PJ9K8QXW7YM1N2ZXE"
+		decidedAt := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+
+		mockUsecase.EXPECT().Approve(mock.Anything, invoiceID, "確認しました", 1).Return(
+			&domainModels.Invoice{ID: invoiceID, Status: value.InvoiceStatusUnprocessed, Version: 2},
+			[]*domainModels.InvoiceApproval{{
+				ID:        "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
+				InvoiceID: invoiceID,
+				Step:      1,
+				Role:      value.UserRoleApprover,
+				Status:    value.ApprovalStatusApproved,
+				DecidedBy: &decidedBy,
+				Comment:   "確認しました",
+				DecidedAt: &decidedAt,
+			}}, nil)
+
+		c, rec := newContext(e, `"1"`, `{"comment": "確認しました"}`)
+		serve(e, c, NewApprovalHandler(mockUsecase).Approve)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		assert.Contains(t, rec.Body.String(), `"status":"未処理"`)
+
+		var response models.ApprovalDecisionResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Approvals, 1)
+		assert.Equal(t, value.ApprovalStatusApproved, response.Approvals[0].Status)
+		assert.Equal(t, decidedBy, *response.Approvals[0].DecidedBy)
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "", `{}`)
+		serve(e, c, NewApprovalHandler(usecase.NewMockApprovalUsecase(t)).Approve)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("コメントが長すぎる場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, `"1"`, `{"comment": "`+strings.Repeat("a", 501)+`"}`)
+		serve(e, c, NewApprovalHandler(usecase.NewMockApprovalUsecase(t)).Reject)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
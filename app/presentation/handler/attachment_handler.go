@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// attachmentFormField は添付ファイルの内容を送る multipart/form-data のフィールド名です
+const attachmentFormField = "file"
+
+type AttachmentHandler struct {
+	attachmentUsecase usecase.AttachmentUsecase
+}
+
+func NewAttachmentHandler(attachmentUsecase usecase.AttachmentUsecase) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentUsecase: attachmentUsecase,
+	}
+}
+
+// UploadAttachment は multipart/form-data の file フィールドのファイルを請求書に添付します。
+// ファイルはメモリーに読み込まず、リクエストボディから順に読みながらユースケースに渡します。
+// If-Match ヘッダーには請求書の ETag が必要で、添付するとバージョンの進んだ請求書の ETag を返します
+func (h *AttachmentHandler) UploadAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+	part, err := attachmentPart(c)
+	if err != nil {
+		return err
+	}
+	defer part.Close()
+
+	attachment, invoice, err := h.attachmentUsecase.UploadAttachment(ctx, c.Param("id"), part.FileName(), &requestBodyReader{r: part}, version)
+	if err != nil {
+		return err
+	}
+
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusCreated, models.FromInvoiceAttachmentDomainModel(attachment))
+}
+
+func (h *AttachmentHandler) GetAttachments(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	attachments, err := h.attachmentUsecase.ListAttachments(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.FromInvoiceAttachmentDomainModels(attachments))
+}
+
+// DownloadAttachment は添付ファイルの内容を BlobStore から順に読みながら返します。
+// Repr-Digest ヘッダー（RFC 9530）には保存時に計算した SHA-256 を返します
+func (h *AttachmentHandler) DownloadAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	attachment, body, err := h.attachmentUsecase.DownloadAttachment(ctx, c.Param("id"), c.Param("attachmentId"))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.Size, 10))
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	if digest, err := hex.DecodeString(attachment.SHA256); err == nil {
+		header.Set("Repr-Digest", "sha-256=:"+base64.StdEncoding.EncodeToString(digest)+":")
+	}
+
+	return c.Stream(http.StatusOK, string(attachment.ContentType), body)
+}
+
+// DeleteAttachment は添付ファイルを削除します。If-Match には請求書の ETag が必要で、
+// ETag には削除を反映した請求書のバージョンを返します
+func (h *AttachmentHandler) DeleteAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	invoice, err := h.attachmentUsecase.DeleteAttachment(ctx, c.Param("id"), c.Param("attachmentId"), version)
+	if err != nil {
+		return err
+	}
+
+	setETag(c, invoice.Version)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// attachmentPart はリクエストボディの file フィールドの位置まで読み進め、そのパートを返します
+func attachmentPart(c echo.Context) (*multipart.Part, error) {
+	reader, err := c.Request().MultipartReader()
+	if err != nil {
+		return nil, errInvalidRequestBody.Wrap(err)
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, apperror.NewValidation(apperror.FieldError{Field: attachmentFormField, Code: apperror.FieldCodeRequired})
+		}
+		if err != nil {
+			return nil, errInvalidRequestBody.Wrap(err)
+		}
+		if part.FormName() == attachmentFormField {
+			return part, nil
+		}
+		_ = part.Close()
+	}
+}
+
+// requestBodyReader はリクエストボディを読めなかったエラーを、不正なリクエストボディのエラーにします
+type requestBodyReader struct {
+	r io.Reader
+}
+
+func (r *requestBodyReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, errInvalidRequestBody.Wrap(err)
+	}
+
+	return n, err
+}
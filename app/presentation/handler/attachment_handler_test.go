@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAttachmentHandler(t *testing.T) {
+	invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+	attachmentID := "01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+	attachment := &domainModels.InvoiceAttachment{
+		ID:          attachmentID,
+		InvoiceID:   invoiceID,
+		FileName:    "請求書.pdf",
+		ContentType: value.AttachmentContentTypePDF,
+		Size:        8,
+		SHA256:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	}
+
+	newContext := func(e *echo.Echo, method, contentType string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/invoices/"+invoiceID+"/attachments", body)
+		if contentType != "" {
+			req.Header.Set(echo.HeaderContentType, contentType)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "attachmentId")
+		c.SetParamValues(invoiceID, attachmentID)
+
+		return c, rec
+	}
+	newUploadContext := func(e *echo.Echo, ifMatch, contentType string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		c, rec := newContext(e, http.MethodPost, contentType, body)
+		if ifMatch != "" {
+			c.Request().Header.Set("If-Match", ifMatch)
+		}
+
+		return c, rec
+	}
+	newDeleteContext := func(e *echo.Echo, ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/invoices/"+invoiceID+"/attachments/"+attachmentID, nil)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "attachmentId")
+		c.SetParamValues(invoiceID, attachmentID)
+
+		return c, rec
+	}
+	multipartBody := func(field, fileName, content string) (string, io.Reader) {
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		assert.NoError(t, w.WriteField("note", "原本"))
+		part, err := w.CreateFormFile(field, fileName)
+		assert.NoError(t, err)
+		_, _ = part.Write([]byte(content))
+		assert.NoError(t, w.Close())
+
+		return w.FormDataContentType(), &buf
+	}
+
+	t.Run("請求書のバージョンを指定してfileフィールドのファイルを添付する", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockAttachmentUsecase(t)
+
+		mockUsecase.EXPECT().UploadAttachment(mock.Anything, invoiceID, "請求書.pdf", mock.Anything, 3).RunAndReturn(func(_ context.Context, _, _ string, body io.Reader, _ int) (*domainModels.InvoiceAttachment, *domainModels.Invoice, error) {
+			content, err := io.ReadAll(body)
+			assert.NoError(t, err)
+			assert.Equal(t, "%PDF-1.7", string(content))
+			return attachment, &domainModels.Invoice{ID: invoiceID, Version: 4}, nil
+		})
+
+		contentType, body := multipartBody("file", "請求書.pdf", "%PDF-1.7")
+		c, rec := newUploadContext(e, `"3"`, contentType, body)
+		serve(e, c, NewAttachmentHandler(mockUsecase).UploadAttachment)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		// 添付で請求書のバージョンが進むため、進んだ請求書の ETag を返す
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+		var response models.InvoiceAttachmentResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, attachmentID, response.ID)
+		assert.Equal(t, attachment.SHA256, response.SHA256)
+	})
+
+	t.Run("fileフィールドがない場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		contentType, body := multipartBody("document", "請求書.pdf", "%PDF-1.7")
+		c, rec := newUploadContext(e, `"3"`, contentType, body)
+		serve(e, c, NewAttachmentHandler(usecase.NewMockAttachmentUsecase(t)).UploadAttachment)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"file"`)
+	})
+
+	t.Run("multipart/form-dataでない場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newUploadContext(e, `"3"`, "application/pdf", strings.NewReader("%PDF-1.7"))
+		serve(e, c, NewAttachmentHandler(usecase.NewMockAttachmentUsecase(t)).UploadAttachment)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"INVALID_REQUEST_BODY"`)
+	})
+
+	t.Run("添付でIf-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		contentType, body := multipartBody("file", "請求書.pdf", "%PDF-1.7")
+		c, rec := newUploadContext(e, "", contentType, body)
+		serve(e, c, NewAttachmentHandler(usecase.NewMockAttachmentUsecase(t)).UploadAttachment)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("添付でIf-Matchの形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, "*", "3", `"0"`, `"abc"`} {
+			e := setupEcho()
+
+			contentType, body := multipartBody("file", "請求書.pdf", "%PDF-1.7")
+			c, rec := newUploadContext(e, ifMatch, contentType, body)
+			serve(e, c, NewAttachmentHandler(usecase.NewMockAttachmentUsecase(t)).UploadAttachment)
+
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, ifMatch)
+		}
+	})
+
+	t.Run("内容をファイル名とハッシュのヘッダー付きで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockAttachmentUsecase(t)
+
+		mockUsecase.EXPECT().DownloadAttachment(mock.Anything, invoiceID, attachmentID).Return(attachment, io.NopCloser(strings.NewReader("%PDF-1.7")), nil)
+
+		c, rec := newContext(e, http.MethodGet, "", nil)
+		serve(e, c, NewAttachmentHandler(mockUsecase).DownloadAttachment)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "8", rec.Header().Get(echo.HeaderContentLength))
+		assert.Equal(t, "attachment; filename*=utf-8''%E8%AB%8B%E6%B1%82%E6%9B%B8.pdf", rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "sha-256=:n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=:", rec.Header().Get("Repr-Digest"))
+		assert.Equal(t, "%PDF-1.7", rec.Body.String())
+	})
+
+	t.Run("請求書のバージョンを指定して添付ファイルを削除する", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockAttachmentUsecase(t)
+
+		mockUsecase.EXPECT().DeleteAttachment(mock.Anything, invoiceID, attachmentID, 3).
+			Return(&domainModels.Invoice{ID: invoiceID, Version: 4}, nil)
+
+		c, rec := newDeleteContext(e, `"3"`)
+		serve(e, c, NewAttachmentHandler(mockUsecase).DeleteAttachment)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+	})
+
+	t.Run("削除でIf-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newDeleteContext(e, "")
+		serve(e, c, NewAttachmentHandler(usecase.NewMockAttachmentUsecase(t)).DeleteAttachment)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("削除でIf-Matchの形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, "*", "3", `"0"`, `"abc"`} {
+			e := setupEcho()
+
+			c, rec := newDeleteContext(e, ifMatch)
+			serve(e, c, NewAttachmentHandler(usecase.NewMockAttachmentUsecase(t)).DeleteAttachment)
+
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, ifMatch)
+		}
+	})
+}
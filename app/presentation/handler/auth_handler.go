@@ -24,16 +24,16 @@ func (h *AuthHandler) Login(c echo.Context) error {
 
 	var req models.LoginRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid request body"))
+		return errInvalidRequestBody.Wrap(err)
 	}
 
 	if err := c.Validate(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse(err.Error()))
+		return err
 	}
 
 	token, err := h.authUsecase.Login(ctx, req.Email, req.Password)
 	if err != nil {
-		return c.JSON(http.StatusUnauthorized, models.NewErrorResponse(err.Error()))
+		return err
 	}
 
 	return c.JSON(http.StatusOK, models.LoginResponse{
@@ -8,7 +8,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/go-playground/validator"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	custommiddleware "github.com/ijufumi/practice-202512/app/presentation/middleware"
 	"github.com/ijufumi/practice-202512/app/presentation/models"
 	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
 	"github.com/labstack/echo/v4"
@@ -16,20 +17,20 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-type CustomValidator struct {
-	validator *validator.Validate
-}
-
-func (cv *CustomValidator) Validate(i interface{}) error {
-	return cv.validator.Struct(i)
-}
-
 func setupEcho() *echo.Echo {
 	e := echo.New()
-	e.Validator = &CustomValidator{validator: validator.New()}
+	e.Validator = custommiddleware.NewCustomValidator()
+	e.HTTPErrorHandler = custommiddleware.NewHTTPErrorHandler()
 	return e
 }
 
+// serve はハンドラーを実行し、エラーが返された場合はルーターと同じエラーハンドラーでレスポンスを書き込みます
+func serve(e *echo.Echo, c echo.Context, h echo.HandlerFunc) {
+	if err := h(c); err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+}
+
 func TestAuthHandler_Login(t *testing.T) {
 	t.Run("ログイン成功", func(t *testing.T) {
 		e := setupEcho()
@@ -46,13 +47,12 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
 		var response models.LoginResponse
-		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
 		assert.Equal(t, "test-jwt-token", response.Token)
 	})
@@ -69,9 +69,8 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
@@ -87,12 +86,37 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
+	t.Run("バリデーションエラー - フィールド単位のエラー", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockAuthUsecase(t)
+
+		handler := NewAuthHandler(mockUsecase)
+
+		reqBody := `{"email":"invalid-email"}`
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.Login)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var response models.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "VALIDATION_FAILED", response.Code)
+		assert.Equal(t, []models.ProblemFieldError{
+			{Field: "email", Code: "email", Message: "email must be a valid email address"},
+			{Field: "password", Code: "required", Message: "password is required"},
+		}, response.Errors)
+	})
+
 	t.Run("バリデーションエラー - 不正なEmail形式", func(t *testing.T) {
 		e := setupEcho()
 		mockUsecase := usecase.NewMockAuthUsecase(t)
@@ -105,9 +129,8 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
@@ -123,9 +146,8 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
@@ -134,7 +156,7 @@ func TestAuthHandler_Login(t *testing.T) {
 		mockUsecase := usecase.NewMockAuthUsecase(t)
 
 		mockUsecase.EXPECT().Login(mock.Anything, "test@example.com", "wrongpassword").
-			Return("", errors.New("invalid email or password"))
+			Return("", apperror.NewUnauthorized(apperror.CodeInvalidCredentials, "invalid email or password"))
 
 		handler := NewAuthHandler(mockUsecase)
 
@@ -144,15 +166,42 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, models.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
 
-		var response map[string]string
-		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		var response models.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "invalid email or password", response["error"])
+		assert.Equal(t, "INVALID_CREDENTIALS", response.Code)
+		assert.Equal(t, "Invalid email or password", response.Title)
+		assert.Equal(t, http.StatusUnauthorized, response.Status)
+	})
+
+	t.Run("認証エラー - 日本語メッセージ", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockAuthUsecase(t)
+
+		mockUsecase.EXPECT().Login(mock.Anything, "test@example.com", "wrongpassword").
+			Return("", apperror.NewUnauthorized(apperror.CodeInvalidCredentials, "invalid email or password"))
+
+		handler := NewAuthHandler(mockUsecase)
+
+		reqBody := `{"email":"test@example.com","password":"wrongpassword"}`
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Accept-Language", "ja-JP,ja;q=0.9,en;q=0.8")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.Login)
+
+		var response models.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "INVALID_CREDENTIALS", response.Code)
+		assert.Equal(t, "メールアドレスまたはパスワードが正しくありません", response.Title)
 	})
 
 	t.Run("内部サーバーエラー", func(t *testing.T) {
@@ -170,9 +219,9 @@ func TestAuthHandler_Login(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.Login(c)
+		serve(e, c, handler.Login)
 
-		assert.NoError(t, err)
-		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "internal error")
 	})
 }
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ClientHandler struct {
+	clientUsecase usecase.ClientUsecase
+}
+
+func NewClientHandler(clientUsecase usecase.ClientUsecase) *ClientHandler {
+	return &ClientHandler{
+		clientUsecase: clientUsecase,
+	}
+}
+
+func (h *ClientHandler) GetClient(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	detail, err := h.clientUsecase.GetClient(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromClientDetailDomainModel(detail)
+	setETag(c, detail.Client.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *ClientHandler) DeleteClient(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+	if err := h.clientUsecase.DeleteClient(ctx, c.Param("id"), version); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
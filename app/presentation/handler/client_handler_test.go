@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientHandler_GetClient(t *testing.T) {
+	t.Run("口座番号をマスクして返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockClientUsecase(t)
+
+		clientID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+		mockUsecase.EXPECT().GetClient(mock.Anything, clientID).Return(&domainModels.ClientDetail{
+			Client: &domainModels.Client{
+				ID:            clientID,
+				CorporateName: "Test Client",
+				PhoneNumber:   "03-0000-0000",
+				Address:       "Test Address",
+				Version:       2,
+			},
+			BankAccounts: []*domainModels.ClientBankAccount{
+				{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD", BankName: "Test Bank", AccountNumber: "1234567890", AccountName: "テスト"},
+			},
+		}, nil)
+
+		handler := NewClientHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/clients/"+clientID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(clientID)
+
+		serve(e, c, handler.GetClient)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		assert.NotContains(t, rec.Body.String(), "1234567890")
+
+		var response models.ClientResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, clientID, response.ID)
+		assert.Equal(t, "03-0000-0000", response.PhoneNumber)
+		assert.Len(t, response.BankAccounts, 1)
+		assert.Equal(t, "****7890", response.BankAccounts[0].AccountNumber)
+		assert.Equal(t, "テスト", response.BankAccounts[0].AccountName)
+	})
+
+	t.Run("取引先が見つからない場合は404", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockClientUsecase(t)
+		mockUsecase.EXPECT().GetClient(mock.Anything, "unknown").
+			Return(nil, apperror.NewNotFound(apperror.CodeNotFound, "client not found"))
+
+		handler := NewClientHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/clients/unknown", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("unknown")
+
+		serve(e, c, handler.GetClient)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		var problem models.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &problem)
+		assert.NoError(t, err)
+		assert.Equal(t, "NOT_FOUND", problem.Code)
+	})
+}
@@ -1,6 +1,30 @@
 package handler
 
+import (
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
 const (
-	DefaultOffset = 0
-	DefaultLimit  = 100
+	DefaultOffset    = 0
+	DefaultLimit     = 100
+	DefaultSortKey   = value.InvoiceSortKeyPaymentDueDate
+	DefaultSortOrder = value.SortOrderAsc
+
+	DefaultReportPeriod = value.ReportPeriodWeek
+	DefaultReportLimit  = 10
+
+	dateFormatLabel = "YYYY-MM-DD"
 )
+
+var errInvalidRequestBody = apperror.NewBadRequest(apperror.CodeInvalidRequestBody, "invalid request body")
+
+// invalidDateFormat は日付パラメータの形式エラーを生成します
+func invalidDateFormat(field string) error {
+	return apperror.NewValidation(apperror.FieldError{Field: field, Code: apperror.FieldCodeInvalidFormat, Param: dateFormatLabel})
+}
+
+// invalidParameter はパラメータの値エラーを生成します
+func invalidParameter(field string) error {
+	return apperror.NewValidation(apperror.FieldError{Field: field, Code: apperror.FieldCodeInvalidValue})
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreditNoteHandler struct {
+	creditNoteUsecase usecase.CreditNoteUsecase
+}
+
+func NewCreditNoteHandler(creditNoteUsecase usecase.CreditNoteUsecase) *CreditNoteHandler {
+	return &CreditNoteHandler{
+		creditNoteUsecase: creditNoteUsecase,
+	}
+}
+
+// IssueCreditNote は請求書に返品・値引きを発行します。ETag には減額を反映した請求書のバージョンを返します
+func (h *CreditNoteHandler) IssueCreditNote(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.IssueCreditNoteRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	// 日付のパース
+	issueDate, err := time.Parse("2006-01-02", req.IssueDate)
+	if err != nil {
+		return invalidDateFormat("issue_date")
+	}
+
+	creditNote := &domainModels.CreditNote{
+		IssueDate:     issueDate,
+		Reason:        value.CreditNoteReason(req.Reason),
+		Note:          req.Note,
+		PaymentAmount: req.PaymentAmount,
+	}
+	invoice, err := h.creditNoteUsecase.IssueCreditNote(ctx, c.Param("id"), creditNote, version)
+	if err != nil {
+		return err
+	}
+
+	response := &models.IssueCreditNoteResponse{
+		CreditNote: models.FromCreditNoteDomainModel(creditNote),
+		Invoice:    models.FromInvoiceDomainModel(invoice),
+	}
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (h *CreditNoteHandler) GetCreditNotes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	creditNotes, err := h.creditNoteUsecase.ListCreditNotes(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromCreditNoteDomainModels(creditNotes)
+
+	return c.JSON(http.StatusOK, response)
+}
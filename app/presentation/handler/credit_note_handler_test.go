@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreditNoteHandler_IssueCreditNote(t *testing.T) {
+	invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+
+	newContext := func(e *echo.Echo, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/invoices/"+invoiceID+"/credit-notes", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		return c, rec
+	}
+
+	t.Run("返品・値引きを発行して請求書の新しいバージョンをETagで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockCreditNoteUsecase(t)
+
+		mockUsecase.EXPECT().IssueCreditNote(mock.Anything, invoiceID, &domainModels.CreditNote{
+			IssueDate:     time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+			Reason:        value.CreditNoteReasonReturn,
+			Note:          "検品不良による返品",
+			PaymentAmount: decimal.NewFromInt(10000),
+		}, 2).RunAndReturn(func(_ context.Context, _ string, creditNote *domainModels.CreditNote, version int) (*domainModels.Invoice, error) {
+			creditNote.ID = "01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+			creditNote.InvoiceID = invoiceID
+			creditNote.CreditAmount = decimal.NewFromInt(10440)
+			return &domainModels.Invoice{
+				ID:             invoiceID,
+				InvoiceAmount:  decimal.NewFromInt(104400),
+				CreditedAmount: decimal.NewFromInt(10440),
+				Status:         value.InvoiceStatusProcessed,
+				Version:        version + 1,
+			}, nil
+		})
+
+		c, rec := newContext(e, `"2"`, `{"issue_date": "2025-12-15", "reason": "return", "payment_amount": 10000, "note": "検品不良による返品"}`)
+		serve(e, c, NewCreditNoteHandler(mockUsecase).IssueCreditNote)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+		var response models.IssueCreditNoteResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "01HQZXFG0PJ9K8QXW7YM1N2ZXD", response.CreditNote.ID)
+		assert.Equal(t, invoiceID, response.CreditNote.InvoiceID)
+		assert.True(t, decimal.NewFromInt(93960).Equal(response.Invoice.NetAmount))
+		assert.True(t, decimal.NewFromInt(93960).Equal(response.Invoice.OutstandingAmount))
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "", `{"issue_date": "2025-12-15", "reason": "return", "payment_amount": 10000}`)
+		serve(e, c, NewCreditNoteHandler(usecase.NewMockCreditNoteUsecase(t)).IssueCreditNote)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("入力が不正な場合は400", func(t *testing.T) {
+		for _, body := range []string{
+			`{"reason": "return", "payment_amount": 10000}`,
+			`{"issue_date": "2025-12-15", "reason": "cancel", "payment_amount": 10000}`,
+			`{"issue_date": "2025/12/15", "reason": "discount", "payment_amount": 10000}`,
+			`{"issue_date": "2025-12-15", "reason": "discount", "payment_amount": 10000, "note": "` + strings.Repeat("a", 201) + `"}`,
+		} {
+			e := setupEcho()
+
+			c, rec := newContext(e, `"2"`, body)
+			serve(e, c, NewCreditNoteHandler(usecase.NewMockCreditNoteUsecase(t)).IssueCreditNote)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+		}
+	})
+}
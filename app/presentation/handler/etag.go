@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+var (
+	errIfMatchRequired = apperror.NewPreconditionRequired(apperror.CodePreconditionRequired, "If-Match header is required")
+	errInvalidIfMatch  = apperror.NewPreconditionFailed(apperror.CodePreconditionFailed, "If-Match does not match the current ETag")
+)
+
+// formatETag はバージョンを強いエンティティタグ（例: "3"）にします
+func formatETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// setETag はレスポンスの ETag ヘッダーにバージョンを設定します
+func setETag(c echo.Context, version int) {
+	c.Response().Header().Set(headerETag, formatETag(version))
+}
+
+// ifMatchVersion は更新系のリクエストで必須の If-Match ヘッダーからバージョンを取り出します。
+// ヘッダーがない場合は428、GET で返した ETag の形式でない場合（弱いタグや * を含む）は412のエラーを返します
+func ifMatchVersion(c echo.Context) (int, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if ifMatch == "" {
+		return 0, errIfMatchRequired
+	}
+
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil || !strings.HasPrefix(ifMatch, `"`) {
+		return 0, errInvalidIfMatch
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+
+	return version, nil
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+const healthStatusOK = "ok"
+
+type HealthHandler struct {
+	healthUsecase usecase.HealthUsecase
+}
+
+func NewHealthHandler(healthUsecase usecase.HealthUsecase) *HealthHandler {
+	return &HealthHandler{
+		healthUsecase: healthUsecase,
+	}
+}
+
+// Healthz はプロセスが応答可能かを返します（liveness）
+func (h *HealthHandler) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, models.HealthResponse{
+		Status: healthStatusOK,
+	})
+}
+
+// Readyz はリクエストを受け付けられる状態かを返します（readiness）
+func (h *HealthHandler) Readyz(c echo.Context) error {
+	if err := h.healthUsecase.CheckReadiness(c.Request().Context()); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.HealthResponse{
+		Status: healthStatusOK,
+	})
+}
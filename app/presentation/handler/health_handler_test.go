@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHealthHandler_Healthz(t *testing.T) {
+	t.Run("常にOKを返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockHealthUsecase(t)
+
+		handler := NewHealthHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.Healthz)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response models.HealthResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", response.Status)
+	})
+}
+
+func TestHealthHandler_Readyz(t *testing.T) {
+	t.Run("準備完了", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockHealthUsecase(t)
+		mockUsecase.EXPECT().CheckReadiness(mock.Anything).Return(nil)
+
+		handler := NewHealthHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.Readyz)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("準備未完了の場合は503", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockHealthUsecase(t)
+		mockUsecase.EXPECT().CheckReadiness(mock.Anything).
+			Return(apperror.NewUnavailable(apperror.CodeServiceUnavailable, "service is not ready").Wrap(errors.New("connection refused")))
+
+		handler := NewHealthHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.Readyz)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+		var problem models.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &problem)
+		assert.NoError(t, err)
+		assert.Equal(t, "SERVICE_UNAVAILABLE", problem.Code)
+	})
+}
@@ -4,10 +4,15 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/presentation/models"
 	"github.com/ijufumi/practice-202512/app/usecase"
+	"github.com/shopspring/decimal"
 
 	"github.com/labstack/echo/v4"
 )
@@ -22,35 +27,94 @@ func NewInvoiceHandler(invoiceUsecase usecase.InvoiceUsecase) *InvoiceHandler {
 	}
 }
 
+func (h *InvoiceHandler) GetInvoice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	invoice, err := h.invoiceUsecase.GetInvoice(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromInvoiceDomainModel(invoice)
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *InvoiceHandler) GetInvoiceByNumber(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	invoice, err := h.invoiceUsecase.GetInvoiceByNumber(ctx, c.Param("number"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromInvoiceDomainModel(invoice)
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *InvoiceHandler) DeleteInvoice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+	if err := h.invoiceUsecase.DeleteInvoice(ctx, c.Param("id"), version); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 func (h *InvoiceHandler) CreateInvoice(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	var req models.CreateInvoiceRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid request body"))
+		return errInvalidRequestBody.Wrap(err)
 	}
 
 	if err := c.Validate(req); err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse(err.Error()))
+		return err
 	}
 
 	// 日付のパース
 	issueDate, err := time.Parse("2006-01-02", req.IssueDate)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid issue_date format. Use YYYY-MM-DD"))
+		return invalidDateFormat("issue_date")
 	}
 
 	paymentDueDate, err := time.Parse("2006-01-02", req.PaymentDueDate)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid payment_due_date format. Use YYYY-MM-DD"))
+		return invalidDateFormat("payment_due_date")
+	}
+
+	var lines []*domainModels.InvoiceLine
+	for _, line := range req.Lines {
+		lines = append(lines, &domainModels.InvoiceLine{
+			Description: line.Description,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice,
+			TaxCategory: value.TaxCategory(line.TaxCategory),
+		})
+	}
+
+	// 通貨を省略した場合は円建てにする
+	currency := value.CurrencyJPY
+	if req.Currency != "" {
+		currency = value.Currency(req.Currency)
 	}
 
-	invoice, err := h.invoiceUsecase.CreateInvoice(ctx, req.ClientID, issueDate, req.PaymentAmount, paymentDueDate)
+	invoice, err := h.invoiceUsecase.CreateInvoice(ctx, req.ClientID, issueDate, value.NewMoney(req.PaymentAmount, currency), paymentDueDate, lines, req.Force)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, models.NewErrorResponse("Failed to create invoice"))
+		return err
 	}
 
 	response := models.FromInvoiceDomainModel(invoice)
+	setETag(c, invoice.Version)
 
 	return c.JSON(http.StatusCreated, response)
 }
@@ -61,32 +125,89 @@ func (h *InvoiceHandler) GetInvoices(c echo.Context) error {
 	// クエリパラメータのパース
 	startDate, err := parseOptionalDate(c.QueryParam("start_date"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid start_date format. Use YYYY-MM-DD"))
+		return invalidDateFormat("start_date")
 	}
 
 	endDate, err := parseOptionalDate(c.QueryParam("end_date"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid end_date format. Use YYYY-MM-DD"))
+		return invalidDateFormat("end_date")
+	}
+
+	issueDateFrom, err := parseOptionalDate(c.QueryParam("issue_date_from"))
+	if err != nil {
+		return invalidDateFormat("issue_date_from")
+	}
+
+	issueDateTo, err := parseOptionalDate(c.QueryParam("issue_date_to"))
+	if err != nil {
+		return invalidDateFormat("issue_date_to")
+	}
+
+	minAmount, err := parseOptionalDecimal(c.QueryParam("min_amount"))
+	if err != nil {
+		return invalidParameter("min_amount")
+	}
+
+	maxAmount, err := parseOptionalDecimal(c.QueryParam("max_amount"))
+	if err != nil {
+		return invalidParameter("max_amount")
+	}
+
+	statuses, err := parseStatuses(c.QueryParam("status"))
+	if err != nil {
+		return invalidParameter("status")
+	}
+
+	sortKey, err := parseSortKey(c.QueryParam("sort"))
+	if err != nil {
+		return invalidParameter("sort")
+	}
+
+	sortOrder, err := parseSortOrder(c.QueryParam("order"))
+	if err != nil {
+		return invalidParameter("order")
 	}
 
 	offset, err := parseOffset(c.QueryParam("offset"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid offset parameter"))
+		return invalidParameter("offset")
 	}
 
 	limit, err := parseLimit(c.QueryParam("limit"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, models.NewErrorResponse("Invalid limit parameter"))
+		return invalidParameter("limit")
+	}
+
+	cursor, err := parseCursor(c.QueryParam("cursor"), sortKey, sortOrder)
+	if err != nil {
+		return invalidParameter("cursor")
+	}
+	if cursor != nil && offset != DefaultOffset {
+		return apperror.NewValidation(apperror.FieldError{Field: "cursor", Code: apperror.FieldCodeExclusive, Param: "offset"})
 	}
 
-	invoices, err := h.invoiceUsecase.GetInvoicesByPaymentDueDateRange(ctx, startDate, endDate, offset, limit)
+	page, err := h.invoiceUsecase.SearchInvoices(ctx, &domainModels.InvoiceSearchCondition{
+		ClientID:           c.QueryParam("client_id"),
+		Statuses:           statuses,
+		PaymentDueDateFrom: startDate,
+		PaymentDueDateTo:   endDate,
+		IssueDateFrom:      issueDateFrom,
+		IssueDateTo:        issueDateTo,
+		MinPaymentAmount:   minAmount,
+		MaxPaymentAmount:   maxAmount,
+		SortKey:            sortKey,
+		SortOrder:          sortOrder,
+		Cursor:             cursor,
+		Offset:             offset,
+		Limit:              limit,
+	})
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, models.NewErrorResponse("Failed to get invoices"))
+		return err
 	}
 
-	responses := models.FromInvoiceDomainModels(invoices)
+	response := models.FromInvoicePageDomainModel(page)
 
-	return c.JSON(http.StatusOK, responses)
+	return c.JSON(http.StatusOK, response)
 }
 
 // parseOptionalDate はオプショナルな日付文字列をパースします
@@ -111,8 +232,8 @@ func parseOffset(offsetStr string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	if offset <= 0 {
-		return 0, errors.New("invalid limit parameter")
+	if offset < 0 {
+		return 0, errors.New("invalid offset parameter")
 	}
 
 	return offset, nil
@@ -133,3 +254,144 @@ func parseLimit(limitStr string) (int, error) {
 
 	return limit, nil
 }
+
+// parseOptionalDecimal はオプショナルな金額文字列をパースします
+func parseOptionalDecimal(decimalStr string) (*decimal.Decimal, error) {
+	if decimalStr == "" {
+		return nil, nil
+	}
+	parsed, err := decimal.NewFromString(decimalStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+// parseStatuses はカンマ区切りのステータス文字列をパースします
+func parseStatuses(statusStr string) ([]value.InvoiceStatus, error) {
+	if statusStr == "" {
+		return nil, nil
+	}
+	var statuses []value.InvoiceStatus
+	for _, s := range strings.Split(statusStr, ",") {
+		status := value.InvoiceStatus(strings.TrimSpace(s))
+		if !status.IsValid() {
+			return nil, errors.New("invalid status parameter")
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// parseSortKey はソートキーをパースします（デフォルト: payment_due_date）
+func parseSortKey(sortStr string) (value.InvoiceSortKey, error) {
+	if sortStr == "" {
+		return DefaultSortKey, nil
+	}
+	sortKey := value.InvoiceSortKey(sortStr)
+	if !sortKey.IsValid() {
+		return "", errors.New("invalid sort parameter")
+	}
+
+	return sortKey, nil
+}
+
+// parseSortOrder はソート順をパースします（デフォルト: asc）
+func parseSortOrder(orderStr string) (value.SortOrder, error) {
+	if orderStr == "" {
+		return DefaultSortOrder, nil
+	}
+	sortOrder := value.SortOrder(strings.ToLower(orderStr))
+	if !sortOrder.IsValid() {
+		return "", errors.New("invalid order parameter")
+	}
+
+	return sortOrder, nil
+}
+
+// parseCursor はカーソル文字列をパースし、ソート条件と一致するか検証します
+func parseCursor(cursorStr string, sortKey value.InvoiceSortKey, sortOrder value.SortOrder) (*value.InvoiceCursor, error) {
+	if cursorStr == "" {
+		return nil, nil
+	}
+	cursor, err := value.DecodeInvoiceCursor(cursorStr)
+	if err != nil {
+		return nil, err
+	}
+	if cursor.SortKey != sortKey || cursor.SortOrder != sortOrder {
+		return nil, value.ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+func (h *InvoiceHandler) UpdateRetentionClass(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.UpdateRetentionClassRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	invoice, err := h.invoiceUsecase.UpdateRetentionClass(ctx, c.Param("id"), value.RetentionClass(req.RetentionClass), version)
+	if err != nil {
+		return err
+	}
+
+	response := models.FromInvoiceDomainModel(invoice)
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *InvoiceHandler) GetNumbering(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	numbering, err := h.invoiceUsecase.GetNumbering(ctx)
+	if err != nil {
+		return err
+	}
+
+	setETag(c, numbering.Version)
+
+	return c.JSON(http.StatusOK, models.FromInvoiceNumbering(numbering))
+}
+
+// UpdateNumbering は請求書番号の振り方を変更します。If-Match には取得時の ETag（振り方のバージョン）が必要です
+func (h *InvoiceHandler) UpdateNumbering(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.InvoiceNumberingRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	numbering, err := h.invoiceUsecase.UpdateNumbering(ctx, req.ToDomainModel(), version)
+	if err != nil {
+		return err
+	}
+
+	setETag(c, numbering.Version)
+
+	return c.JSON(http.StatusOK, models.FromInvoiceNumbering(numbering))
+}
@@ -1,14 +1,17 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/domain/value"
 	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
@@ -48,8 +51,10 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 			mock.Anything,
 			clientID,
 			issueDate,
-			paymentAmount,
+			value.NewMoney(paymentAmount, value.CurrencyJPY),
 			paymentDueDate,
+			[]*models.InvoiceLine(nil),
+			false,
 		).Return(expectedInvoice, nil)
 
 		handler := NewInvoiceHandler(mockUsecase)
@@ -65,13 +70,12 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
 
 		var response map[string]interface{}
-		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedInvoice.ID, response["id"])
 		assert.Equal(t, expectedInvoice.PaymentAmount.String(), response["payment_amount"])
@@ -90,9 +94,8 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
@@ -110,9 +113,8 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
@@ -133,9 +135,8 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
@@ -156,9 +157,8 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid issue_date format")
 	})
@@ -180,13 +180,220 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid payment_due_date format")
 	})
 
+	t.Run("明細を指定して作成し、明細と税区分ごとの消費税を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		mockUsecase.EXPECT().CreateInvoice(
+			mock.Anything,
+			"01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			value.NewMoney(decimal.Decimal{}, value.CurrencyJPY),
+			time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			mock.MatchedBy(func(lines []*models.InvoiceLine) bool {
+				return len(lines) == 2 &&
+					lines[0].Description == "保守作業" && lines[0].Quantity.Equal(decimal.RequireFromString("1.5")) &&
+					lines[0].UnitPrice.Equal(decimal.NewFromInt(8000)) && lines[0].TaxCategory == value.TaxCategoryStandard &&
+					lines[1].TaxCategory == value.TaxCategoryReduced
+			}),
+			false,
+		).RunAndReturn(func(_ context.Context, _ string, _ time.Time, _ value.Money, _ time.Time, lines []*models.InvoiceLine, _ bool) (*models.Invoice, error) {
+			invoice := &models.Invoice{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD", Status: value.InvoiceStatusUnprocessed, Version: 1}
+			invoice.ApplyLines(lines)
+			return invoice, nil
+		})
+
+		reqBody := `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"issue_date": "2025-01-06",
+			"payment_due_date": "2025-01-31",
+			"lines": [
+				{"description": "保守作業", "quantity": "1.5", "unit_price": 8000, "tax_category": "standard"},
+				{"description": "お茶", "quantity": 24, "unit_price": 150, "tax_category": "reduced"}
+			]
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/invoices", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, NewInvoiceHandler(mockUsecase).CreateInvoice)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response struct {
+			PaymentAmount string                   `json:"payment_amount"`
+			Lines         []map[string]interface{} `json:"lines"`
+			TaxBreakdown  []map[string]interface{} `json:"tax_breakdown"`
+		}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		// 12000 + 1200 + 3600 + 288
+		assert.Equal(t, "17088", response.PaymentAmount)
+		assert.Len(t, response.Lines, 2)
+		assert.Equal(t, float64(2), response.Lines[1]["line_no"])
+		assert.Equal(t, "12000", response.Lines[0]["amount"])
+		assert.Len(t, response.TaxBreakdown, 2)
+		assert.Equal(t, "reduced", response.TaxBreakdown[1]["tax_category"])
+		assert.Equal(t, "288", response.TaxBreakdown[1]["tax"])
+	})
+
+	t.Run("通貨を指定した場合はその通貨の支払金額で作成し、円換算額を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		mockUsecase.EXPECT().CreateInvoice(
+			mock.Anything,
+			"01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			mock.MatchedBy(func(paymentAmount value.Money) bool {
+				return paymentAmount.Currency == value.CurrencyUSD && paymentAmount.Amount.Equal(decimal.RequireFromString("1200.50"))
+			}),
+			time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			[]*models.InvoiceLine(nil),
+			false,
+		).Return(&models.Invoice{
+			ID:               "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
+			Currency:         value.CurrencyUSD,
+			PaymentAmount:    decimal.RequireFromString("1200.50"),
+			ExchangeRate:     decimal.RequireFromString("150.25"),
+			PaymentAmountJPY: decimal.NewFromInt(180375),
+			Status:           value.InvoiceStatusUnprocessed,
+			Version:          1,
+		}, nil)
+
+		reqBody := `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"issue_date": "2025-01-06",
+			"currency": "USD",
+			"payment_amount": "1200.50",
+			"payment_due_date": "2025-01-31"
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/invoices", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, NewInvoiceHandler(mockUsecase).CreateInvoice)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response struct {
+			Currency         string `json:"currency"`
+			ExchangeRate     string `json:"exchange_rate"`
+			PaymentAmountJPY string `json:"payment_amount_jpy"`
+		}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", response.Currency)
+		assert.Equal(t, "150.25", response.ExchangeRate)
+		assert.Equal(t, "180375", response.PaymentAmountJPY)
+	})
+
+	t.Run("forceを指定した場合は重複の疑いがあっても作成し、確認の結果を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		suspectedID := "01HQZXFG0PJ9K8QXW7YM1N2ZXE"
+		mockUsecase.EXPECT().CreateInvoice(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
+			Return(&models.Invoice{
+				ID:                   "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
+				Status:               value.InvoiceStatusUnprocessed,
+				DuplicateCheck:       value.DuplicateCheckOverridden,
+				SuspectedDuplicateID: &suspectedID,
+				Version:              1,
+			}, nil)
+
+		reqBody := `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"issue_date": "2025-01-06",
+			"payment_amount": 100000,
+			"payment_due_date": "2025-01-31",
+			"force": true
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/invoices", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		serve(e, e.NewContext(req, rec), NewInvoiceHandler(mockUsecase).CreateInvoice)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"duplicate_check":"overridden","suspected_duplicate_id":"01HQZXFG0PJ9K8QXW7YM1N2ZXE"`)
+	})
+
+	t.Run("重複の疑いのある請求書がある場合は409で疑いのある請求書を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		duplicateErr := apperror.NewConflict(apperror.CodeDuplicateInvoiceSuspected, "invoice may be a duplicate")
+		duplicateErr.Fields = []apperror.FieldError{{Field: "force", Code: apperror.FieldCodeDuplicate, Param: "01HQZXFG0PJ9K8QXW7YM1N2ZXE"}}
+		mockUsecase.EXPECT().CreateInvoice(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, false).
+			Return(nil, duplicateErr)
+
+		reqBody := `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"issue_date": "2025-01-06",
+			"payment_amount": 100000,
+			"payment_due_date": "2025-01-31"
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/invoices", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		serve(e, e.NewContext(req, rec), NewInvoiceHandler(mockUsecase).CreateInvoice)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"DUPLICATE_INVOICE_SUSPECTED"`)
+		assert.Contains(t, rec.Body.String(), `"errors":[{"field":"force","code":"duplicate","message":"May duplicate invoice 01HQZXFG0PJ9K8QXW7YM1N2ZXE. Set force to true to create it anyway"}]`)
+	})
+
+	t.Run("取り扱いのない通貨の場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		reqBody := `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"issue_date": "2025-01-06",
+			"currency": "GBP",
+			"payment_amount": 1000,
+			"payment_due_date": "2025-01-31"
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/invoices", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).CreateInvoice)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"currency"`)
+	})
+
+	t.Run("明細の税区分が不正な場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		reqBody := `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"issue_date": "2025-01-06",
+			"payment_due_date": "2025-01-31",
+			"lines": [{"description": "保守作業", "quantity": 1, "unit_price": 8000, "tax_category": "luxury"}]
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/invoices", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).CreateInvoice)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"tax_category"`)
+	})
+
 	t.Run("Usecaseエラー", func(t *testing.T) {
 		e := setupEcho()
 		mockUsecase := usecase.NewMockInvoiceUsecase(t)
@@ -197,6 +404,8 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 			mock.Anything,
 			mock.Anything,
 			mock.Anything,
+			mock.Anything,
+			mock.Anything,
 		).Return(nil, errors.New("database error"))
 
 		handler := NewInvoiceHandler(mockUsecase)
@@ -212,9 +421,8 @@ func TestInvoiceHandler_CreateInvoice(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.CreateInvoice(c)
+		serve(e, c, handler.CreateInvoice)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	})
 }
@@ -242,13 +450,17 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 			},
 		}
 
-		mockUsecase.EXPECT().GetInvoicesByPaymentDueDateRange(
+		mockUsecase.EXPECT().SearchInvoices(
 			mock.Anything,
-			&startDate,
-			&endDate,
-			0,
-			100,
-		).Return(expectedInvoices, nil)
+			&models.InvoiceSearchCondition{
+				PaymentDueDateFrom: &startDate,
+				PaymentDueDateTo:   &endDate,
+				SortKey:            value.InvoiceSortKeyPaymentDueDate,
+				SortOrder:          value.SortOrderAsc,
+				Offset:             0,
+				Limit:              100,
+			},
+		).Return(&models.InvoicePage{Invoices: expectedInvoices, TotalCount: 2}, nil)
 
 		handler := NewInvoiceHandler(mockUsecase)
 
@@ -256,16 +468,18 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var response []map[string]interface{}
-		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Len(t, response, 2)
-		assert.Equal(t, expectedInvoices[0].ID, response[0]["id"])
+		items := response["items"].([]interface{})
+		assert.Len(t, items, 2)
+		assert.Equal(t, expectedInvoices[0].ID, items[0].(map[string]interface{})["id"])
+		assert.Equal(t, float64(2), response["total_count"])
+		assert.Nil(t, response["next_cursor"])
 	})
 
 	t.Run("請求書一覧取得成功 - 日付範囲指定なし", func(t *testing.T) {
@@ -277,14 +491,15 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXE"},
 		}
 
-		var nilTime *time.Time
-		mockUsecase.EXPECT().GetInvoicesByPaymentDueDateRange(
+		mockUsecase.EXPECT().SearchInvoices(
 			mock.Anything,
-			nilTime,
-			nilTime,
-			0,
-			100,
-		).Return(expectedInvoices, nil)
+			&models.InvoiceSearchCondition{
+				SortKey:   value.InvoiceSortKeyPaymentDueDate,
+				SortOrder: value.SortOrderAsc,
+				Offset:    0,
+				Limit:     100,
+			},
+		).Return(&models.InvoicePage{Invoices: expectedInvoices, TotalCount: 2}, nil)
 
 		handler := NewInvoiceHandler(mockUsecase)
 
@@ -292,15 +507,104 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
+		assert.Len(t, response["items"], 2)
+	})
+
+	t.Run("絞り込み条件とソート条件の指定", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		issueDateFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		issueDateTo := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+		minAmount := decimal.NewFromInt(1000)
+		maxAmount := decimal.NewFromInt(50000)
+
+		mockUsecase.EXPECT().SearchInvoices(
+			mock.Anything,
+			&models.InvoiceSearchCondition{
+				ClientID:         "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+				Statuses:         []value.InvoiceStatus{value.InvoiceStatusUnprocessed, value.InvoiceStatusError},
+				IssueDateFrom:    &issueDateFrom,
+				IssueDateTo:      &issueDateTo,
+				MinPaymentAmount: &minAmount,
+				MaxPaymentAmount: &maxAmount,
+				SortKey:          value.InvoiceSortKeyIssueDate,
+				SortOrder:        value.SortOrderDesc,
+				Offset:           0,
+				Limit:            100,
+			},
+		).Return(&models.InvoicePage{}, nil)
+
+		handler := NewInvoiceHandler(mockUsecase)
+
+		query := url.Values{}
+		query.Set("client_id", "01HQZXFG0PJ9K8QXW7YM1N2ZXC")
+		query.Set("status", "未処理,エラー")
+		query.Set("issue_date_from", "2025-01-01")
+		query.Set("issue_date_to", "2025-01-31")
+		query.Set("min_amount", "1000")
+		query.Set("max_amount", "50000")
+		query.Set("sort", "issue_date")
+		query.Set("order", "desc")
+		req := httptest.NewRequest(http.MethodGet, "/invoices?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.GetInvoices)
+
 		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"items":[],"next_cursor":null,"total_count":0}`, rec.Body.String())
+	})
 
-		var response []map[string]interface{}
-		err = json.Unmarshal(rec.Body.Bytes(), &response)
+	t.Run("カーソルの指定と次ページのカーソル返却", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		cursor := &value.InvoiceCursor{
+			SortKey:   value.InvoiceSortKeyPaymentDueDate,
+			SortOrder: value.SortOrderAsc,
+			Value:     "2025-02-01T00:00:00Z",
+			ID:        "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
+		}
+		nextCursor := &value.InvoiceCursor{
+			SortKey:   value.InvoiceSortKeyPaymentDueDate,
+			SortOrder: value.SortOrderAsc,
+			Value:     "2025-03-01T00:00:00Z",
+			ID:        "01HQZXFG0PJ9K8QXW7YM1N2ZXE",
+		}
+
+		mockUsecase.EXPECT().SearchInvoices(
+			mock.Anything,
+			mock.MatchedBy(func(cond *models.InvoiceSearchCondition) bool {
+				return assert.ObjectsAreEqual(cursor, cond.Cursor) && cond.Limit == 1
+			}),
+		).Return(&models.InvoicePage{
+			Invoices:   []*models.Invoice{{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXE"}},
+			NextCursor: nextCursor,
+			TotalCount: 3,
+		}, nil)
+
+		handler := NewInvoiceHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/invoices?limit=1&cursor="+cursor.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.GetInvoices)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Len(t, response, 2)
+		assert.Equal(t, nextCursor.Encode(), response["next_cursor"])
 	})
 
 	t.Run("不正な日付フォーマット - start_date", func(t *testing.T) {
@@ -313,9 +617,8 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid start_date format")
 	})
@@ -330,9 +633,8 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid end_date format")
 	})
@@ -341,10 +643,7 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		e := setupEcho()
 		mockUsecase := usecase.NewMockInvoiceUsecase(t)
 
-		mockUsecase.EXPECT().GetInvoicesByPaymentDueDateRange(
-			mock.Anything,
-			mock.Anything,
-			mock.Anything,
+		mockUsecase.EXPECT().SearchInvoices(
 			mock.Anything,
 			mock.Anything,
 		).Return(nil, errors.New("database error"))
@@ -355,9 +654,8 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
 	})
 
@@ -365,18 +663,16 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		e := setupEcho()
 		mockUsecase := usecase.NewMockInvoiceUsecase(t)
 
-		var nilTime *time.Time
 		expectedInvoices := []*models.Invoice{
 			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD"},
 		}
 
-		mockUsecase.EXPECT().GetInvoicesByPaymentDueDateRange(
+		mockUsecase.EXPECT().SearchInvoices(
 			mock.Anything,
-			nilTime,
-			nilTime,
-			10,
-			20,
-		).Return(expectedInvoices, nil)
+			mock.MatchedBy(func(cond *models.InvoiceSearchCondition) bool {
+				return cond.Offset == 10 && cond.Limit == 20
+			}),
+		).Return(&models.InvoicePage{Invoices: expectedInvoices, TotalCount: 11}, nil)
 
 		handler := NewInvoiceHandler(mockUsecase)
 
@@ -384,9 +680,30 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("offset=0を指定", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		mockUsecase.EXPECT().SearchInvoices(
+			mock.Anything,
+			mock.MatchedBy(func(cond *models.InvoiceSearchCondition) bool {
+				return cond.Offset == 0
+			}),
+		).Return(&models.InvoicePage{}, nil)
+
+		handler := NewInvoiceHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/invoices?offset=0", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
 
@@ -400,9 +717,8 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid offset parameter")
 	})
@@ -417,9 +733,8 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid limit parameter")
 	})
@@ -434,9 +749,8 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid offset parameter")
 	})
@@ -451,10 +765,284 @@ func TestInvoiceHandler_GetInvoices(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		err := handler.GetInvoices(c)
+		serve(e, c, handler.GetInvoices)
 
-		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "Invalid limit parameter")
 	})
+
+	t.Run("不正なクエリパラメータ", func(t *testing.T) {
+		cursor := (&value.InvoiceCursor{
+			SortKey:   value.InvoiceSortKeyIssueDate,
+			SortOrder: value.SortOrderAsc,
+			Value:     "2025-02-01T00:00:00Z",
+			ID:        "01HQZXFG0PJ9K8QXW7YM1N2ZXD",
+		}).Encode()
+
+		tests := []struct {
+			query   string
+			message string
+		}{
+			{"status=unknown", "Invalid status parameter"},
+			{"sort=client_id", "Invalid sort parameter"},
+			{"order=up", "Invalid order parameter"},
+			{"min_amount=abc", "Invalid min_amount parameter"},
+			{"issue_date_from=2025/01/01", "Invalid issue_date_from format"},
+			{"cursor=invalid", "Invalid cursor parameter"},
+			// カーソル生成時とソート条件が異なる
+			{"cursor=" + cursor, "Invalid cursor parameter"},
+			{"sort=issue_date&offset=10&cursor=" + cursor, "cursor and offset cannot be used together"},
+		}
+		for _, tt := range tests {
+			e := setupEcho()
+			mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+			handler := NewInvoiceHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/invoices?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			serve(e, c, handler.GetInvoices)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, tt.query)
+			assert.Contains(t, rec.Body.String(), tt.message, tt.query)
+		}
+	})
+}
+
+func TestInvoiceHandler_GetInvoice(t *testing.T) {
+	t.Run("バージョンをETagで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+		mockUsecase.EXPECT().GetInvoice(mock.Anything, invoiceID).Return(&models.Invoice{
+			ID:      invoiceID,
+			Status:  value.InvoiceStatusUnprocessed,
+			Version: 3,
+		}, nil)
+
+		handler := NewInvoiceHandler(mockUsecase)
+
+		req := httptest.NewRequest(http.MethodGet, "/invoices/"+invoiceID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		serve(e, c, handler.GetInvoice)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+	})
+}
+
+func TestInvoiceHandler_GetInvoiceByNumber(t *testing.T) {
+	t.Run("請求書番号で探した請求書をバージョンのETagとともに返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		invoiceNumber := "INV-2025-000123"
+		mockUsecase.EXPECT().GetInvoiceByNumber(mock.Anything, invoiceNumber).Return(&models.Invoice{
+			ID:            "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			InvoiceNumber: &invoiceNumber,
+			Status:        value.InvoiceStatusUnprocessed,
+			Version:       2,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/invoices/by-number/"+invoiceNumber, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("number")
+		c.SetParamValues(invoiceNumber)
+
+		serve(e, c, NewInvoiceHandler(mockUsecase).GetInvoiceByNumber)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		var response map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, invoiceNumber, response["invoice_number"])
+	})
+}
+
+func TestInvoiceHandler_GetNumbering(t *testing.T) {
+	t.Run("請求書番号の振り方のバージョンをETagで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+
+		mockUsecase.EXPECT().GetNumbering(mock.Anything).
+			Return(models.InvoiceNumbering{Format: "INV-{YYYY}-{SEQ:6}", FiscalYearStartMonth: 4, Version: 2}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/invoice-numbering", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewInvoiceHandler(mockUsecase).GetNumbering)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		assert.JSONEq(t, `{"format": "INV-{YYYY}-{SEQ:6}", "fiscal_year_start_month": 4, "version": 2}`, rec.Body.String())
+	})
+}
+
+func TestInvoiceHandler_UpdateNumbering(t *testing.T) {
+	newContext := func(e *echo.Echo, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/invoice-numbering", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("書式と会計年度の始まる月を渡して変更後の設定を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+		numbering := models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1}
+
+		mockUsecase.EXPECT().UpdateNumbering(mock.Anything, numbering, 2).
+			Return(models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1, Version: 3}, nil)
+
+		c, rec := newContext(e, `"2"`, `{"format": "B{YY}-{SEQ:4}", "fiscal_year_start_month": 1}`)
+		serve(e, c, NewInvoiceHandler(mockUsecase).UpdateNumbering)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		assert.JSONEq(t, `{"format": "B{YY}-{SEQ:4}", "fiscal_year_start_month": 1, "version": 3}`, rec.Body.String())
+	})
+
+	t.Run("会計年度の始まる月が範囲外の場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, `"1"`, `{"format": "INV-{YYYY}-{SEQ:6}", "fiscal_year_start_month": 13}`)
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).UpdateNumbering)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "", `{"format": "INV-{YYYY}-{SEQ:6}", "fiscal_year_start_month": 4}`)
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).UpdateNumbering)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("If-Matchの形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, "*", "3", `"0"`, `"abc"`} {
+			e := setupEcho()
+
+			c, rec := newContext(e, ifMatch, `{"format": "INV-{YYYY}-{SEQ:6}", "fiscal_year_start_month": 4}`)
+			serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).UpdateNumbering)
+
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, ifMatch)
+		}
+	})
+}
+
+func TestInvoiceHandler_DeleteInvoice(t *testing.T) {
+	invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+
+	newContext := func(e *echo.Echo, ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/invoices/"+invoiceID, nil)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		return c, rec
+	}
+
+	t.Run("If-Matchのバージョンを指定して削除", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+		mockUsecase.EXPECT().DeleteInvoice(mock.Anything, invoiceID, 3).Return(nil)
+
+		c, rec := newContext(e, `"3"`)
+		serve(e, c, NewInvoiceHandler(mockUsecase).DeleteInvoice)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "")
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).DeleteInvoice)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("If-Matchの形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, "*", "3", `"0"`, `"abc"`} {
+			e := setupEcho()
+
+			c, rec := newContext(e, ifMatch)
+			serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).DeleteInvoice)
+
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, ifMatch)
+		}
+	})
+}
+
+func TestInvoiceHandler_UpdateRetentionClass(t *testing.T) {
+	invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+
+	newContext := func(e *echo.Echo, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/invoices/"+invoiceID+"/retention-class", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		return c, rec
+	}
+
+	t.Run("If-Matchのバージョンを指定して変更し、新しいETagを返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockInvoiceUsecase(t)
+		mockUsecase.EXPECT().UpdateRetentionClass(mock.Anything, invoiceID, value.RetentionClassExtended, 3).Return(&models.Invoice{
+			ID:             invoiceID,
+			Status:         value.InvoiceStatusUnprocessed,
+			RetentionClass: value.RetentionClassExtended,
+			Version:        4,
+		}, nil)
+
+		c, rec := newContext(e, `"3"`, `{"retention_class": "extended"}`)
+		serve(e, c, NewInvoiceHandler(mockUsecase).UpdateRetentionClass)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+		var response map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "extended", response["retention_class"])
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "", `{"retention_class": "extended"}`)
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).UpdateRetentionClass)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("不明な区分は400", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, `"3"`, `{"retention_class": "permanent"}`)
+		serve(e, c, NewInvoiceHandler(usecase.NewMockInvoiceUsecase(t)).UpdateRetentionClass)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
 }
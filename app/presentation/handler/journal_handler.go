@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/journal"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type JournalHandler struct {
+	journalUsecase usecase.JournalUsecase
+}
+
+func NewJournalHandler(journalUsecase usecase.JournalUsecase) *JournalHandler {
+	return &JournalHandler{
+		journalUsecase: journalUsecase,
+	}
+}
+
+// ExportJournal は期間の仕訳を format で指定した会計ソフトのインポート形式の CSV で返します
+func (h *JournalHandler) ExportJournal(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	condition, err := parseReportCondition(c)
+	if err != nil {
+		return err
+	}
+	format := value.JournalFormat(c.QueryParam("format"))
+
+	reportRange, entries, err := h.journalUsecase.ExportJournal(ctx, format, condition)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := journal.NewExporter(format)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := exporter.Write(&buf, entries); err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("journal_%s_%s_%s.csv", format, reportRange.From.Format(time.DateOnly), reportRange.LastDate().Format(time.DateOnly))
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	return c.Blob(http.StatusOK, exporter.ContentType(), buf.Bytes())
+}
+
+func (h *JournalHandler) GetAccounts(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	accounts, version, err := h.journalUsecase.GetAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	setETag(c, version)
+
+	return c.JSON(http.StatusOK, models.FromJournalAccounts(accounts, version))
+}
+
+// UpdateAccounts は勘定科目の名前を置き換えます。If-Match には取得時の ETag（設定のバージョン）が必要です
+func (h *JournalHandler) UpdateAccounts(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.JournalAccountsRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	accounts, updated, err := h.journalUsecase.UpdateAccounts(ctx, req.ToDomainModel(), version)
+	if err != nil {
+		return err
+	}
+
+	setETag(c, updated)
+
+	return c.JSON(http.StatusOK, models.FromJournalAccounts(accounts, updated))
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJournalHandler(t *testing.T) {
+	december := domainModels.ReportRange{
+		From: time.Date(2025, 12, 1, 0, 0, 0, 0, domainModels.ReportLocation),
+		To:   time.Date(2026, 1, 1, 0, 0, 0, 0, domainModels.ReportLocation),
+	}
+	entries := []*domainModels.JournalEntry{{
+		No:          1,
+		Date:        time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+		Description: "Test Client 支払",
+		Lines: []*domainModels.JournalLine{{
+			Debit:  &domainModels.JournalPosting{Account: "未払金", TaxCategory: value.JournalTaxNone, Amount: decimal.NewFromInt(10440)},
+			Credit: &domainModels.JournalPosting{Account: "普通預金", TaxCategory: value.JournalTaxNone, Amount: decimal.NewFromInt(10440)},
+		}},
+	}}
+
+	t.Run("指定した形式の CSV をファイル名を付けて返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockJournalUsecase(t)
+
+		from := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().ExportJournal(mock.Anything, value.JournalFormatFreee, &domainModels.ReportCondition{From: &from}).
+			Return(december, entries, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/journals?format=freee&from=2025-12-01", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewJournalHandler(mockUsecase).ExportJournal)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=UTF-8", rec.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="journal_freee_2025-12-01_2025-12-31.csv"`, rec.Header().Get("Content-Disposition"))
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.Equal(t, "1,2025/12/26,未払金,対象外,10440,0,普通預金,対象外,10440,0,Test Client 支払", lines[1])
+	})
+
+	t.Run("形式が不正な場合は400", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockJournalUsecase(t)
+
+		mockUsecase.EXPECT().ExportJournal(mock.Anything, value.JournalFormat("kaikei"), &domainModels.ReportCondition{}).
+			Return(domainModels.ReportRange{}, nil, apperror.NewValidation(apperror.FieldError{Field: "format", Code: apperror.FieldCodeInvalidValue}))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/journals?format=kaikei", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewJournalHandler(mockUsecase).ExportJournal)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"format"`)
+	})
+
+	t.Run("勘定科目の名前をすべての役割について返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockJournalUsecase(t)
+
+		mockUsecase.EXPECT().UpdateAccounts(mock.Anything, domainModels.JournalAccounts{
+			value.JournalAccountExpense: "外注費",
+			value.JournalAccountFee:     "",
+			value.JournalAccountPayable: "",
+			value.JournalAccountBank:    "",
+		}, 2).Return(domainModels.NewJournalAccounts([]*domainModels.JournalAccount{{Type: value.JournalAccountExpense, Name: "外注費"}}), 3, nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/journal-accounts", strings.NewReader(`{"expense":"外注費"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2"`)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewJournalHandler(mockUsecase).UpdateAccounts)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		assert.JSONEq(t, `{"expense":"外注費","fee":"支払手数料","payable":"未払金","bank":"普通預金","version":3}`, rec.Body.String())
+	})
+
+	t.Run("勘定科目の名前が長すぎる場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/journal-accounts", strings.NewReader(`{"bank":"`+strings.Repeat("預", 25)+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewJournalHandler(usecase.NewMockJournalUsecase(t)).UpdateAccounts)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"bank"`)
+	})
+
+	t.Run("勘定科目の名前を設定のバージョンのETagと合わせて返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockJournalUsecase(t)
+
+		mockUsecase.EXPECT().GetAccounts(mock.Anything).Return(domainModels.NewJournalAccounts(nil), 2, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/journal-accounts", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewJournalHandler(mockUsecase).GetAccounts)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		assert.JSONEq(t, `{"expense":"仕入高","fee":"支払手数料","payable":"未払金","bank":"普通預金","version":2}`, rec.Body.String())
+	})
+
+	t.Run("勘定科目の変更でIf-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/journal-accounts", strings.NewReader(`{"expense":"外注費"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewJournalHandler(usecase.NewMockJournalUsecase(t)).UpdateAccounts)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("勘定科目の変更でIf-Matchの形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, "*", "3", `"0"`, `"abc"`} {
+			e := setupEcho()
+
+			req := httptest.NewRequest(http.MethodPut, "/api/admin/journal-accounts", strings.NewReader(`{"expense":"外注費"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", ifMatch)
+			rec := httptest.NewRecorder()
+			serve(e, e.NewContext(req, rec), NewJournalHandler(usecase.NewMockJournalUsecase(t)).UpdateAccounts)
+
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code, ifMatch)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PaymentHandler struct {
+	paymentUsecase usecase.PaymentUsecase
+}
+
+func NewPaymentHandler(paymentUsecase usecase.PaymentUsecase) *PaymentHandler {
+	return &PaymentHandler{
+		paymentUsecase: paymentUsecase,
+	}
+}
+
+// RecordPayment は請求書に支払を記録します。ETag には支払を反映した請求書のバージョンを返します
+func (h *PaymentHandler) RecordPayment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.RecordPaymentRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	// 日付のパース
+	paidDate, err := time.Parse("2006-01-02", req.PaidDate)
+	if err != nil {
+		return invalidDateFormat("paid_date")
+	}
+
+	payment := &domainModels.Payment{
+		Amount:    req.Amount,
+		PaidDate:  paidDate,
+		Method:    value.PaymentMethod(req.Method),
+		Reference: req.Reference,
+	}
+	invoice, err := h.paymentUsecase.RecordPayment(ctx, c.Param("id"), payment, version)
+	if err != nil {
+		return err
+	}
+
+	response := &models.RecordPaymentResponse{
+		Payment: models.FromPaymentDomainModel(payment),
+		Invoice: models.FromInvoiceDomainModel(invoice),
+	}
+	setETag(c, invoice.Version)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (h *PaymentHandler) GetPayments(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	payments, err := h.paymentUsecase.ListPayments(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromPaymentDomainModels(payments)
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPaymentHandler_RecordPayment(t *testing.T) {
+	invoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
+
+	newContext := func(e *echo.Echo, ifMatch, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/invoices/"+invoiceID+"/payments", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(invoiceID)
+
+		return c, rec
+	}
+
+	t.Run("支払を記録して請求書の新しいバージョンをETagで返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockPaymentUsecase(t)
+
+		mockUsecase.EXPECT().RecordPayment(mock.Anything, invoiceID, &domainModels.Payment{
+			Amount:    decimal.NewFromInt(60000),
+			PaidDate:  time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC),
+			Method:    value.PaymentMethodBankTransfer,
+			Reference: "TRX-0001",
+		}, 2).RunAndReturn(func(_ context.Context, _ string, payment *domainModels.Payment, version int) (*domainModels.Invoice, error) {
+			payment.ID = "01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+			payment.InvoiceID = invoiceID
+			return &domainModels.Invoice{
+				ID:            invoiceID,
+				InvoiceAmount: decimal.NewFromInt(104400),
+				PaidAmount:    decimal.NewFromInt(60000),
+				Status:        value.InvoiceStatusPartiallyPaid,
+				Version:       version + 1,
+			}, nil
+		})
+
+		c, rec := newContext(e, `"2"`, `{"amount": 60000, "paid_date": "2025-12-19", "method": "bank_transfer", "reference": "TRX-0001"}`)
+		serve(e, c, NewPaymentHandler(mockUsecase).RecordPayment)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+		var response models.RecordPaymentResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "01HQZXFG0PJ9K8QXW7YM1N2ZXD", response.Payment.ID)
+		assert.Contains(t, rec.Body.String(), `"status":"一部支払済"`)
+		assert.True(t, decimal.NewFromInt(44400).Equal(response.Invoice.OutstandingAmount))
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "", `{"amount": 60000, "paid_date": "2025-12-19", "method": "bank_transfer"}`)
+		serve(e, c, NewPaymentHandler(usecase.NewMockPaymentUsecase(t)).RecordPayment)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("入力が不正な場合は400", func(t *testing.T) {
+		for _, body := range []string{
+			`{"amount": 1000, "method": "bank_transfer"}`,
+			`{"amount": 1000, "paid_date": "2025-12-19", "method": "check"}`,
+			`{"amount": 1000, "paid_date": "2025/12/19", "method": "cash"}`,
+		} {
+			e := setupEcho()
+
+			c, rec := newContext(e, `"2"`, body)
+			serve(e, c, NewPaymentHandler(usecase.NewMockPaymentUsecase(t)).RecordPayment)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReconciliationHandler struct {
+	reconciliationUsecase usecase.ReconciliationUsecase
+}
+
+func NewReconciliationHandler(reconciliationUsecase usecase.ReconciliationUsecase) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconciliationUsecase: reconciliationUsecase,
+	}
+}
+
+// ImportStatement はリクエストボディの明細のファイルを format で指定した形式で取り込みます
+func (h *ReconciliationHandler) ImportStatement(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	format := value.StatementFormat(c.QueryParam("format"))
+	statement, err := h.reconciliationUsecase.ImportStatement(ctx, format, c.Request().Body)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, models.FromBankStatementDomainModel(statement))
+}
+
+func (h *ReconciliationHandler) GetLines(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit, err := parseLimit(c.QueryParam("limit"))
+	if err != nil {
+		return invalidParameter("limit")
+	}
+	offset, err := parseOffset(c.QueryParam("offset"))
+	if err != nil {
+		return invalidParameter("offset")
+	}
+
+	lines, err := h.reconciliationUsecase.ListLines(ctx, &domainModels.BankStatementLineCondition{
+		Status: value.StatementLineStatus(c.QueryParam("status")),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &models.BankStatementLineListResponse{Items: models.FromBankStatementLineDomainModels(lines)})
+}
+
+// ConfirmLine は明細を指定した請求書の支払として記録します
+func (h *ReconciliationHandler) ConfirmLine(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var req models.ConfirmStatementLineRequest
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	line, invoice, err := h.reconciliationUsecase.ConfirmLine(ctx, c.Param("id"), req.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &models.ConfirmStatementLineResponse{
+		Line:    models.FromBankStatementLineDomainModel(line),
+		Invoice: models.FromInvoiceDomainModel(invoice),
+	})
+}
+
+func (h *ReconciliationHandler) IgnoreLine(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	line, err := h.reconciliationUsecase.IgnoreLine(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.FromBankStatementLineDomainModel(line))
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReconciliationHandler(t *testing.T) {
+	invoiceID := "invoiceID"
+	paymentID := "paymentID"
+	invoice := &domainModels.Invoice{
+		ID:             invoiceID,
+		PaymentDueDate: time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+		InvoiceAmount:  decimal.NewFromInt(10440),
+		Status:         value.InvoiceStatusProcessed,
+	}
+	pending := &domainModels.BankStatementLine{
+		ID:               "line2",
+		StatementID:      "statementID",
+		LineNo:           2,
+		TransactionDate:  time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+		Direction:        value.StatementDirectionWithdrawal,
+		Amount:           decimal.NewFromInt(10440),
+		CounterpartyName: "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ",
+		Status:           value.StatementLineStatusPending,
+		Version:          1,
+		Candidates: []*domainModels.StatementCandidate{
+			{Invoice: invoice, ClientName: "Test Client", Score: 70, AmountScore: 50, DateScore: 20},
+		},
+	}
+
+	t.Run("明細を取り込んで状態ごとの件数を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReconciliationUsecase(t)
+
+		confirmed := &domainModels.BankStatementLine{ID: "line1", Status: value.StatementLineStatusConfirmed, InvoiceID: &invoiceID, PaymentID: &paymentID, Score: 100, AutoConfirmed: true}
+		mockUsecase.EXPECT().ImportStatement(mock.Anything, value.StatementFormatCSV, mock.MatchedBy(func(r io.Reader) bool {
+			body, _ := io.ReadAll(r)
+			return string(body) == "date,amount\n"
+		})).Return(&domainModels.BankStatement{
+			ID:             "statementID",
+			Format:         value.StatementFormatCSV,
+			Lines:          []*domainModels.BankStatementLine{confirmed, pending},
+			DuplicateCount: 3,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/bank-statements?format=csv", strings.NewReader("date,amount\n"))
+		req.Header.Set(echo.HeaderContentType, "text/csv")
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReconciliationHandler(mockUsecase).ImportStatement)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, `"line_count":2,"duplicate_count":3,"confirmed_count":1,"pending_count":1,"ignored_count":0`)
+		assert.Contains(t, body, `"invoice_id":"invoiceID","payment_id":"paymentID","score":100,"auto_confirmed":true`)
+		assert.Contains(t, body, `"candidates":[{"invoice_id":"invoiceID","client_name":"Test Client","payment_due_date":"2025-12-26T00:00:00Z","outstanding_amount":"10440","score":70,"amount_score":50,"date_score":20,"name_score":0}]`)
+	})
+
+	t.Run("状態と件数を指定して明細を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReconciliationUsecase(t)
+
+		mockUsecase.EXPECT().ListLines(mock.Anything, &domainModels.BankStatementLineCondition{Status: value.StatementLineStatusPending, Limit: 20, Offset: 40}).
+			Return([]*domainModels.BankStatementLine{pending}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/bank-statement-lines?status=pending&limit=20&offset=40", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReconciliationHandler(mockUsecase).GetLines)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"items":[{"id":"line2"`)
+	})
+
+	t.Run("不正な件数", func(t *testing.T) {
+		e := setupEcho()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/bank-statement-lines?limit=0", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReconciliationHandler(usecase.NewMockReconciliationUsecase(t)).GetLines)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"limit"`)
+	})
+
+	t.Run("明細を指定した請求書の支払として消し込む", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReconciliationUsecase(t)
+
+		confirmed := *pending
+		confirmed.Status = value.StatementLineStatusConfirmed
+		confirmed.InvoiceID = &invoiceID
+		confirmed.Candidates = nil
+		mockUsecase.EXPECT().ConfirmLine(mock.Anything, "line2", invoiceID).Return(&confirmed, invoice, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/bank-statement-lines/line2/confirm", strings.NewReader(`{"invoice_id":"invoiceID"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("line2")
+		serve(e, c, NewReconciliationHandler(mockUsecase).ConfirmLine)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"confirmed"`)
+		assert.Contains(t, rec.Body.String(), `"candidates":[]`)
+		assert.Contains(t, rec.Body.String(), `"invoice":{"id":"invoiceID"`)
+	})
+
+	t.Run("請求書を指定しない場合は400", func(t *testing.T) {
+		e := setupEcho()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/bank-statement-lines/line2/confirm", strings.NewReader(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReconciliationHandler(usecase.NewMockReconciliationUsecase(t)).ConfirmLine)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"invoice_id"`)
+	})
+
+	t.Run("明細を除外する", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReconciliationUsecase(t)
+
+		ignored := *pending
+		ignored.Status = value.StatementLineStatusIgnored
+		ignored.Candidates = nil
+		mockUsecase.EXPECT().IgnoreLine(mock.Anything, "line2").Return(&ignored, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/bank-statement-lines/line2/ignore", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("line2")
+		serve(e, c, NewReconciliationHandler(mockUsecase).IgnoreLine)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"ignored"`)
+	})
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RecurringInvoiceHandler struct {
+	recurringInvoiceUsecase usecase.RecurringInvoiceUsecase
+}
+
+func NewRecurringInvoiceHandler(recurringInvoiceUsecase usecase.RecurringInvoiceUsecase) *RecurringInvoiceHandler {
+	return &RecurringInvoiceHandler{
+		recurringInvoiceUsecase: recurringInvoiceUsecase,
+	}
+}
+
+func (h *RecurringInvoiceHandler) CreateRecurringInvoice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	recurringInvoice, err := bindRecurringInvoice(c)
+	if err != nil {
+		return err
+	}
+
+	created, err := h.recurringInvoiceUsecase.CreateRecurringInvoice(ctx, recurringInvoice)
+	if err != nil {
+		return err
+	}
+
+	response := models.FromRecurringInvoiceDomainModel(created)
+	setETag(c, created.Version)
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (h *RecurringInvoiceHandler) GetRecurringInvoices(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	recurringInvoices, err := h.recurringInvoiceUsecase.ListRecurringInvoices(ctx)
+	if err != nil {
+		return err
+	}
+
+	response := models.FromRecurringInvoiceDomainModels(recurringInvoices)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *RecurringInvoiceHandler) GetRecurringInvoice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	recurringInvoice, err := h.recurringInvoiceUsecase.GetRecurringInvoice(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	response := models.FromRecurringInvoiceDomainModel(recurringInvoice)
+	setETag(c, recurringInvoice.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *RecurringInvoiceHandler) UpdateRecurringInvoice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+	recurringInvoice, err := bindRecurringInvoice(c)
+	if err != nil {
+		return err
+	}
+
+	updated, err := h.recurringInvoiceUsecase.UpdateRecurringInvoice(ctx, c.Param("id"), recurringInvoice, version)
+	if err != nil {
+		return err
+	}
+
+	response := models.FromRecurringInvoiceDomainModel(updated)
+	setETag(c, updated.Version)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *RecurringInvoiceHandler) DeleteRecurringInvoice(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+	if err := h.recurringInvoiceUsecase.DeleteRecurringInvoice(ctx, c.Param("id"), version); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bindRecurringInvoice は定期請求の作成・更新のリクエストを検証してドメインモデルにします
+func bindRecurringInvoice(c echo.Context) (*domainModels.RecurringInvoice, error) {
+	var req models.RecurringInvoiceRequest
+	if err := c.Bind(&req); err != nil {
+		return nil, errInvalidRequestBody.Wrap(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// 日付のパース
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, invalidDateFormat("start_date")
+	}
+
+	var endDate *time.Time
+	if req.EndDate != nil {
+		if endDate, err = parseOptionalDate(*req.EndDate); err != nil {
+			return nil, invalidDateFormat("end_date")
+		}
+	}
+
+	return &domainModels.RecurringInvoice{
+		ClientID:       req.ClientID,
+		PaymentAmount:  req.PaymentAmount,
+		Cadence:        value.RecurrenceCadence(req.Cadence),
+		DayOfMonth:     req.DayOfMonth,
+		PaymentDueDays: req.PaymentDueDays,
+		StartDate:      startDate,
+		EndDate:        endDate,
+	}, nil
+}
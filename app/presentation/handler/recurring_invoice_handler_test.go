@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecurringInvoiceHandler_CreateRecurringInvoice(t *testing.T) {
+	newContext := func(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/recurring-invoices", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("定期請求作成成功", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockRecurringInvoiceUsecase(t)
+
+		endDate := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().CreateRecurringInvoice(mock.Anything, &domainModels.RecurringInvoice{
+			ClientID:       "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			PaymentAmount:  decimal.NewFromInt(100000),
+			Cadence:        value.RecurrenceCadenceEndOfMonth,
+			PaymentDueDays: 30,
+			StartDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:        &endDate,
+		}).RunAndReturn(func(_ context.Context, recurringInvoice *domainModels.RecurringInvoice) (*domainModels.RecurringInvoice, error) {
+			recurringInvoice.ID = "01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+			recurringInvoice.NextIssueDate = time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+			recurringInvoice.Version = 1
+			return recurringInvoice, nil
+		})
+
+		c, rec := newContext(e, `{
+			"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC",
+			"payment_amount": 100000,
+			"cadence": "end_of_month",
+			"payment_due_days": 30,
+			"start_date": "2025-01-01",
+			"end_date": "2025-12-31"
+		}`)
+		serve(e, c, NewRecurringInvoiceHandler(mockUsecase).CreateRecurringInvoice)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, `"1"`, rec.Header().Get("ETag"))
+
+		var response models.RecurringInvoiceResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "01HQZXFG0PJ9K8QXW7YM1N2ZXD", response.ID)
+		assert.Equal(t, value.RecurrenceCadenceEndOfMonth, response.Cadence)
+		assert.Equal(t, "2025-01-31", response.NextIssueDate.Format("2006-01-02"))
+	})
+
+	t.Run("入力が不正な場合は400", func(t *testing.T) {
+		tests := []struct {
+			name string
+			body string
+		}{
+			{
+				name: "周期が不正",
+				body: `{"client_id": "c", "payment_amount": 1000, "cadence": "weekly", "start_date": "2025-01-01"}`,
+			},
+			{
+				name: "日付が31を超える",
+				body: `{"client_id": "c", "payment_amount": 1000, "cadence": "monthly", "day_of_month": 32, "start_date": "2025-01-01"}`,
+			},
+			{
+				name: "開始日の形式が不正",
+				body: `{"client_id": "c", "payment_amount": 1000, "cadence": "monthly", "day_of_month": 1, "start_date": "2025/01/01"}`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				e := setupEcho()
+
+				c, rec := newContext(e, tt.body)
+				serve(e, c, NewRecurringInvoiceHandler(usecase.NewMockRecurringInvoiceUsecase(t)).CreateRecurringInvoice)
+
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+			})
+		}
+	})
+}
+
+func TestRecurringInvoiceHandler_UpdateRecurringInvoice(t *testing.T) {
+	recurringInvoiceID := "01HQZXFG0PJ9K8QXW7YM1N2ZXD"
+	body := `{"client_id": "01HQZXFG0PJ9K8QXW7YM1N2ZXC", "payment_amount": 2000, "cadence": "monthly", "day_of_month": 25, "start_date": "2025-01-01"}`
+
+	newContext := func(e *echo.Echo, ifMatch string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/api/recurring-invoices/"+recurringInvoiceID, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(recurringInvoiceID)
+
+		return c, rec
+	}
+
+	t.Run("If-Matchのバージョンを指定して更新", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockRecurringInvoiceUsecase(t)
+		mockUsecase.EXPECT().UpdateRecurringInvoice(mock.Anything, recurringInvoiceID, mock.MatchedBy(func(recurringInvoice *domainModels.RecurringInvoice) bool {
+			return recurringInvoice.DayOfMonth == 25 && recurringInvoice.PaymentAmount.Equal(decimal.NewFromInt(2000))
+		}), 2).Return(&domainModels.RecurringInvoice{
+			ID:      recurringInvoiceID,
+			Cadence: value.RecurrenceCadenceMonthly,
+			Version: 3,
+		}, nil)
+
+		c, rec := newContext(e, `"2"`)
+		serve(e, c, NewRecurringInvoiceHandler(mockUsecase).UpdateRecurringInvoice)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+	})
+
+	t.Run("If-Matchがない場合は428", func(t *testing.T) {
+		e := setupEcho()
+
+		c, rec := newContext(e, "")
+		serve(e, c, NewRecurringInvoiceHandler(usecase.NewMockRecurringInvoiceUsecase(t)).UpdateRecurringInvoice)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+}
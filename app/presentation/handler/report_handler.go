@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/ijufumi/practice-202512/app/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReportHandler struct {
+	reportUsecase usecase.ReportUsecase
+}
+
+func NewReportHandler(reportUsecase usecase.ReportUsecase) *ReportHandler {
+	return &ReportHandler{
+		reportUsecase: reportUsecase,
+	}
+}
+
+// GetAmountDue は支払期日の週または月ごとの未払い残高を返します
+func (h *ReportHandler) GetAmountDue(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	condition, err := parseReportCondition(c)
+	if err != nil {
+		return err
+	}
+	condition.Period = DefaultReportPeriod
+	if period := c.QueryParam("period"); period != "" {
+		condition.Period = value.ReportPeriod(period)
+	}
+
+	reportRange, reports, err := h.reportUsecase.AmountDue(ctx, condition)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.FromAmountDueReports(reportRange, condition.Period, reports))
+}
+
+// GetStatusCounts はステータスごとの請求書の件数と金額を返します
+func (h *ReportHandler) GetStatusCounts(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	condition, err := parseReportCondition(c)
+	if err != nil {
+		return err
+	}
+
+	reportRange, reports, err := h.reportUsecase.StatusCounts(ctx, condition)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.FromStatusReports(reportRange, reports))
+}
+
+// GetTopClients は請求金額の多い取引先を返します
+func (h *ReportHandler) GetTopClients(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	condition, err := parseReportCondition(c)
+	if err != nil {
+		return err
+	}
+	condition.Limit = DefaultReportLimit
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if condition.Limit, err = strconv.Atoi(limitStr); err != nil {
+			return invalidParameter("limit")
+		}
+	}
+
+	reportRange, reports, err := h.reportUsecase.TopClients(ctx, condition)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.FromClientReports(reportRange, reports))
+}
+
+// GetMonthlyFees は月ごとの手数料と手数料の消費税を返します
+func (h *ReportHandler) GetMonthlyFees(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	condition, err := parseReportCondition(c)
+	if err != nil {
+		return err
+	}
+
+	reportRange, reports, err := h.reportUsecase.MonthlyFees(ctx, condition)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, models.FromFeeReports(reportRange, reports))
+}
+
+// parseReportCondition はレポートに共通する集計期間のクエリパラメータをパースします
+func parseReportCondition(c echo.Context) (*domainModels.ReportCondition, error) {
+	from, err := parseOptionalDate(c.QueryParam("from"))
+	if err != nil {
+		return nil, invalidDateFormat("from")
+	}
+
+	to, err := parseOptionalDate(c.QueryParam("to"))
+	if err != nil {
+		return nil, invalidDateFormat("to")
+	}
+
+	return &domainModels.ReportCondition{From: from, To: to}, nil
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainModels "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	usecase "github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReportHandler(t *testing.T) {
+	tokyoDate := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, domainModels.ReportLocation)
+	}
+	december := domainModels.ReportRange{From: tokyoDate(time.December, 1), To: tokyoDate(time.December, 1).AddDate(0, 1, 0)}
+
+	t.Run("未払い残高を指定した期間・単位で返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReportUsecase(t)
+
+		from := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().AmountDue(mock.Anything, &domainModels.ReportCondition{From: &from, To: &to, Period: value.ReportPeriodMonth}).
+			Return(december, []*domainModels.AmountDueReport{{PeriodStart: december.From, InvoiceCount: 2, OutstandingAmount: decimal.NewFromInt(20880)}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/amount-due?from=2025-12-01&to=2025-12-31&period=month", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(mockUsecase).GetAmountDue)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{
+			"from": "2025-12-01T00:00:00+09:00",
+			"to": "2025-12-31T00:00:00+09:00",
+			"period": "month",
+			"items": [{"period_start": "2025-12-01T00:00:00+09:00", "invoice_count": 2, "outstanding_amount": "20880"}]
+		}`, rec.Body.String())
+	})
+
+	t.Run("単位を指定しない場合は週ごと", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReportUsecase(t)
+
+		mockUsecase.EXPECT().AmountDue(mock.Anything, &domainModels.ReportCondition{Period: value.ReportPeriodWeek}).
+			Return(domainModels.ReportRange{From: tokyoDate(time.December, 1), To: tokyoDate(time.December, 8)}, []*domainModels.AmountDueReport{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/amount-due", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(mockUsecase).GetAmountDue)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"period":"week"`)
+		assert.Contains(t, rec.Body.String(), `"to":"2025-12-07T00:00:00+09:00"`)
+	})
+
+	t.Run("ステータスごとの件数を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReportUsecase(t)
+
+		mockUsecase.EXPECT().StatusCounts(mock.Anything, &domainModels.ReportCondition{}).
+			Return(december, []*domainModels.StatusReport{{Status: value.InvoiceStatusPaid, InvoiceCount: 3, InvoiceAmount: decimal.NewFromInt(31320)}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/status-counts", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(mockUsecase).GetStatusCounts)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"status":"支払済","invoice_count":3,"invoice_amount":"31320"}`)
+	})
+
+	t.Run("件数を指定しない場合は上位10件の取引先を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReportUsecase(t)
+
+		mockUsecase.EXPECT().TopClients(mock.Anything, &domainModels.ReportCondition{Limit: DefaultReportLimit}).
+			Return(december, []*domainModels.ClientReport{{ClientID: "clientID", CorporateName: "Test Client", InvoiceCount: 1, NetAmount: decimal.NewFromInt(10440)}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/top-clients", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(mockUsecase).GetTopClients)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"client_id":"clientID","corporate_name":"Test Client","invoice_count":1,"net_amount":"10440"}`)
+	})
+
+	t.Run("月ごとの手数料を返す", func(t *testing.T) {
+		e := setupEcho()
+		mockUsecase := usecase.NewMockReportUsecase(t)
+
+		mockUsecase.EXPECT().MonthlyFees(mock.Anything, &domainModels.ReportCondition{}).
+			Return(december, []*domainModels.FeeReport{{PeriodStart: december.From, InvoiceCount: 1, Fee: decimal.NewFromInt(400), Tax: decimal.NewFromInt(40)}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/monthly-fees", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(mockUsecase).GetMonthlyFees)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"period_start":"2025-12-01T00:00:00+09:00","invoice_count":1,"fee":"400","tax":"40"}`)
+	})
+
+	t.Run("不正な日付フォーマット - from", func(t *testing.T) {
+		e := setupEcho()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/status-counts?from=2025/12/01", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(usecase.NewMockReportUsecase(t)).GetStatusCounts)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Invalid from format")
+	})
+
+	t.Run("不正な件数", func(t *testing.T) {
+		e := setupEcho()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/reports/top-clients?limit=abc", nil)
+		rec := httptest.NewRecorder()
+		serve(e, e.NewContext(req, rec), NewReportHandler(usecase.NewMockReportUsecase(t)).GetTopClients)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"field":"limit"`)
+	})
+}
@@ -0,0 +1,214 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+)
+
+type Language string
+
+const (
+	LanguageJapanese Language = "ja"
+	LanguageEnglish  Language = "en"
+
+	DefaultLanguage = LanguageEnglish
+)
+
+var titles = map[apperror.Code]map[Language]string{
+	apperror.CodeBadRequest: {
+		LanguageEnglish:  "Bad request",
+		LanguageJapanese: "リクエストが不正です",
+	},
+	apperror.CodeValidationFailed: {
+		LanguageEnglish:  "Validation failed",
+		LanguageJapanese: "入力内容に誤りがあります",
+	},
+	apperror.CodeInvalidRequestBody: {
+		LanguageEnglish:  "Invalid request body",
+		LanguageJapanese: "リクエストボディが不正です",
+	},
+	apperror.CodeInvalidCredentials: {
+		LanguageEnglish:  "Invalid email or password",
+		LanguageJapanese: "メールアドレスまたはパスワードが正しくありません",
+	},
+	apperror.CodeUnauthorized: {
+		LanguageEnglish:  "Unauthorized",
+		LanguageJapanese: "認証が必要です",
+	},
+	apperror.CodeMissingAuthorization: {
+		LanguageEnglish:  "Missing authorization header",
+		LanguageJapanese: "Authorizationヘッダーがありません",
+	},
+	apperror.CodeInvalidAuthorizationFormat: {
+		LanguageEnglish:  "Invalid authorization header format",
+		LanguageJapanese: "Authorizationヘッダーの形式が不正です",
+	},
+	apperror.CodeInvalidToken: {
+		LanguageEnglish:  "Invalid or expired token",
+		LanguageJapanese: "トークンが無効か有効期限切れです",
+	},
+	apperror.CodeForbidden: {
+		LanguageEnglish:  "Forbidden",
+		LanguageJapanese: "この操作は許可されていません",
+	},
+	apperror.CodeNotFound: {
+		LanguageEnglish:  "Resource not found",
+		LanguageJapanese: "リソースが見つかりません",
+	},
+	apperror.CodeConflict: {
+		LanguageEnglish:  "Resource conflict",
+		LanguageJapanese: "リソースが競合しています",
+	},
+	apperror.CodeRestoreWindowExpired: {
+		LanguageEnglish:  "Restore window has expired",
+		LanguageJapanese: "復元できる期間を過ぎています",
+	},
+	apperror.CodeSelfApprovalForbidden: {
+		LanguageEnglish:  "You cannot approve an invoice you created",
+		LanguageJapanese: "自分が作成した請求書は承認できません",
+	},
+	apperror.CodeNotPendingApproval: {
+		LanguageEnglish:  "Invoice is not pending approval",
+		LanguageJapanese: "請求書は承認待ちではありません",
+	},
+	apperror.CodeInvoiceNotPayable: {
+		LanguageEnglish:  "Invoice has not been approved",
+		LanguageJapanese: "承認されていない請求書です",
+	},
+	apperror.CodeStatementLineNotPending: {
+		LanguageEnglish:  "Bank statement line is not pending review",
+		LanguageJapanese: "入出金明細は確認待ちではありません",
+	},
+	apperror.CodeDuplicateInvoiceSuspected: {
+		LanguageEnglish:  "Invoice may be a duplicate",
+		LanguageJapanese: "重複している可能性のある請求書です",
+	},
+	apperror.CodePreconditionFailed: {
+		LanguageEnglish:  "Resource has been modified",
+		LanguageJapanese: "データが他の操作によって更新されています",
+	},
+	apperror.CodePreconditionRequired: {
+		LanguageEnglish:  "If-Match header is required",
+		LanguageJapanese: "If-Matchヘッダーが必要です",
+	},
+	apperror.CodeMethodNotAllowed: {
+		LanguageEnglish:  "Method not allowed",
+		LanguageJapanese: "許可されていないメソッドです",
+	},
+	apperror.CodePayloadTooLarge: {
+		LanguageEnglish:  "Payload too large",
+		LanguageJapanese: "リクエストのサイズが大きすぎます",
+	},
+	apperror.CodeUnsupportedMediaType: {
+		LanguageEnglish:  "Unsupported media type",
+		LanguageJapanese: "サポートされていないメディアタイプです",
+	},
+	apperror.CodeTooManyRequests: {
+		LanguageEnglish:  "Too many requests",
+		LanguageJapanese: "リクエストが多すぎます",
+	},
+	apperror.CodeServiceUnavailable: {
+		LanguageEnglish:  "Service unavailable",
+		LanguageJapanese: "サービスを利用できません",
+	},
+	apperror.CodeInternal: {
+		LanguageEnglish:  "Internal server error",
+		LanguageJapanese: "サーバー内部でエラーが発生しました",
+	},
+}
+
+var fieldMessages = map[apperror.FieldCode]map[Language]string{
+	apperror.FieldCodeRequired: {
+		LanguageEnglish:  "%[1]s is required",
+		LanguageJapanese: "%[1]sは必須です",
+	},
+	apperror.FieldCodeEmail: {
+		LanguageEnglish:  "%[1]s must be a valid email address",
+		LanguageJapanese: "%[1]sはメールアドレスの形式で指定してください",
+	},
+	apperror.FieldCodeMin: {
+		LanguageEnglish:  "%[1]s must be at least %[2]s",
+		LanguageJapanese: "%[1]sは%[2]s以上で指定してください",
+	},
+	apperror.FieldCodeMax: {
+		LanguageEnglish:  "%[1]s must be at most %[2]s",
+		LanguageJapanese: "%[1]sは%[2]s以下で指定してください",
+	},
+	apperror.FieldCodeInvalidFormat: {
+		LanguageEnglish:  "Invalid %[1]s format. Use %[2]s",
+		LanguageJapanese: "%[1]sの形式が不正です。%[2]s形式で指定してください",
+	},
+	apperror.FieldCodeInvalidValue: {
+		LanguageEnglish:  "Invalid %[1]s parameter",
+		LanguageJapanese: "%[1]sの値が不正です",
+	},
+	apperror.FieldCodeExclusive: {
+		LanguageEnglish:  "%[1]s and %[2]s cannot be used together",
+		LanguageJapanese: "%[1]sと%[2]sは同時に指定できません",
+	},
+	apperror.FieldCodeDuplicate: {
+		LanguageEnglish:  "May duplicate invoice %[2]s. Set %[1]s to true to create it anyway",
+		LanguageJapanese: "請求書%[2]sと重複している可能性があります。作成する場合は%[1]sにtrueを指定してください",
+	},
+}
+
+var defaultFieldMessage = map[Language]string{
+	LanguageEnglish:  "%[1]s is invalid",
+	LanguageJapanese: "%[1]sが不正です",
+}
+
+// Title はエラーコードに対応するメッセージを返します
+func Title(lang Language, code apperror.Code) string {
+	if messages, ok := titles[code]; ok {
+		return messages[lang]
+	}
+
+	return titles[apperror.CodeInternal][lang]
+}
+
+// FieldMessage はフィールドエラーに対応するメッセージを返します
+func FieldMessage(lang Language, field apperror.FieldError) string {
+	format := defaultFieldMessage[lang]
+	if messages, ok := fieldMessages[field.Code]; ok {
+		format = messages[lang]
+	}
+
+	return fmt.Sprintf(format, field.Field, field.Param)
+}
+
+// ParseAcceptLanguage は Accept-Language ヘッダーから対応言語を q 値の優先順で選択します
+func ParseAcceptLanguage(header string) Language {
+	type candidate struct {
+		lang Language
+		q    float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		switch Language(primary) {
+		case LanguageJapanese, LanguageEnglish:
+			if q > 0 {
+				candidates = append(candidates, candidate{lang: Language(primary), q: q})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return DefaultLanguage
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	return candidates[0].lang
+}
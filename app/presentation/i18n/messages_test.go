@@ -0,0 +1,35 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected Language
+	}{
+		{"", LanguageEnglish},
+		{"ja", LanguageJapanese},
+		{"ja-JP,ja;q=0.9,en;q=0.8", LanguageJapanese},
+		{"en-US,en;q=0.9,ja;q=0.8", LanguageEnglish},
+		{"fr-FR,ja;q=0.5", LanguageJapanese},
+		{"en;q=0.3,ja;q=0.7", LanguageJapanese},
+		{"ja;q=0", LanguageEnglish},
+		{"zh-CN", LanguageEnglish},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ParseAcceptLanguage(tt.header), tt.header)
+	}
+}
+
+func TestFieldMessage(t *testing.T) {
+	field := apperror.FieldError{Field: "start_date", Code: apperror.FieldCodeInvalidFormat, Param: "YYYY-MM-DD"}
+
+	assert.Equal(t, "Invalid start_date format. Use YYYY-MM-DD", FieldMessage(LanguageEnglish, field))
+	assert.Equal(t, "start_dateの形式が不正です。YYYY-MM-DD形式で指定してください", FieldMessage(LanguageJapanese, field))
+	assert.Equal(t, "client_id is required", FieldMessage(LanguageEnglish, apperror.FieldError{Field: "client_id", Code: apperror.FieldCodeRequired}))
+}
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/presentation/i18n"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+
+	"github.com/labstack/echo/v4"
+)
+
+var kindStatuses = map[apperror.Kind]int{
+	apperror.KindBadRequest:           http.StatusBadRequest,
+	apperror.KindValidation:           http.StatusBadRequest,
+	apperror.KindUnauthorized:         http.StatusUnauthorized,
+	apperror.KindForbidden:            http.StatusForbidden,
+	apperror.KindNotFound:             http.StatusNotFound,
+	apperror.KindConflict:             http.StatusConflict,
+	apperror.KindPreconditionFailed:   http.StatusPreconditionFailed,
+	apperror.KindPreconditionRequired: http.StatusPreconditionRequired,
+	apperror.KindPayloadTooLarge:      http.StatusRequestEntityTooLarge,
+	apperror.KindUnsupportedMediaType: http.StatusUnsupportedMediaType,
+	apperror.KindUnavailable:          http.StatusServiceUnavailable,
+	apperror.KindInternal:             http.StatusInternalServerError,
+}
+
+var statusCodes = map[int]apperror.Code{
+	http.StatusBadRequest:            apperror.CodeBadRequest,
+	http.StatusUnauthorized:          apperror.CodeUnauthorized,
+	http.StatusForbidden:             apperror.CodeForbidden,
+	http.StatusNotFound:              apperror.CodeNotFound,
+	http.StatusMethodNotAllowed:      apperror.CodeMethodNotAllowed,
+	http.StatusConflict:              apperror.CodeConflict,
+	http.StatusPreconditionFailed:    apperror.CodePreconditionFailed,
+	http.StatusPreconditionRequired:  apperror.CodePreconditionRequired,
+	http.StatusRequestEntityTooLarge: apperror.CodePayloadTooLarge,
+	http.StatusUnsupportedMediaType:  apperror.CodeUnsupportedMediaType,
+	http.StatusTooManyRequests:       apperror.CodeTooManyRequests,
+	http.StatusServiceUnavailable:    apperror.CodeServiceUnavailable,
+}
+
+// NewHTTPErrorHandler はハンドラーから返されたエラーを RFC 7807 形式のレスポンスに変換します
+func NewHTTPErrorHandler() echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		status, problem := toProblem(err, c)
+		if status >= http.StatusInternalServerError {
+			slog.ErrorContext(c.Request().Context(), "unhandled error", slog.Any("error", err))
+		}
+
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(status)
+		} else {
+			c.Response().Header().Set(echo.HeaderContentType, models.MIMEApplicationProblemJSON)
+			err = c.JSON(status, problem)
+		}
+		if err != nil {
+			slog.ErrorContext(c.Request().Context(), "failed to write error response", slog.Any("error", err))
+		}
+	}
+}
+
+func toProblem(err error, c echo.Context) (int, *models.ProblemDetails) {
+	lang := i18n.ParseAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+
+	status := http.StatusInternalServerError
+	code := apperror.CodeInternal
+	var fields []apperror.FieldError
+
+	var httpErr *echo.HTTPError
+	if appErr, ok := apperror.As(err); ok {
+		if s, ok := kindStatuses[appErr.Kind]; ok {
+			status = s
+		}
+		code = appErr.Code
+		fields = appErr.Fields
+	} else if errors.As(err, &httpErr) {
+		status = httpErr.Code
+		if mapped, ok := statusCodes[status]; ok {
+			code = mapped
+		} else if status < http.StatusInternalServerError {
+			code = apperror.CodeBadRequest
+		}
+	}
+
+	problem := &models.ProblemDetails{
+		Type:     "/problems/" + strings.ReplaceAll(strings.ToLower(string(code)), "_", "-"),
+		Title:    i18n.Title(lang, code),
+		Status:   status,
+		Instance: c.Request().URL.Path,
+		Code:     string(code),
+	}
+	for _, field := range fields {
+		problem.Errors = append(problem.Errors, models.ProblemFieldError{
+			Field:   field.Field,
+			Code:    string(field.Code),
+			Message: i18n.FieldMessage(lang, field),
+		})
+	}
+	if len(problem.Errors) == 1 {
+		problem.Detail = problem.Errors[0].Message
+	}
+
+	return status, problem
+}
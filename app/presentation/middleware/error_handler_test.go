@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/presentation/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPErrorHandler(t *testing.T) {
+	handle := func(method string, err error, acceptLanguage string) (*httptest.ResponseRecorder, models.ProblemDetails) {
+		e := echo.New()
+		req := httptest.NewRequest(method, "/api/invoices", nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		NewHTTPErrorHandler()(err, c)
+
+		var problem models.ProblemDetails
+		if rec.Body.Len() > 0 {
+			_ = json.Unmarshal(rec.Body.Bytes(), &problem)
+		}
+		return rec, problem
+	}
+
+	t.Run("型付きエラーの分類をステータスに変換", func(t *testing.T) {
+		tests := []struct {
+			err    error
+			status int
+			code   string
+		}{
+			{apperror.NewNotFound(apperror.CodeNotFound, "not found"), http.StatusNotFound, "NOT_FOUND"},
+			{apperror.NewConflict(apperror.CodeConflict, "conflict"), http.StatusConflict, "CONFLICT"},
+			{apperror.NewForbidden(apperror.CodeForbidden, "forbidden"), http.StatusForbidden, "FORBIDDEN"},
+			{apperror.NewPayloadTooLarge(apperror.CodePayloadTooLarge, "too large"), http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE"},
+			{apperror.NewUnsupportedMediaType(apperror.CodeUnsupportedMediaType, "unsupported"), http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE"},
+			{fmt.Errorf("wrapped: %w", apperror.NewValidation()), http.StatusBadRequest, "VALIDATION_FAILED"},
+		}
+		for _, tt := range tests {
+			rec, problem := handle(http.MethodGet, tt.err, "")
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, models.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, tt.code, problem.Code)
+			assert.Equal(t, "/api/invoices", problem.Instance)
+		}
+	})
+
+	t.Run("フィールドエラーを言語に応じたメッセージで返す", func(t *testing.T) {
+		err := apperror.NewValidation(apperror.FieldError{Field: "limit", Code: apperror.FieldCodeInvalidValue})
+
+		_, problem := handle(http.MethodGet, err, "ja")
+
+		assert.Equal(t, "/problems/validation-failed", problem.Type)
+		assert.Equal(t, "入力内容に誤りがあります", problem.Title)
+		assert.Equal(t, "limitの値が不正です", problem.Detail)
+		assert.Equal(t, []models.ProblemFieldError{
+			{Field: "limit", Code: "invalid_value", Message: "limitの値が不正です"},
+		}, problem.Errors)
+	})
+
+	t.Run("echo.HTTPErrorをステータスに応じたコードに変換", func(t *testing.T) {
+		rec, problem := handle(http.MethodGet, echo.ErrNotFound, "")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "NOT_FOUND", problem.Code)
+	})
+
+	t.Run("予期しないエラーは詳細を隠して500を返す", func(t *testing.T) {
+		rec, problem := handle(http.MethodGet, errors.New("dial tcp: connection refused"), "")
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, "INTERNAL_ERROR", problem.Code)
+		assert.NotContains(t, rec.Body.String(), "connection refused")
+	})
+
+	t.Run("HEADリクエストはボディを返さない", func(t *testing.T) {
+		rec, _ := handle(http.MethodHead, apperror.NewNotFound(apperror.CodeNotFound, "not found"), "")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute は登録されたルートに一致しなかったリクエストのラベルです
+const unmatchedRoute = "unmatched"
+
+// HTTPMetrics はHTTPリクエストのメトリクスを記録します
+type HTTPMetrics interface {
+	ObserveHTTPRequest(method, route string, status int, duration time.Duration)
+}
+
+// MetricsMiddleware はルート・ステータス別にリクエスト件数とレイテンシを記録します
+func MetricsMiddleware(metrics HTTPMetrics) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			// エラーレスポンスのステータスを記録するため、ここでエラーハンドラーを呼び出す
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" || route == "/*" {
+				route = unmatchedRoute
+			}
+			metrics.ObserveHTTPRequest(c.Request().Method, route, c.Response().Status, time.Since(start))
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type observation struct {
+	method string
+	route  string
+	status int
+}
+
+type fakeHTTPMetrics struct {
+	observations []observation
+}
+
+func (m *fakeHTTPMetrics) ObserveHTTPRequest(method, route string, status int, _ time.Duration) {
+	m.observations = append(m.observations, observation{method: method, route: route, status: status})
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	setup := func() (*echo.Echo, *fakeHTTPMetrics) {
+		metrics := &fakeHTTPMetrics{}
+		e := echo.New()
+		e.HTTPErrorHandler = NewHTTPErrorHandler()
+		e.Use(MetricsMiddleware(metrics))
+		e.GET("/api/invoices/:id", func(c echo.Context) error {
+			if c.Param("id") == "missing" {
+				return apperror.NewNotFound(apperror.CodeNotFound, "invoice not found")
+			}
+			return c.NoContent(http.StatusOK)
+		})
+		return e, metrics
+	}
+
+	t.Run("ルートテンプレートとステータスを記録", func(t *testing.T) {
+		e, metrics := setup()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/invoices/01HQZXFG0PJ9K8QXW7YM1N2ZXC", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []observation{{method: http.MethodGet, route: "/api/invoices/:id", status: http.StatusOK}}, metrics.observations)
+	})
+
+	t.Run("エラーレスポンスのステータスを記録", func(t *testing.T) {
+		e, metrics := setup()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/invoices/missing", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, []observation{{method: http.MethodGet, route: "/api/invoices/:id", status: http.StatusNotFound}}, metrics.observations)
+	})
+
+	t.Run("未登録のパスはまとめて記録", func(t *testing.T) {
+		e, metrics := setup()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/unknown/path", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, []observation{{method: http.MethodGet, route: unmatchedRoute, status: http.StatusNotFound}}, metrics.observations)
+	})
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+// requestIDPattern はクライアントから受け取るリクエストIDとして許可する形式です
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9\-_.:]{1,128}$`)
+
+// RequestIDMiddleware はリクエストIDをコンテキストとレスポンスヘッダーに設定します。
+// X-Request-ID ヘッダーが不正または未指定の場合は新しく採番します
+func RequestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			requestID := req.Header.Get(echo.HeaderXRequestID)
+			if !requestIDPattern.MatchString(requestID) {
+				requestID = util.GenerateULID()
+			}
+
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+			c.SetRequest(req.WithContext(util.SetRequestID(req.Context(), requestID)))
+
+			return next(c)
+		}
+	}
+}
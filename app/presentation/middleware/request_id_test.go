@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	serveWithRequestID := func(header string) (*httptest.ResponseRecorder, string) {
+		e := echo.New()
+		e.Use(RequestIDMiddleware())
+
+		var fromContext string
+		e.GET("/", func(c echo.Context) error {
+			fromContext = util.GetRequestID(c.Request().Context())
+			return c.NoContent(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if header != "" {
+			req.Header.Set(echo.HeaderXRequestID, header)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec, fromContext
+	}
+
+	t.Run("ヘッダーのリクエストIDを引き継ぐ", func(t *testing.T) {
+		rec, fromContext := serveWithRequestID("abc-123")
+
+		assert.Equal(t, "abc-123", fromContext)
+		assert.Equal(t, "abc-123", rec.Header().Get(echo.HeaderXRequestID))
+	})
+
+	t.Run("未指定の場合は採番", func(t *testing.T) {
+		rec, fromContext := serveWithRequestID("")
+
+		assert.Len(t, fromContext, 26)
+		assert.Equal(t, fromContext, rec.Header().Get(echo.HeaderXRequestID))
+	})
+
+	t.Run("不正な形式の場合は採番し直す", func(t *testing.T) {
+		for _, header := range []string{"has space", "line\nbreak", strings.Repeat("a", 129)} {
+			rec, fromContext := serveWithRequestID(header)
+
+			assert.NotEqual(t, header, fromContext)
+			assert.Len(t, fromContext, 26)
+			assert.Equal(t, fromContext, rec.Header().Get(echo.HeaderXRequestID))
+		}
+	})
+}
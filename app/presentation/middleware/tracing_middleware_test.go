@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	setup := func() (*echo.Echo, *tracetest.SpanRecorder) {
+		recorder := tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		e := echo.New()
+		e.HTTPErrorHandler = NewHTTPErrorHandler()
+		e.Use(TracingMiddleware())
+		e.GET("/api/invoices/:id", func(c echo.Context) error {
+			if c.Param("id") == "broken" {
+				return errors.New("boom")
+			}
+			// ハンドラーのコンテキストにサーバースパンが設定されていること
+			if !trace.SpanFromContext(c.Request().Context()).SpanContext().IsValid() {
+				return errors.New("span not found")
+			}
+			return c.NoContent(http.StatusOK)
+		})
+		return e, recorder
+	}
+
+	t.Run("traceparentヘッダーのトレースを引き継ぐ", func(t *testing.T) {
+		e, recorder := setup()
+		req := httptest.NewRequest(http.MethodGet, "/api/invoices/01HQZXFG0PJ9K8QXW7YM1N2ZXC", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "GET /api/invoices/:id", span.Name())
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+		assert.True(t, span.Parent().IsRemote())
+
+		attrs := map[string]string{}
+		for _, attr := range span.Attributes() {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+		assert.Equal(t, "GET", attrs["http.request.method"])
+		assert.Equal(t, "/api/invoices/:id", attrs["http.route"])
+		assert.Equal(t, "200", attrs["http.response.status_code"])
+	})
+
+	t.Run("traceparentヘッダーがない場合は新しいトレースを開始", func(t *testing.T) {
+		e, recorder := setup()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/invoices/01HQZXFG0PJ9K8QXW7YM1N2ZXC", nil))
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.False(t, spans[0].Parent().IsValid())
+	})
+
+	t.Run("サーバーエラーはスパンのステータスをエラーにする", func(t *testing.T) {
+		e, recorder := setup()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/invoices/broken", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		span := recorder.Ended()[0]
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Len(t, span.Events(), 1)
+	})
+}
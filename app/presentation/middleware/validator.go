@@ -1,10 +1,13 @@
 package middleware
 
 import (
-	"net/http"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
 
 	"github.com/go-playground/validator"
-	"github.com/labstack/echo/v4"
 )
 
 type CustomValidator struct {
@@ -12,14 +15,54 @@ type CustomValidator struct {
 }
 
 func NewCustomValidator() *CustomValidator {
-	return &CustomValidator{validator: validator.New()}
+	v := validator.New()
+	// エラーのフィールド名をJSONのキー名で返す
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || name == "" {
+			return field.Name
+		}
+
+		return name
+	})
+
+	return &CustomValidator{validator: v}
 }
 
 func (cv *CustomValidator) Validate(i interface{}) error {
-	if err := cv.validator.Struct(i); err != nil {
-		// Optionally, you could return the error to give each route more control over the status code
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	err := cv.validator.Struct(i)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	fields := make([]apperror.FieldError, len(validationErrors))
+	for i, fieldErr := range validationErrors {
+		fields[i] = apperror.FieldError{
+			Field: fieldErr.Field(),
+			Code:  toFieldCode(fieldErr.Tag()),
+			Param: fieldErr.Param(),
+		}
+	}
+
+	return apperror.NewValidation(fields...).Wrap(err)
+}
+
+func toFieldCode(tag string) apperror.FieldCode {
+	switch tag {
+	case "required":
+		return apperror.FieldCodeRequired
+	case "email":
+		return apperror.FieldCodeEmail
+	case "min", "gte", "gt":
+		return apperror.FieldCodeMin
+	case "max", "lte", "lt":
+		return apperror.FieldCodeMax
 	}
 
-	return nil
+	return apperror.FieldCodeInvalidValue
 }
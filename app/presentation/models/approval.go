@@ -0,0 +1,90 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+type ApprovalStepRequest struct {
+	MinAmount decimal.Decimal `json:"min_amount"`
+	Role      string          `json:"role" validate:"required,oneof=member approver admin"`
+}
+
+// ApprovalWorkflowRequest は承認フローの段階を承認する順に並べたものです
+type ApprovalWorkflowRequest struct {
+	Steps []*ApprovalStepRequest `json:"steps" validate:"max=10,dive,required"`
+}
+
+type ApprovalDecisionRequest struct {
+	Comment string `json:"comment" validate:"max=500"`
+}
+
+type ApprovalStepResponse struct {
+	Step      int             `json:"step"`
+	MinAmount decimal.Decimal `json:"min_amount"`
+	Role      value.UserRole  `json:"role"`
+}
+
+type ApprovalWorkflowResponse struct {
+	Steps   []*ApprovalStepResponse `json:"steps"`
+	Version int                     `json:"version"`
+}
+
+func FromApprovalWorkflowDomainModel(workflow *domainModel.ApprovalWorkflow) *ApprovalWorkflowResponse {
+	steps := make([]*ApprovalStepResponse, len(workflow.Rules))
+	for i, rule := range workflow.Rules {
+		steps[i] = &ApprovalStepResponse{
+			Step:      rule.Step,
+			MinAmount: rule.MinAmount,
+			Role:      rule.Role,
+		}
+	}
+
+	return &ApprovalWorkflowResponse{Steps: steps, Version: workflow.Version}
+}
+
+type InvoiceApprovalResponse struct {
+	ID        string               `json:"id"`
+	InvoiceID string               `json:"invoice_id"`
+	Step      int                  `json:"step"`
+	Role      value.UserRole       `json:"role"`
+	Status    value.ApprovalStatus `json:"status"`
+	DecidedBy *string              `json:"decided_by"`
+	Comment   string               `json:"comment"`
+	DecidedAt *time.Time           `json:"decided_at"`
+}
+
+func FromInvoiceApprovalDomainModel(approval *domainModel.InvoiceApproval) *InvoiceApprovalResponse {
+	return &InvoiceApprovalResponse{
+		ID:        approval.ID,
+		InvoiceID: approval.InvoiceID,
+		Step:      approval.Step,
+		Role:      approval.Role,
+		Status:    approval.Status,
+		DecidedBy: approval.DecidedBy,
+		Comment:   approval.Comment,
+		DecidedAt: approval.DecidedAt,
+	}
+}
+
+type InvoiceApprovalListResponse struct {
+	Items []*InvoiceApprovalResponse `json:"items"`
+}
+
+func FromInvoiceApprovalDomainModels(approvals []*domainModel.InvoiceApproval) *InvoiceApprovalListResponse {
+	items := make([]*InvoiceApprovalResponse, len(approvals))
+	for i, approval := range approvals {
+		items[i] = FromInvoiceApprovalDomainModel(approval)
+	}
+
+	return &InvoiceApprovalListResponse{Items: items}
+}
+
+// ApprovalDecisionResponse は判断を反映した請求書と、その請求書に必要な承認の一覧です
+type ApprovalDecisionResponse struct {
+	Invoice   *InvoiceResponse           `json:"invoice"`
+	Approvals []*InvoiceApprovalResponse `json:"approvals"`
+}
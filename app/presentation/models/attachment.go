@@ -0,0 +1,45 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+
+	"time"
+)
+
+type InvoiceAttachmentResponse struct {
+	ID          string                      `json:"id"`
+	InvoiceID   string                      `json:"invoice_id"`
+	FileName    string                      `json:"file_name"`
+	ContentType value.AttachmentContentType `json:"content_type"`
+	Size        int64                       `json:"size"`
+	SHA256      string                      `json:"sha256"`
+	CreatedBy   string                      `json:"created_by"`
+	CreatedAt   time.Time                   `json:"created_at"`
+}
+
+func FromInvoiceAttachmentDomainModel(attachment *domainModel.InvoiceAttachment) *InvoiceAttachmentResponse {
+	return &InvoiceAttachmentResponse{
+		ID:          attachment.ID,
+		InvoiceID:   attachment.InvoiceID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		CreatedBy:   attachment.CreatedBy,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+type InvoiceAttachmentListResponse struct {
+	Items []*InvoiceAttachmentResponse `json:"items"`
+}
+
+func FromInvoiceAttachmentDomainModels(attachments []*domainModel.InvoiceAttachment) *InvoiceAttachmentListResponse {
+	items := make([]*InvoiceAttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		items[i] = FromInvoiceAttachmentDomainModel(attachment)
+	}
+
+	return &InvoiceAttachmentListResponse{Items: items}
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// BankStatementResponse は取り込んだ明細と、消し込みの状態ごとの件数です
+type BankStatementResponse struct {
+	ID             string                       `json:"id"`
+	Format         value.StatementFormat        `json:"format"`
+	LineCount      int                          `json:"line_count"`
+	DuplicateCount int                          `json:"duplicate_count"`
+	ConfirmedCount int                          `json:"confirmed_count"`
+	PendingCount   int                          `json:"pending_count"`
+	IgnoredCount   int                          `json:"ignored_count"`
+	Lines          []*BankStatementLineResponse `json:"lines"`
+	CreatedAt      time.Time                    `json:"created_at"`
+}
+
+func FromBankStatementDomainModel(statement *domainModel.BankStatement) *BankStatementResponse {
+	return &BankStatementResponse{
+		ID:             statement.ID,
+		Format:         statement.Format,
+		LineCount:      len(statement.Lines),
+		DuplicateCount: statement.DuplicateCount,
+		ConfirmedCount: statement.CountByStatus(value.StatementLineStatusConfirmed),
+		PendingCount:   statement.CountByStatus(value.StatementLineStatusPending),
+		IgnoredCount:   statement.CountByStatus(value.StatementLineStatusIgnored),
+		Lines:          FromBankStatementLineDomainModels(statement.Lines),
+		CreatedAt:      statement.CreatedAt,
+	}
+}
+
+type BankStatementLineResponse struct {
+	ID               string                        `json:"id"`
+	StatementID      string                        `json:"statement_id"`
+	LineNo           int                           `json:"line_no"`
+	TransactionDate  time.Time                     `json:"transaction_date"`
+	Direction        value.StatementDirection      `json:"direction"`
+	Amount           decimal.Decimal               `json:"amount"`
+	CounterpartyName string                        `json:"counterparty_name"`
+	Description      string                        `json:"description"`
+	Reference        string                        `json:"reference"`
+	Status           value.StatementLineStatus     `json:"status"`
+	InvoiceID        *string                       `json:"invoice_id"`
+	PaymentID        *string                       `json:"payment_id"`
+	Score            int                           `json:"score"`
+	AutoConfirmed    bool                          `json:"auto_confirmed"`
+	DecidedAt        *time.Time                    `json:"decided_at"`
+	Candidates       []*StatementCandidateResponse `json:"candidates"`
+	Version          int                           `json:"version"`
+}
+
+func FromBankStatementLineDomainModel(line *domainModel.BankStatementLine) *BankStatementLineResponse {
+	candidates := make([]*StatementCandidateResponse, len(line.Candidates))
+	for i, candidate := range line.Candidates {
+		candidates[i] = FromStatementCandidateDomainModel(candidate)
+	}
+
+	return &BankStatementLineResponse{
+		ID:               line.ID,
+		StatementID:      line.StatementID,
+		LineNo:           line.LineNo,
+		TransactionDate:  line.TransactionDate,
+		Direction:        line.Direction,
+		Amount:           line.Amount,
+		CounterpartyName: line.CounterpartyName,
+		Description:      line.Description,
+		Reference:        line.Reference,
+		Status:           line.Status,
+		InvoiceID:        line.InvoiceID,
+		PaymentID:        line.PaymentID,
+		Score:            line.Score,
+		AutoConfirmed:    line.AutoConfirmed,
+		DecidedAt:        line.DecidedAt,
+		Candidates:       candidates,
+		Version:          line.Version,
+	}
+}
+
+func FromBankStatementLineDomainModels(lines []*domainModel.BankStatementLine) []*BankStatementLineResponse {
+	items := make([]*BankStatementLineResponse, len(lines))
+	for i, line := range lines {
+		items[i] = FromBankStatementLineDomainModel(line)
+	}
+
+	return items
+}
+
+type BankStatementLineListResponse struct {
+	Items []*BankStatementLineResponse `json:"items"`
+}
+
+// StatementCandidateResponse は明細に一致する可能性のある請求書と、一致度の内訳です
+type StatementCandidateResponse struct {
+	InvoiceID         string          `json:"invoice_id"`
+	ClientName        string          `json:"client_name"`
+	PaymentDueDate    time.Time       `json:"payment_due_date"`
+	OutstandingAmount decimal.Decimal `json:"outstanding_amount"`
+	Score             int             `json:"score"`
+	AmountScore       int             `json:"amount_score"`
+	DateScore         int             `json:"date_score"`
+	NameScore         int             `json:"name_score"`
+}
+
+func FromStatementCandidateDomainModel(candidate *domainModel.StatementCandidate) *StatementCandidateResponse {
+	return &StatementCandidateResponse{
+		InvoiceID:         candidate.Invoice.ID,
+		ClientName:        candidate.ClientName,
+		PaymentDueDate:    candidate.Invoice.PaymentDueDate,
+		OutstandingAmount: candidate.Invoice.OutstandingAmount(),
+		Score:             candidate.Score,
+		AmountScore:       candidate.AmountScore,
+		DateScore:         candidate.DateScore,
+		NameScore:         candidate.NameScore,
+	}
+}
+
+type ConfirmStatementLineRequest struct {
+	InvoiceID string `json:"invoice_id" validate:"required"`
+}
+
+// ConfirmStatementLineResponse は消し込んだ明細と、支払を反映した請求書です
+type ConfirmStatementLineResponse struct {
+	Line    *BankStatementLineResponse `json:"line"`
+	Invoice *InvoiceResponse           `json:"invoice"`
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+
+	"time"
+)
+
+type ClientResponse struct {
+	ID                 string           `json:"id"`
+	CorporateName      string           `json:"corporate_name"`
+	RepresentativeName string           `json:"representative_name"`
+	PhoneNumber        string           `json:"phone_number"`
+	PostalCode         string           `json:"postal_code"`
+	Address            string           `json:"address"`
+	ClientType         value.ClientType `json:"client_type"`
+	Withholding        bool             `json:"withholding"`
+	// WithholdingCategory は源泉徴収しない取引先では省略します
+	WithholdingCategory value.WithholdingCategory `json:"withholding_category,omitempty"`
+	BankAccounts        []*BankAccountResponse    `json:"bank_accounts"`
+	Version             int                       `json:"version"`
+	CreatedAt           time.Time                 `json:"created_at"`
+	UpdatedAt           time.Time                 `json:"updated_at"`
+}
+
+// BankAccountResponse の口座番号は下4桁以外をマスクして返します
+type BankAccountResponse struct {
+	ID            string `json:"id"`
+	BankName      string `json:"bank_name"`
+	BranchName    string `json:"branch_name"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+}
+
+func FromClientDetailDomainModel(detail *domainModel.ClientDetail) *ClientResponse {
+	bankAccounts := make([]*BankAccountResponse, len(detail.BankAccounts))
+	for i, account := range detail.BankAccounts {
+		bankAccounts[i] = &BankAccountResponse{
+			ID:            account.ID,
+			BankName:      account.BankName,
+			BranchName:    account.BranchName,
+			AccountNumber: account.MaskedAccountNumber(),
+			AccountName:   account.AccountName,
+		}
+	}
+
+	return &ClientResponse{
+		ID:                  detail.Client.ID,
+		CorporateName:       detail.Client.CorporateName,
+		RepresentativeName:  detail.Client.RepresentativeName,
+		PhoneNumber:         detail.Client.PhoneNumber,
+		PostalCode:          detail.Client.PostalCode,
+		Address:             detail.Client.Address,
+		ClientType:          detail.Client.ClientType,
+		Withholding:         detail.Client.Withholding,
+		WithholdingCategory: detail.Client.WithholdingCategory,
+		BankAccounts:        bankAccounts,
+		Version:             detail.Client.Version,
+		CreatedAt:           detail.Client.CreatedAt,
+		UpdatedAt:           detail.Client.UpdatedAt,
+	}
+}
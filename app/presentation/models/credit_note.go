@@ -0,0 +1,67 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+type IssueCreditNoteRequest struct {
+	IssueDate     string          `json:"issue_date" validate:"required"`
+	Reason        string          `json:"reason" validate:"required,oneof=return discount"`
+	PaymentAmount decimal.Decimal `json:"payment_amount"`
+	Note          string          `json:"note" validate:"max=200"`
+}
+
+type CreditNoteResponse struct {
+	ID            string                 `json:"id"`
+	InvoiceID     string                 `json:"invoice_id"`
+	IssueDate     time.Time              `json:"issue_date"`
+	Reason        value.CreditNoteReason `json:"reason"`
+	Note          string                 `json:"note"`
+	PaymentAmount decimal.Decimal        `json:"payment_amount"`
+	Fee           decimal.Decimal        `json:"fee"`
+	FeeRate       decimal.Decimal        `json:"fee_rate"`
+	Tax           decimal.Decimal        `json:"tax"`
+	TaxRate       decimal.Decimal        `json:"tax_rate"`
+	CreditAmount  decimal.Decimal        `json:"credit_amount"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+func FromCreditNoteDomainModel(creditNote *domainModel.CreditNote) *CreditNoteResponse {
+	return &CreditNoteResponse{
+		ID:            creditNote.ID,
+		InvoiceID:     creditNote.InvoiceID,
+		IssueDate:     creditNote.IssueDate,
+		Reason:        creditNote.Reason,
+		Note:          creditNote.Note,
+		PaymentAmount: creditNote.PaymentAmount,
+		Fee:           creditNote.Fee,
+		FeeRate:       creditNote.FeeRate,
+		Tax:           creditNote.Tax,
+		TaxRate:       creditNote.TaxRate,
+		CreditAmount:  creditNote.CreditAmount,
+		CreatedAt:     creditNote.CreatedAt,
+	}
+}
+
+type CreditNoteListResponse struct {
+	Items []*CreditNoteResponse `json:"items"`
+}
+
+func FromCreditNoteDomainModels(creditNotes []*domainModel.CreditNote) *CreditNoteListResponse {
+	items := make([]*CreditNoteResponse, len(creditNotes))
+	for i, creditNote := range creditNotes {
+		items[i] = FromCreditNoteDomainModel(creditNote)
+	}
+
+	return &CreditNoteListResponse{Items: items}
+}
+
+// IssueCreditNoteResponse は発行した返品・値引きと、減額を反映した元の請求書です
+type IssueCreditNoteResponse struct {
+	CreditNote *CreditNoteResponse `json:"credit_note"`
+	Invoice    *InvoiceResponse    `json:"invoice"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type HealthResponse struct {
+	Status string `json:"status"`
+}
@@ -9,44 +9,149 @@ import (
 )
 
 type CreateInvoiceRequest struct {
-	ClientID       string          `json:"client_id" validate:"required"`
-	IssueDate      string          `json:"issue_date" validate:"required"`
-	PaymentAmount  decimal.Decimal `json:"payment_amount" validate:"required,min=1"`
-	PaymentDueDate string          `json:"payment_due_date" validate:"required"`
+	ClientID  string `json:"client_id" validate:"required"`
+	IssueDate string `json:"issue_date" validate:"required"`
+	// Currency は ISO 4217 の通貨コードです。省略した場合は円建てです
+	Currency string `json:"currency" validate:"omitempty,oneof=JPY USD EUR"`
+	// PaymentAmount は明細を指定しない場合の支払金額です。明細との排他はユースケースで確認します
+	PaymentAmount decimal.Decimal `json:"payment_amount"`
+	// Lines を指定した場合は明細から支払金額を計算します
+	Lines          []*InvoiceLineRequest `json:"lines" validate:"max=100,dive,required"`
+	PaymentDueDate string                `json:"payment_due_date" validate:"required"`
+	// Force を指定すると重複の疑いのある請求書があっても作成します
+	Force bool `json:"force"`
+}
+
+// UpdateRetentionClassRequest は削除した後の保存期間の区分の変更です
+type UpdateRetentionClassRequest struct {
+	RetentionClass string `json:"retention_class" validate:"required,oneof=standard extended"`
+}
+
+type InvoiceLineRequest struct {
+	Description string          `json:"description" validate:"required,max=200"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	TaxCategory string          `json:"tax_category" validate:"required,oneof=standard reduced exempt"`
 }
 
 type InvoiceResponse struct {
-	ID             string              `json:"id"`
-	ClientID       string              `json:"client_id"`
-	IssueDate      time.Time           `json:"issue_date"`
-	PaymentAmount  decimal.Decimal     `json:"payment_amount"`
-	Fee            decimal.Decimal     `json:"fee"`
-	FeeRate        decimal.Decimal     `json:"fee_rate"`
-	Tax            decimal.Decimal     `json:"tax"`
-	TaxRate        decimal.Decimal     `json:"tax_rate"`
-	InvoiceAmount  decimal.Decimal     `json:"invoice_amount"`
-	PaymentDueDate time.Time           `json:"payment_due_date"`
-	Status         value.InvoiceStatus `json:"status"`
-	CreatedAt      time.Time           `json:"created_at"`
-	UpdatedAt      time.Time           `json:"updated_at"`
+	ID string `json:"id"`
+	// InvoiceNumber は番号付けを導入する前に作成した請求書では null です
+	InvoiceNumber *string        `json:"invoice_number"`
+	ClientID      string         `json:"client_id"`
+	IssueDate     time.Time      `json:"issue_date"`
+	Currency      value.Currency `json:"currency"`
+	// PaymentAmount と明細、源泉徴収税額は請求書の通貨建て、それ以外の金額は発行日の為替レートで換算した円建てです
+	PaymentAmount     decimal.Decimal     `json:"payment_amount"`
+	ExchangeRate      decimal.Decimal     `json:"exchange_rate"`
+	PaymentAmountJPY  decimal.Decimal     `json:"payment_amount_jpy"`
+	Fee               decimal.Decimal     `json:"fee"`
+	FeeRate           decimal.Decimal     `json:"fee_rate"`
+	Tax               decimal.Decimal     `json:"tax"`
+	TaxRate           decimal.Decimal     `json:"tax_rate"`
+	InvoiceAmount     decimal.Decimal     `json:"invoice_amount"`
+	WithholdingTax    decimal.Decimal     `json:"withholding_tax"`
+	TransferAmount    decimal.Decimal     `json:"transfer_amount"`
+	CreditedAmount    decimal.Decimal     `json:"credited_amount"`
+	NetAmount         decimal.Decimal     `json:"net_amount"`
+	PaidAmount        decimal.Decimal     `json:"paid_amount"`
+	OutstandingAmount decimal.Decimal     `json:"outstanding_amount"`
+	PaymentDueDate    time.Time           `json:"payment_due_date"`
+	Status            value.InvoiceStatus `json:"status"`
+	// DuplicateCheck は作成時の重複の確認の結果で、SuspectedDuplicateID は force を指定して作成した場合の疑いのあった請求書です
+	DuplicateCheck       value.DuplicateCheck `json:"duplicate_check"`
+	SuspectedDuplicateID *string              `json:"suspected_duplicate_id"`
+	RetentionClass       value.RetentionClass `json:"retention_class"`
+	Version              int                  `json:"version"`
+	CreatedAt            time.Time            `json:"created_at"`
+	UpdatedAt            time.Time            `json:"updated_at"`
+	// Lines と TaxBreakdown は明細のある請求書を作成・取得した場合だけ返します（一覧では返しません）
+	Lines        []*InvoiceLineResponse `json:"lines,omitempty"`
+	TaxBreakdown []*LineTaxResponse     `json:"tax_breakdown,omitempty"`
+	// DueDateAdjustment は作成時に支払期日を営業日へ移動した場合だけ返します
+	DueDateAdjustment *DueDateAdjustmentResponse `json:"due_date_adjustment,omitempty"`
+}
+
+type InvoiceLineResponse struct {
+	LineNo      int               `json:"line_no"`
+	Description string            `json:"description"`
+	Quantity    decimal.Decimal   `json:"quantity"`
+	UnitPrice   decimal.Decimal   `json:"unit_price"`
+	TaxCategory value.TaxCategory `json:"tax_category"`
+	Amount      decimal.Decimal   `json:"amount"`
+}
+
+// LineTaxResponse は税区分ごとの明細の合計と消費税です
+type LineTaxResponse struct {
+	TaxCategory value.TaxCategory `json:"tax_category"`
+	TaxRate     decimal.Decimal   `json:"tax_rate"`
+	Amount      decimal.Decimal   `json:"amount"`
+	Tax         decimal.Decimal   `json:"tax"`
+}
+
+type DueDateAdjustmentResponse struct {
+	RequestedDate time.Time           `json:"requested_date"`
+	Reason        string              `json:"reason"`
+	Policy        value.DueDatePolicy `json:"policy"`
 }
 
 func FromInvoiceDomainModel(invoice *domainModel.Invoice) *InvoiceResponse {
-	return &InvoiceResponse{
-		ID:             invoice.ID,
-		ClientID:       invoice.ClientID,
-		IssueDate:      invoice.IssueDate,
-		PaymentAmount:  invoice.PaymentAmount,
-		Fee:            invoice.Fee,
-		FeeRate:        invoice.FeeRate,
-		Tax:            invoice.Tax,
-		TaxRate:        invoice.TaxRate,
-		InvoiceAmount:  invoice.InvoiceAmount,
-		PaymentDueDate: invoice.PaymentDueDate,
-		Status:         invoice.Status,
-		CreatedAt:      invoice.CreatedAt,
-		UpdatedAt:      invoice.UpdatedAt,
+	response := &InvoiceResponse{
+		ID:                   invoice.ID,
+		InvoiceNumber:        invoice.InvoiceNumber,
+		ClientID:             invoice.ClientID,
+		IssueDate:            invoice.IssueDate,
+		Currency:             invoice.Currency,
+		PaymentAmount:        invoice.PaymentAmount,
+		ExchangeRate:         invoice.ExchangeRate,
+		PaymentAmountJPY:     invoice.PaymentAmountJPY,
+		Fee:                  invoice.Fee,
+		FeeRate:              invoice.FeeRate,
+		Tax:                  invoice.Tax,
+		TaxRate:              invoice.TaxRate,
+		InvoiceAmount:        invoice.InvoiceAmount,
+		WithholdingTax:       invoice.WithholdingTax,
+		TransferAmount:       invoice.TransferAmount(),
+		CreditedAmount:       invoice.CreditedAmount,
+		NetAmount:            invoice.NetAmount(),
+		PaidAmount:           invoice.PaidAmount,
+		OutstandingAmount:    invoice.OutstandingAmount(),
+		PaymentDueDate:       invoice.PaymentDueDate,
+		Status:               invoice.Status,
+		DuplicateCheck:       invoice.DuplicateCheck,
+		SuspectedDuplicateID: invoice.SuspectedDuplicateID,
+		RetentionClass:       invoice.RetentionClass,
+		Version:              invoice.Version,
+		CreatedAt:            invoice.CreatedAt,
+		UpdatedAt:            invoice.UpdatedAt,
+	}
+	for _, line := range invoice.Lines {
+		response.Lines = append(response.Lines, &InvoiceLineResponse{
+			LineNo:      line.LineNo,
+			Description: line.Description,
+			Quantity:    line.Quantity,
+			UnitPrice:   line.UnitPrice,
+			TaxCategory: line.TaxCategory,
+			Amount:      line.Amount,
+		})
+	}
+	for _, tax := range domainModel.SummarizeLineTaxes(invoice.Lines, invoice.Currency) {
+		response.TaxBreakdown = append(response.TaxBreakdown, &LineTaxResponse{
+			TaxCategory: tax.TaxCategory,
+			TaxRate:     tax.TaxRate,
+			Amount:      tax.Amount,
+			Tax:         tax.Tax,
+		})
 	}
+	if adjustment := invoice.DueDateAdjustment; adjustment != nil {
+		response.DueDateAdjustment = &DueDateAdjustmentResponse{
+			RequestedDate: adjustment.RequestedDate,
+			Reason:        adjustment.Reason,
+			Policy:        adjustment.Policy,
+		}
+	}
+
+	return response
 }
 
 func FromInvoiceDomainModels(invoices []*domainModel.Invoice) []*InvoiceResponse {
@@ -57,3 +162,49 @@ func FromInvoiceDomainModels(invoices []*domainModel.Invoice) []*InvoiceResponse
 
 	return responses
 }
+
+type InvoiceListResponse struct {
+	Items      []*InvoiceResponse `json:"items"`
+	NextCursor *string            `json:"next_cursor"`
+	TotalCount int64              `json:"total_count"`
+}
+
+func FromInvoicePageDomainModel(page *domainModel.InvoicePage) *InvoiceListResponse {
+	response := &InvoiceListResponse{
+		Items:      FromInvoiceDomainModels(page.Invoices),
+		TotalCount: page.TotalCount,
+	}
+	if page.NextCursor != nil {
+		nextCursor := page.NextCursor.Encode()
+		response.NextCursor = &nextCursor
+	}
+
+	return response
+}
+
+// InvoiceNumberingRequest は請求書番号の書式と会計年度の始まる月です。書式の構文はユースケースで確認します
+type InvoiceNumberingRequest struct {
+	Format               string `json:"format" validate:"required,max=30"`
+	FiscalYearStartMonth int    `json:"fiscal_year_start_month" validate:"required,min=1,max=12"`
+}
+
+func (r *InvoiceNumberingRequest) ToDomainModel() domainModel.InvoiceNumbering {
+	return domainModel.InvoiceNumbering{
+		Format:               value.InvoiceNumberFormat(r.Format),
+		FiscalYearStartMonth: r.FiscalYearStartMonth,
+	}
+}
+
+type InvoiceNumberingResponse struct {
+	Format               value.InvoiceNumberFormat `json:"format"`
+	FiscalYearStartMonth int                       `json:"fiscal_year_start_month"`
+	Version              int                       `json:"version"`
+}
+
+func FromInvoiceNumbering(numbering domainModel.InvoiceNumbering) *InvoiceNumberingResponse {
+	return &InvoiceNumberingResponse{
+		Format:               numbering.Format,
+		FiscalYearStartMonth: numbering.FiscalYearStartMonth,
+		Version:              numbering.Version,
+	}
+}
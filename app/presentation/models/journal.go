@@ -0,0 +1,41 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+)
+
+// JournalAccountsRequest は仕訳に使う勘定科目の名前です。指定しない役割は既定の名前に戻します
+type JournalAccountsRequest struct {
+	Expense string `json:"expense" validate:"max=24"`
+	Fee     string `json:"fee" validate:"max=24"`
+	Payable string `json:"payable" validate:"max=24"`
+	Bank    string `json:"bank" validate:"max=24"`
+}
+
+func (r *JournalAccountsRequest) ToDomainModel() domainModel.JournalAccounts {
+	return domainModel.JournalAccounts{
+		value.JournalAccountExpense: r.Expense,
+		value.JournalAccountFee:     r.Fee,
+		value.JournalAccountPayable: r.Payable,
+		value.JournalAccountBank:    r.Bank,
+	}
+}
+
+type JournalAccountsResponse struct {
+	Expense string `json:"expense"`
+	Fee     string `json:"fee"`
+	Payable string `json:"payable"`
+	Bank    string `json:"bank"`
+	Version int    `json:"version"`
+}
+
+func FromJournalAccounts(accounts domainModel.JournalAccounts, version int) *JournalAccountsResponse {
+	return &JournalAccountsResponse{
+		Expense: accounts.Name(value.JournalAccountExpense),
+		Fee:     accounts.Name(value.JournalAccountFee),
+		Payable: accounts.Name(value.JournalAccountPayable),
+		Bank:    accounts.Name(value.JournalAccountBank),
+		Version: version,
+	}
+}
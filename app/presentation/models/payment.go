@@ -0,0 +1,57 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+type RecordPaymentRequest struct {
+	Amount    decimal.Decimal `json:"amount"`
+	PaidDate  string          `json:"paid_date" validate:"required"`
+	Method    string          `json:"method" validate:"required,oneof=bank_transfer direct_debit credit_card cash other"`
+	Reference string          `json:"reference" validate:"max=100"`
+}
+
+type PaymentResponse struct {
+	ID        string              `json:"id"`
+	InvoiceID string              `json:"invoice_id"`
+	Amount    decimal.Decimal     `json:"amount"`
+	PaidDate  time.Time           `json:"paid_date"`
+	Method    value.PaymentMethod `json:"method"`
+	Reference string              `json:"reference"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+func FromPaymentDomainModel(payment *domainModel.Payment) *PaymentResponse {
+	return &PaymentResponse{
+		ID:        payment.ID,
+		InvoiceID: payment.InvoiceID,
+		Amount:    payment.Amount,
+		PaidDate:  payment.PaidDate,
+		Method:    payment.Method,
+		Reference: payment.Reference,
+		CreatedAt: payment.CreatedAt,
+	}
+}
+
+type PaymentListResponse struct {
+	Items []*PaymentResponse `json:"items"`
+}
+
+func FromPaymentDomainModels(payments []*domainModel.Payment) *PaymentListResponse {
+	items := make([]*PaymentResponse, len(payments))
+	for i, payment := range payments {
+		items[i] = FromPaymentDomainModel(payment)
+	}
+
+	return &PaymentListResponse{Items: items}
+}
+
+// RecordPaymentResponse は記録した支払と、支払を反映した請求書です
+type RecordPaymentResponse struct {
+	Payment *PaymentResponse `json:"payment"`
+	Invoice *InvoiceResponse `json:"invoice"`
+}
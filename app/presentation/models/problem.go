@@ -0,0 +1,20 @@
+package models
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// ProblemDetails は RFC 7807 形式のエラーレスポンスです
+type ProblemDetails struct {
+	Type     string              `json:"type"`
+	Title    string              `json:"title"`
+	Status   int                 `json:"status"`
+	Detail   string              `json:"detail,omitempty"`
+	Instance string              `json:"instance,omitempty"`
+	Code     string              `json:"code"`
+	Errors   []ProblemFieldError `json:"errors,omitempty"`
+}
+
+type ProblemFieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
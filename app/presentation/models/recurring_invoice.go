@@ -0,0 +1,65 @@
+package models
+
+import (
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+
+	"time"
+)
+
+// RecurringInvoiceRequest は定期請求の作成・更新のリクエストです
+type RecurringInvoiceRequest struct {
+	ClientID       string          `json:"client_id" validate:"required"`
+	PaymentAmount  decimal.Decimal `json:"payment_amount"`
+	Cadence        string          `json:"cadence" validate:"required,oneof=monthly end_of_month"`
+	DayOfMonth     int             `json:"day_of_month" validate:"omitempty,min=1,max=31"`
+	PaymentDueDays int             `json:"payment_due_days" validate:"min=0,max=365"`
+	StartDate      string          `json:"start_date" validate:"required"`
+	EndDate        *string         `json:"end_date"`
+}
+
+type RecurringInvoiceResponse struct {
+	ID             string                  `json:"id"`
+	ClientID       string                  `json:"client_id"`
+	PaymentAmount  decimal.Decimal         `json:"payment_amount"`
+	Cadence        value.RecurrenceCadence `json:"cadence"`
+	DayOfMonth     int                     `json:"day_of_month,omitempty"`
+	PaymentDueDays int                     `json:"payment_due_days"`
+	StartDate      time.Time               `json:"start_date"`
+	EndDate        *time.Time              `json:"end_date"`
+	NextIssueDate  time.Time               `json:"next_issue_date"`
+	Version        int                     `json:"version"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+func FromRecurringInvoiceDomainModel(recurringInvoice *domainModel.RecurringInvoice) *RecurringInvoiceResponse {
+	return &RecurringInvoiceResponse{
+		ID:             recurringInvoice.ID,
+		ClientID:       recurringInvoice.ClientID,
+		PaymentAmount:  recurringInvoice.PaymentAmount,
+		Cadence:        recurringInvoice.Cadence,
+		DayOfMonth:     recurringInvoice.DayOfMonth,
+		PaymentDueDays: recurringInvoice.PaymentDueDays,
+		StartDate:      recurringInvoice.StartDate,
+		EndDate:        recurringInvoice.EndDate,
+		NextIssueDate:  recurringInvoice.NextIssueDate,
+		Version:        recurringInvoice.Version,
+		CreatedAt:      recurringInvoice.CreatedAt,
+		UpdatedAt:      recurringInvoice.UpdatedAt,
+	}
+}
+
+type RecurringInvoiceListResponse struct {
+	Items []*RecurringInvoiceResponse `json:"items"`
+}
+
+func FromRecurringInvoiceDomainModels(recurringInvoices []*domainModel.RecurringInvoice) *RecurringInvoiceListResponse {
+	items := make([]*RecurringInvoiceResponse, len(recurringInvoices))
+	for i, recurringInvoice := range recurringInvoices {
+		items[i] = FromRecurringInvoiceDomainModel(recurringInvoice)
+	}
+
+	return &RecurringInvoiceListResponse{Items: items}
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	domainModel "github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+)
+
+// ReportRangeResponse は集計した期間です。From と To は日本時間の0時で、To の日も含みます
+type ReportRangeResponse struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+func fromReportRange(reportRange domainModel.ReportRange) ReportRangeResponse {
+	return ReportRangeResponse{From: reportRange.From, To: reportRange.LastDate()}
+}
+
+type AmountDueReportResponse struct {
+	PeriodStart       time.Time       `json:"period_start"`
+	InvoiceCount      int64           `json:"invoice_count"`
+	OutstandingAmount decimal.Decimal `json:"outstanding_amount"`
+}
+
+type AmountDueReportListResponse struct {
+	ReportRangeResponse
+	Period value.ReportPeriod         `json:"period"`
+	Items  []*AmountDueReportResponse `json:"items"`
+}
+
+func FromAmountDueReports(reportRange domainModel.ReportRange, period value.ReportPeriod, reports []*domainModel.AmountDueReport) *AmountDueReportListResponse {
+	items := make([]*AmountDueReportResponse, len(reports))
+	for i, report := range reports {
+		items[i] = &AmountDueReportResponse{
+			PeriodStart:       report.PeriodStart,
+			InvoiceCount:      report.InvoiceCount,
+			OutstandingAmount: report.OutstandingAmount,
+		}
+	}
+
+	return &AmountDueReportListResponse{ReportRangeResponse: fromReportRange(reportRange), Period: period, Items: items}
+}
+
+type StatusReportResponse struct {
+	Status        value.InvoiceStatus `json:"status"`
+	InvoiceCount  int64               `json:"invoice_count"`
+	InvoiceAmount decimal.Decimal     `json:"invoice_amount"`
+}
+
+type StatusReportListResponse struct {
+	ReportRangeResponse
+	Items []*StatusReportResponse `json:"items"`
+}
+
+func FromStatusReports(reportRange domainModel.ReportRange, reports []*domainModel.StatusReport) *StatusReportListResponse {
+	items := make([]*StatusReportResponse, len(reports))
+	for i, report := range reports {
+		items[i] = &StatusReportResponse{
+			Status:        report.Status,
+			InvoiceCount:  report.InvoiceCount,
+			InvoiceAmount: report.InvoiceAmount,
+		}
+	}
+
+	return &StatusReportListResponse{ReportRangeResponse: fromReportRange(reportRange), Items: items}
+}
+
+type ClientReportResponse struct {
+	ClientID      string          `json:"client_id"`
+	CorporateName string          `json:"corporate_name"`
+	InvoiceCount  int64           `json:"invoice_count"`
+	NetAmount     decimal.Decimal `json:"net_amount"`
+}
+
+type ClientReportListResponse struct {
+	ReportRangeResponse
+	Items []*ClientReportResponse `json:"items"`
+}
+
+func FromClientReports(reportRange domainModel.ReportRange, reports []*domainModel.ClientReport) *ClientReportListResponse {
+	items := make([]*ClientReportResponse, len(reports))
+	for i, report := range reports {
+		items[i] = &ClientReportResponse{
+			ClientID:      report.ClientID,
+			CorporateName: report.CorporateName,
+			InvoiceCount:  report.InvoiceCount,
+			NetAmount:     report.NetAmount,
+		}
+	}
+
+	return &ClientReportListResponse{ReportRangeResponse: fromReportRange(reportRange), Items: items}
+}
+
+type FeeReportResponse struct {
+	PeriodStart  time.Time       `json:"period_start"`
+	InvoiceCount int64           `json:"invoice_count"`
+	Fee          decimal.Decimal `json:"fee"`
+	Tax          decimal.Decimal `json:"tax"`
+}
+
+type FeeReportListResponse struct {
+	ReportRangeResponse
+	Items []*FeeReportResponse `json:"items"`
+}
+
+func FromFeeReports(reportRange domainModel.ReportRange, reports []*domainModel.FeeReport) *FeeReportListResponse {
+	items := make([]*FeeReportResponse, len(reports))
+	for i, report := range reports {
+		items[i] = &FeeReportResponse{
+			PeriodStart:  report.PeriodStart,
+			InvoiceCount: report.InvoiceCount,
+			Fee:          report.Fee,
+			Tax:          report.Tax,
+		}
+	}
+
+	return &FeeReportListResponse{ReportRangeResponse: fromReportRange(reportRange), Items: items}
+}
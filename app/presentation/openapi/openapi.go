@@ -0,0 +1,51 @@
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// swaggerUIVersion は Swagger UI ページで読み込む swagger-ui-dist のバージョンです
+const swaggerUIVersion = "5.17.14"
+
+//go:embed openapi.json
+var spec []byte
+
+// Spec は埋め込まれた OpenAPI 3.1 仕様書（JSON）を返します
+func Spec() []byte {
+	return spec
+}
+
+// SpecHandler は OpenAPI 仕様書を返します
+func SpecHandler(c echo.Context) error {
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, spec)
+}
+
+// SwaggerUIHandler は OpenAPI 仕様書を表示する Swagger UI ページを返します
+func SwaggerUIHandler(c echo.Context) error {
+	return c.HTML(http.StatusOK, swaggerUIPage)
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>スーパー支払い君.com API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@` + swaggerUIVersion + `/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@` + swaggerUIVersion + `/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({
+        url: "/api/openapi.json",
+        dom_id: "#swagger-ui",
+      });
+    };
+  </script>
+</body>
+</html>
+`
@@ -1,29 +1,68 @@
 package presentation
 
 import (
+	"net/http"
+
 	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/infrastructure/metrics"
 	"github.com/ijufumi/practice-202512/app/presentation/handler"
 	custommiddleware "github.com/ijufumi/practice-202512/app/presentation/middleware"
+	"github.com/ijufumi/practice-202512/app/presentation/openapi"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"gorm.io/gorm"
 )
 
-func NewRouter(db *gorm.DB, cfg *config.Config, invoiceHandler *handler.InvoiceHandler, authHandler *handler.AuthHandler) *echo.Echo {
+// attachmentUploadPath は添付ファイルをアップロードするルートのパスです
+const attachmentUploadPath = "/api/invoices/:id/attachments"
+
+func NewRouter(db *gorm.DB, cfg *config.Config, appMetrics *metrics.Metrics, invoiceHandler *handler.InvoiceHandler, paymentHandler *handler.PaymentHandler, creditNoteHandler *handler.CreditNoteHandler, approvalHandler *handler.ApprovalHandler, authHandler *handler.AuthHandler, healthHandler *handler.HealthHandler, clientHandler *handler.ClientHandler, recurringInvoiceHandler *handler.RecurringInvoiceHandler, reportHandler *handler.ReportHandler, journalHandler *handler.JournalHandler, reconciliationHandler *handler.ReconciliationHandler, attachmentHandler *handler.AttachmentHandler, adminHandler *handler.AdminHandler) *echo.Echo {
 	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
 
 	// バリデーション
 	e.Validator = custommiddleware.NewCustomValidator()
 
+	// エラーレスポンス（RFC 7807）
+	e.HTTPErrorHandler = custommiddleware.NewHTTPErrorHandler()
+
 	// ミドルウェア
-	e.Use(middleware.RequestLogger())
+	e.Use(custommiddleware.RequestIDMiddleware())
+	e.Use(custommiddleware.TracingMiddleware())
+	e.Use(custommiddleware.RequestLoggerMiddleware())
+	e.Use(custommiddleware.MetricsMiddleware(appMetrics))
 	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
+	if cfg.BodyLimit != "" {
+		e.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
+			// 添付ファイルはユースケースで attachment_max_size を上限に読み込む
+			Skipper: func(c echo.Context) bool {
+				return c.Request().Method == http.MethodPost && c.Path() == attachmentUploadPath
+			},
+			Limit: cfg.BodyLimit,
+		}))
+	}
+	// 楽観ロックの ETag をブラウザーから参照できるようにする
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins:  middleware.DefaultCORSConfig.AllowOrigins,
+		AllowMethods:  middleware.DefaultCORSConfig.AllowMethods,
+		ExposeHeaders: []string{"ETag"},
+	}))
 	e.Use(custommiddleware.DBMiddleware(db))
 
-	// 認証API
+	// ヘルスチェック・メトリクス
+	e.GET("/healthz", healthHandler.Healthz)
+	e.GET("/readyz", healthHandler.Readyz)
+	e.GET("/metrics", echo.WrapHandler(appMetrics.Handler()))
+
 	api := e.Group("/api")
+
+	// APIドキュメント
+	api.GET("/openapi.json", openapi.SpecHandler)
+	api.GET("/docs", openapi.SwaggerUIHandler)
+
+	// 認証API
 	api.POST("/login", authHandler.Login)
 
 	// 請求書API（JWT認証が必要）
@@ -31,6 +70,71 @@ func NewRouter(db *gorm.DB, cfg *config.Config, invoiceHandler *handler.InvoiceH
 	invoices.Use(custommiddleware.JWTMiddleware(cfg))
 	invoices.POST("", invoiceHandler.CreateInvoice)
 	invoices.GET("", invoiceHandler.GetInvoices)
+	invoices.GET("/by-number/:number", invoiceHandler.GetInvoiceByNumber)
+	invoices.GET("/:id", invoiceHandler.GetInvoice)
+	invoices.DELETE("/:id", invoiceHandler.DeleteInvoice)
+	invoices.POST("/:id/payments", paymentHandler.RecordPayment)
+	invoices.GET("/:id/payments", paymentHandler.GetPayments)
+	invoices.POST("/:id/credit-notes", creditNoteHandler.IssueCreditNote)
+	invoices.GET("/:id/credit-notes", creditNoteHandler.GetCreditNotes)
+	invoices.GET("/:id/approvals", approvalHandler.GetApprovals)
+	invoices.POST("/:id/approve", approvalHandler.Approve)
+	invoices.POST("/:id/reject", approvalHandler.Reject)
+	invoices.POST("/:id/attachments", attachmentHandler.UploadAttachment)
+	invoices.GET("/:id/attachments", attachmentHandler.GetAttachments)
+	invoices.GET("/:id/attachments/:attachmentId", attachmentHandler.DownloadAttachment)
+	invoices.DELETE("/:id/attachments/:attachmentId", attachmentHandler.DeleteAttachment)
+
+	// 取引先API（JWT認証が必要）
+	clients := api.Group("/clients")
+	clients.Use(custommiddleware.JWTMiddleware(cfg))
+	clients.GET("/:id", clientHandler.GetClient)
+	clients.DELETE("/:id", clientHandler.DeleteClient)
+
+	// 定期請求API（JWT認証が必要）
+	recurringInvoices := api.Group("/recurring-invoices")
+	recurringInvoices.Use(custommiddleware.JWTMiddleware(cfg))
+	recurringInvoices.POST("", recurringInvoiceHandler.CreateRecurringInvoice)
+	recurringInvoices.GET("", recurringInvoiceHandler.GetRecurringInvoices)
+	recurringInvoices.GET("/:id", recurringInvoiceHandler.GetRecurringInvoice)
+	recurringInvoices.PUT("/:id", recurringInvoiceHandler.UpdateRecurringInvoice)
+	recurringInvoices.DELETE("/:id", recurringInvoiceHandler.DeleteRecurringInvoice)
+
+	// レポートAPI（JWT認証が必要）
+	reports := api.Group("/reports")
+	reports.Use(custommiddleware.JWTMiddleware(cfg))
+	reports.GET("/amount-due", reportHandler.GetAmountDue)
+	reports.GET("/status-counts", reportHandler.GetStatusCounts)
+	reports.GET("/top-clients", reportHandler.GetTopClients)
+	reports.GET("/monthly-fees", reportHandler.GetMonthlyFees)
+
+	// 仕訳エクスポートAPI（JWT認証が必要）
+	journals := api.Group("/journals")
+	journals.Use(custommiddleware.JWTMiddleware(cfg))
+	journals.GET("", journalHandler.ExportJournal)
+
+	// 入出金明細の取り込み・消し込みAPI（JWT認証が必要）
+	bankStatements := api.Group("/bank-statements")
+	bankStatements.Use(custommiddleware.JWTMiddleware(cfg))
+	bankStatements.POST("", reconciliationHandler.ImportStatement)
+	statementLines := api.Group("/bank-statement-lines")
+	statementLines.Use(custommiddleware.JWTMiddleware(cfg))
+	statementLines.GET("", reconciliationHandler.GetLines)
+	statementLines.POST("/:id/confirm", reconciliationHandler.ConfirmLine)
+	statementLines.POST("/:id/ignore", reconciliationHandler.IgnoreLine)
+
+	// 管理者API（JWT認証が必要、ロールはユースケースで確認する）
+	admin := api.Group("/admin")
+	admin.Use(custommiddleware.JWTMiddleware(cfg))
+	admin.POST("/invoices/:id/restore", adminHandler.RestoreInvoice)
+	admin.PUT("/invoices/:id/retention-class", invoiceHandler.UpdateRetentionClass)
+	admin.POST("/clients/:id/restore", adminHandler.RestoreClient)
+	admin.GET("/approval-workflow", approvalHandler.GetWorkflow)
+	admin.PUT("/approval-workflow", approvalHandler.UpdateWorkflow)
+	admin.GET("/journal-accounts", journalHandler.GetAccounts)
+	admin.PUT("/journal-accounts", journalHandler.UpdateAccounts)
+	admin.GET("/invoice-numbering", invoiceHandler.GetNumbering)
+	admin.PUT("/invoice-numbering", invoiceHandler.UpdateNumbering)
 
 	return e
 }
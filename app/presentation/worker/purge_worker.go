@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/server"
+	"github.com/ijufumi/practice-202512/app/usecase"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// NewPurgeWorker は起動時と interval ごとに、保存期間を過ぎたデータを処理するワーカーを返します
+func NewPurgeWorker(db *gorm.DB, retentionUsecase usecase.RetentionUsecase, interval time.Duration) server.Worker {
+	return server.WorkerFunc(func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			// 停止の指示を受けても処理中のバッチは最後まで実行する
+			purgeCtx := util.SetDB(context.WithoutCancel(ctx), db)
+			if _, err := retentionUsecase.Purge(purgeCtx); err != nil {
+				slog.ErrorContext(ctx, "failed to purge expired records", slog.Any("error", err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
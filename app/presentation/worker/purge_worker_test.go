@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestPurgeWorker(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	t.Run("起動時に処理し、失敗しても停止するまで繰り返す", func(t *testing.T) {
+		mockRetentionUsecase := mocks.NewMockRetentionUsecase(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		mockRetentionUsecase.EXPECT().Purge(mock.MatchedBy(func(ctx context.Context) bool {
+			_, err := util.GetDB(ctx)
+			return err == nil
+		})).RunAndReturn(func(ctx context.Context) ([]*models.PurgeResult, error) {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return nil, errors.New("purge failed")
+		})
+
+		done := make(chan error, 1)
+		go func() { done <- NewPurgeWorker(db, mockRetentionUsecase, time.Millisecond).Run(ctx) }()
+
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("worker did not stop")
+		}
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/infrastructure/server"
+	"github.com/ijufumi/practice-202512/app/usecase"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// NewRecurringInvoiceWorker は起動時と interval ごとに、発行日が来た定期請求の請求書を作成するワーカーを返します
+func NewRecurringInvoiceWorker(db *gorm.DB, recurringInvoiceUsecase usecase.RecurringInvoiceUsecase, interval time.Duration) server.Worker {
+	return server.WorkerFunc(func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			// 停止の指示を受けても作成中の請求書は最後まで作成する
+			generateCtx := util.SetDB(context.WithoutCancel(ctx), db)
+			if _, err := recurringInvoiceUsecase.GenerateInvoices(generateCtx); err != nil {
+				slog.ErrorContext(ctx, "failed to generate recurring invoices", slog.Any("error", err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestRecurringInvoiceWorker(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	t.Run("起動時に請求書を作成し、停止するまで繰り返す", func(t *testing.T) {
+		mockRecurringInvoiceUsecase := mocks.NewMockRecurringInvoiceUsecase(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		mockRecurringInvoiceUsecase.EXPECT().GenerateInvoices(mock.MatchedBy(func(ctx context.Context) bool {
+			_, err := util.GetDB(ctx)
+			return err == nil
+		})).RunAndReturn(func(ctx context.Context) (int, error) {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return 1, nil
+		})
+
+		done := make(chan error, 1)
+		go func() { done <- NewRecurringInvoiceWorker(db, mockRecurringInvoiceUsecase, time.Millisecond).Run(ctx) }()
+
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("worker did not stop")
+		}
+		assert.Equal(t, 2, calls)
+	})
+}
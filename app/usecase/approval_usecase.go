@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+type ApprovalUsecase interface {
+	// GetWorkflow はログインユーザーの会社の承認フローを段階の順に、バージョンと合わせて返します（管理者のみ）
+	GetWorkflow(ctx context.Context) (*models.ApprovalWorkflow, error)
+	// UpdateWorkflow はログインユーザーの会社の承認フローを置き換えます（管理者のみ）。
+	// version が承認フローの現在のバージョンと一致する場合だけ置き換え、変更は以後に作成する請求書から適用します
+	UpdateWorkflow(ctx context.Context, rules []*models.ApprovalRule, version int) (*models.ApprovalWorkflow, error)
+	ListApprovals(ctx context.Context, invoiceID string) ([]*models.InvoiceApproval, error)
+	// Approve は請求書の次の段階を承認し、判断を反映した請求書と承認の一覧を返します。
+	// version が請求書の現在のバージョンと一致する場合だけ承認します
+	Approve(ctx context.Context, invoiceID, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error)
+	// Reject は請求書の次の段階で却下し、判断を反映した請求書と承認の一覧を返します。
+	// version が請求書の現在のバージョンと一致する場合だけ却下します
+	Reject(ctx context.Context, invoiceID, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error)
+}
+
+var (
+	errSelfApproval        = apperror.NewForbidden(apperror.CodeSelfApprovalForbidden, "creator cannot approve or reject own invoice")
+	errApproverRoleMissing = apperror.NewForbidden(apperror.CodeForbidden, "user does not have the role required for this approval step")
+	errNotPendingApproval  = apperror.NewConflict(apperror.CodeNotPendingApproval, "invoice is not pending approval")
+)
+
+type approvalUsecase struct {
+	approvalRuleRepository    repository.ApprovalRuleRepository
+	invoiceApprovalRepository repository.InvoiceApprovalRepository
+	invoiceRepository         repository.InvoiceRepository
+	userRepository            repository.UserRepository
+	now                       func() time.Time
+}
+
+func NewApprovalUsecase(invoiceRepository repository.InvoiceRepository, approvalRuleRepository repository.ApprovalRuleRepository, invoiceApprovalRepository repository.InvoiceApprovalRepository, userRepository repository.UserRepository) ApprovalUsecase {
+	return &tracedApprovalUsecase{
+		next: &approvalUsecase{
+			approvalRuleRepository:    approvalRuleRepository,
+			invoiceApprovalRepository: invoiceApprovalRepository,
+			invoiceRepository:         invoiceRepository,
+			userRepository:            userRepository,
+			now:                       time.Now,
+		},
+	}
+}
+
+func (u *approvalUsecase) GetWorkflow(ctx context.Context) (*models.ApprovalWorkflow, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.findAdmin(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	// 段階より先にバージョンを読む。間に更新が入っても古いバージョンを返すだけで、
+	// 新しい段階を見ずに上書きすることはない
+	version, err := u.approvalRuleRepository.FindVersionByCompanyID(db, user.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := u.approvalRuleRepository.FindByCompanyID(db, user.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ApprovalWorkflow{Rules: rules, Version: version}, nil
+}
+
+// UpdateWorkflow は rules の並び順を段階の順とします。空の場合は承認フローをなくします
+func (u *approvalUsecase) UpdateWorkflow(ctx context.Context, rules []*models.ApprovalRule, version int) (*models.ApprovalWorkflow, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.findAdmin(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		// decimal は validate タグの min で検証できないためここで確認する
+		if rule.MinAmount.IsNegative() {
+			return nil, apperror.NewValidation(apperror.FieldError{Field: "min_amount", Code: apperror.FieldCodeMin, Param: "0"})
+		}
+	}
+	for i, rule := range rules {
+		rule.CompanyID = user.CompanyID
+		rule.Step = i + 1
+	}
+	current, err := u.approvalRuleRepository.FindVersionByCompanyID(db, user.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(current, version); err != nil {
+		return nil, err
+	}
+	if err := u.approvalRuleRepository.ReplaceByCompanyID(db, user.CompanyID, rules, version); err != nil {
+		return nil, versionConflictError(err)
+	}
+	slog.InfoContext(ctx, "approval workflow updated",
+		slog.String("company_id", user.CompanyID),
+		slog.Int("steps", len(rules)),
+	)
+
+	return &models.ApprovalWorkflow{Rules: rules, Version: version + 1}, nil
+}
+
+// ListApprovals はログインユーザーの会社の請求書に必要な承認を段階の順に返します
+func (u *approvalUsecase) ListApprovals(ctx context.Context, invoiceID string) ([]*models.InvoiceApproval, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.invoiceApprovalRepository.FindByInvoiceID(db, invoice.ID)
+}
+
+func (u *approvalUsecase) Approve(ctx context.Context, invoiceID, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error) {
+	return u.decide(ctx, invoiceID, value.ApprovalStatusApproved, comment, version)
+}
+
+// Reject は作成者が修正できるよう、却下の理由をコメントで必須にします
+func (u *approvalUsecase) Reject(ctx context.Context, invoiceID, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, nil, apperror.NewValidation(apperror.FieldError{Field: "comment", Code: apperror.FieldCodeRequired})
+	}
+
+	return u.decide(ctx, invoiceID, value.ApprovalStatusRejected, comment, version)
+}
+
+// decide は承認待ちの請求書の次の段階を、その段階のロールを持つユーザーとして判断します。
+// 請求書を作成したユーザーは、ロールにかかわらずどの段階も判断できません
+func (u *approvalUsecase) decide(ctx context.Context, invoiceID string, status value.ApprovalStatus, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return nil, nil, err
+	}
+	if invoice.Status != value.InvoiceStatusPendingApproval {
+		return nil, nil, errNotPendingApproval
+	}
+	if invoice.CreatedBy == user.ID {
+		return nil, nil, errSelfApproval
+	}
+
+	approvals, err := u.invoiceApprovalRepository.FindByInvoiceID(db, invoice.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	approval := models.NextPendingApproval(approvals)
+	if approval == nil {
+		return nil, nil, errNotPendingApproval
+	}
+	if user.Role != approval.Role {
+		return nil, nil, errApproverRoleMissing
+	}
+
+	now := u.now()
+	approval.Decide(status, user.ID, comment, now)
+	invoice.ApplyApproval(approvals)
+	invoice.UpdatedAt = now
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		// 同じ段階を同時に判断しないよう、請求書のバージョンを進めてから記録する
+		if err := u.invoiceRepository.UpdateStatus(tx, invoice, version); err != nil {
+			return versionConflictError(err)
+		}
+
+		return u.invoiceApprovalRepository.Decide(tx, approval)
+	}); err != nil {
+		return nil, nil, err
+	}
+	slog.InfoContext(ctx, "invoice approval decided",
+		slog.String("invoice_id", invoice.ID),
+		slog.String("company_id", invoice.CompanyID),
+		slog.Int("step", approval.Step),
+		slog.String("decision", string(status)),
+		slog.String("status", string(invoice.Status)),
+	)
+
+	return invoice, approvals, nil
+}
+
+func (u *approvalUsecase) findAdmin(ctx context.Context, db *gorm.DB) (*models.User, error) {
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsAdmin() {
+		return nil, errAdminRequired
+	}
+
+	return user, nil
+}
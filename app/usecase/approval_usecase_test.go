@@ -0,0 +1,317 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type approvalMocks struct {
+	approvalRuleRepository    *repository.MockApprovalRuleRepository
+	invoiceApprovalRepository *repository.MockInvoiceApprovalRepository
+	invoiceRepository         *repository.MockInvoiceRepository
+	userRepository            *repository.MockUserRepository
+}
+
+func newTestApprovalUsecase(t *testing.T, now time.Time) (*approvalUsecase, *approvalMocks) {
+	m := &approvalMocks{
+		approvalRuleRepository:    repository.NewMockApprovalRuleRepository(t),
+		invoiceApprovalRepository: repository.NewMockInvoiceApprovalRepository(t),
+		invoiceRepository:         repository.NewMockInvoiceRepository(t),
+		userRepository:            repository.NewMockUserRepository(t),
+	}
+
+	return &approvalUsecase{
+		approvalRuleRepository:    m.approvalRuleRepository,
+		invoiceApprovalRepository: m.invoiceApprovalRepository,
+		invoiceRepository:         m.invoiceRepository,
+		userRepository:            m.userRepository,
+		now:                       func() time.Time { return now },
+	}, m
+}
+
+func TestApprovalUsecase_UpdateWorkflow(t *testing.T) {
+	t.Run("並び順を段階として会社の承認フローを置き換える", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, time.Now())
+		admin := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}
+		rules := []*models.ApprovalRule{
+			{MinAmount: decimal.Zero, Role: value.UserRoleApprover},
+			{MinAmount: decimal.NewFromInt(1000000), Role: value.UserRoleAdmin},
+		}
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		m.approvalRuleRepository.EXPECT().FindVersionByCompanyID(mock.Anything, "companyID").Return(3, nil)
+		m.approvalRuleRepository.EXPECT().ReplaceByCompanyID(mock.Anything, "companyID", rules, 3).Return(nil)
+
+		updated, err := usecase.UpdateWorkflow(ctx, rules, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, updated.Rules[0].Step)
+		assert.Equal(t, 2, updated.Rules[1].Step)
+		assert.Equal(t, "companyID", updated.Rules[1].CompanyID)
+		assert.Equal(t, 4, updated.Version)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}, nil)
+		m.approvalRuleRepository.EXPECT().FindVersionByCompanyID(mock.Anything, "companyID").Return(4, nil)
+
+		_, err := usecase.UpdateWorkflow(ctx, []*models.ApprovalRule{{Role: value.UserRoleApprover}}, 3)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("取得後に他の更新と競合した場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}, nil)
+		m.approvalRuleRepository.EXPECT().FindVersionByCompanyID(mock.Anything, "companyID").Return(3, nil)
+		m.approvalRuleRepository.EXPECT().ReplaceByCompanyID(mock.Anything, "companyID", mock.Anything, 3).
+			Return(&domainRepository.VersionConflictError{Entity: "approval_workflow", ID: "companyID", Version: 3})
+
+		_, err := usecase.UpdateWorkflow(ctx, []*models.ApprovalRule{{Role: value.UserRoleApprover}}, 3)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("負の金額の段階は設定できない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}, nil)
+
+		_, err := usecase.UpdateWorkflow(ctx, []*models.ApprovalRule{{MinAmount: decimal.NewFromInt(-1), Role: value.UserRoleApprover}}, 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, []apperror.FieldError{{Field: "min_amount", Code: apperror.FieldCodeMin, Param: "0"}}, appErr.Fields)
+	})
+
+	t.Run("管理者以外は変更できない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleApprover}, nil)
+
+		_, err := usecase.UpdateWorkflow(ctx, []*models.ApprovalRule{{Role: value.UserRoleApprover}}, 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindForbidden, appErr.Kind)
+	})
+}
+
+func TestApprovalUsecase_Decide(t *testing.T) {
+	approver := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleApprover}
+	now := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+	newInvoice := func() *models.Invoice {
+		return &models.Invoice{
+			ID:            "invoiceID",
+			CompanyID:     approver.CompanyID,
+			InvoiceAmount: decimal.NewFromInt(1044000),
+			Status:        value.InvoiceStatusPendingApproval,
+			CreatedBy:     "creatorID",
+			Version:       1,
+		}
+	}
+	newApprovals := func() []*models.InvoiceApproval {
+		return []*models.InvoiceApproval{
+			{ID: "approval1", InvoiceID: "invoiceID", Step: 1, Role: value.UserRoleApprover, Status: value.ApprovalStatusPending},
+			{ID: "approval2", InvoiceID: "invoiceID", Step: 2, Role: value.UserRoleAdmin, Status: value.ApprovalStatusPending},
+		}
+	}
+
+	t.Run("残りの段階がある場合は承認待ちのまま", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceApprovalRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(newApprovals(), nil)
+		m.invoiceRepository.EXPECT().UpdateStatus(mock.Anything, mock.Anything, 1).
+			Run(func(_ *gorm.DB, invoice *models.Invoice, version int) { invoice.Version = version + 1 }).Return(nil)
+		m.invoiceApprovalRepository.EXPECT().Decide(mock.Anything, mock.MatchedBy(func(approval *models.InvoiceApproval) bool {
+			return approval.ID == "approval1" && approval.Status == value.ApprovalStatusApproved &&
+				*approval.DecidedBy == approver.ID && approval.Comment == "確認しました" && approval.DecidedAt.Equal(now)
+		})).Return(nil)
+
+		invoice, approvals, err := usecase.Approve(ctx, "invoiceID", "確認しました", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusPendingApproval, invoice.Status)
+		assert.Equal(t, 2, invoice.Version)
+		assert.Equal(t, value.ApprovalStatusPending, approvals[1].Status)
+	})
+
+	t.Run("最後の段階を承認すると未処理にする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+		admin := &models.User{ID: "adminID", CompanyID: "companyID", Role: value.UserRoleAdmin}
+		approvals := newApprovals()
+		approvals[0].Status = value.ApprovalStatusApproved
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, "userID").Return(admin, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceApprovalRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(approvals, nil)
+		m.invoiceRepository.EXPECT().UpdateStatus(mock.Anything, mock.MatchedBy(func(invoice *models.Invoice) bool {
+			return invoice.Status == value.InvoiceStatusUnprocessed
+		}), 1).Return(nil)
+		m.invoiceApprovalRepository.EXPECT().Decide(mock.Anything, approvals[1]).Return(nil)
+
+		invoice, _, err := usecase.Approve(ctx, "invoiceID", "", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusUnprocessed, invoice.Status)
+	})
+
+	t.Run("却下すると残りの段階にかかわらず却下にする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceApprovalRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(newApprovals(), nil)
+		m.invoiceRepository.EXPECT().UpdateStatus(mock.Anything, mock.Anything, 1).Return(nil)
+		m.invoiceApprovalRepository.EXPECT().Decide(mock.Anything, mock.Anything).Return(nil)
+
+		invoice, approvals, err := usecase.Reject(ctx, "invoiceID", "金額が誤っています", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusRejected, invoice.Status)
+		assert.Equal(t, value.ApprovalStatusRejected, approvals[0].Status)
+	})
+
+	t.Run("作成者は自分の請求書を判断できない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+		current := newInvoice()
+		current.CreatedBy = approver.ID
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(current, nil)
+
+		for _, decide := range []func() error{
+			func() error { _, _, err := usecase.Approve(ctx, "invoiceID", "", 1); return err },
+			func() error { _, _, err := usecase.Reject(ctx, "invoiceID", "差し戻します", 1); return err },
+		} {
+			appErr, ok := apperror.As(decide())
+			assert.True(t, ok)
+			assert.Equal(t, apperror.KindForbidden, appErr.Kind)
+			assert.Equal(t, apperror.CodeSelfApprovalForbidden, appErr.Code)
+		}
+	})
+
+	t.Run("コメントのない却下はしない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, _ := newTestApprovalUsecase(t, now)
+
+		_, _, err := usecase.Reject(ctx, "invoiceID", " ", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, []apperror.FieldError{{Field: "comment", Code: apperror.FieldCodeRequired}}, appErr.Fields)
+	})
+
+	t.Run("段階のロールを持たないユーザーは判断できない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+		member := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleMember}
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, member.ID).Return(member, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceApprovalRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(newApprovals(), nil)
+
+		_, _, err := usecase.Approve(ctx, "invoiceID", "", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindForbidden, appErr.Kind)
+		assert.Equal(t, apperror.CodeForbidden, appErr.Code)
+	})
+
+	t.Run("承認待ちでない請求書はConflict", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+		current := newInvoice()
+		current.Status = value.InvoiceStatusUnprocessed
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(current, nil)
+
+		_, _, err := usecase.Approve(ctx, "invoiceID", "", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindConflict, appErr.Kind)
+		assert.Equal(t, apperror.CodeNotPendingApproval, appErr.Code)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+
+		_, _, err := usecase.Approve(ctx, "invoiceID", "", 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("取得後に他の判断と競合した場合はPreconditionFailedで記録しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceApprovalRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(newApprovals(), nil)
+		m.invoiceRepository.EXPECT().UpdateStatus(mock.Anything, mock.Anything, 1).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice", ID: "invoiceID", Version: 1})
+
+		_, _, err := usecase.Approve(ctx, "invoiceID", "", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("他社の請求書はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestApprovalUsecase(t, now)
+		other := newInvoice()
+		other.CompanyID = "otherCompanyID"
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, approver.ID).Return(approver, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(other, nil)
+
+		_, _, err := usecase.Approve(ctx, "invoiceID", "", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
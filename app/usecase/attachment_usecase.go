@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentUsecase interface {
+	// UploadAttachment は body の内容を請求書に添付し、添付ファイルとバージョンを進めた請求書を返します。fileName はダウンロード時のファイル名です。
+	// version が請求書の現在のバージョンと一致する場合だけ添付します
+	UploadAttachment(ctx context.Context, invoiceID, fileName string, body io.Reader, version int) (*models.InvoiceAttachment, *models.Invoice, error)
+	// ListAttachments は請求書の添付ファイルを添付した順に返します
+	ListAttachments(ctx context.Context, invoiceID string) ([]*models.InvoiceAttachment, error)
+	// DownloadAttachment は添付ファイルの情報と内容を返します。内容は呼び出し側で Close してください
+	DownloadAttachment(ctx context.Context, invoiceID, attachmentID string) (*models.InvoiceAttachment, io.ReadCloser, error)
+	// DeleteAttachment は請求書の添付ファイルを削除し、バージョンを進めた請求書を返します。
+	// version が請求書の現在のバージョンと一致する場合だけ削除します
+	DeleteAttachment(ctx context.Context, invoiceID, attachmentID string, version int) (*models.Invoice, error)
+}
+
+// maxAttachmentFileNameLength は添付ファイルのファイル名の文字数の上限です
+const maxAttachmentFileNameLength = 255
+
+var (
+	errAttachmentNotFound        = apperror.NewNotFound(apperror.CodeNotFound, "attachment not found")
+	errAttachmentTooLarge        = apperror.NewPayloadTooLarge(apperror.CodePayloadTooLarge, "attachment is too large")
+	errAttachmentTypeUnsupported = apperror.NewUnsupportedMediaType(apperror.CodeUnsupportedMediaType, "attachment must be a PDF, JPEG, PNG or TIFF file")
+)
+
+type attachmentUsecase struct {
+	invoiceRepository           repository.InvoiceRepository
+	invoiceAttachmentRepository repository.InvoiceAttachmentRepository
+	userRepository              repository.UserRepository
+	blobStore                   repository.BlobStore
+	maxSize                     int64
+	now                         func() time.Time
+}
+
+func NewAttachmentUsecase(invoiceRepository repository.InvoiceRepository, invoiceAttachmentRepository repository.InvoiceAttachmentRepository, userRepository repository.UserRepository, blobStore repository.BlobStore, cfg *config.Config) AttachmentUsecase {
+	return &tracedAttachmentUsecase{
+		next: &attachmentUsecase{
+			invoiceRepository:           invoiceRepository,
+			invoiceAttachmentRepository: invoiceAttachmentRepository,
+			userRepository:              userRepository,
+			blobStore:                   blobStore,
+			maxSize:                     cfg.AttachmentMaxSizeBytes(),
+			now:                         time.Now,
+		},
+	}
+}
+
+// UploadAttachment は内容をメモリーに読み込まないよう一時ファイルに書き出しながら SHA-256 とサイズを求め、
+// 先頭のバイト列から判定した形式で BlobStore に保存します。クライアントが申告した形式は使いません
+func (u *attachmentUsecase) UploadAttachment(ctx context.Context, invoiceID, fileName string, body io.Reader, version int) (*models.InvoiceAttachment, *models.Invoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 内容を読み込む前に確認し、古い請求書に対するアップロードを早く断る
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return nil, nil, err
+	}
+	fileName, err = normalizeAttachmentFileName(fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+	// 上限を1バイト超えるまで読み、上限を超えたかを判定する
+	size, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(body, u.maxSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if size > u.maxSize {
+		return nil, nil, errAttachmentTooLarge
+	}
+	if size == 0 {
+		return nil, nil, apperror.NewValidation(apperror.FieldError{Field: "file", Code: apperror.FieldCodeRequired})
+	}
+
+	head := make([]byte, value.AttachmentSniffLength)
+	n, err := tmp.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, err
+	}
+	contentType, ok := value.DetectAttachmentContentType(head[:n])
+	if !ok {
+		return nil, nil, errAttachmentTypeUnsupported
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+
+	attachmentID := util.GenerateULID()
+	attachment := &models.InvoiceAttachment{
+		ID:          attachmentID,
+		InvoiceID:   invoice.ID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		SHA256:      hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  models.InvoiceAttachmentStorageKey(invoice.ID, attachmentID),
+		CreatedBy:   user.ID,
+	}
+	if err := u.blobStore.Put(ctx, attachment.StorageKey, tmp, size, string(contentType)); err != nil {
+		return nil, nil, err
+	}
+	invoice.UpdatedAt = u.now()
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		// 添付ファイルも請求書の内容として、削除と同じく請求書のバージョンを進めてから記録する
+		if err := u.invoiceRepository.UpdateAttachments(tx, invoice, version); err != nil {
+			return versionConflictError(err)
+		}
+
+		return u.invoiceAttachmentRepository.Create(tx, attachment)
+	}); err != nil {
+		// 記録できなかった内容は参照されないため削除する
+		u.deleteBlob(ctx, attachment.StorageKey)
+		return nil, nil, err
+	}
+	slog.InfoContext(ctx, "invoice attachment uploaded",
+		slog.String("attachment_id", attachment.ID),
+		slog.String("invoice_id", invoice.ID),
+		slog.String("company_id", invoice.CompanyID),
+		slog.String("content_type", string(attachment.ContentType)),
+		slog.Int64("size", attachment.Size),
+		slog.String("sha256", attachment.SHA256),
+	)
+
+	return attachment, invoice, nil
+}
+
+func (u *attachmentUsecase) ListAttachments(ctx context.Context, invoiceID string) ([]*models.InvoiceAttachment, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.invoiceAttachmentRepository.FindByInvoiceID(db, invoice.ID)
+}
+
+func (u *attachmentUsecase) DownloadAttachment(ctx context.Context, invoiceID, attachmentID string) (*models.InvoiceAttachment, io.ReadCloser, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, attachment, err := u.findAttachment(ctx, db, invoiceID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := u.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, body, nil
+}
+
+// DeleteAttachment は添付ファイルの記録を削除してから内容を削除します。
+// 内容の削除に失敗しても、記録のない内容は参照されないため成功として扱います
+func (u *attachmentUsecase) DeleteAttachment(ctx context.Context, invoiceID, attachmentID string, version int) (*models.Invoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, attachment, err := u.findAttachment(ctx, db, invoiceID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return nil, err
+	}
+
+	invoice.UpdatedAt = u.now()
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		// 添付ファイルも請求書の内容として、請求書のバージョンを進めてから削除する
+		if err := u.invoiceRepository.UpdateAttachments(tx, invoice, version); err != nil {
+			return versionConflictError(err)
+		}
+
+		return u.invoiceAttachmentRepository.Delete(tx, attachment.ID)
+	}); err != nil {
+		return nil, err
+	}
+	u.deleteBlob(ctx, attachment.StorageKey)
+	slog.InfoContext(ctx, "invoice attachment deleted",
+		slog.String("attachment_id", attachment.ID),
+		slog.String("invoice_id", attachment.InvoiceID),
+		slog.String("sha256", attachment.SHA256),
+	)
+
+	return invoice, nil
+}
+
+// deleteBlob は BlobStore の内容を削除します。失敗しても処理は続け、ログに残します
+func (u *attachmentUsecase) deleteBlob(ctx context.Context, key string) {
+	if err := u.blobStore.Delete(ctx, key); err != nil {
+		slog.WarnContext(ctx, "failed to delete attachment blob",
+			slog.String("storage_key", key),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// findAttachment はログインユーザーの会社の請求書と、その添付ファイルを返します
+func (u *attachmentUsecase) findAttachment(ctx context.Context, db *gorm.DB, invoiceID, attachmentID string) (*models.Invoice, *models.InvoiceAttachment, error) {
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := u.invoiceAttachmentRepository.FindByID(db, invoice.ID, attachmentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errAttachmentNotFound
+		}
+		return nil, nil, err
+	}
+
+	return invoice, attachment, nil
+}
+
+// normalizeAttachmentFileName はクライアントのパスを取り除いたファイル名を返します
+func normalizeAttachmentFileName(fileName string) (string, error) {
+	fileName = strings.TrimSpace(path.Base(strings.ReplaceAll(fileName, `\`, "/")))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return "", apperror.NewValidation(apperror.FieldError{Field: "file_name", Code: apperror.FieldCodeRequired})
+	}
+	if !utf8.ValidString(fileName) || strings.ContainsFunc(fileName, func(r rune) bool { return r < 0x20 || r == 0x7f }) {
+		return "", apperror.NewValidation(apperror.FieldError{Field: "file_name", Code: apperror.FieldCodeInvalidValue})
+	}
+	if utf8.RuneCountInString(fileName) > maxAttachmentFileNameLength {
+		return "", apperror.NewValidation(apperror.FieldError{Field: "file_name", Code: apperror.FieldCodeMax, Param: strconv.Itoa(maxAttachmentFileNameLength)})
+	}
+
+	return fileName, nil
+}
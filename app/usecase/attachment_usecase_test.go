@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type attachmentMocks struct {
+	invoiceRepository           *repository.MockInvoiceRepository
+	invoiceAttachmentRepository *repository.MockInvoiceAttachmentRepository
+	userRepository              *repository.MockUserRepository
+	blobStore                   *repository.MockBlobStore
+}
+
+func newTestAttachmentUsecase(t *testing.T, maxSize int64) (*attachmentUsecase, *attachmentMocks) {
+	m := &attachmentMocks{
+		invoiceRepository:           repository.NewMockInvoiceRepository(t),
+		invoiceAttachmentRepository: repository.NewMockInvoiceAttachmentRepository(t),
+		userRepository:              repository.NewMockUserRepository(t),
+		blobStore:                   repository.NewMockBlobStore(t),
+	}
+
+	return &attachmentUsecase{
+		invoiceRepository:           m.invoiceRepository,
+		invoiceAttachmentRepository: m.invoiceAttachmentRepository,
+		userRepository:              m.userRepository,
+		blobStore:                   m.blobStore,
+		maxSize:                     maxSize,
+		now:                         time.Now,
+	}, m
+}
+
+func TestAttachmentUsecase_UploadAttachment(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	pdf := []byte("%PDF-1.7\n1 0 obj\n<<>>\nendobj\n")
+	expectInvoice := func(m *attachmentMocks) {
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").
+			Return(&models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID, Version: 3}, nil)
+	}
+	expectUpdateAttachments := func(m *attachmentMocks) {
+		m.invoiceRepository.EXPECT().UpdateAttachments(mock.Anything, mock.Anything, 3).
+			RunAndReturn(func(_ *gorm.DB, invoice *models.Invoice, version int) error {
+				invoice.Version = version + 1
+				return nil
+			})
+	}
+
+	t.Run("内容のハッシュと判定した形式で保存する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		var stored []byte
+		m.blobStore.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "invoices/invoiceID/attachments/")
+		}), mock.Anything, int64(len(pdf)), "application/pdf").Run(func(_ context.Context, _ string, body io.ReadSeeker, _ int64, _ string) {
+			stored, _ = io.ReadAll(body)
+		}).Return(nil)
+		expectUpdateAttachments(m)
+		m.invoiceAttachmentRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+		attachment, invoice, err := usecase.UploadAttachment(ctx, "invoiceID", `C:\scan\請求書.pdf`, bytes.NewReader(pdf), 3)
+
+		assert.NoError(t, err)
+		// 添付ファイルも請求書の内容として、請求書のバージョンを進める
+		assert.Equal(t, 4, invoice.Version)
+		sum := sha256.Sum256(pdf)
+		assert.Equal(t, hex.EncodeToString(sum[:]), attachment.SHA256)
+		assert.Equal(t, "請求書.pdf", attachment.FileName)
+		assert.Equal(t, value.AttachmentContentTypePDF, attachment.ContentType)
+		assert.Equal(t, int64(len(pdf)), attachment.Size)
+		assert.Equal(t, "invoiceID", attachment.InvoiceID)
+		assert.Equal(t, user.ID, attachment.CreatedBy)
+		assert.Equal(t, models.InvoiceAttachmentStorageKey("invoiceID", attachment.ID), attachment.StorageKey)
+		assert.Equal(t, pdf, stored)
+	})
+
+	t.Run("上限を超えるファイルはPayloadTooLarge", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, int64(len(pdf)-1))
+		expectInvoice(m)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", bytes.NewReader(pdf), 3)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindPayloadTooLarge))
+	})
+
+	t.Run("添付できない形式はUnsupportedMediaType", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		// 拡張子は PDF でも内容で判定する
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", strings.NewReader("<html></html>"), 3)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindUnsupportedMediaType))
+	})
+
+	t.Run("空のファイルはバリデーションエラー", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", strings.NewReader(""), 3)
+
+		appErr, ok := apperror.As(err)
+		if assert.True(t, ok) {
+			assert.Equal(t, []apperror.FieldError{{Field: "file", Code: apperror.FieldCodeRequired}}, appErr.Fields)
+		}
+	})
+
+	t.Run("ファイル名が長すぎる場合はバリデーションエラー", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", strings.Repeat("あ", 252)+".pdf", bytes.NewReader(pdf), 3)
+
+		appErr, ok := apperror.As(err)
+		if assert.True(t, ok) {
+			assert.Equal(t, []apperror.FieldError{{Field: "file_name", Code: apperror.FieldCodeMax, Param: "255"}}, appErr.Fields)
+		}
+	})
+
+	t.Run("他社の請求書はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(&models.Invoice{ID: "invoiceID", CompanyID: "otherCompanyID"}, nil)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", bytes.NewReader(pdf), 3)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindNotFound))
+	})
+
+	t.Run("記録できなかった場合は保存した内容を削除する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		var key string
+		m.blobStore.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(len(pdf)), "application/pdf").Run(func(_ context.Context, k string, _ io.ReadSeeker, _ int64, _ string) {
+			key = k
+		}).Return(nil)
+		expectUpdateAttachments(m)
+		m.invoiceAttachmentRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("database is locked"))
+		m.blobStore.EXPECT().Delete(mock.Anything, mock.Anything).Run(func(_ context.Context, k string) {
+			assert.Equal(t, key, k)
+		}).Return(nil)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", bytes.NewReader(pdf), 3)
+
+		assert.EqualError(t, err, "database is locked")
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailedで内容を読まない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", bytes.NewReader(pdf), 2)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindPreconditionFailed))
+	})
+
+	t.Run("保存中に他の更新と競合した場合はPreconditionFailedで保存した内容を削除する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectInvoice(m)
+
+		m.blobStore.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(len(pdf)), "application/pdf").Return(nil)
+		m.invoiceRepository.EXPECT().UpdateAttachments(mock.Anything, mock.Anything, 3).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice", ID: "invoiceID", Version: 3})
+		m.blobStore.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil)
+
+		_, _, err := usecase.UploadAttachment(ctx, "invoiceID", "invoice.pdf", bytes.NewReader(pdf), 3)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindPreconditionFailed))
+	})
+}
+
+func TestAttachmentUsecase_DownloadAttachment(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	invoice := &models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID}
+	attachment := &models.InvoiceAttachment{ID: "attachmentID", InvoiceID: invoice.ID, StorageKey: "invoices/invoiceID/attachments/attachmentID"}
+
+	t.Run("添付ファイルの情報と内容を返す", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(invoice, nil)
+		m.invoiceAttachmentRepository.EXPECT().FindByID(mock.Anything, "invoiceID", "attachmentID").Return(attachment, nil)
+		m.blobStore.EXPECT().Get(mock.Anything, attachment.StorageKey).Return(io.NopCloser(strings.NewReader("%PDF-1.7")), nil)
+
+		got, body, err := usecase.DownloadAttachment(ctx, "invoiceID", "attachmentID")
+
+		assert.NoError(t, err)
+		assert.Equal(t, attachment, got)
+		content, _ := io.ReadAll(body)
+		assert.Equal(t, "%PDF-1.7", string(content))
+	})
+
+	t.Run("請求書の添付ファイルでない場合はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(invoice, nil)
+		m.invoiceAttachmentRepository.EXPECT().FindByID(mock.Anything, "invoiceID", "attachmentID").Return(nil, gorm.ErrRecordNotFound)
+
+		_, _, err := usecase.DownloadAttachment(ctx, "invoiceID", "attachmentID")
+
+		assert.True(t, apperror.IsKind(err, apperror.KindNotFound))
+	})
+}
+
+func TestAttachmentUsecase_DeleteAttachment(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	newInvoice := func() *models.Invoice {
+		return &models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID, Version: 3}
+	}
+	attachment := &models.InvoiceAttachment{ID: "attachmentID", InvoiceID: "invoiceID", StorageKey: "invoices/invoiceID/attachments/attachmentID"}
+	expectAttachment := func(m *attachmentMocks) {
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceAttachmentRepository.EXPECT().FindByID(mock.Anything, "invoiceID", "attachmentID").Return(attachment, nil)
+	}
+	expectUpdateAttachments := func(m *attachmentMocks) {
+		m.invoiceRepository.EXPECT().UpdateAttachments(mock.Anything, mock.Anything, 3).
+			RunAndReturn(func(_ *gorm.DB, invoice *models.Invoice, version int) error {
+				invoice.Version = version + 1
+				return nil
+			})
+	}
+
+	t.Run("請求書のバージョンを進めて記録と内容を削除する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectAttachment(m)
+		expectUpdateAttachments(m)
+		m.invoiceAttachmentRepository.EXPECT().Delete(mock.Anything, "attachmentID").Return(nil)
+		m.blobStore.EXPECT().Delete(mock.Anything, attachment.StorageKey).Return(nil)
+
+		invoice, err := usecase.DeleteAttachment(ctx, "invoiceID", "attachmentID", 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, invoice.Version)
+	})
+
+	t.Run("内容を削除できなくても成功する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectAttachment(m)
+		expectUpdateAttachments(m)
+		m.invoiceAttachmentRepository.EXPECT().Delete(mock.Anything, "attachmentID").Return(nil)
+		m.blobStore.EXPECT().Delete(mock.Anything, attachment.StorageKey).Return(errors.New("connection refused"))
+
+		_, err := usecase.DeleteAttachment(ctx, "invoiceID", "attachmentID", 3)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailedで削除しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectAttachment(m)
+
+		_, err := usecase.DeleteAttachment(ctx, "invoiceID", "attachmentID", 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("取得後に他の更新と競合した場合はPreconditionFailedで削除しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestAttachmentUsecase(t, 1024)
+		expectAttachment(m)
+		m.invoiceRepository.EXPECT().UpdateAttachments(mock.Anything, mock.Anything, 3).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice", ID: "invoiceID", Version: 3})
+
+		_, err := usecase.DeleteAttachment(ctx, "invoiceID", "attachmentID", 3)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+}
@@ -3,8 +3,10 @@ package usecase
 import (
 	"context"
 	"errors"
+	"log/slog"
 
 	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
 	"github.com/ijufumi/practice-202512/app/domain/repository"
 	"github.com/ijufumi/practice-202512/app/util"
 
@@ -16,15 +18,19 @@ type AuthUsecase interface {
 	Login(ctx context.Context, email, password string) (string, error)
 }
 
+var errInvalidCredentials = apperror.NewUnauthorized(apperror.CodeInvalidCredentials, "invalid email or password")
+
 type authUsecase struct {
 	userRepository repository.UserRepository
 	config         *config.Config
 }
 
 func NewAuthUsecase(userRepository repository.UserRepository, cfg *config.Config) AuthUsecase {
-	return &authUsecase{
-		userRepository: userRepository,
-		config:         cfg,
+	return &tracedAuthUsecase{
+		next: &authUsecase{
+			userRepository: userRepository,
+			config:         cfg,
+		},
 	}
 }
 
@@ -37,20 +43,26 @@ func (u *authUsecase) Login(ctx context.Context, email, password string) (string
 	user, err := u.userRepository.FindByEmail(db, email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return "", errors.New("invalid email or password")
+			slog.WarnContext(ctx, "login failed", slog.String("reason", "user not found"))
+			return "", errInvalidCredentials
 		}
 
 		return "", err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
-		return "", errors.New("invalid email or password")
+	_, span := startSpan(ctx, "bcrypt.CompareHashAndPassword")
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	span.End()
+	if err != nil {
+		slog.WarnContext(ctx, "login failed", slog.String("reason", "password mismatch"), slog.Any("user", user))
+		return "", errInvalidCredentials
 	}
 
 	token, err := util.GenerateJWT(user.ID, u.config.JWTSecret)
 	if err != nil {
 		return "", err
 	}
+	slog.InfoContext(ctx, "user logged in", slog.Any("user", user))
 
 	return token, nil
 }
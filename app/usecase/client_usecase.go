@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+type ClientUsecase interface {
+	GetClient(ctx context.Context, clientID string) (*models.ClientDetail, error)
+	// DeleteClient は version が現在のバージョンと一致する場合だけ削除します
+	DeleteClient(ctx context.Context, clientID string, version int) error
+}
+
+var (
+	errClientNotFound    = apperror.NewNotFound(apperror.CodeNotFound, "client not found")
+	errClientHasInvoices = apperror.NewConflict(apperror.CodeConflict, "client has invoices")
+)
+
+type clientUsecase struct {
+	clientRepository            repository.ClientRepository
+	clientBankAccountRepository repository.ClientBankAccountRepository
+	invoiceRepository           repository.InvoiceRepository
+	userRepository              repository.UserRepository
+	now                         func() time.Time
+}
+
+func NewClientUsecase(clientRepository repository.ClientRepository, clientBankAccountRepository repository.ClientBankAccountRepository, invoiceRepository repository.InvoiceRepository, userRepository repository.UserRepository) ClientUsecase {
+	return &tracedClientUsecase{
+		next: &clientUsecase{
+			clientRepository:            clientRepository,
+			clientBankAccountRepository: clientBankAccountRepository,
+			invoiceRepository:           invoiceRepository,
+			userRepository:              userRepository,
+			now:                         time.Now,
+		},
+	}
+}
+
+// GetClient はログインユーザーの会社の取引先と銀行口座を返します。
+// 他社の取引先は存在を明かさないよう、存在しない場合と同じエラーにします
+func (u *clientUsecase) GetClient(ctx context.Context, clientID string) (*models.ClientDetail, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := u.clientRepository.FindByID(db, clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errClientNotFound
+		}
+		return nil, err
+	}
+	if client.CompanyID != user.CompanyID {
+		return nil, errClientNotFound
+	}
+
+	bankAccounts, err := u.clientBankAccountRepository.FindByClientID(db, client.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ClientDetail{
+		Client:       client,
+		BankAccounts: bankAccounts,
+	}, nil
+}
+
+// DeleteClient はログインユーザーの会社の取引先と、その銀行口座を論理削除します。
+// 削除していない請求書がある取引先は削除できません
+func (u *clientUsecase) DeleteClient(ctx context.Context, clientID string, version int) error {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return err
+	}
+
+	client, err := u.clientRepository.FindByID(db, clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errClientNotFound
+		}
+		return err
+	}
+	if client.CompanyID != user.CompanyID {
+		return errClientNotFound
+	}
+	if err := checkVersion(client.Version, version); err != nil {
+		return err
+	}
+
+	invoiceCount, err := u.invoiceRepository.Count(db, &models.InvoiceSearchCondition{CompanyID: user.CompanyID, ClientID: client.ID})
+	if err != nil {
+		return err
+	}
+	if invoiceCount > 0 {
+		return errClientHasInvoices
+	}
+
+	// 取引先と銀行口座を同じ日時で削除し、復元時に一緒に削除した口座を判別できるようにする
+	deletedAt := u.now()
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		if err := u.clientRepository.Delete(tx, client.ID, version, deletedAt); err != nil {
+			return versionConflictError(err)
+		}
+
+		return u.clientBankAccountRepository.DeleteByClientID(tx, client.ID, deletedAt)
+	}); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "client deleted",
+		slog.String("client_id", client.ID),
+		slog.String("company_id", client.CompanyID),
+	)
+
+	return nil
+}
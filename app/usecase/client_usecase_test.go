@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupClientUsecaseContext(t *testing.T) context.Context {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	ctx := util.SetDB(context.Background(), db)
+	return util.SetUserID(ctx, "userID")
+}
+
+func TestClientUsecase_GetClient(t *testing.T) {
+	user := &models.User{
+		ID:        "userID",
+		CompanyID: "companyID",
+	}
+
+	t.Run("取引先と銀行口座を取得", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockClientBankAccountRepository := repository.NewMockClientBankAccountRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		client := &models.Client{ID: "clientID", CompanyID: user.CompanyID}
+		accounts := []*models.ClientBankAccount{{ID: "accountID", ClientID: client.ID, AccountNumber: "1234567"}}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, client.ID).Return(client, nil)
+		mockClientBankAccountRepository.EXPECT().FindByClientID(mock.Anything, client.ID).Return(accounts, nil)
+
+		usecase := NewClientUsecase(mockClientRepository, mockClientBankAccountRepository, repository.NewMockInvoiceRepository(t), mockUserRepository)
+		detail, err := usecase.GetClient(ctx, client.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, client, detail.Client)
+		assert.Equal(t, accounts, detail.BankAccounts)
+	})
+
+	t.Run("取引先が存在しない場合はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, "unknown").Return(nil, gorm.ErrRecordNotFound)
+
+		usecase := NewClientUsecase(mockClientRepository, repository.NewMockClientBankAccountRepository(t), repository.NewMockInvoiceRepository(t), mockUserRepository)
+		detail, err := usecase.GetClient(ctx, "unknown")
+
+		assert.Nil(t, detail)
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+
+	t.Run("他社の取引先はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, "clientID").
+			Return(&models.Client{ID: "clientID", CompanyID: "otherCompanyID"}, nil)
+
+		usecase := NewClientUsecase(mockClientRepository, repository.NewMockClientBankAccountRepository(t), repository.NewMockInvoiceRepository(t), mockUserRepository)
+		detail, err := usecase.GetClient(ctx, "clientID")
+
+		assert.Nil(t, detail)
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
+
+func TestClientUsecase_DeleteClient(t *testing.T) {
+	user := &models.User{
+		ID:        "userID",
+		CompanyID: "companyID",
+	}
+	client := &models.Client{ID: "clientID", CompanyID: user.CompanyID, Version: 2}
+
+	t.Run("取引先と銀行口座を同じ日時で論理削除", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockClientBankAccountRepository := repository.NewMockClientBankAccountRepository(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		deletedAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, client.ID).Return(client, nil)
+		mockInvoiceRepository.EXPECT().Count(mock.Anything, &models.InvoiceSearchCondition{CompanyID: user.CompanyID, ClientID: client.ID}).Return(0, nil)
+		mockClientRepository.EXPECT().Delete(mock.Anything, client.ID, 2, deletedAt).Return(nil)
+		mockClientBankAccountRepository.EXPECT().DeleteByClientID(mock.Anything, client.ID, deletedAt).Return(nil)
+
+		usecase := &clientUsecase{
+			clientRepository:            mockClientRepository,
+			clientBankAccountRepository: mockClientBankAccountRepository,
+			invoiceRepository:           mockInvoiceRepository,
+			userRepository:              mockUserRepository,
+			now:                         func() time.Time { return deletedAt },
+		}
+		err := usecase.DeleteClient(ctx, client.ID, 2)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("請求書がある取引先はConflict", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, client.ID).Return(client, nil)
+		mockInvoiceRepository.EXPECT().Count(mock.Anything, mock.Anything).Return(1, nil)
+
+		usecase := NewClientUsecase(mockClientRepository, repository.NewMockClientBankAccountRepository(t), mockInvoiceRepository, mockUserRepository)
+		err := usecase.DeleteClient(ctx, client.ID, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindConflict, appErr.Kind)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, client.ID).Return(client, nil)
+
+		usecase := NewClientUsecase(mockClientRepository, repository.NewMockClientBankAccountRepository(t), repository.NewMockInvoiceRepository(t), mockUserRepository)
+		err := usecase.DeleteClient(ctx, client.ID, 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+}
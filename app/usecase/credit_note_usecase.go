@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+type CreditNoteUsecase interface {
+	// IssueCreditNote は請求書に返品・値引きを発行し、減額を反映した請求書を返します。
+	// version が請求書の現在のバージョンと一致する場合だけ発行します
+	IssueCreditNote(ctx context.Context, invoiceID string, creditNote *models.CreditNote, version int) (*models.Invoice, error)
+	ListCreditNotes(ctx context.Context, invoiceID string) ([]*models.CreditNote, error)
+}
+
+// minCreditPaymentAmount は減額できる金額の下限です
+var minCreditPaymentAmount = decimal.NewFromInt(1)
+
+type creditNoteUsecase struct {
+	invoiceRepository    repository.InvoiceRepository
+	creditNoteRepository repository.CreditNoteRepository
+	userRepository       repository.UserRepository
+	now                  func() time.Time
+}
+
+func NewCreditNoteUsecase(invoiceRepository repository.InvoiceRepository, creditNoteRepository repository.CreditNoteRepository, userRepository repository.UserRepository) CreditNoteUsecase {
+	return &tracedCreditNoteUsecase{
+		next: &creditNoteUsecase{
+			invoiceRepository:    invoiceRepository,
+			creditNoteRepository: creditNoteRepository,
+			userRepository:       userRepository,
+			now:                  time.Now,
+		},
+	}
+}
+
+// IssueCreditNote は元の請求書を変更せずに、手数料・消費税を含めた減額が未払いの残高を超えない返品・値引きだけを発行します。
+// 手数料・消費税は元の請求書の手数料率・税率で計算します
+func (u *creditNoteUsecase) IssueCreditNote(ctx context.Context, invoiceID string, creditNote *models.CreditNote, version int) (*models.Invoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return nil, err
+	}
+
+	if creditNote.PaymentAmount.LessThan(minCreditPaymentAmount) {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMin, Param: minCreditPaymentAmount.String()})
+	}
+	if creditNote.IssueDate.Before(invoice.IssueDate) {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "issue_date", Code: apperror.FieldCodeInvalidValue})
+	}
+	if maxAmount := models.MaxCreditPaymentAmount(invoice); creditNote.PaymentAmount.GreaterThan(maxAmount) {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMax, Param: maxAmount.String()})
+	}
+
+	creditNote.CompanyID = invoice.CompanyID
+	creditNote.InvoiceID = invoice.ID
+	creditNote.CalculateAmounts(invoice)
+	invoice.ApplyCreditNote(creditNote)
+	invoice.UpdatedAt = u.now()
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		// 同時に発行した返品・値引きや支払で残高を超えないよう、請求書のバージョンを進めてから発行する
+		if err := u.invoiceRepository.UpdateCredit(tx, invoice, version); err != nil {
+			return versionConflictError(err)
+		}
+
+		return u.creditNoteRepository.Create(tx, creditNote)
+	}); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "credit note issued",
+		slog.String("credit_note_id", creditNote.ID),
+		slog.String("invoice_id", invoice.ID),
+		slog.String("company_id", invoice.CompanyID),
+		slog.String("reason", string(creditNote.Reason)),
+	)
+
+	return invoice, nil
+}
+
+// ListCreditNotes はログインユーザーの会社の請求書の返品・値引きを発行日の順に返します
+func (u *creditNoteUsecase) ListCreditNotes(ctx context.Context, invoiceID string) ([]*models.CreditNote, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.creditNoteRepository.FindByInvoiceID(db, invoice.ID)
+}
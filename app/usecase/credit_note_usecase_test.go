@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type creditNoteMocks struct {
+	invoiceRepository    *repository.MockInvoiceRepository
+	creditNoteRepository *repository.MockCreditNoteRepository
+	userRepository       *repository.MockUserRepository
+}
+
+func newTestCreditNoteUsecase(t *testing.T, now time.Time) (*creditNoteUsecase, *creditNoteMocks) {
+	m := &creditNoteMocks{
+		invoiceRepository:    repository.NewMockInvoiceRepository(t),
+		creditNoteRepository: repository.NewMockCreditNoteRepository(t),
+		userRepository:       repository.NewMockUserRepository(t),
+	}
+
+	return &creditNoteUsecase{
+		invoiceRepository:    m.invoiceRepository,
+		creditNoteRepository: m.creditNoteRepository,
+		userRepository:       m.userRepository,
+		now:                  func() time.Time { return now },
+	}, m
+}
+
+func TestCreditNoteUsecase_IssueCreditNote(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	now := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+	newInvoice := func() *models.Invoice {
+		return &models.Invoice{
+			ID:            "invoiceID",
+			CompanyID:     user.CompanyID,
+			IssueDate:     time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			PaymentAmount: decimal.NewFromInt(100000),
+			Fee:           decimal.NewFromInt(4000),
+			FeeRate:       decimal.NewFromFloat(0.04),
+			Tax:           decimal.NewFromInt(400),
+			TaxRate:       decimal.NewFromFloat(0.10),
+			InvoiceAmount: decimal.NewFromInt(104400),
+			Status:        value.InvoiceStatusProcessed,
+			Version:       2,
+		}
+	}
+	newCreditNote := func(paymentAmount int64) *models.CreditNote {
+		return &models.CreditNote{
+			IssueDate:     time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+			Reason:        value.CreditNoteReasonReturn,
+			Note:          "検品不良による返品",
+			PaymentAmount: decimal.NewFromInt(paymentAmount),
+		}
+	}
+
+	t.Run("元の請求書の手数料率・税率で減額を計算して反映する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestCreditNoteUsecase(t, now)
+		creditNote := newCreditNote(10000)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceRepository.EXPECT().UpdateCredit(mock.Anything, mock.MatchedBy(func(invoice *models.Invoice) bool {
+			return invoice.CreditedAmount.Equal(decimal.NewFromInt(10440)) && invoice.UpdatedAt.Equal(now)
+		}), 2).Run(func(_ *gorm.DB, invoice *models.Invoice, version int) { invoice.Version = version + 1 }).Return(nil)
+		m.creditNoteRepository.EXPECT().Create(mock.Anything, creditNote).Return(nil)
+
+		invoice, err := usecase.IssueCreditNote(ctx, "invoiceID", creditNote, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "invoiceID", creditNote.InvoiceID)
+		assert.Equal(t, user.CompanyID, creditNote.CompanyID)
+		assert.True(t, decimal.NewFromInt(400).Equal(creditNote.Fee))
+		assert.True(t, decimal.NewFromInt(40).Equal(creditNote.Tax))
+		assert.True(t, decimal.NewFromInt(10440).Equal(creditNote.CreditAmount))
+		// 元の請求書の金額は変更しない
+		assert.True(t, decimal.NewFromInt(104400).Equal(invoice.InvoiceAmount))
+		assert.True(t, decimal.NewFromInt(93960).Equal(invoice.NetAmount()))
+		assert.True(t, decimal.NewFromInt(93960).Equal(invoice.OutstandingAmount()))
+		// 支払がないため処理の状況を表すステータスのまま
+		assert.Equal(t, value.InvoiceStatusProcessed, invoice.Status)
+		assert.Equal(t, 3, invoice.Version)
+	})
+
+	t.Run("減額で残高がなくなった場合は支払済にする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestCreditNoteUsecase(t, now)
+		current := newInvoice()
+		current.PaidAmount = decimal.NewFromInt(60000)
+		current.Status = value.InvoiceStatusPartiallyPaid
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(current, nil)
+		m.invoiceRepository.EXPECT().UpdateCredit(mock.Anything, mock.Anything, 2).Return(nil)
+		m.creditNoteRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+		// 42529 + 手数料 1701 + 消費税 170 = 残高 44400
+		invoice, err := usecase.IssueCreditNote(ctx, "invoiceID", newCreditNote(42529), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusPaid, invoice.Status)
+		assert.True(t, invoice.OutstandingAmount().IsZero())
+	})
+
+	t.Run("入力が不正な場合は発行しない", func(t *testing.T) {
+		partiallyPaid := newInvoice()
+		partiallyPaid.PaidAmount = decimal.NewFromInt(60000)
+		beforeIssue := newCreditNote(1000)
+		beforeIssue.IssueDate = time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+
+		tests := []struct {
+			name       string
+			invoice    *models.Invoice
+			creditNote *models.CreditNote
+			expected   apperror.FieldError
+		}{
+			{"0以下の減額", newInvoice(), newCreditNote(0), apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMin, Param: "1"}},
+			{"支払金額を超える減額", newInvoice(), newCreditNote(100001), apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMax, Param: "100000"}},
+			{"手数料・消費税を含めて残高を超える減額", partiallyPaid, newCreditNote(42530), apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMax, Param: "42529"}},
+			{"元の請求書の発行日より前", newInvoice(), beforeIssue, apperror.FieldError{Field: "issue_date", Code: apperror.FieldCodeInvalidValue}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := setupClientUsecaseContext(t)
+				usecase, m := newTestCreditNoteUsecase(t, now)
+
+				m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+				m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(tt.invoice, nil)
+
+				invoice, err := usecase.IssueCreditNote(ctx, "invoiceID", tt.creditNote, 2)
+
+				assert.Nil(t, invoice)
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, apperror.KindValidation, appErr.Kind)
+				assert.Equal(t, []apperror.FieldError{tt.expected}, appErr.Fields)
+			})
+		}
+	})
+
+	t.Run("取得後に他の更新と競合した場合はPreconditionFailedで発行しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestCreditNoteUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceRepository.EXPECT().UpdateCredit(mock.Anything, mock.Anything, 2).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice", ID: "invoiceID", Version: 2})
+
+		_, err := usecase.IssueCreditNote(ctx, "invoiceID", newCreditNote(1000), 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("発行に失敗した場合はエラー", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestCreditNoteUsecase(t, now)
+		createErr := errors.New("insert failed")
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceRepository.EXPECT().UpdateCredit(mock.Anything, mock.Anything, 2).Return(nil)
+		m.creditNoteRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(createErr)
+
+		_, err := usecase.IssueCreditNote(ctx, "invoiceID", newCreditNote(1000), 2)
+
+		assert.ErrorIs(t, err, createErr)
+	})
+
+	t.Run("他社の請求書はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestCreditNoteUsecase(t, now)
+		other := newInvoice()
+		other.CompanyID = "otherCompanyID"
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(other, nil)
+
+		_, err := usecase.IssueCreditNote(ctx, "invoiceID", newCreditNote(1000), 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
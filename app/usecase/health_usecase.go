@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/util"
+)
+
+type HealthUsecase interface {
+	CheckReadiness(ctx context.Context) error
+}
+
+var errNotReady = apperror.NewUnavailable(apperror.CodeServiceUnavailable, "service is not ready")
+
+type healthUsecase struct {
+	healthRepository repository.HealthRepository
+}
+
+func NewHealthUsecase(healthRepository repository.HealthRepository) HealthUsecase {
+	return &healthUsecase{
+		healthRepository: healthRepository,
+	}
+}
+
+// CheckReadiness はDBに接続でき、マイグレーションが適用済みかを確認します
+func (u *healthUsecase) CheckReadiness(ctx context.Context) error {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return errNotReady.Wrap(err)
+	}
+
+	if err := u.healthRepository.Ping(db); err != nil {
+		return errNotReady.Wrap(err)
+	}
+
+	if err := u.healthRepository.CheckMigrations(db); err != nil {
+		return errNotReady.Wrap(err)
+	}
+
+	return nil
+}
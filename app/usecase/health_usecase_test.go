@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHealthUsecase_CheckReadiness(t *testing.T) {
+	t.Run("準備完了", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		mockRepo := repository.NewMockHealthRepository(t)
+		mockRepo.On("Ping", mock.Anything).Return(nil)
+		mockRepo.On("CheckMigrations", mock.Anything).Return(nil)
+
+		usecase := NewHealthUsecase(mockRepo)
+		err := usecase.CheckReadiness(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("DBに接続できない場合はエラー", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		mockRepo := repository.NewMockHealthRepository(t)
+		mockRepo.On("Ping", mock.Anything).Return(errors.New("connection refused"))
+
+		usecase := NewHealthUsecase(mockRepo)
+		err := usecase.CheckReadiness(ctx)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindUnavailable))
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+
+	t.Run("マイグレーション未適用の場合はエラー", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		mockRepo := repository.NewMockHealthRepository(t)
+		mockRepo.On("Ping", mock.Anything).Return(nil)
+		mockRepo.On("CheckMigrations", mock.Anything).Return(errors.New("table invoices is not migrated"))
+
+		usecase := NewHealthUsecase(mockRepo)
+		err := usecase.CheckReadiness(ctx)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindUnavailable))
+	})
+
+	t.Run("コンテキストにDBがない場合はエラー", func(t *testing.T) {
+		mockRepo := repository.NewMockHealthRepository(t)
+
+		usecase := NewHealthUsecase(mockRepo)
+		err := usecase.CheckReadiness(context.Background())
+
+		assert.True(t, apperror.IsKind(err, apperror.KindUnavailable))
+	})
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"github.com/ijufumi/practice-202512/app/domain/models"
+)
+
+// InvoiceMetrics は請求書に関する業務メトリクスを記録します
+type InvoiceMetrics interface {
+	InvoiceCreated(invoice *models.Invoice)
+}
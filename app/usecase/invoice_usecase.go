@@ -2,41 +2,93 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/calendar"
 	"github.com/ijufumi/practice-202512/app/domain/models"
 	"github.com/ijufumi/practice-202512/app/domain/repository"
 	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/util"
 	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
 )
 
 type InvoiceUsecase interface {
-	CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount decimal.Decimal, paymentDueDate time.Time) (*models.Invoice, error)
-	GetInvoicesByPaymentDueDateRange(ctx context.Context, startDate, endDate *time.Time, offset, limit int) ([]*models.Invoice, error)
+	// CreateInvoice は paymentAmount の通貨で請求書を作成します。lines を指定した場合は paymentAmount の金額を指定せず、支払金額を明細から計算します。
+	// 重複の疑いのある請求書がある場合は force を指定しない限り作成せず、疑いのある請求書の ID を返します。
+	// ctx に util.AfterCommit がある場合、作成のメトリクスとログは呼び出し元がコミットした後の Run で記録します
+	CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount value.Money, paymentDueDate time.Time, lines []*models.InvoiceLine, force bool) (*models.Invoice, error)
+	SearchInvoices(ctx context.Context, condition *models.InvoiceSearchCondition) (*models.InvoicePage, error)
+	GetInvoice(ctx context.Context, invoiceID string) (*models.Invoice, error)
+	// GetInvoiceByNumber はログインユーザーの会社の請求書を請求書番号で探し、明細とともに返します
+	GetInvoiceByNumber(ctx context.Context, invoiceNumber string) (*models.Invoice, error)
+	// DeleteInvoice は version が現在のバージョンと一致する場合だけ削除します
+	DeleteInvoice(ctx context.Context, invoiceID string, version int) error
+	// UpdateRetentionClass は削除した後の保存期間の区分を変更します（管理者のみ）。
+	// version が現在のバージョンと一致する場合だけ変更します
+	UpdateRetentionClass(ctx context.Context, invoiceID string, retentionClass value.RetentionClass, version int) (*models.Invoice, error)
+	// GetNumbering はログインユーザーの会社の請求書番号の振り方を、バージョンと合わせて返します（管理者のみ）
+	GetNumbering(ctx context.Context) (models.InvoiceNumbering, error)
+	// UpdateNumbering はログインユーザーの会社の請求書番号の振り方を変更します（管理者のみ）。
+	// version が振り方の現在のバージョンと一致する場合だけ変更します。
+	// 変更は以後に作成する請求書から適用し、同じ会計年度の連番は書式を変えても続きから振ります
+	UpdateNumbering(ctx context.Context, numbering models.InvoiceNumbering, version int) (models.InvoiceNumbering, error)
 }
 
+var errInvoiceNotFound = apperror.NewNotFound(apperror.CodeNotFound, "invoice not found")
+
 type invoiceUsecase struct {
-	invoiceRepository repository.InvoiceRepository
-	userRepository    repository.UserRepository
-	config            *config.Config
+	invoiceRepository               repository.InvoiceRepository
+	invoiceLineRepository           repository.InvoiceLineRepository
+	invoiceNumberSequenceRepository repository.InvoiceNumberSequenceRepository
+	userRepository                  repository.UserRepository
+	companyRepository               repository.CompanyRepository
+	clientRepository                repository.ClientRepository
+	exchangeRateRepository          repository.ExchangeRateRepository
+	approvalRuleRepository          repository.ApprovalRuleRepository
+	invoiceApprovalRepository       repository.InvoiceApprovalRepository
+	businessCalendar                *calendar.Calendar
+	invoiceMetrics                  InvoiceMetrics
+	config                          *config.Config
+	now                             func() time.Time
 }
 
-func NewInvoiceUsecase(invoiceRepository repository.InvoiceRepository, userRepository repository.UserRepository) InvoiceUsecase {
-	return &invoiceUsecase{
-		invoiceRepository: invoiceRepository,
-		userRepository:    userRepository,
-		config:            config.Load(),
+func NewInvoiceUsecase(invoiceRepository repository.InvoiceRepository, invoiceLineRepository repository.InvoiceLineRepository, invoiceNumberSequenceRepository repository.InvoiceNumberSequenceRepository, userRepository repository.UserRepository, companyRepository repository.CompanyRepository, clientRepository repository.ClientRepository, exchangeRateRepository repository.ExchangeRateRepository, approvalRuleRepository repository.ApprovalRuleRepository, invoiceApprovalRepository repository.InvoiceApprovalRepository, businessCalendar *calendar.Calendar, invoiceMetrics InvoiceMetrics, cfg *config.Config) InvoiceUsecase {
+	return &tracedInvoiceUsecase{
+		next: &invoiceUsecase{
+			invoiceRepository:               invoiceRepository,
+			invoiceLineRepository:           invoiceLineRepository,
+			invoiceNumberSequenceRepository: invoiceNumberSequenceRepository,
+			userRepository:                  userRepository,
+			companyRepository:               companyRepository,
+			clientRepository:                clientRepository,
+			exchangeRateRepository:          exchangeRateRepository,
+			approvalRuleRepository:          approvalRuleRepository,
+			invoiceApprovalRepository:       invoiceApprovalRepository,
+			businessCalendar:                businessCalendar,
+			invoiceMetrics:                  invoiceMetrics,
+			config:                          cfg,
+			now:                             time.Now,
+		},
 	}
 }
 
-func (u *invoiceUsecase) CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount decimal.Decimal, paymentDueDate time.Time) (*models.Invoice, error) {
+func (u *invoiceUsecase) CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount value.Money, paymentDueDate time.Time, lines []*models.InvoiceLine, force bool) (*models.Invoice, error) {
 	db, err := util.GetDB(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := validateInvoiceAmount(paymentAmount, lines); err != nil {
+		return nil, err
+	}
+
 	userID, err := util.GetUserID(ctx)
 	if err != nil {
 		return nil, err
@@ -45,41 +97,519 @@ func (u *invoiceUsecase) CreateInvoice(ctx context.Context, clientID string, iss
 	if err != nil {
 		return nil, err
 	}
+	company, err := u.companyRepository.FindByID(db, user.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := u.clientRepository.FindByID(db, clientID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if client == nil || client.CompanyID != user.CompanyID {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "client_id", Code: apperror.FieldCodeInvalidValue})
+	}
+	// 源泉徴収の税額は円で定められているため、源泉徴収する取引先の請求書は円建てに限る
+	if client.IsWithholdingTarget() && paymentAmount.Currency != value.CurrencyJPY {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "currency", Code: apperror.FieldCodeInvalidValue})
+	}
+	exchangeRate, err := u.findExchangeRate(db, paymentAmount.Currency, issueDate)
+	if err != nil {
+		return nil, err
+	}
 
 	invoice := &models.Invoice{
 		CompanyID:      user.CompanyID,
 		ClientID:       clientID,
 		IssueDate:      issueDate,
-		PaymentAmount:  paymentAmount,
+		Currency:       paymentAmount.Currency,
+		PaymentAmount:  paymentAmount.Amount,
 		PaymentDueDate: paymentDueDate,
 		Status:         value.InvoiceStatusUnprocessed,
+		RetentionClass: value.RetentionClassStandard,
+		CreatedBy:      user.ID,
+	}
+	if len(lines) > 0 {
+		invoice.ApplyLines(lines)
+		// 単価がすべて0円の明細だけでは請求できない
+		if !invoice.PaymentAmount.IsPositive() {
+			return nil, apperror.NewValidation(apperror.FieldError{Field: "lines", Code: apperror.FieldCodeMin, Param: "1"})
+		}
+	}
+	if err := u.checkDuplicate(db, invoice, force); err != nil {
+		return nil, err
 	}
+	u.adjustDueDate(invoice, company.DueDatePolicy)
 
 	// domain/models の計算メソッドを使用
+	invoice.ApplyExchangeRate(exchangeRate)
 	invoice.CalculateFee(u.config.FeeRate)
 	invoice.CalculateTax(u.config.TaxRate)
 	invoice.CalculateInvoiceAmount()
+	invoice.CalculateWithholdingTax(client)
 
-	if err := u.invoiceRepository.Create(db, invoice); err != nil {
+	// 承認フローの下限以上の請求書は、すべての段階で承認されるまで支払えない
+	rules, err := u.approvalRuleRepository.FindByCompanyID(db, user.CompanyID)
+	if err != nil {
 		return nil, err
 	}
+	approvals := models.NewInvoiceApprovals(rules, invoice)
+	if len(approvals) > 0 {
+		invoice.Status = value.InvoiceStatusPendingApproval
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		// SQLite で書き込みのロックを待てるよう、採番はトランザクションの最初の文にする
+		if err := u.assignNumber(tx, invoice, company.InvoiceNumbering); err != nil {
+			return err
+		}
+		if err := u.invoiceRepository.Create(tx, invoice); err != nil {
+			return err
+		}
+		if len(invoice.Lines) > 0 {
+			for _, line := range invoice.Lines {
+				line.InvoiceID = invoice.ID
+			}
+			if err := u.invoiceLineRepository.CreateAll(tx, invoice.Lines); err != nil {
+				return err
+			}
+		}
+		if len(approvals) == 0 {
+			return nil
+		}
+		for _, approval := range approvals {
+			approval.InvoiceID = invoice.ID
+		}
+
+		return u.invoiceApprovalRepository.CreateAll(tx, approvals)
+	}); err != nil {
+		return nil, err
+	}
+	// 呼び出し元のトランザクションの中で作成した場合は、コミットした後に記録する
+	util.OnCommit(ctx, func() {
+		u.invoiceMetrics.InvoiceCreated(invoice)
+		slog.InfoContext(ctx, "invoice created",
+			slog.String("invoice_id", invoice.ID),
+			slog.String("invoice_number", *invoice.InvoiceNumber),
+			slog.String("company_id", invoice.CompanyID),
+			slog.String("currency", string(invoice.Currency)),
+			slog.Int("lines", len(invoice.Lines)),
+			slog.Int("approval_steps", len(approvals)),
+			slog.String("duplicate_check", string(invoice.DuplicateCheck)),
+		)
+	})
 
 	return invoice, nil
 }
 
-func (u *invoiceUsecase) GetInvoicesByPaymentDueDateRange(ctx context.Context, startDate, endDate *time.Time, offset, limit int) ([]*models.Invoice, error) {
+// assignNumber は発行日の会計年度の次の連番で請求書番号を振ります。
+// 連番は tx と一緒にコミットされるため、作成に失敗した請求書の番号は欠番にならず次の請求書に振られます
+func (u *invoiceUsecase) assignNumber(tx *gorm.DB, invoice *models.Invoice, numbering models.InvoiceNumbering) error {
+	fiscalYear := numbering.FiscalYear(invoice.IssueDate)
+	seq, err := u.invoiceNumberSequenceRepository.Next(tx, invoice.CompanyID, fiscalYear)
+	if err != nil {
+		return err
+	}
+	number := numbering.Number(fiscalYear, seq)
+	invoice.InvoiceNumber = &number
+
+	return nil
+}
+
+// checkDuplicate は同じ取引先の請求書から重複の疑いのあるものを探し、確認の結果を請求書に記録します。
+// 疑いのある請求書があり force を指定していない場合は、疑いのある請求書ごとのフィールドエラーを持つ競合エラーを返します
+func (u *invoiceUsecase) checkDuplicate(db *gorm.DB, invoice *models.Invoice, force bool) error {
+	rule := models.DuplicateRule{
+		WindowDays:      u.config.DuplicateInvoiceWindowDays,
+		AmountTolerance: u.config.DuplicateInvoiceAmountTolerance,
+	}
+	// 取引先・通貨・発行日・支払金額の絞り込みはデータベースで行い、件数を制限せずに全ての候補を確認する
+	candidates, err := u.invoiceRepository.FindDuplicateCandidates(db, invoice, rule)
+	if err != nil {
+		return err
+	}
+
+	suspects := rule.SuspectedDuplicates(invoice, candidates)
+	if len(suspects) == 0 {
+		invoice.DuplicateCheck = value.DuplicateCheckClear
+		return nil
+	}
+	if !force {
+		fields := make([]apperror.FieldError, len(suspects))
+		for i, suspect := range suspects {
+			fields[i] = apperror.FieldError{Field: "force", Code: apperror.FieldCodeDuplicate, Param: suspect.ID}
+		}
+		duplicateErr := apperror.NewConflict(apperror.CodeDuplicateInvoiceSuspected, "invoice may be a duplicate")
+		duplicateErr.Fields = fields
+
+		return duplicateErr
+	}
+
+	invoice.DuplicateCheck = value.DuplicateCheckOverridden
+	invoice.SuspectedDuplicateID = &suspects[0].ID
+
+	return nil
+}
+
+// validateInvoiceAmount は通貨と、支払金額と明細のどちらか一方だけが指定されていることと、明細の数量・単価を確認します。
+// decimal は validate タグの min で検証できないためここで確認します
+func validateInvoiceAmount(paymentAmount value.Money, lines []*models.InvoiceLine) error {
+	if !paymentAmount.Currency.IsValid() {
+		return apperror.NewValidation(apperror.FieldError{Field: "currency", Code: apperror.FieldCodeInvalidValue})
+	}
+	if len(lines) == 0 {
+		if !paymentAmount.Amount.IsPositive() {
+			return apperror.NewValidation(apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMin, Param: "1"})
+		}
+		// 円建ては1円単位、ドル・ユーロはセント単位まで
+		if !paymentAmount.HasValidPrecision() {
+			return apperror.NewValidation(apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeInvalidValue})
+		}
+		return nil
+	}
+	if !paymentAmount.Amount.IsZero() {
+		return apperror.NewValidation(apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeInvalidValue})
+	}
+
+	var fields []apperror.FieldError
+	for i, line := range lines {
+		field := func(name string) string { return fmt.Sprintf("lines[%d].%s", i, name) }
+		// 数量は小数点以下3桁、単価は2桁まで保存できる
+		if !line.Quantity.IsPositive() || !line.Quantity.Equal(line.Quantity.Truncate(3)) {
+			fields = append(fields, apperror.FieldError{Field: field("quantity"), Code: apperror.FieldCodeInvalidValue})
+		}
+		if line.UnitPrice.IsNegative() {
+			fields = append(fields, apperror.FieldError{Field: field("unit_price"), Code: apperror.FieldCodeMin, Param: "0"})
+		} else if !line.UnitPrice.Equal(line.UnitPrice.Truncate(2)) {
+			fields = append(fields, apperror.FieldError{Field: field("unit_price"), Code: apperror.FieldCodeInvalidValue})
+		}
+		if !line.TaxCategory.IsValid() {
+			fields = append(fields, apperror.FieldError{Field: field("tax_category"), Code: apperror.FieldCodeInvalidValue})
+		}
+	}
+	if len(fields) > 0 {
+		return apperror.NewValidation(fields...)
+	}
+
+	return nil
+}
+
+// findExchangeRate は発行日以前で最も新しい通貨1単位あたりの円の為替レートを返します。円建ての場合は1です
+func (u *invoiceUsecase) findExchangeRate(db *gorm.DB, currency value.Currency, issueDate time.Time) (decimal.Decimal, error) {
+	if currency == value.CurrencyJPY {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, err := u.exchangeRateRepository.FindLatest(db, currency, issueDate)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 発行日以前のレートを読み込んでいない通貨では作成できない
+			return decimal.Zero, apperror.NewValidation(apperror.FieldError{Field: "currency", Code: apperror.FieldCodeInvalidValue})
+		}
+		return decimal.Zero, err
+	}
+
+	return rate.Rate, nil
+}
+
+// adjustDueDate は銀行の休業日に当たる支払期日を会社の方針に従って営業日に移動します。
+// 前営業日に繰り上げると発行日より前になる場合は翌営業日に繰り下げます
+func (u *invoiceUsecase) adjustDueDate(invoice *models.Invoice, policy value.DueDatePolicy) {
+	requested := invoice.PaymentDueDate
+	adjusted, reason, ok := u.businessCalendar.Adjust(requested, policy)
+	if !ok {
+		return
+	}
+	if adjusted.Before(invoice.IssueDate) {
+		adjusted = u.businessCalendar.NextBusinessDay(requested)
+	}
+
+	invoice.PaymentDueDate = adjusted
+	invoice.DueDateAdjustment = &models.DueDateAdjustment{
+		RequestedDate: requested,
+		Reason:        reason,
+		Policy:        policy,
+	}
+}
+
+func (u *invoiceUsecase) SearchInvoices(ctx context.Context, condition *models.InvoiceSearchCondition) (*models.InvoicePage, error) {
 	db, err := util.GetDB(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 検索条件は呼び出し元と共有しないようコピーして補正する
+	cond := *condition
+	cond.CompanyID = user.CompanyID
+
 	// デフォルト値の設定
-	if offset < 0 {
-		offset = 0
+	if !cond.SortKey.IsValid() {
+		cond.SortKey = value.InvoiceSortKeyPaymentDueDate
+	}
+	if !cond.SortOrder.IsValid() {
+		cond.SortOrder = value.SortOrderAsc
+	}
+	if cond.Offset < 0 {
+		cond.Offset = 0
+	}
+	if cond.Limit <= 0 {
+		cond.Limit = 100
+	}
+	limit := cond.Limit
+
+	totalCount, err := u.invoiceRepository.Count(db, &cond)
+	if err != nil {
+		return nil, err
+	}
+
+	// 次ページの有無を判定するため1件多く取得する
+	cond.Limit = limit + 1
+	invoices, err := u.invoiceRepository.Search(db, &cond)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.InvoicePage{
+		Invoices:   invoices,
+		TotalCount: totalCount,
+	}
+	if len(invoices) > limit {
+		page.Invoices = invoices[:limit]
+		page.NextCursor = page.Invoices[limit-1].CursorAfter(cond.SortKey, cond.SortOrder)
+	}
+
+	return page, nil
+}
+
+// GetInvoice はログインユーザーの会社の請求書を明細とともに返します
+func (u *invoiceUsecase) GetInvoice(ctx context.Context, invoiceID string) (*models.Invoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := u.invoiceLineRepository.FindByInvoiceID(db, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Lines = lines
+
+	return invoice, nil
+}
+
+func (u *invoiceUsecase) GetInvoiceByNumber(ctx context.Context, invoiceNumber string) (*models.Invoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 請求書番号は会社ごとに振るため、ログインユーザーの会社の中だけを探す
+	invoice, err := u.invoiceRepository.FindByNumber(db, user.CompanyID, invoiceNumber)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvoiceNotFound
+		}
+		return nil, err
+	}
+	lines, err := u.invoiceLineRepository.FindByInvoiceID(db, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Lines = lines
+
+	return invoice, nil
+}
+
+// DeleteInvoice はログインユーザーの会社の請求書を論理削除します
+func (u *invoiceUsecase) DeleteInvoice(ctx context.Context, invoiceID string, version int) error {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return err
+	}
+
+	if err := u.invoiceRepository.Delete(db, invoice.ID, version, u.now()); err != nil {
+		return versionConflictError(err)
+	}
+	slog.InfoContext(ctx, "invoice deleted",
+		slog.String("invoice_id", invoice.ID),
+		slog.String("company_id", invoice.CompanyID),
+	)
+
+	return nil
+}
+
+// UpdateRetentionClass はログインユーザーの会社の請求書の保存期間の区分を変更します
+func (u *invoiceUsecase) UpdateRetentionClass(ctx context.Context, invoiceID string, retentionClass value.RetentionClass, version int) (*models.Invoice, error) {
+	if !retentionClass.IsValid() {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "retention_class", Code: apperror.FieldCodeInvalidValue})
+	}
+
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsAdmin() {
+		return nil, errAdminRequired
+	}
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return nil, err
+	}
+
+	invoice.RetentionClass = retentionClass
+	invoice.UpdatedAt = u.now()
+	if err := u.invoiceRepository.UpdateRetentionClass(db, invoice, version); err != nil {
+		return nil, versionConflictError(err)
+	}
+	slog.InfoContext(ctx, "invoice retention class updated",
+		slog.String("invoice_id", invoice.ID),
+		slog.String("company_id", invoice.CompanyID),
+		slog.String("retention_class", string(retentionClass)),
+	)
+
+	return invoice, nil
+}
+
+// findCompanyInvoice はログインユーザーとその会社の請求書を返します
+func findCompanyInvoice(ctx context.Context, db *gorm.DB, userRepository repository.UserRepository, invoiceRepository repository.InvoiceRepository, invoiceID string) (*models.User, *models.Invoice, error) {
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	user, err := userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice, err := findInvoiceOfCompany(db, invoiceRepository, user.CompanyID, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, invoice, nil
+}
+
+// findInvoiceOfCompany は会社の請求書を返します。
+// 他社の請求書は存在を明かさないよう、存在しない場合と同じエラーにします
+func findInvoiceOfCompany(db *gorm.DB, invoiceRepository repository.InvoiceRepository, companyID, invoiceID string) (*models.Invoice, error) {
+	invoice, err := invoiceRepository.FindByID(db, invoiceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvoiceNotFound
+		}
+		return nil, err
+	}
+	if invoice.CompanyID != companyID {
+		return nil, errInvoiceNotFound
+	}
+
+	return invoice, nil
+}
+
+func (u *invoiceUsecase) GetNumbering(ctx context.Context) (models.InvoiceNumbering, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+
+	user, err := u.findAdmin(ctx, db)
+	if err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+	company, err := u.companyRepository.FindByID(db, user.CompanyID)
+	if err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+
+	return company.InvoiceNumbering, nil
+}
+
+func (u *invoiceUsecase) UpdateNumbering(ctx context.Context, numbering models.InvoiceNumbering, version int) (models.InvoiceNumbering, error) {
+	var fields []apperror.FieldError
+	if !numbering.Format.IsValid() {
+		fields = append(fields, apperror.FieldError{Field: "format", Code: apperror.FieldCodeInvalidValue})
+	}
+	if numbering.FiscalYearStartMonth < 1 || numbering.FiscalYearStartMonth > 12 {
+		fields = append(fields, apperror.FieldError{Field: "fiscal_year_start_month", Code: apperror.FieldCodeInvalidValue})
+	}
+	if len(fields) > 0 {
+		return models.InvoiceNumbering{}, apperror.NewValidation(fields...)
+	}
+
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+
+	user, err := u.findAdmin(ctx, db)
+	if err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+	company, err := u.companyRepository.FindByID(db, user.CompanyID)
+	if err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+	if err := checkVersion(company.InvoiceNumbering.Version, version); err != nil {
+		return models.InvoiceNumbering{}, err
+	}
+	if err := u.companyRepository.UpdateInvoiceNumbering(db, user.CompanyID, numbering, version); err != nil {
+		return models.InvoiceNumbering{}, versionConflictError(err)
+	}
+	numbering.Version = version + 1
+	slog.InfoContext(ctx, "invoice numbering updated",
+		slog.String("company_id", user.CompanyID),
+		slog.String("format", string(numbering.Format)),
+		slog.Int("fiscal_year_start_month", numbering.FiscalYearStartMonth),
+	)
+
+	return numbering, nil
+}
+
+func (u *invoiceUsecase) findAdmin(ctx context.Context, db *gorm.DB) (*models.User, error) {
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
 	}
-	if limit <= 0 {
-		limit = 100
+	if !user.IsAdmin() {
+		return nil, errAdminRequired
 	}
 
-	return u.invoiceRepository.FindByPaymentDueDateRange(db, startDate, endDate, offset, limit)
+	return user, nil
 }
@@ -6,9 +6,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/calendar"
 	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
 	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
 	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/usecase/mocks"
 	"github.com/ijufumi/practice-202512/app/util"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -26,10 +31,45 @@ func setupInvoiceUsecaseContext(t *testing.T) (context.Context, *gorm.DB) {
 	return ctx, db
 }
 
+// newNoApprovalRuleRepository は承認フローを設定していない会社の ApprovalRuleRepository を返します
+func newNoApprovalRuleRepository(t *testing.T) *repository.MockApprovalRuleRepository {
+	mockApprovalRuleRepository := repository.NewMockApprovalRuleRepository(t)
+	mockApprovalRuleRepository.EXPECT().FindByCompanyID(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	return mockApprovalRuleRepository
+}
+
+// newNoDuplicateInvoiceRepository は重複の疑いのある請求書のない InvoiceRepository を返します
+func newNoDuplicateInvoiceRepository(t *testing.T) *repository.MockInvoiceRepository {
+	mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+	mockInvoiceRepository.EXPECT().FindDuplicateCandidates(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	return mockInvoiceRepository
+}
+
+// newInvoiceNumberSequenceRepository は会計年度の最初の連番を返す InvoiceNumberSequenceRepository を返します
+func newInvoiceNumberSequenceRepository(t *testing.T) *repository.MockInvoiceNumberSequenceRepository {
+	mockInvoiceNumberSequenceRepository := repository.NewMockInvoiceNumberSequenceRepository(t)
+	mockInvoiceNumberSequenceRepository.EXPECT().Next(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Maybe()
+
+	return mockInvoiceNumberSequenceRepository
+}
+
+// newCorporateClientRepository は会社に登録済みの法人の取引先を返す ClientRepository を返します
+func newCorporateClientRepository(t *testing.T) *repository.MockClientRepository {
+	mockClientRepository := repository.NewMockClientRepository(t)
+	mockClientRepository.EXPECT().FindByID(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ *gorm.DB, clientID string) (*models.Client, error) {
+			return &models.Client{ID: clientID, CompanyID: "companyID", ClientType: value.ClientTypeCorporation}, nil
+		}).Maybe()
+
+	return mockClientRepository
+}
+
 func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 	t.Run("請求書作成成功", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
-		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
 		clientID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
@@ -42,6 +82,9 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 			CompanyID: "companyID",
 		}
 		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
 
 		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
 			// 手数料と消費税の計算確認
@@ -57,9 +100,11 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 				inv.InvoiceAmount.Equal(expectedInvoiceAmount) &&
 				inv.Status == value.InvoiceStatusUnprocessed
 		})).Return(nil)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, value.NewMoney(paymentAmount, value.CurrencyJPY), paymentDueDate, nil, false)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, invoice)
@@ -73,9 +118,33 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 		assert.Equal(t, value.InvoiceStatusUnprocessed, invoice.Status)
 	})
 
+	t.Run("呼び出し元のトランザクションの中で作成した場合はコミットした後にメトリクスを記録する", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		afterCommit := &util.AfterCommit{}
+		ctx = util.SetAfterCommit(ctx, afterCommit)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		user := &models.User{ID: "userID", CompanyID: "companyID"}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "01HQZXFG0PJ9K8QXW7YM1N2ZXC", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), nil, false)
+
+		assert.NoError(t, err)
+		mockInvoiceMetrics.AssertNotCalled(t, "InvoiceCreated", mock.Anything)
+
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(invoice).Return()
+		afterCommit.Run()
+	})
+
 	t.Run("手数料と消費税の計算確認 - 別の金額", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
-		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
 		clientID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
@@ -88,6 +157,9 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 			CompanyID: "companyID",
 		}
 		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
 		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
 			expectedFee := paymentAmount.Mul(decimal.NewFromFloat(0.04))             // 10000
 			expectedTax := expectedFee.Mul(decimal.NewFromFloat(0.10))               // 1000
@@ -97,9 +169,11 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 				inv.Tax.Equal(expectedTax) &&
 				inv.InvoiceAmount.Equal(expectedInvoiceAmount)
 		})).Return(nil)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, value.NewMoney(paymentAmount, value.CurrencyJPY), paymentDueDate, nil, false)
 
 		assert.NoError(t, err)
 		assert.Equal(t, decimal.NewFromInt(10000), invoice.Fee)
@@ -109,7 +183,7 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 
 	t.Run("リポジトリエラー", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
-		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
 		clientID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
@@ -122,21 +196,461 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 			CompanyID: "companyID",
 		}
 		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
 
 		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).
 			Return(errors.New("database error"))
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, value.NewMoney(paymentAmount, value.CurrencyJPY), paymentDueDate, nil, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, "database error", err.Error())
 		assert.Nil(t, invoice)
 	})
 
+	t.Run("休業日の支払期日を会社の方針に従って営業日に移動", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			policy         value.DueDatePolicy
+			issueDate      time.Time
+			paymentDueDate time.Time
+			expected       time.Time
+			reason         string
+		}{
+			{
+				name:           "土曜日を前営業日に繰り上げ",
+				policy:         value.DueDatePolicyPrevious,
+				issueDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				paymentDueDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+				expected:       time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				reason:         "土曜日",
+			},
+			{
+				name:           "年末年始を翌営業日に繰り下げ",
+				policy:         value.DueDatePolicyNext,
+				issueDate:      time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+				paymentDueDate: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+				expected:       time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+				reason:         "年末年始",
+			},
+			{
+				name:           "前営業日が発行日より前になる場合は翌営業日",
+				policy:         value.DueDatePolicyPrevious,
+				issueDate:      time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC),
+				paymentDueDate: time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC),
+				expected:       time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC),
+				reason:         "こどもの日",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx, _ := setupInvoiceUsecaseContext(t)
+				mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+				mockUserRepository := repository.NewMockUserRepository(t)
+				mockCompanyRepository := repository.NewMockCompanyRepository(t)
+				mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+				user := &models.User{ID: "userID", CompanyID: "companyID"}
+				mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+				mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+					Return(&models.Company{ID: user.CompanyID, DueDatePolicy: tt.policy}, nil)
+				mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
+					return inv.PaymentDueDate.Equal(tt.expected)
+				})).Return(nil)
+				mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+				usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+				invoice, err := usecase.CreateInvoice(ctx, "clientID", tt.issueDate, value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), tt.paymentDueDate, nil, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, invoice.PaymentDueDate)
+				assert.Equal(t, &models.DueDateAdjustment{
+					RequestedDate: tt.paymentDueDate,
+					Reason:        tt.reason,
+					Policy:        tt.policy,
+				}, invoice.DueDateAdjustment)
+			})
+		}
+	})
+
+	t.Run("調整しない方針では休業日のまま作成", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		paymentDueDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		user := &models.User{ID: "userID", CompanyID: "companyID"}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), paymentDueDate, nil, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, paymentDueDate, invoice.PaymentDueDate)
+		assert.Nil(t, invoice.DueDateAdjustment)
+	})
+
+	t.Run("承認フローの下限以上の請求書は承認待ちで作成し、必要な段階を記録", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockApprovalRuleRepository := repository.NewMockApprovalRuleRepository(t)
+		mockInvoiceApprovalRepository := repository.NewMockInvoiceApprovalRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		user := &models.User{ID: "userID", CompanyID: "companyID"}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
+		// 請求金額 104,400 円は2段階目の下限に届かない
+		mockApprovalRuleRepository.EXPECT().FindByCompanyID(mock.Anything, user.CompanyID).Return([]*models.ApprovalRule{
+			{Step: 1, MinAmount: decimal.NewFromInt(100000), Role: value.UserRoleApprover},
+			{Step: 2, MinAmount: decimal.NewFromInt(1000000), Role: value.UserRoleAdmin},
+		}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
+			return inv.Status == value.InvoiceStatusPendingApproval && inv.CreatedBy == user.ID
+		})).Run(func(_ *gorm.DB, invoice *models.Invoice) { invoice.ID = "invoiceID" }).Return(nil)
+		mockInvoiceApprovalRepository.EXPECT().CreateAll(mock.Anything, []*models.InvoiceApproval{
+			{InvoiceID: "invoiceID", Step: 1, Role: value.UserRoleApprover, Status: value.ApprovalStatusPending},
+		}).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), mockApprovalRuleRepository, mockInvoiceApprovalRepository, calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusPendingApproval, invoice.Status)
+	})
+
+	t.Run("承認の記録に失敗した場合はエラー", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockApprovalRuleRepository := repository.NewMockApprovalRuleRepository(t)
+		mockInvoiceApprovalRepository := repository.NewMockInvoiceApprovalRepository(t)
+		createErr := errors.New("insert failed")
+
+		user := &models.User{ID: "userID", CompanyID: "companyID"}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
+		mockApprovalRuleRepository.EXPECT().FindByCompanyID(mock.Anything, user.CompanyID).Return([]*models.ApprovalRule{
+			{Step: 1, MinAmount: decimal.Zero, Role: value.UserRoleApprover},
+		}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceApprovalRepository.EXPECT().CreateAll(mock.Anything, mock.Anything).Return(createErr)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), mockApprovalRuleRepository, mockInvoiceApprovalRepository, calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(1000), value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil, false)
+
+		assert.ErrorIs(t, err, createErr)
+		assert.Nil(t, invoice)
+	})
+
+	t.Run("明細から税区分ごとに消費税を計算して支払金額にする", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockInvoiceLineRepository := repository.NewMockInvoiceLineRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+		lines := []*models.InvoiceLine{
+			{Description: "部品", Quantity: decimal.NewFromInt(3), UnitPrice: decimal.RequireFromString("1333.33"), TaxCategory: value.TaxCategoryStandard},
+			{Description: "送料", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(105), TaxCategory: value.TaxCategoryStandard},
+			{Description: "お茶", Quantity: decimal.NewFromInt(24), UnitPrice: decimal.NewFromInt(150), TaxCategory: value.TaxCategoryReduced},
+			{Description: "印紙代", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(200), TaxCategory: value.TaxCategoryExempt},
+		}
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).
+			Run(func(_ *gorm.DB, invoice *models.Invoice) { invoice.ID = "invoiceID" }).Return(nil)
+		mockInvoiceLineRepository.EXPECT().CreateAll(mock.Anything, mock.MatchedBy(func(created []*models.InvoiceLine) bool {
+			return len(created) == 4 && created[0].InvoiceID == "invoiceID" && created[3].LineNo == 4
+		})).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockInvoiceLineRepository, newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.Zero, value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), lines, false)
+
+		assert.NoError(t, err)
+		// 3 × 1333.33 は1円未満を切り捨てて 3999 円
+		assert.True(t, decimal.NewFromInt(3999).Equal(invoice.Lines[0].Amount))
+		taxes := models.SummarizeLineTaxes(invoice.Lines, invoice.Currency)
+		assert.Len(t, taxes, 3)
+		// 標準税率の消費税は明細ごとではなく合計 4104 円に対して計算する（明細ごとでは 399 + 10 = 409 円）
+		assert.True(t, decimal.NewFromInt(4104).Equal(taxes[0].Amount))
+		assert.True(t, decimal.NewFromInt(410).Equal(taxes[0].Tax))
+		assert.True(t, decimal.NewFromInt(288).Equal(taxes[1].Tax))
+		assert.True(t, taxes[2].Tax.IsZero())
+		// 4104 + 410 + 3600 + 288 + 200
+		assert.True(t, decimal.NewFromInt(8602).Equal(invoice.PaymentAmount))
+		assert.True(t, decimal.NewFromInt(344).Equal(invoice.Fee))
+		assert.True(t, decimal.NewFromInt(34).Equal(invoice.Tax))
+		assert.True(t, decimal.NewFromInt(8980).Equal(invoice.InvoiceAmount))
+	})
+
+	t.Run("支払金額と明細が不正な場合は作成しない", func(t *testing.T) {
+		line := func(quantity, unitPrice string, category value.TaxCategory) *models.InvoiceLine {
+			return &models.InvoiceLine{Description: "明細", Quantity: decimal.RequireFromString(quantity), UnitPrice: decimal.RequireFromString(unitPrice), TaxCategory: category}
+		}
+		tests := []struct {
+			name          string
+			paymentAmount decimal.Decimal
+			lines         []*models.InvoiceLine
+			expected      []apperror.FieldError
+		}{
+			{"支払金額も明細もない", decimal.Zero, nil, []apperror.FieldError{{Field: "payment_amount", Code: apperror.FieldCodeMin, Param: "1"}}},
+			{"支払金額と明細の両方", decimal.NewFromInt(1000), []*models.InvoiceLine{line("1", "1000", value.TaxCategoryStandard)}, []apperror.FieldError{{Field: "payment_amount", Code: apperror.FieldCodeInvalidValue}}},
+			{"数量・単価・税区分が不正な明細", decimal.Zero, []*models.InvoiceLine{
+				line("1", "1000", value.TaxCategoryStandard),
+				line("0", "-1", "luxury"),
+				line("0.0001", "0.001", value.TaxCategoryReduced),
+			}, []apperror.FieldError{
+				{Field: "lines[1].quantity", Code: apperror.FieldCodeInvalidValue},
+				{Field: "lines[1].unit_price", Code: apperror.FieldCodeMin, Param: "0"},
+				{Field: "lines[1].tax_category", Code: apperror.FieldCodeInvalidValue},
+				{Field: "lines[2].quantity", Code: apperror.FieldCodeInvalidValue},
+				{Field: "lines[2].unit_price", Code: apperror.FieldCodeInvalidValue},
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx, _ := setupInvoiceUsecaseContext(t)
+
+				usecase := NewInvoiceUsecase(newNoDuplicateInvoiceRepository(t), repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), repository.NewMockUserRepository(t), repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+				invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), value.NewMoney(tt.paymentAmount, value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), tt.lines, false)
+
+				assert.Nil(t, invoice)
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expected, appErr.Fields)
+			})
+		}
+	})
+
+	t.Run("源泉徴収の対象の個人の取引先は支払金額から源泉徴収税額を計算する", func(t *testing.T) {
+		individual := &models.Client{ID: "clientID", CompanyID: "companyID", ClientType: value.ClientTypeIndividual, Withholding: true, WithholdingCategory: value.WithholdingCategoryDesign}
+		tests := []struct {
+			name          string
+			client        *models.Client
+			paymentAmount int64
+			expected      int64
+		}{
+			{"100万円以下は10.21%", individual, 333333, 34033},
+			{"100万円ちょうど", individual, 1000000, 102100},
+			// 102,100 + 500,000 × 20.42%
+			{"100万円を超える部分は20.42%", individual, 1500000, 204200},
+			{"法人の取引先は源泉徴収しない", &models.Client{ID: "clientID", CompanyID: "companyID", ClientType: value.ClientTypeCorporation, Withholding: true}, 100000, 0},
+			{"源泉徴収しない個人の取引先", &models.Client{ID: "clientID", CompanyID: "companyID", ClientType: value.ClientTypeIndividual}, 100000, 0},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx, _ := setupInvoiceUsecaseContext(t)
+				mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+				mockUserRepository := repository.NewMockUserRepository(t)
+				mockCompanyRepository := repository.NewMockCompanyRepository(t)
+				mockClientRepository := repository.NewMockClientRepository(t)
+				mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+				mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+				mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+				mockClientRepository.EXPECT().FindByID(mock.Anything, "clientID").Return(tt.client, nil)
+				mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
+					return inv.WithholdingTax.Equal(decimal.NewFromInt(tt.expected))
+				})).Return(nil)
+				mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+				usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, mockClientRepository, repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+				invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(tt.paymentAmount), value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil, false)
+
+				assert.NoError(t, err)
+				assert.True(t, decimal.NewFromInt(tt.expected).Equal(invoice.WithholdingTax))
+				assert.True(t, decimal.NewFromInt(tt.paymentAmount-tt.expected).Equal(invoice.TransferAmount()))
+				// 手数料と請求金額は源泉徴収の有無で変わらない
+				assert.True(t, invoice.InvoiceAmount.Equal(invoice.PaymentAmount.Add(invoice.Fee).Add(invoice.Tax)))
+			})
+		}
+	})
+
+	t.Run("明細を指定した場合は消費税を除いた明細の合計から源泉徴収する", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockInvoiceLineRepository := repository.NewMockInvoiceLineRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockClientRepository := repository.NewMockClientRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+		lines := []*models.InvoiceLine{
+			{Description: "ロゴデザイン", Quantity: decimal.NewFromInt(2), UnitPrice: decimal.NewFromInt(50000), TaxCategory: value.TaxCategoryStandard},
+		}
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+		mockClientRepository.EXPECT().FindByID(mock.Anything, "clientID").
+			Return(&models.Client{ID: "clientID", CompanyID: "companyID", ClientType: value.ClientTypeIndividual, Withholding: true, WithholdingCategory: value.WithholdingCategoryDesign}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceLineRepository.EXPECT().CreateAll(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockInvoiceLineRepository, newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, mockClientRepository, repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.Zero, value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), lines, false)
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(110000).Equal(invoice.PaymentAmount))
+		// 消費税 10,000 円を除いた 100,000 円の10.21%
+		assert.True(t, decimal.NewFromInt(10210).Equal(invoice.WithholdingTax))
+		assert.True(t, decimal.NewFromInt(99790).Equal(invoice.TransferAmount()))
+	})
+
+	t.Run("存在しない・他社の取引先の請求書は作成しない", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			client *models.Client
+			err    error
+		}{
+			{"存在しない取引先", nil, gorm.ErrRecordNotFound},
+			{"他社の取引先", &models.Client{ID: "clientID", CompanyID: "otherCompanyID"}, nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx, _ := setupInvoiceUsecaseContext(t)
+				mockUserRepository := repository.NewMockUserRepository(t)
+				mockCompanyRepository := repository.NewMockCompanyRepository(t)
+				mockClientRepository := repository.NewMockClientRepository(t)
+
+				mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+				mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+				mockClientRepository.EXPECT().FindByID(mock.Anything, "clientID").Return(tt.client, tt.err)
+
+				usecase := NewInvoiceUsecase(newNoDuplicateInvoiceRepository(t), repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, mockClientRepository, repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+				invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil, false)
+
+				assert.Nil(t, invoice)
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, []apperror.FieldError{{Field: "client_id", Code: apperror.FieldCodeInvalidValue}}, appErr.Fields)
+			})
+		}
+	})
+
+	t.Run("外貨建ては発行日以前の最新の為替レートで円換算し、円換算額から手数料を計算する", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockExchangeRateRepository := repository.NewMockExchangeRateRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+		issueDate := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+		mockExchangeRateRepository.EXPECT().FindLatest(mock.Anything, value.CurrencyUSD, issueDate).
+			Return(&models.ExchangeRate{Currency: value.CurrencyUSD, RateDate: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), Rate: decimal.RequireFromString("150.25")}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
+			return inv.Currency == value.CurrencyUSD && inv.ExchangeRate.Equal(decimal.RequireFromString("150.25"))
+		})).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), mockExchangeRateRepository, newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", issueDate, value.NewMoney(decimal.RequireFromString("1200.50"), value.CurrencyUSD), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil, false)
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.RequireFromString("1200.50").Equal(invoice.PaymentAmount))
+		// 1200.50 × 150.25 = 180375.125 は1円未満を切り捨てる
+		assert.True(t, decimal.NewFromInt(180375).Equal(invoice.PaymentAmountJPY))
+		assert.True(t, decimal.NewFromInt(7215).Equal(invoice.Fee))
+		assert.True(t, decimal.NewFromInt(721).Equal(invoice.Tax))
+		assert.True(t, decimal.NewFromInt(188311).Equal(invoice.InvoiceAmount))
+	})
+
+	t.Run("外貨建ての明細はセント未満を切り捨てる", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockInvoiceLineRepository := repository.NewMockInvoiceLineRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockExchangeRateRepository := repository.NewMockExchangeRateRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+		lines := []*models.InvoiceLine{
+			{Description: "Consulting", Quantity: decimal.RequireFromString("1.5"), UnitPrice: decimal.RequireFromString("33.33"), TaxCategory: value.TaxCategoryStandard},
+		}
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+		mockExchangeRateRepository.EXPECT().FindLatest(mock.Anything, value.CurrencyEUR, mock.Anything).
+			Return(&models.ExchangeRate{Currency: value.CurrencyEUR, Rate: decimal.NewFromInt(150)}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceLineRepository.EXPECT().CreateAll(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockInvoiceLineRepository, newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), mockExchangeRateRepository, newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.Zero, value.CurrencyEUR), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), lines, false)
+
+		assert.NoError(t, err)
+		// 1.5 × 33.33 = 49.995 は 49.99、消費税 4.999 は 4.99
+		assert.True(t, decimal.RequireFromString("49.99").Equal(invoice.Lines[0].Amount))
+		assert.True(t, decimal.RequireFromString("54.98").Equal(invoice.PaymentAmount))
+		assert.True(t, decimal.NewFromInt(8247).Equal(invoice.PaymentAmountJPY))
+	})
+
+	t.Run("外貨建てで作成できない場合はエラー", func(t *testing.T) {
+		corporation := &models.Client{ID: "clientID", CompanyID: "companyID", ClientType: value.ClientTypeCorporation}
+		individual := &models.Client{ID: "clientID", CompanyID: "companyID", ClientType: value.ClientTypeIndividual, Withholding: true, WithholdingCategory: value.WithholdingCategoryManuscript}
+		tests := []struct {
+			name          string
+			client        *models.Client
+			paymentAmount value.Money
+			rateErr       error
+			expected      []apperror.FieldError
+		}{
+			{"取り扱いのない通貨", nil, value.NewMoney(decimal.NewFromInt(1000), "GBP"), nil, []apperror.FieldError{{Field: "currency", Code: apperror.FieldCodeInvalidValue}}},
+			{"補助単位より細かい金額", nil, value.NewMoney(decimal.RequireFromString("100.001"), value.CurrencyUSD), nil, []apperror.FieldError{{Field: "payment_amount", Code: apperror.FieldCodeInvalidValue}}},
+			{"円建ての1円未満の金額", nil, value.NewMoney(decimal.RequireFromString("1000.5"), value.CurrencyJPY), nil, []apperror.FieldError{{Field: "payment_amount", Code: apperror.FieldCodeInvalidValue}}},
+			{"発行日以前の為替レートがない", corporation, value.NewMoney(decimal.NewFromInt(1000), value.CurrencyUSD), gorm.ErrRecordNotFound, []apperror.FieldError{{Field: "currency", Code: apperror.FieldCodeInvalidValue}}},
+			{"源泉徴収する取引先への外貨建て", individual, value.NewMoney(decimal.NewFromInt(1000), value.CurrencyUSD), nil, []apperror.FieldError{{Field: "currency", Code: apperror.FieldCodeInvalidValue}}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx, _ := setupInvoiceUsecaseContext(t)
+				mockUserRepository := repository.NewMockUserRepository(t)
+				mockCompanyRepository := repository.NewMockCompanyRepository(t)
+				mockClientRepository := repository.NewMockClientRepository(t)
+				mockExchangeRateRepository := repository.NewMockExchangeRateRepository(t)
+				if tt.client != nil {
+					mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+					mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").Return(&models.Company{ID: "companyID"}, nil)
+					mockClientRepository.EXPECT().FindByID(mock.Anything, "clientID").Return(tt.client, nil)
+				}
+				if tt.rateErr != nil {
+					mockExchangeRateRepository.EXPECT().FindLatest(mock.Anything, tt.paymentAmount.Currency, mock.Anything).Return(nil, tt.rateErr)
+				}
+
+				usecase := NewInvoiceUsecase(newNoDuplicateInvoiceRepository(t), repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, mockClientRepository, mockExchangeRateRepository, repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+				invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), tt.paymentAmount, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), nil, false)
+
+				assert.Nil(t, invoice)
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expected, appErr.Fields)
+			})
+		}
+	})
+
 	t.Run("コンテキストにDBがない", func(t *testing.T) {
 		ctx := context.Background()
-		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
 		clientID := "01HQZXFG0PJ9K8QXW7YM1N2ZXC"
@@ -144,8 +658,8 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 		paymentAmount := decimal.NewFromInt(100000)
 		paymentDueDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, clientID, issueDate, value.NewMoney(paymentAmount, value.CurrencyJPY), paymentDueDate, nil, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, "database connection not found in context", err.Error())
@@ -153,8 +667,152 @@ func TestInvoiceUsecase_CreateInvoice(t *testing.T) {
 	})
 }
 
-func TestInvoiceUsecase_GetInvoicesByPaymentDueDateRange(t *testing.T) {
-	var nilDate *time.Time
+func TestInvoiceUsecase_CreateInvoice_Duplicate(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	issueDate := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	paymentDueDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	// 発行日の前後3日以内、支払金額の差が1%以内を重複の疑いとする
+	cfg := config.Default()
+	cfg.DuplicateInvoiceWindowDays = 3
+	cfg.DuplicateInvoiceAmountTolerance = decimal.RequireFromString("0.01")
+
+	candidates := []*models.Invoice{
+		{ID: "sameAmountTwoDaysBefore", ClientID: "clientID", Currency: value.CurrencyJPY, IssueDate: time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC), PaymentAmount: decimal.NewFromInt(100000)},
+		{ID: "sameDay", ClientID: "clientID", Currency: value.CurrencyJPY, IssueDate: issueDate, PaymentAmount: decimal.NewFromInt(100000)},
+		{ID: "nearAmount", ClientID: "clientID", Currency: value.CurrencyJPY, IssueDate: time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC), PaymentAmount: decimal.NewFromInt(100900)},
+		// 金額の差が1%を超える、通貨が違う請求書は疑わない
+		{ID: "farAmount", ClientID: "clientID", Currency: value.CurrencyJPY, IssueDate: issueDate, PaymentAmount: decimal.NewFromInt(101100)},
+		{ID: "otherCurrency", ClientID: "clientID", Currency: value.CurrencyUSD, IssueDate: issueDate, PaymentAmount: decimal.NewFromInt(100000)},
+	}
+	setup := func(t *testing.T) (*repository.MockInvoiceRepository, *repository.MockUserRepository, *repository.MockCompanyRepository) {
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).
+			Return(&models.Company{ID: user.CompanyID, DueDatePolicy: value.DueDatePolicyNone}, nil)
+		mockInvoiceRepository.EXPECT().FindDuplicateCandidates(mock.Anything, mock.MatchedBy(func(invoice *models.Invoice) bool {
+			return invoice.CompanyID == user.CompanyID && invoice.ClientID == "clientID" && invoice.IssueDate.Equal(issueDate)
+		}), mock.MatchedBy(func(rule models.DuplicateRule) bool {
+			return rule.WindowDays == 3 && rule.AmountTolerance.Equal(decimal.RequireFromString("0.01"))
+		})).Return(candidates, nil)
+
+		return mockInvoiceRepository, mockUserRepository, mockCompanyRepository
+	}
+
+	t.Run("重複の疑いのある請求書がある場合は作成せず、発行日と金額の近い順にIDを返す", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository, mockUserRepository, mockCompanyRepository := setup(t)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), cfg)
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", issueDate, value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), paymentDueDate, nil, false)
+
+		assert.Nil(t, invoice)
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindConflict, appErr.Kind)
+		assert.Equal(t, apperror.CodeDuplicateInvoiceSuspected, appErr.Code)
+		assert.Equal(t, []apperror.FieldError{
+			{Field: "force", Code: apperror.FieldCodeDuplicate, Param: "sameDay"},
+			{Field: "force", Code: apperror.FieldCodeDuplicate, Param: "sameAmountTwoDaysBefore"},
+			{Field: "force", Code: apperror.FieldCodeDuplicate, Param: "nearAmount"},
+		}, appErr.Fields)
+	})
+
+	t.Run("forceを指定した場合は作成し、最も近い疑いのある請求書を記録する", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository, mockUserRepository, mockCompanyRepository := setup(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
+			return inv.DuplicateCheck == value.DuplicateCheckOverridden && *inv.SuspectedDuplicateID == "sameDay"
+		})).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, cfg)
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", issueDate, value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), paymentDueDate, nil, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.DuplicateCheckOverridden, invoice.DuplicateCheck)
+		assert.Equal(t, "sameDay", *invoice.SuspectedDuplicateID)
+	})
+
+	t.Run("疑いのある請求書がない場合は確認済みとして作成する", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository, mockUserRepository, mockCompanyRepository := setup(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, cfg)
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", issueDate, value.NewMoney(decimal.NewFromInt(50000), value.CurrencyJPY), paymentDueDate, nil, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.DuplicateCheckClear, invoice.DuplicateCheck)
+		assert.Nil(t, invoice.SuspectedDuplicateID)
+	})
+}
+
+func TestInvoiceUsecase_CreateInvoice_Numbering(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	paymentDueDate := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+	setup := func(t *testing.T) (*repository.MockUserRepository, *repository.MockCompanyRepository) {
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, user.CompanyID).Return(&models.Company{
+			ID:               user.CompanyID,
+			DueDatePolicy:    value.DueDatePolicyNone,
+			InvoiceNumbering: models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4},
+		}, nil)
+
+		return mockUserRepository, mockCompanyRepository
+	}
+
+	t.Run("発行日の会計年度の次の連番で請求書番号を振る", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository, mockCompanyRepository := setup(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockInvoiceNumberSequenceRepository := repository.NewMockInvoiceNumberSequenceRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		// 4月始まりのため、2026年3月の請求書は2025年度
+		mockInvoiceNumberSequenceRepository.EXPECT().Next(mock.Anything, user.CompanyID, 2025).Return(123, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(inv *models.Invoice) bool {
+			return *inv.InvoiceNumber == "INV-2025-000123"
+		})).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), mockInvoiceNumberSequenceRepository, mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), paymentDueDate, nil, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INV-2025-000123", *invoice.InvoiceNumber)
+	})
+
+	t.Run("採番に失敗した場合は作成しない", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository, mockCompanyRepository := setup(t)
+		mockInvoiceNumberSequenceRepository := repository.NewMockInvoiceNumberSequenceRepository(t)
+
+		mockInvoiceNumberSequenceRepository.EXPECT().Next(mock.Anything, user.CompanyID, 2026).Return(0, errors.New("database is locked"))
+
+		usecase := NewInvoiceUsecase(newNoDuplicateInvoiceRepository(t), repository.NewMockInvoiceLineRepository(t), mockInvoiceNumberSequenceRepository, mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		invoice, err := usecase.CreateInvoice(ctx, "clientID", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), paymentDueDate, nil, false)
+
+		assert.EqualError(t, err, "database is locked")
+		assert.Nil(t, invoice)
+	})
+}
+
+func TestInvoiceUsecase_SearchInvoices(t *testing.T) {
+	user := &models.User{
+		ID:        "userID",
+		CompanyID: "companyID",
+	}
 
 	t.Run("日付範囲で請求書取得成功", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
@@ -179,37 +837,57 @@ func TestInvoiceUsecase_GetInvoicesByPaymentDueDateRange(t *testing.T) {
 			},
 		}
 
-		mockInvoiceRepository.EXPECT().FindByPaymentDueDateRange(mock.Anything, &startDate, &endDate, 0, 100).
-			Return(expectedInvoices, nil)
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().Count(mock.Anything, mock.Anything).Return(int64(2), nil)
+		mockInvoiceRepository.EXPECT().Search(mock.Anything, mock.MatchedBy(func(cond *models.InvoiceSearchCondition) bool {
+			return cond.CompanyID == user.CompanyID &&
+				cond.PaymentDueDateFrom.Equal(startDate) &&
+				cond.PaymentDueDateTo.Equal(endDate) &&
+				cond.Limit == 101
+		})).Return(expectedInvoices, nil)
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoices, err := usecase.GetInvoicesByPaymentDueDateRange(ctx, &startDate, &endDate, 0, 100)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		page, err := usecase.SearchInvoices(ctx, &models.InvoiceSearchCondition{
+			PaymentDueDateFrom: &startDate,
+			PaymentDueDateTo:   &endDate,
+			Limit:              100,
+		})
 
 		assert.NoError(t, err)
-		assert.Len(t, invoices, 2)
-		assert.Equal(t, expectedInvoices[0].ID, invoices[0].ID)
-		assert.Equal(t, expectedInvoices[1].ID, invoices[1].ID)
+		assert.Len(t, page.Invoices, 2)
+		assert.Equal(t, expectedInvoices[0].ID, page.Invoices[0].ID)
+		assert.Equal(t, expectedInvoices[1].ID, page.Invoices[1].ID)
+		assert.Equal(t, int64(2), page.TotalCount)
+		assert.Nil(t, page.NextCursor)
 	})
 
-	t.Run("日付範囲なし（全件取得）", func(t *testing.T) {
+	t.Run("次ページがある場合はカーソルを返す", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
 		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
 		expectedInvoices := []*models.Invoice{
-			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD"},
-			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXE"},
-			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXF"},
+			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD", PaymentDueDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXE", PaymentDueDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXF", PaymentDueDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
 		}
 
-		mockInvoiceRepository.EXPECT().FindByPaymentDueDateRange(mock.Anything, nilDate, nilDate, 0, 100).
-			Return(expectedInvoices, nil)
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().Count(mock.Anything, mock.Anything).Return(int64(5), nil)
+		mockInvoiceRepository.EXPECT().Search(mock.Anything, mock.Anything).Return(expectedInvoices, nil)
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoices, err := usecase.GetInvoicesByPaymentDueDateRange(ctx, nil, nil, 0, 100)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		page, err := usecase.SearchInvoices(ctx, &models.InvoiceSearchCondition{Limit: 2})
 
 		assert.NoError(t, err)
-		assert.Len(t, invoices, 3)
+		assert.Len(t, page.Invoices, 2)
+		assert.Equal(t, int64(5), page.TotalCount)
+		assert.Equal(t, &value.InvoiceCursor{
+			SortKey:   value.InvoiceSortKeyPaymentDueDate,
+			SortOrder: value.SortOrderAsc,
+			Value:     "2025-02-01T00:00:00Z",
+			ID:        "01HQZXFG0PJ9K8QXW7YM1N2ZXE",
+		}, page.NextCursor)
 	})
 
 	t.Run("リポジトリエラー", func(t *testing.T) {
@@ -217,18 +895,17 @@ func TestInvoiceUsecase_GetInvoicesByPaymentDueDateRange(t *testing.T) {
 		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
-		startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-		endDate := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
-
-		mockInvoiceRepository.EXPECT().FindByPaymentDueDateRange(mock.Anything, &startDate, &endDate, 0, 100).
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().Count(mock.Anything, mock.Anything).Return(int64(0), nil)
+		mockInvoiceRepository.EXPECT().Search(mock.Anything, mock.Anything).
 			Return(nil, errors.New("database error"))
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoices, err := usecase.GetInvoicesByPaymentDueDateRange(ctx, &startDate, &endDate, 0, 100)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		page, err := usecase.SearchInvoices(ctx, &models.InvoiceSearchCondition{})
 
 		assert.Error(t, err)
 		assert.Equal(t, "database error", err.Error())
-		assert.Nil(t, invoices)
+		assert.Nil(t, page)
 	})
 
 	t.Run("コンテキストにDBがない", func(t *testing.T) {
@@ -236,15 +913,15 @@ func TestInvoiceUsecase_GetInvoicesByPaymentDueDateRange(t *testing.T) {
 		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoices, err := usecase.GetInvoicesByPaymentDueDateRange(ctx, nil, nil, 0, 100)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		page, err := usecase.SearchInvoices(ctx, &models.InvoiceSearchCondition{})
 
 		assert.Error(t, err)
 		assert.Equal(t, "database connection not found in context", err.Error())
-		assert.Nil(t, invoices)
+		assert.Nil(t, page)
 	})
 
-	t.Run("offsetとlimitのデフォルト値設定", func(t *testing.T) {
+	t.Run("デフォルト値設定", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
 		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
@@ -253,33 +930,334 @@ func TestInvoiceUsecase_GetInvoicesByPaymentDueDateRange(t *testing.T) {
 			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXD"},
 		}
 
-		// 負のoffsetは0に、0以下のlimitは100に補正される
-		mockInvoiceRepository.EXPECT().FindByPaymentDueDateRange(mock.Anything, nilDate, nilDate, 0, 100).
-			Return(expectedInvoices, nil)
+		// 負のoffsetは0に、0以下のlimitは100に、ソート条件は支払期日の昇順に補正される
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().Count(mock.Anything, mock.Anything).Return(int64(1), nil)
+		mockInvoiceRepository.EXPECT().Search(mock.Anything, mock.MatchedBy(func(cond *models.InvoiceSearchCondition) bool {
+			return cond.Offset == 0 &&
+				cond.Limit == 101 &&
+				cond.SortKey == value.InvoiceSortKeyPaymentDueDate &&
+				cond.SortOrder == value.SortOrderAsc
+		})).Return(expectedInvoices, nil)
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoices, err := usecase.GetInvoicesByPaymentDueDateRange(ctx, nil, nil, -1, 0)
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		page, err := usecase.SearchInvoices(ctx, &models.InvoiceSearchCondition{Offset: -1, Limit: 0})
 
 		assert.NoError(t, err)
-		assert.Len(t, invoices, 1)
+		assert.Len(t, page.Invoices, 1)
 	})
+}
 
-	t.Run("カスタムoffsetとlimit", func(t *testing.T) {
+func TestInvoiceUsecase_GetInvoice(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+
+	t.Run("明細とともに請求書を返す", func(t *testing.T) {
 		ctx, _ := setupInvoiceUsecaseContext(t)
 		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockInvoiceLineRepository := repository.NewMockInvoiceLineRepository(t)
 		mockUserRepository := repository.NewMockUserRepository(t)
+		lines := []*models.InvoiceLine{{ID: "lineID", InvoiceID: "invoiceID", LineNo: 1}}
 
-		expectedInvoices := []*models.Invoice{
-			{ID: "01HQZXFG0PJ9K8QXW7YM1N2ZXE"},
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").
+			Return(&models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID}, nil)
+		mockInvoiceLineRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(lines, nil)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockInvoiceLineRepository, newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		invoice, err := usecase.GetInvoice(ctx, "invoiceID")
+
+		assert.NoError(t, err)
+		assert.Equal(t, lines, invoice.Lines)
+	})
+
+	t.Run("他社の請求書はNotFound", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").
+			Return(&models.Invoice{ID: "invoiceID", CompanyID: "otherCompanyID"}, nil)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		_, err := usecase.GetInvoice(ctx, "invoiceID")
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
+
+func TestInvoiceUsecase_GetInvoiceByNumber(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+
+	t.Run("ログインユーザーの会社の請求書を明細とともに返す", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockInvoiceLineRepository := repository.NewMockInvoiceLineRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		lines := []*models.InvoiceLine{{ID: "lineID", InvoiceID: "invoiceID", LineNo: 1}}
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByNumber(mock.Anything, user.CompanyID, "INV-2025-000001").
+			Return(&models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID}, nil)
+		mockInvoiceLineRepository.EXPECT().FindByInvoiceID(mock.Anything, "invoiceID").Return(lines, nil)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockInvoiceLineRepository, repository.NewMockInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		invoice, err := usecase.GetInvoiceByNumber(ctx, "INV-2025-000001")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "invoiceID", invoice.ID)
+		assert.Equal(t, lines, invoice.Lines)
+	})
+
+	t.Run("会社に存在しない請求書番号はNotFound", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByNumber(mock.Anything, user.CompanyID, "INV-2025-000001").Return(nil, gorm.ErrRecordNotFound)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), repository.NewMockInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		_, err := usecase.GetInvoiceByNumber(ctx, "INV-2025-000001")
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
+
+func TestInvoiceUsecase_DeleteInvoice(t *testing.T) {
+	user := &models.User{
+		ID:        "userID",
+		CompanyID: "companyID",
+	}
+
+	t.Run("請求書を論理削除", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		invoice := &models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID, Version: 1}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, invoice.ID).Return(invoice, nil)
+		mockInvoiceRepository.EXPECT().Delete(mock.Anything, invoice.ID, 1, mock.AnythingOfType("time.Time")).Return(nil)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		err := usecase.DeleteInvoice(ctx, invoice.ID, 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("取得後に他の更新と競合した場合はPreconditionFailed", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		invoice := &models.Invoice{ID: "invoiceID", CompanyID: user.CompanyID, Version: 1}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, invoice.ID).Return(invoice, nil)
+		mockInvoiceRepository.EXPECT().Delete(mock.Anything, invoice.ID, 1, mock.AnythingOfType("time.Time")).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice", ID: invoice.ID, Version: 1})
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		err := usecase.DeleteInvoice(ctx, invoice.ID, 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.CodePreconditionFailed, appErr.Code)
+	})
+
+	t.Run("他社の請求書はNotFound", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").
+			Return(&models.Invoice{ID: "invoiceID", CompanyID: "otherCompanyID"}, nil)
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		err := usecase.DeleteInvoice(ctx, "invoiceID", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
+
+func TestInvoiceUsecase_UpdateRetentionClass(t *testing.T) {
+	admin := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}
+	newUsecase := func(t *testing.T, invoiceRepository *repository.MockInvoiceRepository, userRepository *repository.MockUserRepository) InvoiceUsecase {
+		return NewInvoiceUsecase(invoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), userRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+	}
+
+	t.Run("保存期間の区分を変更してバージョンを進める", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		invoice := &models.Invoice{ID: "invoiceID", CompanyID: admin.CompanyID, RetentionClass: value.RetentionClassStandard, Version: 2}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, invoice.ID).Return(invoice, nil)
+		mockInvoiceRepository.EXPECT().UpdateRetentionClass(mock.Anything, mock.MatchedBy(func(i *models.Invoice) bool {
+			return i.RetentionClass == value.RetentionClassExtended
+		}), 2).RunAndReturn(func(_ *gorm.DB, i *models.Invoice, version int) error {
+			i.Version = version + 1
+			return nil
+		})
+
+		updated, err := newUsecase(t, mockInvoiceRepository, mockUserRepository).UpdateRetentionClass(ctx, invoice.ID, value.RetentionClassExtended, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.RetentionClassExtended, updated.RetentionClass)
+		assert.Equal(t, 3, updated.Version)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(&models.Invoice{ID: "invoiceID", CompanyID: admin.CompanyID, Version: 3}, nil)
+
+		_, err := newUsecase(t, mockInvoiceRepository, mockUserRepository).UpdateRetentionClass(ctx, "invoiceID", value.RetentionClassExtended, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.CodePreconditionFailed, appErr.Code)
+	})
+
+	t.Run("管理者以外はForbidden", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(&models.User{ID: admin.ID, CompanyID: admin.CompanyID, Role: value.UserRoleMember}, nil)
+		mockInvoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(&models.Invoice{ID: "invoiceID", CompanyID: admin.CompanyID, Version: 1}, nil)
+
+		_, err := newUsecase(t, mockInvoiceRepository, mockUserRepository).UpdateRetentionClass(ctx, "invoiceID", value.RetentionClassExtended, 1)
+
+		assert.True(t, apperror.IsKind(err, apperror.KindForbidden))
+	})
+
+	t.Run("不明な区分はバリデーションエラー", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+
+		_, err := newUsecase(t, repository.NewMockInvoiceRepository(t), repository.NewMockUserRepository(t)).UpdateRetentionClass(ctx, "invoiceID", "permanent", 1)
+
+		appErr, ok := apperror.As(err)
+		if assert.True(t, ok) {
+			assert.Equal(t, []apperror.FieldError{{Field: "retention_class", Code: apperror.FieldCodeInvalidValue}}, appErr.Fields)
 		}
+	})
+}
+
+func TestInvoiceUsecase_Numbering(t *testing.T) {
+	admin := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}
+	newUsecase := func(t *testing.T, mockUserRepository *repository.MockUserRepository, mockCompanyRepository *repository.MockCompanyRepository) InvoiceUsecase {
+		return NewInvoiceUsecase(repository.NewMockInvoiceRepository(t), repository.NewMockInvoiceLineRepository(t), repository.NewMockInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+	}
+
+	t.Run("会社の請求書番号の振り方を返す", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		numbering := models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4, Version: 2}
 
-		mockInvoiceRepository.EXPECT().FindByPaymentDueDateRange(mock.Anything, nilDate, nilDate, 10, 20).
-			Return(expectedInvoices, nil)
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, admin.CompanyID).
+			Return(&models.Company{ID: admin.CompanyID, InvoiceNumbering: numbering}, nil)
 
-		usecase := NewInvoiceUsecase(mockInvoiceRepository, mockUserRepository)
-		invoices, err := usecase.GetInvoicesByPaymentDueDateRange(ctx, nil, nil, 10, 20)
+		found, err := newUsecase(t, mockUserRepository, mockCompanyRepository).GetNumbering(ctx)
 
 		assert.NoError(t, err)
-		assert.Len(t, invoices, 1)
+		assert.Equal(t, numbering, found)
+	})
+
+	t.Run("書式と会計年度の始まる月を変更する", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		numbering := models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1}
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, admin.CompanyID).
+			Return(&models.Company{ID: admin.CompanyID, InvoiceNumbering: models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4, Version: 2}}, nil)
+		mockCompanyRepository.EXPECT().UpdateInvoiceNumbering(mock.Anything, admin.CompanyID, numbering, 2).Return(nil)
+
+		updated, err := newUsecase(t, mockUserRepository, mockCompanyRepository).UpdateNumbering(ctx, numbering, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1, Version: 3}, updated)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, admin.CompanyID).
+			Return(&models.Company{ID: admin.CompanyID, InvoiceNumbering: models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4, Version: 3}}, nil)
+
+		_, err := newUsecase(t, mockUserRepository, mockCompanyRepository).
+			UpdateNumbering(ctx, models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1}, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("取得後に他の更新と競合した場合はPreconditionFailed", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, admin.CompanyID).
+			Return(&models.Company{ID: admin.CompanyID, InvoiceNumbering: models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4, Version: 2}}, nil)
+		mockCompanyRepository.EXPECT().UpdateInvoiceNumbering(mock.Anything, admin.CompanyID, mock.Anything, 2).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice_numbering", ID: admin.CompanyID, Version: 2})
+
+		_, err := newUsecase(t, mockUserRepository, mockCompanyRepository).
+			UpdateNumbering(ctx, models.InvoiceNumbering{Format: "B{YY}-{SEQ:4}", FiscalYearStartMonth: 1}, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("連番と会計年度を含まない書式と範囲外の月はバリデーションエラー", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+
+		for _, format := range []value.InvoiceNumberFormat{"INV-{SEQ:6}", "INV-{YYYY}", "INV-{YYYY}-{SEQ}-{SEQ}", "INV/{YYYY}/{SEQ}", "INV-{YYYY}-{SEQ:11}", "INV-{MM}-{YYYY}-{SEQ}"} {
+			_, err := newUsecase(t, repository.NewMockUserRepository(t), repository.NewMockCompanyRepository(t)).
+				UpdateNumbering(ctx, models.InvoiceNumbering{Format: format, FiscalYearStartMonth: 13}, 1)
+
+			appErr, ok := apperror.As(err)
+			assert.True(t, ok, format)
+			assert.Equal(t, []apperror.FieldError{
+				{Field: "format", Code: apperror.FieldCodeInvalidValue},
+				{Field: "fiscal_year_start_month", Code: apperror.FieldCodeInvalidValue},
+			}, appErr.Fields, format)
+		}
+	})
+
+	t.Run("管理者以外は変更できない", func(t *testing.T) {
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleMember}, nil)
+
+		_, err := newUsecase(t, mockUserRepository, repository.NewMockCompanyRepository(t)).
+			UpdateNumbering(ctx, models.InvoiceNumbering{Format: value.DefaultInvoiceNumberFormat, FiscalYearStartMonth: 4}, 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindForbidden, appErr.Kind)
 	})
 }
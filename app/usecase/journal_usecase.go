@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// JournalUsecase は請求書・返品・値引き・支払を会計ソフトに取り込む仕訳にします
+type JournalUsecase interface {
+	// GetAccounts はログインユーザーの会社の勘定科目の名前を、設定のバージョンと合わせて返します（管理者のみ）。
+	// 設定のない役割は既定の名前です
+	GetAccounts(ctx context.Context) (models.JournalAccounts, int, error)
+	// UpdateAccounts はログインユーザーの会社の勘定科目の名前を置き換え、進めたバージョンと合わせて返します（管理者のみ）。
+	// version が設定の現在のバージョンと一致する場合だけ置き換え、空の名前を指定した役割は既定の名前に戻します
+	UpdateAccounts(ctx context.Context, accounts models.JournalAccounts, version int) (models.JournalAccounts, int, error)
+	// ExportJournal は期間の仕訳を日付の順に返します。期間を指定しない場合は日本時間の今月です
+	ExportJournal(ctx context.Context, format value.JournalFormat, condition *models.ReportCondition) (models.ReportRange, []*models.JournalEntry, error)
+}
+
+type journalUsecase struct {
+	journalRepository        repository.JournalRepository
+	journalAccountRepository repository.JournalAccountRepository
+	userRepository           repository.UserRepository
+	now                      func() time.Time
+}
+
+func NewJournalUsecase(journalRepository repository.JournalRepository, journalAccountRepository repository.JournalAccountRepository, userRepository repository.UserRepository) JournalUsecase {
+	return &tracedJournalUsecase{
+		next: &journalUsecase{
+			journalRepository:        journalRepository,
+			journalAccountRepository: journalAccountRepository,
+			userRepository:           userRepository,
+			now:                      time.Now,
+		},
+	}
+}
+
+func (u *journalUsecase) GetAccounts(ctx context.Context) (models.JournalAccounts, int, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	user, err := u.findAdmin(ctx, db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 設定より先にバージョンを読む。間に更新が入っても古いバージョンを返すだけで、
+	// 新しい設定を見ずに上書きすることはない
+	version, err := u.journalAccountRepository.FindVersionByCompanyID(db, user.CompanyID)
+	if err != nil {
+		return nil, 0, err
+	}
+	accounts, err := u.findAccounts(db, user.CompanyID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return accounts, version, nil
+}
+
+func (u *journalUsecase) UpdateAccounts(ctx context.Context, accounts models.JournalAccounts, version int) (models.JournalAccounts, int, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	user, err := u.findAdmin(ctx, db)
+	if err != nil {
+		return nil, 0, err
+	}
+	current, err := u.journalAccountRepository.FindVersionByCompanyID(db, user.CompanyID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkVersion(current, version); err != nil {
+		return nil, 0, err
+	}
+
+	var settings []*models.JournalAccount
+	for _, accountType := range value.JournalAccountTypes() {
+		if name := accounts[accountType]; name != "" {
+			settings = append(settings, &models.JournalAccount{
+				CompanyID: user.CompanyID,
+				Type:      accountType,
+				Name:      name,
+			})
+		}
+	}
+	if err := u.journalAccountRepository.ReplaceByCompanyID(db, user.CompanyID, settings, version); err != nil {
+		return nil, 0, versionConflictError(err)
+	}
+	slog.InfoContext(ctx, "journal accounts updated",
+		slog.String("company_id", user.CompanyID),
+		slog.Int("accounts", len(settings)),
+	)
+
+	return models.NewJournalAccounts(settings), version + 1, nil
+}
+
+// ExportJournal は期間の発行日の請求書・返品・値引きと、支払日の支払を仕訳にします
+func (u *journalUsecase) ExportJournal(ctx context.Context, format value.JournalFormat, condition *models.ReportCondition) (models.ReportRange, []*models.JournalEntry, error) {
+	if format == "" {
+		return models.ReportRange{}, nil, apperror.NewValidation(apperror.FieldError{Field: "format", Code: apperror.FieldCodeRequired})
+	}
+	if !format.IsValid() {
+		return models.ReportRange{}, nil, apperror.NewValidation(apperror.FieldError{Field: "format", Code: apperror.FieldCodeInvalidValue})
+	}
+	thisMonth := models.StartOfReportPeriod(u.now(), value.ReportPeriodMonth)
+	reportRange, err := resolveReportRange(condition, thisMonth, thisMonth.AddDate(0, 1, 0))
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	accounts, err := u.findAccounts(db, user.CompanyID)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	source, err := u.journalRepository.FindSource(db, user.CompanyID, reportRange)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	return reportRange, source.Entries(accounts), nil
+}
+
+func (u *journalUsecase) findAccounts(db *gorm.DB, companyID string) (models.JournalAccounts, error) {
+	accounts, err := u.journalAccountRepository.FindByCompanyID(db, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewJournalAccounts(accounts), nil
+}
+
+func (u *journalUsecase) findAdmin(ctx context.Context, db *gorm.DB) (*models.User, error) {
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsAdmin() {
+		return nil, errAdminRequired
+	}
+
+	return user, nil
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type journalUsecaseMocks struct {
+	journalRepository        *repository.MockJournalRepository
+	journalAccountRepository *repository.MockJournalAccountRepository
+	userRepository           *repository.MockUserRepository
+}
+
+func newTestJournalUsecase(t *testing.T, now time.Time) (*journalUsecase, *journalUsecaseMocks) {
+	m := &journalUsecaseMocks{
+		journalRepository:        repository.NewMockJournalRepository(t),
+		journalAccountRepository: repository.NewMockJournalAccountRepository(t),
+		userRepository:           repository.NewMockUserRepository(t),
+	}
+
+	return &journalUsecase{
+		journalRepository:        m.journalRepository,
+		journalAccountRepository: m.journalAccountRepository,
+		userRepository:           m.userRepository,
+		now:                      func() time.Time { return now },
+	}, m
+}
+
+func TestJournalUsecase_Accounts(t *testing.T) {
+	admin := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}
+
+	t.Run("設定のない勘定科目は既定の名前で返す", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		usecase, m := newTestJournalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		m.journalAccountRepository.EXPECT().FindVersionByCompanyID(mock.Anything, admin.CompanyID).Return(2, nil)
+		m.journalAccountRepository.EXPECT().FindByCompanyID(mock.Anything, admin.CompanyID).
+			Return([]*models.JournalAccount{{Type: value.JournalAccountExpense, Name: "外注費"}}, nil)
+
+		accounts, version, err := usecase.GetAccounts(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "外注費", accounts.Name(value.JournalAccountExpense))
+		assert.Equal(t, "支払手数料", accounts.Name(value.JournalAccountFee))
+		assert.Equal(t, 2, version)
+	})
+
+	t.Run("名前を指定した勘定科目だけを保存する", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		usecase, m := newTestJournalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		m.journalAccountRepository.EXPECT().FindVersionByCompanyID(mock.Anything, admin.CompanyID).Return(2, nil)
+		m.journalAccountRepository.EXPECT().ReplaceByCompanyID(mock.Anything, admin.CompanyID, []*models.JournalAccount{
+			{CompanyID: admin.CompanyID, Type: value.JournalAccountPayable, Name: "買掛金"},
+		}, 2).Return(nil)
+
+		accounts, version, err := usecase.UpdateAccounts(ctx, models.JournalAccounts{
+			value.JournalAccountExpense: "",
+			value.JournalAccountPayable: "買掛金",
+		}, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "仕入高", accounts.Name(value.JournalAccountExpense))
+		assert.Equal(t, "買掛金", accounts.Name(value.JournalAccountPayable))
+		assert.Equal(t, 3, version)
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		usecase, m := newTestJournalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		m.journalAccountRepository.EXPECT().FindVersionByCompanyID(mock.Anything, admin.CompanyID).Return(3, nil)
+
+		_, _, err := usecase.UpdateAccounts(ctx, models.JournalAccounts{value.JournalAccountBank: "当座預金"}, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("取得後に他の更新と競合した場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		usecase, m := newTestJournalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		m.journalAccountRepository.EXPECT().FindVersionByCompanyID(mock.Anything, admin.CompanyID).Return(2, nil)
+		m.journalAccountRepository.EXPECT().ReplaceByCompanyID(mock.Anything, admin.CompanyID, mock.Anything, 2).
+			Return(&domainRepository.VersionConflictError{Entity: "journal_accounts", ID: admin.CompanyID, Version: 2})
+
+		_, _, err := usecase.UpdateAccounts(ctx, models.JournalAccounts{value.JournalAccountBank: "当座預金"}, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("管理者以外は変更できない", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		usecase, m := newTestJournalUsecase(t, time.Now())
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleMember}, nil)
+
+		_, _, err := usecase.UpdateAccounts(ctx, models.JournalAccounts{value.JournalAccountBank: "当座預金"}, 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindForbidden, appErr.Kind)
+	})
+}
+
+func TestJournalUsecase_ExportJournal(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleMember}
+	// UTC では11月30日だが、日本時間では12月1日
+	now := time.Date(2025, 11, 30, 15, 30, 0, 0, time.UTC)
+
+	t.Run("期間を指定しない場合は日本時間の今月の取引を会社の勘定科目で仕訳にする", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		usecase, m := newTestJournalUsecase(t, now)
+
+		thisMonth := models.ReportRange{From: tokyoDate(2025, 12, 1), To: tokyoDate(2026, 1, 1)}
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.journalAccountRepository.EXPECT().FindByCompanyID(mock.Anything, user.CompanyID).
+			Return([]*models.JournalAccount{{Type: value.JournalAccountBank, Name: "当座預金"}}, nil)
+		m.journalRepository.EXPECT().FindSource(mock.Anything, user.CompanyID, thisMonth).Return(&models.JournalSource{
+			Payments:    []*models.Payment{{InvoiceID: "invoiceID", Amount: decimal.NewFromInt(10440), PaidDate: thisMonth.From}},
+			ClientNames: map[string]string{"invoiceID": "Test Client"},
+		}, nil)
+
+		reportRange, entries, err := usecase.ExportJournal(ctx, value.JournalFormatFreee, &models.ReportCondition{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, thisMonth, reportRange)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, 1, entries[0].No)
+		assert.Equal(t, "Test Client 支払", entries[0].Description)
+		assert.Equal(t, "未払金", entries[0].Lines[0].Debit.Account)
+		assert.Equal(t, "当座預金", entries[0].Lines[0].Credit.Account)
+	})
+
+	t.Run("形式が不正な場合はValidationエラー", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			format   value.JournalFormat
+			expected apperror.FieldError
+		}{
+			{name: "形式を指定しない", format: "", expected: apperror.FieldError{Field: "format", Code: apperror.FieldCodeRequired}},
+			{name: "対応していない形式", format: "kaikei", expected: apperror.FieldError{Field: "format", Code: apperror.FieldCodeInvalidValue}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				usecase, _ := newTestJournalUsecase(t, now)
+				_, _, err := usecase.ExportJournal(setupReportUsecaseContext(t), tt.format, &models.ReportCondition{})
+
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, []apperror.FieldError{tt.expected}, appErr.Fields)
+			})
+		}
+	})
+}
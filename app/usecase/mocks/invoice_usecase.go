@@ -9,7 +9,7 @@ import (
 	"time"
 
 	"github.com/ijufumi/practice-202512/app/domain/models"
-	"github.com/shopspring/decimal"
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -41,8 +41,8 @@ func (_m *MockInvoiceUsecase) EXPECT() *MockInvoiceUsecase_Expecter {
 }
 
 // CreateInvoice provides a mock function for the type MockInvoiceUsecase
-func (_mock *MockInvoiceUsecase) CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount decimal.Decimal, paymentDueDate time.Time) (*models.Invoice, error) {
-	ret := _mock.Called(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+func (_mock *MockInvoiceUsecase) CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount value.Money, paymentDueDate time.Time, lines []*models.InvoiceLine, force bool) (*models.Invoice, error) {
+	ret := _mock.Called(ctx, clientID, issueDate, paymentAmount, paymentDueDate, lines, force)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateInvoice")
@@ -50,18 +50,18 @@ func (_mock *MockInvoiceUsecase) CreateInvoice(ctx context.Context, clientID str
 
 	var r0 *models.Invoice
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, decimal.Decimal, time.Time) (*models.Invoice, error)); ok {
-		return returnFunc(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, value.Money, time.Time, []*models.InvoiceLine, bool) (*models.Invoice, error)); ok {
+		return returnFunc(ctx, clientID, issueDate, paymentAmount, paymentDueDate, lines, force)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, decimal.Decimal, time.Time) *models.Invoice); ok {
-		r0 = returnFunc(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, value.Money, time.Time, []*models.InvoiceLine, bool) *models.Invoice); ok {
+		r0 = returnFunc(ctx, clientID, issueDate, paymentAmount, paymentDueDate, lines, force)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Invoice)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, decimal.Decimal, time.Time) error); ok {
-		r1 = returnFunc(ctx, clientID, issueDate, paymentAmount, paymentDueDate)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, value.Money, time.Time, []*models.InvoiceLine, bool) error); ok {
+		r1 = returnFunc(ctx, clientID, issueDate, paymentAmount, paymentDueDate, lines, force)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -77,13 +77,15 @@ type MockInvoiceUsecase_CreateInvoice_Call struct {
 //   - ctx context.Context
 //   - clientID string
 //   - issueDate time.Time
-//   - paymentAmount decimal.Decimal
+//   - paymentAmount value.Money
 //   - paymentDueDate time.Time
-func (_e *MockInvoiceUsecase_Expecter) CreateInvoice(ctx interface{}, clientID interface{}, issueDate interface{}, paymentAmount interface{}, paymentDueDate interface{}) *MockInvoiceUsecase_CreateInvoice_Call {
-	return &MockInvoiceUsecase_CreateInvoice_Call{Call: _e.mock.On("CreateInvoice", ctx, clientID, issueDate, paymentAmount, paymentDueDate)}
+//   - lines []*models.InvoiceLine
+//   - force bool
+func (_e *MockInvoiceUsecase_Expecter) CreateInvoice(ctx interface{}, clientID interface{}, issueDate interface{}, paymentAmount interface{}, paymentDueDate interface{}, lines interface{}, force interface{}) *MockInvoiceUsecase_CreateInvoice_Call {
+	return &MockInvoiceUsecase_CreateInvoice_Call{Call: _e.mock.On("CreateInvoice", ctx, clientID, issueDate, paymentAmount, paymentDueDate, lines, force)}
 }
 
-func (_c *MockInvoiceUsecase_CreateInvoice_Call) Run(run func(ctx context.Context, clientID string, issueDate time.Time, paymentAmount decimal.Decimal, paymentDueDate time.Time)) *MockInvoiceUsecase_CreateInvoice_Call {
+func (_c *MockInvoiceUsecase_CreateInvoice_Call) Run(run func(ctx context.Context, clientID string, issueDate time.Time, paymentAmount value.Money, paymentDueDate time.Time, lines []*models.InvoiceLine, force bool)) *MockInvoiceUsecase_CreateInvoice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -97,20 +99,30 @@ func (_c *MockInvoiceUsecase_CreateInvoice_Call) Run(run func(ctx context.Contex
 		if args[2] != nil {
 			arg2 = args[2].(time.Time)
 		}
-		var arg3 decimal.Decimal
+		var arg3 value.Money
 		if args[3] != nil {
-			arg3 = args[3].(decimal.Decimal)
+			arg3 = args[3].(value.Money)
 		}
 		var arg4 time.Time
 		if args[4] != nil {
 			arg4 = args[4].(time.Time)
 		}
+		var arg5 []*models.InvoiceLine
+		if args[5] != nil {
+			arg5 = args[5].([]*models.InvoiceLine)
+		}
+		var arg6 bool
+		if args[6] != nil {
+			arg6 = args[6].(bool)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -121,93 +133,486 @@ func (_c *MockInvoiceUsecase_CreateInvoice_Call) Return(invoice *models.Invoice,
 	return _c
 }
 
-func (_c *MockInvoiceUsecase_CreateInvoice_Call) RunAndReturn(run func(ctx context.Context, clientID string, issueDate time.Time, paymentAmount decimal.Decimal, paymentDueDate time.Time) (*models.Invoice, error)) *MockInvoiceUsecase_CreateInvoice_Call {
+func (_c *MockInvoiceUsecase_CreateInvoice_Call) RunAndReturn(run func(ctx context.Context, clientID string, issueDate time.Time, paymentAmount value.Money, paymentDueDate time.Time, lines []*models.InvoiceLine, force bool) (*models.Invoice, error)) *MockInvoiceUsecase_CreateInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInvoice provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) DeleteInvoice(ctx context.Context, invoiceID string, version int) error {
+	ret := _mock.Called(ctx, invoiceID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvoice")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = returnFunc(ctx, invoiceID, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInvoiceUsecase_DeleteInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInvoice'
+type MockInvoiceUsecase_DeleteInvoice_Call struct {
+	*mock.Call
+}
+
+// DeleteInvoice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invoiceID string
+//   - version int
+func (_e *MockInvoiceUsecase_Expecter) DeleteInvoice(ctx interface{}, invoiceID interface{}, version interface{}) *MockInvoiceUsecase_DeleteInvoice_Call {
+	return &MockInvoiceUsecase_DeleteInvoice_Call{Call: _e.mock.On("DeleteInvoice", ctx, invoiceID, version)}
+}
+
+func (_c *MockInvoiceUsecase_DeleteInvoice_Call) Run(run func(ctx context.Context, invoiceID string, version int)) *MockInvoiceUsecase_DeleteInvoice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_DeleteInvoice_Call) Return(err error) *MockInvoiceUsecase_DeleteInvoice_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_DeleteInvoice_Call) RunAndReturn(run func(ctx context.Context, invoiceID string, version int) error) *MockInvoiceUsecase_DeleteInvoice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInvoicesByPaymentDueDateRange provides a mock function for the type MockInvoiceUsecase
-func (_mock *MockInvoiceUsecase) GetInvoicesByPaymentDueDateRange(ctx context.Context, startDate *time.Time, endDate *time.Time, offset int, limit int) ([]*models.Invoice, error) {
-	ret := _mock.Called(ctx, startDate, endDate, offset, limit)
+// GetInvoice provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) GetInvoice(ctx context.Context, invoiceID string) (*models.Invoice, error) {
+	ret := _mock.Called(ctx, invoiceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetInvoicesByPaymentDueDateRange")
+		panic("no return value specified for GetInvoice")
 	}
 
-	var r0 []*models.Invoice
+	var r0 *models.Invoice
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *time.Time, *time.Time, int, int) ([]*models.Invoice, error)); ok {
-		return returnFunc(ctx, startDate, endDate, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.Invoice, error)); ok {
+		return returnFunc(ctx, invoiceID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *time.Time, *time.Time, int, int) []*models.Invoice); ok {
-		r0 = returnFunc(ctx, startDate, endDate, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.Invoice); ok {
+		r0 = returnFunc(ctx, invoiceID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Invoice)
+			r0 = ret.Get(0).(*models.Invoice)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *time.Time, *time.Time, int, int) error); ok {
-		r1 = returnFunc(ctx, startDate, endDate, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, invoiceID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoicesByPaymentDueDateRange'
-type MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call struct {
+// MockInvoiceUsecase_GetInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoice'
+type MockInvoiceUsecase_GetInvoice_Call struct {
 	*mock.Call
 }
 
-// GetInvoicesByPaymentDueDateRange is a helper method to define mock.On call
+// GetInvoice is a helper method to define mock.On call
 //   - ctx context.Context
-//   - startDate *time.Time
-//   - endDate *time.Time
-//   - offset int
-//   - limit int
-func (_e *MockInvoiceUsecase_Expecter) GetInvoicesByPaymentDueDateRange(ctx interface{}, startDate interface{}, endDate interface{}, offset interface{}, limit interface{}) *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call {
-	return &MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call{Call: _e.mock.On("GetInvoicesByPaymentDueDateRange", ctx, startDate, endDate, offset, limit)}
+//   - invoiceID string
+func (_e *MockInvoiceUsecase_Expecter) GetInvoice(ctx interface{}, invoiceID interface{}) *MockInvoiceUsecase_GetInvoice_Call {
+	return &MockInvoiceUsecase_GetInvoice_Call{Call: _e.mock.On("GetInvoice", ctx, invoiceID)}
 }
 
-func (_c *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call) Run(run func(ctx context.Context, startDate *time.Time, endDate *time.Time, offset int, limit int)) *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call {
+func (_c *MockInvoiceUsecase_GetInvoice_Call) Run(run func(ctx context.Context, invoiceID string)) *MockInvoiceUsecase_GetInvoice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *time.Time
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*time.Time)
+			arg1 = args[1].(string)
 		}
-		var arg2 *time.Time
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_GetInvoice_Call) Return(invoice *models.Invoice, err error) *MockInvoiceUsecase_GetInvoice_Call {
+	_c.Call.Return(invoice, err)
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_GetInvoice_Call) RunAndReturn(run func(ctx context.Context, invoiceID string) (*models.Invoice, error)) *MockInvoiceUsecase_GetInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvoiceByNumber provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) GetInvoiceByNumber(ctx context.Context, invoiceNumber string) (*models.Invoice, error) {
+	ret := _mock.Called(ctx, invoiceNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvoiceByNumber")
+	}
+
+	var r0 *models.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.Invoice, error)); ok {
+		return returnFunc(ctx, invoiceNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.Invoice); ok {
+		r0 = returnFunc(ctx, invoiceNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, invoiceNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceUsecase_GetInvoiceByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvoiceByNumber'
+type MockInvoiceUsecase_GetInvoiceByNumber_Call struct {
+	*mock.Call
+}
+
+// GetInvoiceByNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invoiceNumber string
+func (_e *MockInvoiceUsecase_Expecter) GetInvoiceByNumber(ctx interface{}, invoiceNumber interface{}) *MockInvoiceUsecase_GetInvoiceByNumber_Call {
+	return &MockInvoiceUsecase_GetInvoiceByNumber_Call{Call: _e.mock.On("GetInvoiceByNumber", ctx, invoiceNumber)}
+}
+
+func (_c *MockInvoiceUsecase_GetInvoiceByNumber_Call) Run(run func(ctx context.Context, invoiceNumber string)) *MockInvoiceUsecase_GetInvoiceByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_GetInvoiceByNumber_Call) Return(invoice *models.Invoice, err error) *MockInvoiceUsecase_GetInvoiceByNumber_Call {
+	_c.Call.Return(invoice, err)
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_GetInvoiceByNumber_Call) RunAndReturn(run func(ctx context.Context, invoiceNumber string) (*models.Invoice, error)) *MockInvoiceUsecase_GetInvoiceByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNumbering provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) GetNumbering(ctx context.Context) (models.InvoiceNumbering, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNumbering")
+	}
+
+	var r0 models.InvoiceNumbering
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (models.InvoiceNumbering, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) models.InvoiceNumbering); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(models.InvoiceNumbering)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceUsecase_GetNumbering_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNumbering'
+type MockInvoiceUsecase_GetNumbering_Call struct {
+	*mock.Call
+}
+
+// GetNumbering is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInvoiceUsecase_Expecter) GetNumbering(ctx interface{}) *MockInvoiceUsecase_GetNumbering_Call {
+	return &MockInvoiceUsecase_GetNumbering_Call{Call: _e.mock.On("GetNumbering", ctx)}
+}
+
+func (_c *MockInvoiceUsecase_GetNumbering_Call) Run(run func(ctx context.Context)) *MockInvoiceUsecase_GetNumbering_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_GetNumbering_Call) Return(invoiceNumbering models.InvoiceNumbering, err error) *MockInvoiceUsecase_GetNumbering_Call {
+	_c.Call.Return(invoiceNumbering, err)
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_GetNumbering_Call) RunAndReturn(run func(ctx context.Context) (models.InvoiceNumbering, error)) *MockInvoiceUsecase_GetNumbering_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchInvoices provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) SearchInvoices(ctx context.Context, condition *models.InvoiceSearchCondition) (*models.InvoicePage, error) {
+	ret := _mock.Called(ctx, condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchInvoices")
+	}
+
+	var r0 *models.InvoicePage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.InvoiceSearchCondition) (*models.InvoicePage, error)); ok {
+		return returnFunc(ctx, condition)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.InvoiceSearchCondition) *models.InvoicePage); ok {
+		r0 = returnFunc(ctx, condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InvoicePage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *models.InvoiceSearchCondition) error); ok {
+		r1 = returnFunc(ctx, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceUsecase_SearchInvoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchInvoices'
+type MockInvoiceUsecase_SearchInvoices_Call struct {
+	*mock.Call
+}
+
+// SearchInvoices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - condition *models.InvoiceSearchCondition
+func (_e *MockInvoiceUsecase_Expecter) SearchInvoices(ctx interface{}, condition interface{}) *MockInvoiceUsecase_SearchInvoices_Call {
+	return &MockInvoiceUsecase_SearchInvoices_Call{Call: _e.mock.On("SearchInvoices", ctx, condition)}
+}
+
+func (_c *MockInvoiceUsecase_SearchInvoices_Call) Run(run func(ctx context.Context, condition *models.InvoiceSearchCondition)) *MockInvoiceUsecase_SearchInvoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.InvoiceSearchCondition
+		if args[1] != nil {
+			arg1 = args[1].(*models.InvoiceSearchCondition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_SearchInvoices_Call) Return(invoicePage *models.InvoicePage, err error) *MockInvoiceUsecase_SearchInvoices_Call {
+	_c.Call.Return(invoicePage, err)
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_SearchInvoices_Call) RunAndReturn(run func(ctx context.Context, condition *models.InvoiceSearchCondition) (*models.InvoicePage, error)) *MockInvoiceUsecase_SearchInvoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNumbering provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) UpdateNumbering(ctx context.Context, numbering models.InvoiceNumbering, version int) (models.InvoiceNumbering, error) {
+	ret := _mock.Called(ctx, numbering, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNumbering")
+	}
+
+	var r0 models.InvoiceNumbering
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.InvoiceNumbering, int) (models.InvoiceNumbering, error)); ok {
+		return returnFunc(ctx, numbering, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.InvoiceNumbering, int) models.InvoiceNumbering); ok {
+		r0 = returnFunc(ctx, numbering, version)
+	} else {
+		r0 = ret.Get(0).(models.InvoiceNumbering)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.InvoiceNumbering, int) error); ok {
+		r1 = returnFunc(ctx, numbering, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceUsecase_UpdateNumbering_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNumbering'
+type MockInvoiceUsecase_UpdateNumbering_Call struct {
+	*mock.Call
+}
+
+// UpdateNumbering is a helper method to define mock.On call
+//   - ctx context.Context
+//   - numbering models.InvoiceNumbering
+//   - version int
+func (_e *MockInvoiceUsecase_Expecter) UpdateNumbering(ctx interface{}, numbering interface{}, version interface{}) *MockInvoiceUsecase_UpdateNumbering_Call {
+	return &MockInvoiceUsecase_UpdateNumbering_Call{Call: _e.mock.On("UpdateNumbering", ctx, numbering, version)}
+}
+
+func (_c *MockInvoiceUsecase_UpdateNumbering_Call) Run(run func(ctx context.Context, numbering models.InvoiceNumbering, version int)) *MockInvoiceUsecase_UpdateNumbering_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.InvoiceNumbering
+		if args[1] != nil {
+			arg1 = args[1].(models.InvoiceNumbering)
+		}
+		var arg2 int
 		if args[2] != nil {
-			arg2 = args[2].(*time.Time)
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_UpdateNumbering_Call) Return(invoiceNumbering models.InvoiceNumbering, err error) *MockInvoiceUsecase_UpdateNumbering_Call {
+	_c.Call.Return(invoiceNumbering, err)
+	return _c
+}
+
+func (_c *MockInvoiceUsecase_UpdateNumbering_Call) RunAndReturn(run func(ctx context.Context, numbering models.InvoiceNumbering, version int) (models.InvoiceNumbering, error)) *MockInvoiceUsecase_UpdateNumbering_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRetentionClass provides a mock function for the type MockInvoiceUsecase
+func (_mock *MockInvoiceUsecase) UpdateRetentionClass(ctx context.Context, invoiceID string, retentionClass value.RetentionClass, version int) (*models.Invoice, error) {
+	ret := _mock.Called(ctx, invoiceID, retentionClass, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRetentionClass")
+	}
+
+	var r0 *models.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, value.RetentionClass, int) (*models.Invoice, error)); ok {
+		return returnFunc(ctx, invoiceID, retentionClass, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, value.RetentionClass, int) *models.Invoice); ok {
+		r0 = returnFunc(ctx, invoiceID, retentionClass, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, value.RetentionClass, int) error); ok {
+		r1 = returnFunc(ctx, invoiceID, retentionClass, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceUsecase_UpdateRetentionClass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRetentionClass'
+type MockInvoiceUsecase_UpdateRetentionClass_Call struct {
+	*mock.Call
+}
+
+// UpdateRetentionClass is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invoiceID string
+//   - retentionClass value.RetentionClass
+//   - version int
+func (_e *MockInvoiceUsecase_Expecter) UpdateRetentionClass(ctx interface{}, invoiceID interface{}, retentionClass interface{}, version interface{}) *MockInvoiceUsecase_UpdateRetentionClass_Call {
+	return &MockInvoiceUsecase_UpdateRetentionClass_Call{Call: _e.mock.On("UpdateRetentionClass", ctx, invoiceID, retentionClass, version)}
+}
+
+func (_c *MockInvoiceUsecase_UpdateRetentionClass_Call) Run(run func(ctx context.Context, invoiceID string, retentionClass value.RetentionClass, version int)) *MockInvoiceUsecase_UpdateRetentionClass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 value.RetentionClass
+		if args[2] != nil {
+			arg2 = args[2].(value.RetentionClass)
 		}
 		var arg3 int
 		if args[3] != nil {
 			arg3 = args[3].(int)
 		}
-		var arg4 int
-		if args[4] != nil {
-			arg4 = args[4].(int)
-		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call) Return(invoices []*models.Invoice, err error) *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call {
-	_c.Call.Return(invoices, err)
+func (_c *MockInvoiceUsecase_UpdateRetentionClass_Call) Return(invoice *models.Invoice, err error) *MockInvoiceUsecase_UpdateRetentionClass_Call {
+	_c.Call.Return(invoice, err)
 	return _c
 }
 
-func (_c *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call) RunAndReturn(run func(ctx context.Context, startDate *time.Time, endDate *time.Time, offset int, limit int) ([]*models.Invoice, error)) *MockInvoiceUsecase_GetInvoicesByPaymentDueDateRange_Call {
+func (_c *MockInvoiceUsecase_UpdateRetentionClass_Call) RunAndReturn(run func(ctx context.Context, invoiceID string, retentionClass value.RetentionClass, version int) (*models.Invoice, error)) *MockInvoiceUsecase_UpdateRetentionClass_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+type PaymentUsecase interface {
+	// RecordPayment は請求書に支払を記録し、支払済みの合計とステータスを更新した請求書を返します。
+	// version が請求書の現在のバージョンと一致する場合だけ記録します
+	RecordPayment(ctx context.Context, invoiceID string, payment *models.Payment, version int) (*models.Invoice, error)
+	ListPayments(ctx context.Context, invoiceID string) ([]*models.Payment, error)
+}
+
+// minPaymentAmount は記録できる支払額の下限です
+var minPaymentAmount = decimal.NewFromInt(1)
+
+var errInvoiceNotPayable = apperror.NewConflict(apperror.CodeInvoiceNotPayable, "invoice has not been approved")
+
+type paymentUsecase struct {
+	invoiceRepository repository.InvoiceRepository
+	paymentRepository repository.PaymentRepository
+	userRepository    repository.UserRepository
+	now               func() time.Time
+}
+
+func NewPaymentUsecase(invoiceRepository repository.InvoiceRepository, paymentRepository repository.PaymentRepository, userRepository repository.UserRepository) PaymentUsecase {
+	return &tracedPaymentUsecase{
+		next: &paymentUsecase{
+			invoiceRepository: invoiceRepository,
+			paymentRepository: paymentRepository,
+			userRepository:    userRepository,
+			now:               time.Now,
+		},
+	}
+}
+
+// RecordPayment は承認フローで承認済みの請求書に、未払いの残高を超えない支払だけを記録します。
+// 残高がなくなった請求書は支払済、残高が残る請求書は一部支払済にします
+func (u *paymentUsecase) RecordPayment(ctx context.Context, invoiceID string, payment *models.Payment, version int) (*models.Invoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(invoice.Version, version); err != nil {
+		return nil, err
+	}
+	if !invoice.Status.IsPayable() {
+		return nil, errInvoiceNotPayable
+	}
+
+	now := u.now()
+	if payment.Amount.LessThan(minPaymentAmount) {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "amount", Code: apperror.FieldCodeMin, Param: minPaymentAmount.String()})
+	}
+	if payment.PaidDate.After(now) {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "paid_date", Code: apperror.FieldCodeInvalidValue})
+	}
+	if outstanding := invoice.OutstandingAmount(); payment.Amount.GreaterThan(outstanding) {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "amount", Code: apperror.FieldCodeMax, Param: outstanding.String()})
+	}
+
+	payment.InvoiceID = invoice.ID
+	invoice.ApplyPayment(payment.Amount)
+	invoice.UpdatedAt = now
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		// 同時に記録した支払で残高を超えないよう、請求書のバージョンを進めてから記録する
+		if err := u.invoiceRepository.UpdatePayment(tx, invoice, version); err != nil {
+			return versionConflictError(err)
+		}
+
+		return u.paymentRepository.Create(tx, payment)
+	}); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "payment recorded",
+		slog.String("payment_id", payment.ID),
+		slog.String("invoice_id", invoice.ID),
+		slog.String("company_id", invoice.CompanyID),
+		slog.String("status", string(invoice.Status)),
+	)
+
+	return invoice, nil
+}
+
+// ListPayments はログインユーザーの会社の請求書の支払を支払日の順に返します
+func (u *paymentUsecase) ListPayments(ctx context.Context, invoiceID string) ([]*models.Payment, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := findCompanyInvoice(ctx, db, u.userRepository, u.invoiceRepository, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.paymentRepository.FindByInvoiceID(db, invoice.ID)
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type paymentMocks struct {
+	invoiceRepository *repository.MockInvoiceRepository
+	paymentRepository *repository.MockPaymentRepository
+	userRepository    *repository.MockUserRepository
+}
+
+func newTestPaymentUsecase(t *testing.T, now time.Time) (*paymentUsecase, *paymentMocks) {
+	m := &paymentMocks{
+		invoiceRepository: repository.NewMockInvoiceRepository(t),
+		paymentRepository: repository.NewMockPaymentRepository(t),
+		userRepository:    repository.NewMockUserRepository(t),
+	}
+
+	return &paymentUsecase{
+		invoiceRepository: m.invoiceRepository,
+		paymentRepository: m.paymentRepository,
+		userRepository:    m.userRepository,
+		now:               func() time.Time { return now },
+	}, m
+}
+
+func TestPaymentUsecase_RecordPayment(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	now := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+	newInvoice := func() *models.Invoice {
+		return &models.Invoice{
+			ID:            "invoiceID",
+			CompanyID:     user.CompanyID,
+			InvoiceAmount: decimal.NewFromInt(104400),
+			Status:        value.InvoiceStatusUnprocessed,
+			Version:       2,
+		}
+	}
+	newPayment := func(amount int64) *models.Payment {
+		return &models.Payment{
+			Amount:    decimal.NewFromInt(amount),
+			PaidDate:  time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC),
+			Method:    value.PaymentMethodBankTransfer,
+			Reference: "TRX-0001",
+		}
+	}
+
+	t.Run("残高が残る支払は一部支払済にする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestPaymentUsecase(t, now)
+		payment := newPayment(60000)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceRepository.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(invoice *models.Invoice) bool {
+			return invoice.PaidAmount.Equal(decimal.NewFromInt(60000)) && invoice.Status == value.InvoiceStatusPartiallyPaid
+		}), 2).Run(func(_ *gorm.DB, invoice *models.Invoice, version int) { invoice.Version = version + 1 }).Return(nil)
+		m.paymentRepository.EXPECT().Create(mock.Anything, payment).Return(nil)
+
+		invoice, err := usecase.RecordPayment(ctx, "invoiceID", payment, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "invoiceID", payment.InvoiceID)
+		assert.Equal(t, value.InvoiceStatusPartiallyPaid, invoice.Status)
+		assert.True(t, decimal.NewFromInt(44400).Equal(invoice.OutstandingAmount()))
+		assert.Equal(t, 3, invoice.Version)
+	})
+
+	t.Run("残高と同額の支払は支払済にする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestPaymentUsecase(t, now)
+		current := newInvoice()
+		current.PaidAmount = decimal.NewFromInt(60000)
+		current.Status = value.InvoiceStatusPartiallyPaid
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(current, nil)
+		m.invoiceRepository.EXPECT().UpdatePayment(mock.Anything, mock.Anything, 2).Return(nil)
+		m.paymentRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+		invoice, err := usecase.RecordPayment(ctx, "invoiceID", newPayment(44400), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.InvoiceStatusPaid, invoice.Status)
+		assert.True(t, invoice.OutstandingAmount().IsZero())
+	})
+
+	t.Run("入力が不正な場合は記録しない", func(t *testing.T) {
+		paid := newInvoice()
+		paid.PaidAmount = paid.InvoiceAmount
+		paid.Status = value.InvoiceStatusPaid
+		future := newPayment(1000)
+		future.PaidDate = time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+
+		tests := []struct {
+			name     string
+			invoice  *models.Invoice
+			payment  *models.Payment
+			expected apperror.FieldError
+		}{
+			{"0以下の支払", newInvoice(), newPayment(-1000), apperror.FieldError{Field: "amount", Code: apperror.FieldCodeMin, Param: "1"}},
+			{"残高を超える支払", newInvoice(), newPayment(104401), apperror.FieldError{Field: "amount", Code: apperror.FieldCodeMax, Param: "104400"}},
+			{"支払済の請求書への支払", paid, newPayment(1), apperror.FieldError{Field: "amount", Code: apperror.FieldCodeMax, Param: "0"}},
+			{"未来の支払日", newInvoice(), future, apperror.FieldError{Field: "paid_date", Code: apperror.FieldCodeInvalidValue}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := setupClientUsecaseContext(t)
+				usecase, m := newTestPaymentUsecase(t, now)
+
+				m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+				m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(tt.invoice, nil)
+
+				invoice, err := usecase.RecordPayment(ctx, "invoiceID", tt.payment, 2)
+
+				assert.Nil(t, invoice)
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, apperror.KindValidation, appErr.Kind)
+				assert.Equal(t, []apperror.FieldError{tt.expected}, appErr.Fields)
+			})
+		}
+	})
+
+	t.Run("承認待ち・却下の請求書には記録しない", func(t *testing.T) {
+		for _, status := range []value.InvoiceStatus{value.InvoiceStatusPendingApproval, value.InvoiceStatusRejected} {
+			ctx := setupClientUsecaseContext(t)
+			usecase, m := newTestPaymentUsecase(t, now)
+			current := newInvoice()
+			current.Status = status
+
+			m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+			m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(current, nil)
+
+			_, err := usecase.RecordPayment(ctx, "invoiceID", newPayment(1000), 2)
+
+			appErr, ok := apperror.As(err)
+			assert.True(t, ok, status)
+			assert.Equal(t, apperror.CodeInvoiceNotPayable, appErr.Code, status)
+		}
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestPaymentUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+
+		_, err := usecase.RecordPayment(ctx, "invoiceID", newPayment(1000), 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("取得後に他の支払と競合した場合はPreconditionFailedで記録しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestPaymentUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceRepository.EXPECT().UpdatePayment(mock.Anything, mock.Anything, 2).
+			Return(&domainRepository.VersionConflictError{Entity: "invoice", ID: "invoiceID", Version: 2})
+
+		_, err := usecase.RecordPayment(ctx, "invoiceID", newPayment(1000), 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("支払の記録に失敗した場合はエラー", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestPaymentUsecase(t, now)
+		createErr := errors.New("insert failed")
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(newInvoice(), nil)
+		m.invoiceRepository.EXPECT().UpdatePayment(mock.Anything, mock.Anything, 2).Return(nil)
+		m.paymentRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(createErr)
+
+		_, err := usecase.RecordPayment(ctx, "invoiceID", newPayment(1000), 2)
+
+		assert.ErrorIs(t, err, createErr)
+	})
+
+	t.Run("他社の請求書はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestPaymentUsecase(t, now)
+		other := newInvoice()
+		other.CompanyID = "otherCompanyID"
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, "invoiceID").Return(other, nil)
+
+		_, err := usecase.RecordPayment(ctx, "invoiceID", newPayment(1000), 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
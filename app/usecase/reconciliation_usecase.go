@@ -0,0 +1,331 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/statement"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// ReconciliationUsecase は銀行の入出金明細を取り込み、出金を請求書の支払として消し込みます
+type ReconciliationUsecase interface {
+	// ImportStatement は明細を取り込み、一致度が満点の請求書が1件だけの出金は支払を記録して自動で消し込みます。
+	// 残りの出金は確認待ち、入金は除外にします。取り込み済みの明細は除きます
+	ImportStatement(ctx context.Context, format value.StatementFormat, r io.Reader) (*models.BankStatement, error)
+	// ListLines はログインユーザーの会社の明細を返します。状態を指定しない場合は確認待ちの明細で、確認待ちの明細には候補を付けます
+	ListLines(ctx context.Context, condition *models.BankStatementLineCondition) ([]*models.BankStatementLine, error)
+	// ConfirmLine は確認待ちの明細を指定した請求書の支払として記録し、消し込んだ明細と請求書を返します
+	ConfirmLine(ctx context.Context, lineID string, invoiceID string) (*models.BankStatementLine, *models.Invoice, error)
+	// IgnoreLine は確認待ちの明細を請求書の支払ではないものとして除外します
+	IgnoreLine(ctx context.Context, lineID string) (*models.BankStatementLine, error)
+}
+
+var (
+	errStatementLineNotFound   = apperror.NewNotFound(apperror.CodeNotFound, "bank statement line not found")
+	errStatementLineNotPending = apperror.NewConflict(apperror.CodeStatementLineNotPending, "bank statement line is not pending")
+)
+
+type reconciliationUsecase struct {
+	bankStatementRepository repository.BankStatementRepository
+	invoiceRepository       repository.InvoiceRepository
+	userRepository          repository.UserRepository
+	paymentUsecase          PaymentUsecase
+	now                     func() time.Time
+}
+
+func NewReconciliationUsecase(bankStatementRepository repository.BankStatementRepository, invoiceRepository repository.InvoiceRepository, userRepository repository.UserRepository, paymentUsecase PaymentUsecase) ReconciliationUsecase {
+	return &tracedReconciliationUsecase{
+		next: &reconciliationUsecase{
+			bankStatementRepository: bankStatementRepository,
+			invoiceRepository:       invoiceRepository,
+			userRepository:          userRepository,
+			paymentUsecase:          paymentUsecase,
+			now:                     time.Now,
+		},
+	}
+}
+
+func (u *reconciliationUsecase) ImportStatement(ctx context.Context, format value.StatementFormat, r io.Reader) (*models.BankStatement, error) {
+	if format == "" {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "format", Code: apperror.FieldCodeRequired})
+	}
+	if !format.IsValid() {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "format", Code: apperror.FieldCodeInvalidValue})
+	}
+
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.findUser(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := statement.Parse(format, r)
+	if err != nil {
+		var parseErr *statement.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, apperror.NewValidation(apperror.FieldError{Field: "file", Code: apperror.FieldCodeInvalidFormat, Param: strconv.Itoa(parseErr.Line)}).Wrap(err)
+		}
+		return nil, err
+	}
+
+	fingerprints := make([]string, len(lines))
+	for i, line := range lines {
+		fingerprints[i] = line.Fingerprint
+	}
+	imported, err := u.bankStatementRepository.FindFingerprints(db, user.CompanyID, fingerprints)
+	if err != nil {
+		return nil, err
+	}
+	bankStatement := &models.BankStatement{
+		CompanyID:  user.CompanyID,
+		Format:     format,
+		ImportedBy: user.ID,
+		Lines:      make([]*models.BankStatementLine, 0, len(lines)),
+	}
+	for _, line := range lines {
+		if imported[line.Fingerprint] {
+			bankStatement.DuplicateCount++
+			continue
+		}
+		bankStatement.Lines = append(bankStatement.Lines, line)
+	}
+
+	targets, err := u.bankStatementRepository.FindReconciliationTargets(db, user.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	autoMatches := statement.Reconcile(bankStatement.Lines, targets)
+
+	now := u.now()
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		txCtx := util.SetDB(ctx, tx)
+		for _, line := range bankStatement.Lines {
+			candidate, ok := autoMatches[line]
+			if !ok {
+				continue
+			}
+			payment, err := u.recordPayment(txCtx, line, candidate.Invoice)
+			if err != nil {
+				// 取引日が未来など支払を記録できない明細は、自動で消し込まずに確認待ちのままにする
+				if _, ok := apperror.As(err); ok {
+					slog.WarnContext(ctx, "bank statement line could not be reconciled automatically",
+						slog.Int("line_no", line.LineNo),
+						slog.String("invoice_id", candidate.Invoice.ID),
+						slog.String("error", err.Error()),
+					)
+					continue
+				}
+				return err
+			}
+			line.Confirm(candidate.Invoice.ID, payment.ID, candidate.Score, true, user.ID, now)
+			line.Candidates = nil
+		}
+
+		return u.bankStatementRepository.Create(tx, bankStatement)
+	}); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "bank statement imported",
+		slog.String("statement_id", bankStatement.ID),
+		slog.String("company_id", bankStatement.CompanyID),
+		slog.Int("lines", len(bankStatement.Lines)),
+		slog.Int("duplicates", bankStatement.DuplicateCount),
+		slog.Int("confirmed", bankStatement.CountByStatus(value.StatementLineStatusConfirmed)),
+		slog.Int("pending", bankStatement.CountByStatus(value.StatementLineStatusPending)),
+	)
+
+	return bankStatement, nil
+}
+
+func (u *reconciliationUsecase) ListLines(ctx context.Context, condition *models.BankStatementLineCondition) ([]*models.BankStatementLine, error) {
+	if condition.Status == "" {
+		condition.Status = value.StatementLineStatusPending
+	}
+	if !condition.Status.IsValid() {
+		return nil, apperror.NewValidation(apperror.FieldError{Field: "status", Code: apperror.FieldCodeInvalidValue})
+	}
+
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.findUser(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := u.bankStatementRepository.SearchLines(db, user.CompanyID, condition)
+	if err != nil {
+		return nil, err
+	}
+	if condition.Status != value.StatementLineStatusPending || len(lines) == 0 {
+		return lines, nil
+	}
+
+	// 候補は取り込んだ後の支払・請求書の登録を反映するよう、一覧を返すたびに計算する
+	targets, err := u.bankStatementRepository.FindReconciliationTargets(db, user.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		line.Candidates = statement.Candidates(line, targets)
+	}
+
+	return lines, nil
+}
+
+func (u *reconciliationUsecase) ConfirmLine(ctx context.Context, lineID string, invoiceID string) (*models.BankStatementLine, *models.Invoice, error) {
+	if invoiceID == "" {
+		return nil, nil, apperror.NewValidation(apperror.FieldError{Field: "invoice_id", Code: apperror.FieldCodeRequired})
+	}
+
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	user, line, err := u.findPendingLine(ctx, db, lineID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice, err := findInvoiceOfCompany(db, u.invoiceRepository, user.CompanyID, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	score, err := u.score(db, user.CompanyID, line, invoice.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := u.now()
+	version := line.Version
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		payment, err := u.recordPayment(util.SetDB(ctx, tx), line, invoice)
+		if err != nil {
+			return err
+		}
+		line.Confirm(invoice.ID, payment.ID, score, false, user.ID, now)
+		line.UpdatedAt = now
+
+		// 同じ明細を同時に消し込んだ場合は、後の支払の記録を取り消す
+		if err := u.bankStatementRepository.UpdateLine(tx, line, version); err != nil {
+			return versionConflictError(err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	updated, err := u.invoiceRepository.FindByID(db, invoice.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	slog.InfoContext(ctx, "bank statement line confirmed",
+		slog.String("line_id", line.ID),
+		slog.String("invoice_id", invoice.ID),
+		slog.Int("score", score),
+	)
+
+	return line, updated, nil
+}
+
+func (u *reconciliationUsecase) IgnoreLine(ctx context.Context, lineID string) (*models.BankStatementLine, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, line, err := u.findPendingLine(ctx, db, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	version := line.Version
+	line.Ignore(user.ID, now)
+	line.UpdatedAt = now
+	if err := u.bankStatementRepository.UpdateLine(db, line, version); err != nil {
+		return nil, versionConflictError(err)
+	}
+
+	return line, nil
+}
+
+// recordPayment は出金の明細を請求書の銀行振込の支払として記録します。
+// 明細の取引日を支払日、照会番号を参照番号にします
+func (u *reconciliationUsecase) recordPayment(ctx context.Context, line *models.BankStatementLine, invoice *models.Invoice) (*models.Payment, error) {
+	payment := &models.Payment{
+		Amount:    line.Amount,
+		PaidDate:  line.TransactionDate,
+		Method:    value.PaymentMethodBankTransfer,
+		Reference: line.Reference,
+	}
+	if _, err := u.paymentUsecase.RecordPayment(ctx, invoice.ID, payment, invoice.Version); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// score は手動で消し込む請求書との一致度を返します。支払を記録できない請求書は0です
+func (u *reconciliationUsecase) score(db *gorm.DB, companyID string, line *models.BankStatementLine, invoiceID string) (int, error) {
+	targets, err := u.bankStatementRepository.FindReconciliationTargets(db, companyID)
+	if err != nil {
+		return 0, err
+	}
+	for _, target := range targets {
+		if target.Invoice.ID == invoiceID {
+			return statement.Score(line, target), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// findUser はログインユーザーを返します
+func (u *reconciliationUsecase) findUser(ctx context.Context, db *gorm.DB) (*models.User, error) {
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.userRepository.FindByID(db, userID)
+}
+
+// findPendingLine はログインユーザーとその会社の確認待ちの明細を返します。
+// 他社の明細は存在を明かさないよう、存在しない場合と同じエラーにします
+func (u *reconciliationUsecase) findPendingLine(ctx context.Context, db *gorm.DB, lineID string) (*models.User, *models.BankStatementLine, error) {
+	user, err := u.findUser(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	line, err := u.bankStatementRepository.FindLineByID(db, lineID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errStatementLineNotFound
+		}
+		return nil, nil, err
+	}
+	if line.CompanyID != user.CompanyID {
+		return nil, nil, errStatementLineNotFound
+	}
+	if line.Status != value.StatementLineStatusPending {
+		return nil, nil, errStatementLineNotPending
+	}
+
+	return user, line, nil
+}
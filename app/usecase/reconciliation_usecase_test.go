@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type reconciliationMocks struct {
+	bankStatementRepository *repository.MockBankStatementRepository
+	invoiceRepository       *repository.MockInvoiceRepository
+	userRepository          *repository.MockUserRepository
+	paymentUsecase          *mocks.MockPaymentUsecase
+}
+
+func newTestReconciliationUsecase(t *testing.T, now time.Time) (*reconciliationUsecase, *reconciliationMocks) {
+	m := &reconciliationMocks{
+		bankStatementRepository: repository.NewMockBankStatementRepository(t),
+		invoiceRepository:       repository.NewMockInvoiceRepository(t),
+		userRepository:          repository.NewMockUserRepository(t),
+		paymentUsecase:          mocks.NewMockPaymentUsecase(t),
+	}
+
+	return &reconciliationUsecase{
+		bankStatementRepository: m.bankStatementRepository,
+		invoiceRepository:       m.invoiceRepository,
+		userRepository:          m.userRepository,
+		paymentUsecase:          m.paymentUsecase,
+		now:                     func() time.Time { return now },
+	}, m
+}
+
+func TestReconciliationUsecase_ImportStatement(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	now := time.Date(2025, 12, 27, 9, 0, 0, 0, time.UTC)
+	newTarget := func(id string, amount int64, clientName, accountName string) *models.ReconciliationTarget {
+		return &models.ReconciliationTarget{
+			Invoice: &models.Invoice{
+				ID:             id,
+				CompanyID:      user.CompanyID,
+				IssueDate:      time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+				PaymentDueDate: time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+				InvoiceAmount:  decimal.NewFromInt(amount),
+				Status:         value.InvoiceStatusProcessed,
+				Version:        3,
+			},
+			ClientName:   clientName,
+			AccountNames: []string{accountName},
+		}
+	}
+	csv := "date,amount,name,reference\n" +
+		"2025-12-26,-10440,ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ,REF-1\n" +
+		"2025-12-26,-5000,ﾃｽﾄｼｮｳﾃﾝ,REF-2\n" +
+		"2025-12-26,50000,ﾃｽﾄ,REF-3\n"
+
+	t.Run("満点の出金は支払を記録して消し込み、残りは確認待ちと除外にする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		exact := newTarget("exact", 10440, "株式会社サンプル商事", "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ")
+		partial := newTarget("partial", 8000, "テスト商店", "ﾃｽﾄｼｮｳﾃﾝ")
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindFingerprints(mock.Anything, user.CompanyID, mock.Anything).Return(map[string]bool{}, nil)
+		m.bankStatementRepository.EXPECT().FindReconciliationTargets(mock.Anything, user.CompanyID).Return([]*models.ReconciliationTarget{exact, partial}, nil)
+		m.paymentUsecase.EXPECT().RecordPayment(mock.Anything, "exact", mock.MatchedBy(func(p *models.Payment) bool {
+			return p.Amount.Equal(decimal.NewFromInt(10440)) && p.Method == value.PaymentMethodBankTransfer && p.Reference == "REF-1"
+		}), 3).RunAndReturn(func(_ context.Context, _ string, p *models.Payment, _ int) (*models.Invoice, error) {
+			p.ID = "paymentID"
+			return exact.Invoice, nil
+		})
+		m.bankStatementRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(s *models.BankStatement) bool {
+			return s.CompanyID == user.CompanyID && s.ImportedBy == user.ID && len(s.Lines) == 3
+		})).Return(nil)
+
+		result, err := usecase.ImportStatement(ctx, value.StatementFormatCSV, strings.NewReader(csv))
+
+		assert.NoError(t, err)
+		confirmed := result.Lines[0]
+		assert.Equal(t, value.StatementLineStatusConfirmed, confirmed.Status)
+		assert.Equal(t, "paymentID", *confirmed.PaymentID)
+		assert.True(t, confirmed.AutoConfirmed)
+		assert.Equal(t, 100, confirmed.Score)
+		assert.Empty(t, confirmed.Candidates)
+		assert.Equal(t, value.StatementLineStatusPending, result.Lines[1].Status)
+		assert.Equal(t, "partial", result.Lines[1].Candidates[0].Invoice.ID)
+		assert.Equal(t, value.StatementLineStatusIgnored, result.Lines[2].Status)
+	})
+
+	t.Run("取り込み済みの明細は除く", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindFingerprints(mock.Anything, user.CompanyID, mock.Anything).
+			RunAndReturn(func(_ *gorm.DB, _ string, fingerprints []string) (map[string]bool, error) {
+				return map[string]bool{fingerprints[0]: true, fingerprints[1]: true}, nil
+			})
+		m.bankStatementRepository.EXPECT().FindReconciliationTargets(mock.Anything, user.CompanyID).Return([]*models.ReconciliationTarget{}, nil)
+		m.bankStatementRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+		result, err := usecase.ImportStatement(ctx, value.StatementFormatCSV, strings.NewReader(csv))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Lines, 1)
+		assert.Equal(t, 2, result.DuplicateCount)
+	})
+
+	t.Run("支払を記録できない場合は確認待ちのままにする", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		exact := newTarget("exact", 10440, "株式会社サンプル商事", "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ")
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindFingerprints(mock.Anything, user.CompanyID, mock.Anything).Return(map[string]bool{}, nil)
+		m.bankStatementRepository.EXPECT().FindReconciliationTargets(mock.Anything, user.CompanyID).Return([]*models.ReconciliationTarget{exact}, nil)
+		m.paymentUsecase.EXPECT().RecordPayment(mock.Anything, "exact", mock.Anything, 3).Return(nil, errVersionMismatch)
+		m.bankStatementRepository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+		result, err := usecase.ImportStatement(ctx, value.StatementFormatCSV, strings.NewReader(csv))
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.StatementLineStatusPending, result.Lines[0].Status)
+		assert.Len(t, result.Lines[0].Candidates, 1)
+	})
+
+	t.Run("読み込めないファイルは行の番号を返す", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+
+		_, err := usecase.ImportStatement(ctx, value.StatementFormatCSV, strings.NewReader("date,amount\n2025-12-26,abc\n"))
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, []apperror.FieldError{{Field: "file", Code: apperror.FieldCodeInvalidFormat, Param: "2"}}, appErr.Fields)
+	})
+
+	t.Run("形式が不正な場合はValidationエラー", func(t *testing.T) {
+		usecase, _ := newTestReconciliationUsecase(t, now)
+
+		_, err := usecase.ImportStatement(setupClientUsecaseContext(t), "ofx", strings.NewReader(""))
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, []apperror.FieldError{{Field: "format", Code: apperror.FieldCodeInvalidValue}}, appErr.Fields)
+	})
+}
+
+func TestReconciliationUsecase_Lines(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	now := time.Date(2025, 12, 27, 9, 0, 0, 0, time.UTC)
+	newLine := func(status value.StatementLineStatus) *models.BankStatementLine {
+		return &models.BankStatementLine{
+			ID:               "lineID",
+			CompanyID:        user.CompanyID,
+			TransactionDate:  time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+			Direction:        value.StatementDirectionWithdrawal,
+			Amount:           decimal.NewFromInt(10440),
+			CounterpartyName: "ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ",
+			Reference:        "REF-1",
+			Status:           status,
+			Version:          1,
+		}
+	}
+	invoice := &models.Invoice{
+		ID:             "invoiceID",
+		CompanyID:      user.CompanyID,
+		IssueDate:      time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+		PaymentDueDate: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		InvoiceAmount:  decimal.NewFromInt(10440),
+		Status:         value.InvoiceStatusProcessed,
+		Version:        2,
+	}
+	target := &models.ReconciliationTarget{Invoice: invoice, ClientName: "サンプル商事", AccountNames: []string{"ｶ)ｻﾝﾌﾟﾙｼｮｳｼﾞ"}}
+
+	t.Run("状態を指定しない場合は確認待ちの明細に候補を付けて返す", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().SearchLines(mock.Anything, user.CompanyID, &models.BankStatementLineCondition{Status: value.StatementLineStatusPending, Limit: 10}).
+			Return([]*models.BankStatementLine{newLine(value.StatementLineStatusPending)}, nil)
+		m.bankStatementRepository.EXPECT().FindReconciliationTargets(mock.Anything, user.CompanyID).Return([]*models.ReconciliationTarget{target}, nil)
+
+		lines, err := usecase.ListLines(ctx, &models.BankStatementLineCondition{Limit: 10})
+
+		assert.NoError(t, err)
+		assert.Len(t, lines[0].Candidates, 1)
+		assert.Equal(t, 50+10+30, lines[0].Candidates[0].Score)
+	})
+
+	t.Run("確認待ちの明細を指定した請求書の支払として消し込む", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		paid := *invoice
+		paid.Status = value.InvoiceStatusPaid
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindLineByID(mock.Anything, "lineID").Return(newLine(value.StatementLineStatusPending), nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, invoice.ID).Return(invoice, nil).Once()
+		m.bankStatementRepository.EXPECT().FindReconciliationTargets(mock.Anything, user.CompanyID).Return([]*models.ReconciliationTarget{target}, nil)
+		m.paymentUsecase.EXPECT().RecordPayment(mock.Anything, invoice.ID, mock.Anything, 2).
+			RunAndReturn(func(_ context.Context, _ string, p *models.Payment, _ int) (*models.Invoice, error) {
+				p.ID = "paymentID"
+				return &paid, nil
+			})
+		m.bankStatementRepository.EXPECT().UpdateLine(mock.Anything, mock.MatchedBy(func(l *models.BankStatementLine) bool {
+			return l.Status == value.StatementLineStatusConfirmed && *l.PaymentID == "paymentID" && *l.DecidedBy == user.ID && !l.AutoConfirmed
+		}), 1).Return(nil)
+		m.invoiceRepository.EXPECT().FindByID(mock.Anything, invoice.ID).Return(&paid, nil).Once()
+
+		line, updated, err := usecase.ConfirmLine(ctx, "lineID", invoice.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 90, line.Score)
+		assert.Equal(t, value.InvoiceStatusPaid, updated.Status)
+	})
+
+	t.Run("確認待ちでない明細は消し込めない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindLineByID(mock.Anything, "lineID").Return(newLine(value.StatementLineStatusIgnored), nil)
+
+		_, _, err := usecase.ConfirmLine(ctx, "lineID", invoice.ID)
+
+		assert.ErrorIs(t, err, errStatementLineNotPending)
+	})
+
+	t.Run("他社の明細はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		other := newLine(value.StatementLineStatusPending)
+		other.CompanyID = "otherCompany"
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindLineByID(mock.Anything, "lineID").Return(other, nil)
+
+		_, err := usecase.IgnoreLine(ctx, "lineID")
+
+		assert.ErrorIs(t, err, errStatementLineNotFound)
+	})
+
+	t.Run("確認待ちの明細を除外する", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestReconciliationUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.bankStatementRepository.EXPECT().FindLineByID(mock.Anything, "lineID").Return(newLine(value.StatementLineStatusPending), nil)
+		m.bankStatementRepository.EXPECT().UpdateLine(mock.Anything, mock.MatchedBy(func(l *models.BankStatementLine) bool {
+			return l.Status == value.StatementLineStatusIgnored && l.DecidedAt.Equal(now)
+		}), 1).Return(nil)
+
+		line, err := usecase.IgnoreLine(ctx, "lineID")
+
+		assert.NoError(t, err)
+		assert.Equal(t, value.StatementLineStatusIgnored, line.Status)
+	})
+}
@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// generateBatchSize は1回の実行で請求書を作成する定期請求の件数です。残りは次回の実行で処理します
+const generateBatchSize = 100
+
+type RecurringInvoiceUsecase interface {
+	// CreateRecurringInvoice は定期請求を作成します。開始日と今日の遅い方以降の発行日から請求書を作成します
+	CreateRecurringInvoice(ctx context.Context, recurringInvoice *models.RecurringInvoice) (*models.RecurringInvoice, error)
+	ListRecurringInvoices(ctx context.Context) ([]*models.RecurringInvoice, error)
+	GetRecurringInvoice(ctx context.Context, recurringInvoiceID string) (*models.RecurringInvoice, error)
+	// UpdateRecurringInvoice は version が現在のバージョンと一致する場合だけ更新します
+	UpdateRecurringInvoice(ctx context.Context, recurringInvoiceID string, recurringInvoice *models.RecurringInvoice, version int) (*models.RecurringInvoice, error)
+	// DeleteRecurringInvoice は version が現在のバージョンと一致する場合だけ削除します
+	DeleteRecurringInvoice(ctx context.Context, recurringInvoiceID string, version int) error
+	// GenerateInvoices は発行日が来た定期請求の請求書を作成し、作成した件数を返します。
+	// 期間ごとに1回だけ作成し、停止していた間の期間もさかのぼって作成します
+	GenerateInvoices(ctx context.Context) (int, error)
+}
+
+var errRecurringInvoiceNotFound = apperror.NewNotFound(apperror.CodeNotFound, "recurring invoice not found")
+
+type recurringInvoiceUsecase struct {
+	recurringInvoiceRepository repository.RecurringInvoiceRepository
+	clientRepository           repository.ClientRepository
+	userRepository             repository.UserRepository
+	invoiceUsecase             InvoiceUsecase
+	now                        func() time.Time
+}
+
+func NewRecurringInvoiceUsecase(recurringInvoiceRepository repository.RecurringInvoiceRepository, clientRepository repository.ClientRepository, userRepository repository.UserRepository, invoiceUsecase InvoiceUsecase) RecurringInvoiceUsecase {
+	return &tracedRecurringInvoiceUsecase{
+		next: &recurringInvoiceUsecase{
+			recurringInvoiceRepository: recurringInvoiceRepository,
+			clientRepository:           clientRepository,
+			userRepository:             userRepository,
+			invoiceUsecase:             invoiceUsecase,
+			now:                        time.Now,
+		},
+	}
+}
+
+func (u *recurringInvoiceUsecase) CreateRecurringInvoice(ctx context.Context, recurringInvoice *models.RecurringInvoice) (*models.RecurringInvoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &models.RecurringInvoice{
+		CompanyID: user.CompanyID,
+		CreatedBy: user.ID,
+	}
+	if err := u.apply(db, created, recurringInvoice); err != nil {
+		return nil, err
+	}
+
+	if err := u.recurringInvoiceRepository.Create(db, created); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "recurring invoice created",
+		slog.String("recurring_invoice_id", created.ID),
+		slog.String("company_id", created.CompanyID),
+	)
+
+	return created, nil
+}
+
+func (u *recurringInvoiceUsecase) ListRecurringInvoices(ctx context.Context) ([]*models.RecurringInvoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.recurringInvoiceRepository.FindByCompanyID(db, user.CompanyID)
+}
+
+func (u *recurringInvoiceUsecase) GetRecurringInvoice(ctx context.Context, recurringInvoiceID string) (*models.RecurringInvoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.findRecurringInvoice(ctx, db, recurringInvoiceID)
+}
+
+func (u *recurringInvoiceUsecase) UpdateRecurringInvoice(ctx context.Context, recurringInvoiceID string, recurringInvoice *models.RecurringInvoice, version int) (*models.RecurringInvoice, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.findRecurringInvoice(ctx, db, recurringInvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(current.Version, version); err != nil {
+		return nil, err
+	}
+
+	updated := *current
+	if err := u.apply(db, &updated, recurringInvoice); err != nil {
+		return nil, err
+	}
+	updated.UpdatedAt = u.now()
+
+	if err := u.recurringInvoiceRepository.Update(db, &updated, version); err != nil {
+		return nil, versionConflictError(err)
+	}
+	slog.InfoContext(ctx, "recurring invoice updated",
+		slog.String("recurring_invoice_id", updated.ID),
+		slog.String("company_id", updated.CompanyID),
+	)
+
+	return &updated, nil
+}
+
+func (u *recurringInvoiceUsecase) DeleteRecurringInvoice(ctx context.Context, recurringInvoiceID string, version int) error {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	recurringInvoice, err := u.findRecurringInvoice(ctx, db, recurringInvoiceID)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(recurringInvoice.Version, version); err != nil {
+		return err
+	}
+
+	if err := u.recurringInvoiceRepository.Delete(db, recurringInvoice.ID, version, u.now()); err != nil {
+		return versionConflictError(err)
+	}
+	slog.InfoContext(ctx, "recurring invoice deleted",
+		slog.String("recurring_invoice_id", recurringInvoice.ID),
+		slog.String("company_id", recurringInvoice.CompanyID),
+	)
+
+	return nil
+}
+
+func (u *recurringInvoiceUsecase) GenerateInvoices(ctx context.Context) (int, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	today := truncateToDate(u.now())
+	recurringInvoices, err := u.recurringInvoiceRepository.FindDue(db, today, generateBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, recurringInvoice := range recurringInvoices {
+		// 停止していた間の期間も1期間ずつ作成する
+		for !recurringInvoice.NextIssueDate.After(today) && !recurringInvoice.IsEnded(recurringInvoice.NextIssueDate) {
+			created, err := u.generateInvoice(ctx, db, recurringInvoice)
+			if err != nil {
+				// 1件の失敗で他の定期請求を止めないよう、記録して次の定期請求に進む
+				slog.ErrorContext(ctx, "failed to generate recurring invoice",
+					slog.String("recurring_invoice_id", recurringInvoice.ID),
+					slog.Any("error", err),
+				)
+				break
+			}
+			if created {
+				generated++
+			}
+		}
+	}
+
+	return generated, nil
+}
+
+// generateInvoice は次の発行日の請求書を作成し、次の発行日を1期間進めます。
+// 次の発行日の更新（バージョンの compare-and-swap）、請求書の作成、作成記録を1つのトランザクションで行うため、
+// 再起動や複数台での同時実行でも同じ期間の請求書は1回だけ作成されます。作成済みの期間は発行日だけを進めます
+func (u *recurringInvoiceUsecase) generateInvoice(ctx context.Context, db *gorm.DB, recurringInvoice *models.RecurringInvoice) (bool, error) {
+	issueDate := recurringInvoice.NextIssueDate
+	period := models.RecurringPeriod(issueDate)
+
+	advanced := *recurringInvoice
+	advanced.NextIssueDate = recurringInvoice.IssueDateAfter(issueDate)
+	advanced.UpdatedAt = u.now()
+
+	created := false
+	// 請求書の作成のメトリクスとログは、作成記録と合わせてコミットした後に記録する
+	afterCommit := &util.AfterCommit{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := u.recurringInvoiceRepository.Update(tx, &advanced, recurringInvoice.Version); err != nil {
+			return err
+		}
+
+		exists, err := u.recurringInvoiceRepository.ExistsRun(tx, recurringInvoice.ID, period)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+
+		// 通常の請求書作成と同じ処理で、定期請求を作成したユーザーとして作成する。
+		// 同じ期間の二重作成は実行の記録で防いでいるため、重複の疑いがあっても作成して確認の結果だけを記録する
+		invoiceCtx := util.SetAfterCommit(util.SetUserID(util.SetDB(ctx, tx), recurringInvoice.CreatedBy), afterCommit)
+		invoice, err := u.invoiceUsecase.CreateInvoice(invoiceCtx, recurringInvoice.ClientID, issueDate, value.NewMoney(recurringInvoice.PaymentAmount, value.CurrencyJPY), recurringInvoice.PaymentDueDate(issueDate), nil, true)
+		if err != nil {
+			return err
+		}
+		created = true
+
+		return u.recurringInvoiceRepository.CreateRun(tx, &models.RecurringInvoiceRun{
+			RecurringInvoiceID: recurringInvoice.ID,
+			Period:             period,
+			IssueDate:          issueDate,
+			InvoiceID:          invoice.ID,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	*recurringInvoice = advanced
+	afterCommit.Run()
+
+	if created {
+		slog.InfoContext(ctx, "recurring invoice generated",
+			slog.String("recurring_invoice_id", recurringInvoice.ID),
+			slog.String("period", period),
+		)
+	}
+
+	return created, nil
+}
+
+// findRecurringInvoice はログインユーザーの会社の定期請求を返します。
+// 他社の定期請求は存在を明かさないよう、存在しない場合と同じエラーにします
+func (u *recurringInvoiceUsecase) findRecurringInvoice(ctx context.Context, db *gorm.DB, recurringInvoiceID string) (*models.RecurringInvoice, error) {
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringInvoice, err := u.recurringInvoiceRepository.FindByID(db, recurringInvoiceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errRecurringInvoiceNotFound
+		}
+		return nil, err
+	}
+	if recurringInvoice.CompanyID != user.CompanyID {
+		return nil, errRecurringInvoiceNotFound
+	}
+
+	return recurringInvoice, nil
+}
+
+// apply は入力を検証して target に反映し、次の発行日を開始日と今日の遅い方以降の最初の発行日にします
+func (u *recurringInvoiceUsecase) apply(db *gorm.DB, target *models.RecurringInvoice, input *models.RecurringInvoice) error {
+	var fields []apperror.FieldError
+	// 作成時の請求書と同じ条件で確認し、毎回の作成に失敗する定期請求を登録しない
+	if !input.PaymentAmount.IsPositive() {
+		fields = append(fields, apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeMin, Param: "1"})
+	} else if !value.NewMoney(input.PaymentAmount, value.CurrencyJPY).HasValidPrecision() {
+		fields = append(fields, apperror.FieldError{Field: "payment_amount", Code: apperror.FieldCodeInvalidValue})
+	}
+	switch input.Cadence {
+	case value.RecurrenceCadenceMonthly:
+		if input.DayOfMonth < 1 || input.DayOfMonth > 31 {
+			fields = append(fields, apperror.FieldError{Field: "day_of_month", Code: apperror.FieldCodeInvalidValue})
+		}
+	case value.RecurrenceCadenceEndOfMonth:
+	default:
+		fields = append(fields, apperror.FieldError{Field: "cadence", Code: apperror.FieldCodeInvalidValue})
+	}
+	if input.EndDate != nil && input.EndDate.Before(input.StartDate) {
+		fields = append(fields, apperror.FieldError{Field: "end_date", Code: apperror.FieldCodeMin, Param: "start_date"})
+	}
+	if len(fields) > 0 {
+		return apperror.NewValidation(fields...)
+	}
+
+	client, err := u.clientRepository.FindByID(db, input.ClientID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if client == nil || client.CompanyID != target.CompanyID {
+		return apperror.NewValidation(apperror.FieldError{Field: "client_id", Code: apperror.FieldCodeInvalidValue})
+	}
+
+	target.ClientID = input.ClientID
+	target.PaymentAmount = input.PaymentAmount
+	target.Cadence = input.Cadence
+	target.DayOfMonth = 0
+	if input.Cadence == value.RecurrenceCadenceMonthly {
+		target.DayOfMonth = input.DayOfMonth
+	}
+	target.PaymentDueDays = input.PaymentDueDays
+	target.StartDate = input.StartDate
+	target.EndDate = input.EndDate
+
+	// 過去の期間はさかのぼって作成しない
+	from := input.StartDate
+	if today := truncateToDate(u.now()); today.After(from) {
+		from = today
+	}
+	target.NextIssueDate = target.IssueDateOnOrAfter(from)
+	if target.IsEnded(target.NextIssueDate) {
+		return apperror.NewValidation(apperror.FieldError{Field: "end_date", Code: apperror.FieldCodeMin, Param: target.NextIssueDate.Format(time.DateOnly)})
+	}
+
+	return nil
+}
+
+// truncateToDate は t の日付（UTC の0時）を返します
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
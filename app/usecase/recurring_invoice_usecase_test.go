@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	domainRepository "github.com/ijufumi/practice-202512/app/domain/repository"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type recurringInvoiceMocks struct {
+	recurringInvoiceRepository *repository.MockRecurringInvoiceRepository
+	clientRepository           *repository.MockClientRepository
+	userRepository             *repository.MockUserRepository
+	invoiceUsecase             *mocks.MockInvoiceUsecase
+}
+
+func newTestRecurringInvoiceUsecase(t *testing.T, now time.Time) (*recurringInvoiceUsecase, *recurringInvoiceMocks) {
+	m := &recurringInvoiceMocks{
+		recurringInvoiceRepository: repository.NewMockRecurringInvoiceRepository(t),
+		clientRepository:           repository.NewMockClientRepository(t),
+		userRepository:             repository.NewMockUserRepository(t),
+		invoiceUsecase:             mocks.NewMockInvoiceUsecase(t),
+	}
+
+	return &recurringInvoiceUsecase{
+		recurringInvoiceRepository: m.recurringInvoiceRepository,
+		clientRepository:           m.clientRepository,
+		userRepository:             m.userRepository,
+		invoiceUsecase:             m.invoiceUsecase,
+		now:                        func() time.Time { return now },
+	}, m
+}
+
+func TestRecurringInvoiceUsecase_CreateRecurringInvoice(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	client := &models.Client{ID: "clientID", CompanyID: user.CompanyID}
+	now := time.Date(2025, 2, 10, 9, 0, 0, 0, time.UTC)
+
+	t.Run("開始日と今日の遅い方以降の最初の発行日を次の発行日にする", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			cadence   value.RecurrenceCadence
+			day       int
+			startDate time.Time
+			expected  time.Time
+		}{
+			{"過去の開始日は今日から", value.RecurrenceCadenceMonthly, 5, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)},
+			{"31日がない月は月末", value.RecurrenceCadenceMonthly, 31, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+			{"今日が発行日", value.RecurrenceCadenceMonthly, 10, time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)},
+			{"未来の開始日の月末", value.RecurrenceCadenceEndOfMonth, 0, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := setupClientUsecaseContext(t)
+				usecase, m := newTestRecurringInvoiceUsecase(t, now)
+
+				m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+				m.clientRepository.EXPECT().FindByID(mock.Anything, client.ID).Return(client, nil)
+				m.recurringInvoiceRepository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r *models.RecurringInvoice) bool {
+					return r.CompanyID == user.CompanyID && r.CreatedBy == user.ID && r.NextIssueDate.Equal(tt.expected)
+				})).Return(nil)
+
+				created, err := usecase.CreateRecurringInvoice(ctx, &models.RecurringInvoice{
+					ClientID:       client.ID,
+					PaymentAmount:  decimal.NewFromInt(50000),
+					Cadence:        tt.cadence,
+					DayOfMonth:     tt.day,
+					PaymentDueDays: 30,
+					StartDate:      tt.startDate,
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, created.NextIssueDate)
+			})
+		}
+	})
+
+	t.Run("入力が不正な場合はValidation", func(t *testing.T) {
+		endDate := time.Date(2025, 2, 20, 0, 0, 0, 0, time.UTC)
+		amount := decimal.NewFromInt(50000)
+		tests := []struct {
+			name  string
+			input *models.RecurringInvoice
+			field string
+		}{
+			{"monthlyで発行日がない", &models.RecurringInvoice{ClientID: client.ID, PaymentAmount: amount, Cadence: value.RecurrenceCadenceMonthly}, "day_of_month"},
+			{"終了日が開始日より前", &models.RecurringInvoice{ClientID: client.ID, PaymentAmount: amount, Cadence: value.RecurrenceCadenceEndOfMonth, StartDate: endDate.AddDate(0, 0, 1), EndDate: &endDate}, "end_date"},
+			{"最初の発行日が終了日より後", &models.RecurringInvoice{ClientID: client.ID, PaymentAmount: amount, Cadence: value.RecurrenceCadenceEndOfMonth, StartDate: now, EndDate: &endDate}, "end_date"},
+			{"他社の取引先", &models.RecurringInvoice{ClientID: "otherClientID", PaymentAmount: amount, Cadence: value.RecurrenceCadenceEndOfMonth}, "client_id"},
+			{"支払金額が0", &models.RecurringInvoice{ClientID: client.ID, PaymentAmount: decimal.Zero, Cadence: value.RecurrenceCadenceEndOfMonth}, "payment_amount"},
+			{"支払金額が負", &models.RecurringInvoice{ClientID: client.ID, PaymentAmount: decimal.NewFromInt(-1000), Cadence: value.RecurrenceCadenceEndOfMonth}, "payment_amount"},
+			{"支払金額が1円未満の端数を含む", &models.RecurringInvoice{ClientID: client.ID, PaymentAmount: decimal.RequireFromString("100.5"), Cadence: value.RecurrenceCadenceEndOfMonth}, "payment_amount"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := setupClientUsecaseContext(t)
+				usecase, m := newTestRecurringInvoiceUsecase(t, now)
+
+				m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+				m.clientRepository.EXPECT().FindByID(mock.Anything, client.ID).Return(client, nil).Maybe()
+				m.clientRepository.EXPECT().FindByID(mock.Anything, "otherClientID").
+					Return(&models.Client{ID: "otherClientID", CompanyID: "otherCompanyID"}, nil).Maybe()
+
+				_, err := usecase.CreateRecurringInvoice(ctx, tt.input)
+
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, apperror.KindValidation, appErr.Kind)
+				assert.Equal(t, tt.field, appErr.Fields[0].Field)
+			})
+		}
+	})
+}
+
+func TestRecurringInvoiceUsecase_UpdateRecurringInvoice(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	now := time.Date(2025, 2, 10, 9, 0, 0, 0, time.UTC)
+	current := &models.RecurringInvoice{
+		ID:            "recurringInvoiceID",
+		CompanyID:     user.CompanyID,
+		ClientID:      "clientID",
+		Cadence:       value.RecurrenceCadenceEndOfMonth,
+		StartDate:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		NextIssueDate: time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		CreatedBy:     "creatorID",
+		Version:       3,
+	}
+	input := &models.RecurringInvoice{
+		ClientID:       "clientID",
+		PaymentAmount:  decimal.NewFromInt(80000),
+		Cadence:        value.RecurrenceCadenceMonthly,
+		DayOfMonth:     25,
+		PaymentDueDays: 10,
+		StartDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("内容と次の発行日を更新", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.recurringInvoiceRepository.EXPECT().FindByID(mock.Anything, current.ID).Return(current, nil)
+		m.clientRepository.EXPECT().FindByID(mock.Anything, "clientID").Return(&models.Client{ID: "clientID", CompanyID: user.CompanyID}, nil)
+		m.recurringInvoiceRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(r *models.RecurringInvoice) bool {
+			return r.DayOfMonth == 25 && r.CreatedBy == "creatorID" &&
+				r.NextIssueDate.Equal(time.Date(2025, 2, 25, 0, 0, 0, 0, time.UTC))
+		}), 3).Return(nil)
+
+		updated, err := usecase.UpdateRecurringInvoice(ctx, current.ID, input, 3)
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(80000).Equal(updated.PaymentAmount))
+	})
+
+	t.Run("支払金額が不正な場合はValidationで更新しない", func(t *testing.T) {
+		for _, amount := range []string{"0", "-1000", "100.5"} {
+			t.Run(amount, func(t *testing.T) {
+				ctx := setupClientUsecaseContext(t)
+				usecase, m := newTestRecurringInvoiceUsecase(t, now)
+
+				m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+				m.recurringInvoiceRepository.EXPECT().FindByID(mock.Anything, current.ID).Return(current, nil)
+
+				invalid := *input
+				invalid.PaymentAmount = decimal.RequireFromString(amount)
+				_, err := usecase.UpdateRecurringInvoice(ctx, current.ID, &invalid, 3)
+
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, apperror.KindValidation, appErr.Kind)
+				assert.Equal(t, "payment_amount", appErr.Fields[0].Field)
+			})
+		}
+	})
+
+	t.Run("バージョンが一致しない場合はPreconditionFailed", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.recurringInvoiceRepository.EXPECT().FindByID(mock.Anything, current.ID).Return(current, nil)
+
+		_, err := usecase.UpdateRecurringInvoice(ctx, current.ID, input, 2)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindPreconditionFailed, appErr.Kind)
+	})
+
+	t.Run("他社の定期請求はNotFound", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+
+		m.userRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		m.recurringInvoiceRepository.EXPECT().FindByID(mock.Anything, "otherID").
+			Return(&models.RecurringInvoice{ID: "otherID", CompanyID: "otherCompanyID"}, nil)
+
+		err := usecase.DeleteRecurringInvoice(ctx, "otherID", 1)
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+	})
+}
+
+func TestRecurringInvoiceUsecase_GenerateInvoices(t *testing.T) {
+	now := time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC)
+	newRecurringInvoice := func() *models.RecurringInvoice {
+		return &models.RecurringInvoice{
+			ID:             "recurringInvoiceID",
+			CompanyID:      "companyID",
+			ClientID:       "clientID",
+			PaymentAmount:  decimal.NewFromInt(50000),
+			Cadence:        value.RecurrenceCadenceEndOfMonth,
+			PaymentDueDays: 30,
+			StartDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			NextIssueDate:  time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			CreatedBy:      "creatorID",
+			Version:        1,
+		}
+	}
+	isCreator := mock.MatchedBy(func(ctx context.Context) bool {
+		userID, err := util.GetUserID(ctx)
+		return err == nil && userID == "creatorID"
+	})
+
+	t.Run("停止していた間の期間も作成し、作成済みの期間は発行日だけ進める", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+		recurringInvoice := newRecurringInvoice()
+
+		m.recurringInvoiceRepository.EXPECT().FindDue(mock.Anything, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), generateBatchSize).
+			Return([]*models.RecurringInvoice{recurringInvoice}, nil)
+		// 1月分: 作成する
+		m.recurringInvoiceRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(r *models.RecurringInvoice) bool {
+			return r.NextIssueDate.Equal(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC))
+		}), 1).Run(func(_ *gorm.DB, r *models.RecurringInvoice, version int) { r.Version = version + 1 }).Return(nil)
+		m.recurringInvoiceRepository.EXPECT().ExistsRun(mock.Anything, recurringInvoice.ID, "2025-01").Return(false, nil)
+		recorded := false
+		m.invoiceUsecase.EXPECT().CreateInvoice(isCreator, "clientID", time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), value.NewMoney(decimal.NewFromInt(50000), value.CurrencyJPY), time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), []*models.InvoiceLine(nil), true).
+			RunAndReturn(func(ctx context.Context, _ string, _ time.Time, _ value.Money, _ time.Time, _ []*models.InvoiceLine, _ bool) (*models.Invoice, error) {
+				util.OnCommit(ctx, func() { recorded = true })
+				return &models.Invoice{ID: "invoiceID"}, nil
+			})
+		m.recurringInvoiceRepository.EXPECT().CreateRun(mock.Anything, &models.RecurringInvoiceRun{
+			RecurringInvoiceID: recurringInvoice.ID,
+			Period:             "2025-01",
+			IssueDate:          time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			InvoiceID:          "invoiceID",
+		}).Return(nil)
+		// 2月分: 作成済み
+		m.recurringInvoiceRepository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(r *models.RecurringInvoice) bool {
+			return r.NextIssueDate.Equal(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC))
+		}), 2).Return(nil)
+		m.recurringInvoiceRepository.EXPECT().ExistsRun(mock.Anything, recurringInvoice.ID, "2025-02").Return(true, nil)
+
+		generated, err := usecase.GenerateInvoices(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, generated)
+		assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), recurringInvoice.NextIssueDate)
+		// 請求書の作成のメトリクスとログはコミットした後に記録する
+		assert.True(t, recorded)
+	})
+
+	t.Run("作成記録に失敗した場合は請求書の作成のメトリクスとログを記録しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+		recurringInvoice := newRecurringInvoice()
+
+		m.recurringInvoiceRepository.EXPECT().FindDue(mock.Anything, mock.Anything, generateBatchSize).
+			Return([]*models.RecurringInvoice{recurringInvoice}, nil)
+		m.recurringInvoiceRepository.EXPECT().Update(mock.Anything, mock.Anything, 1).Return(nil)
+		m.recurringInvoiceRepository.EXPECT().ExistsRun(mock.Anything, recurringInvoice.ID, "2025-01").Return(false, nil)
+		recorded := false
+		m.invoiceUsecase.EXPECT().CreateInvoice(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, _ string, _ time.Time, _ value.Money, _ time.Time, _ []*models.InvoiceLine, _ bool) (*models.Invoice, error) {
+				util.OnCommit(ctx, func() { recorded = true })
+				return &models.Invoice{ID: "invoiceID"}, nil
+			})
+		m.recurringInvoiceRepository.EXPECT().CreateRun(mock.Anything, mock.Anything).Return(errors.New("database is locked"))
+
+		generated, err := usecase.GenerateInvoices(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, generated)
+		assert.False(t, recorded)
+	})
+
+	t.Run("他の実行と競合した定期請求は作成しない", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+		recurringInvoice := newRecurringInvoice()
+
+		m.recurringInvoiceRepository.EXPECT().FindDue(mock.Anything, mock.Anything, generateBatchSize).
+			Return([]*models.RecurringInvoice{recurringInvoice}, nil)
+		m.recurringInvoiceRepository.EXPECT().Update(mock.Anything, mock.Anything, 1).
+			Return(&domainRepository.VersionConflictError{Entity: "recurring_invoice", ID: recurringInvoice.ID, Version: 1})
+
+		generated, err := usecase.GenerateInvoices(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, generated)
+		assert.Equal(t, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), recurringInvoice.NextIssueDate)
+	})
+
+	t.Run("請求書の作成に失敗した場合は記録せずに次回に持ち越す", func(t *testing.T) {
+		ctx := setupClientUsecaseContext(t)
+		usecase, m := newTestRecurringInvoiceUsecase(t, now)
+		recurringInvoice := newRecurringInvoice()
+
+		m.recurringInvoiceRepository.EXPECT().FindDue(mock.Anything, mock.Anything, generateBatchSize).
+			Return([]*models.RecurringInvoice{recurringInvoice}, nil)
+		m.recurringInvoiceRepository.EXPECT().Update(mock.Anything, mock.Anything, 1).Return(nil)
+		m.recurringInvoiceRepository.EXPECT().ExistsRun(mock.Anything, recurringInvoice.ID, "2025-01").Return(false, nil)
+		m.invoiceUsecase.EXPECT().CreateInvoice(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, apperror.NewValidation())
+
+		generated, err := usecase.GenerateInvoices(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, generated)
+		assert.Equal(t, 1, recurringInvoice.Version)
+	})
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// maxReportYears は1回に集計できる期間の年数です
+	maxReportYears = 2
+	// maxTopClients は取引先ごとのレポートで返す件数の上限です
+	maxTopClients = 100
+	// defaultFeeMonths は手数料のレポートで期間を指定しない場合に集計する、今月までの月数です
+	defaultFeeMonths = 12
+)
+
+// ReportUsecase はダッシュボード向けにログインユーザーの会社の請求書を集計します。
+// 日・週・月は日本時間で区切り、金額はすべて円建てです
+type ReportUsecase interface {
+	// AmountDue は支払期日が期間に入る未払いの請求書を週または月ごとに集計します。期間を指定しない場合は今週または今月です
+	AmountDue(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.AmountDueReport, error)
+	// StatusCounts は発行日が期間に入る請求書をステータスごとに集計します。期間を指定しない場合は今月です
+	StatusCounts(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.StatusReport, error)
+	// TopClients は発行日が期間に入る請求書の金額の多い取引先を返します。期間を指定しない場合は今月です
+	TopClients(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.ClientReport, error)
+	// MonthlyFees は手数料を発行した月ごとに集計します。期間を指定しない場合は今月までの12か月です
+	MonthlyFees(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.FeeReport, error)
+}
+
+type reportUsecase struct {
+	reportRepository repository.ReportRepository
+	userRepository   repository.UserRepository
+	now              func() time.Time
+}
+
+func NewReportUsecase(reportRepository repository.ReportRepository, userRepository repository.UserRepository) ReportUsecase {
+	return &tracedReportUsecase{
+		next: &reportUsecase{
+			reportRepository: reportRepository,
+			userRepository:   userRepository,
+			now:              time.Now,
+		},
+	}
+}
+
+func (u *reportUsecase) AmountDue(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.AmountDueReport, error) {
+	period := condition.Period
+	if !period.IsValid() {
+		return models.ReportRange{}, nil, apperror.NewValidation(apperror.FieldError{Field: "period", Code: apperror.FieldCodeInvalidValue})
+	}
+	start := models.StartOfReportPeriod(u.now(), period)
+	reportRange, err := resolveReportRange(condition, start, models.NextReportPeriod(start, period))
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	db, companyID, err := u.companyOf(ctx)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	reports, err := u.reportRepository.SumAmountDueByPeriod(db, companyID, reportRange.Buckets(period))
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	return reportRange, reports, nil
+}
+
+func (u *reportUsecase) StatusCounts(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.StatusReport, error) {
+	reportRange, err := u.resolveMonthRange(condition)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	db, companyID, err := u.companyOf(ctx)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	reports, err := u.reportRepository.CountByStatus(db, companyID, reportRange)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	return reportRange, reports, nil
+}
+
+func (u *reportUsecase) TopClients(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.ClientReport, error) {
+	limit := condition.Limit
+	if limit < 1 {
+		return models.ReportRange{}, nil, apperror.NewValidation(apperror.FieldError{Field: "limit", Code: apperror.FieldCodeMin, Param: "1"})
+	}
+	if limit > maxTopClients {
+		return models.ReportRange{}, nil, apperror.NewValidation(apperror.FieldError{Field: "limit", Code: apperror.FieldCodeMax, Param: strconv.Itoa(maxTopClients)})
+	}
+	reportRange, err := u.resolveMonthRange(condition)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	db, companyID, err := u.companyOf(ctx)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	reports, err := u.reportRepository.SumByClient(db, companyID, reportRange, limit)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	return reportRange, reports, nil
+}
+
+func (u *reportUsecase) MonthlyFees(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.FeeReport, error) {
+	thisMonth := models.StartOfReportPeriod(u.now(), value.ReportPeriodMonth)
+	reportRange, err := resolveReportRange(condition, thisMonth.AddDate(0, 1-defaultFeeMonths, 0), thisMonth.AddDate(0, 1, 0))
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	db, companyID, err := u.companyOf(ctx)
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+	reports, err := u.reportRepository.SumFeesByPeriod(db, companyID, reportRange.Buckets(value.ReportPeriodMonth))
+	if err != nil {
+		return models.ReportRange{}, nil, err
+	}
+
+	return reportRange, reports, nil
+}
+
+// companyOf はログインユーザーの会社を返します。レポートはすべてこの会社の請求書だけを集計します
+func (u *reportUsecase) companyOf(ctx context.Context) (*gorm.DB, string, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return db, user.CompanyID, nil
+}
+
+// resolveMonthRange は期間を指定しない場合は今月にします
+func (u *reportUsecase) resolveMonthRange(condition *models.ReportCondition) (models.ReportRange, error) {
+	thisMonth := models.StartOfReportPeriod(u.now(), value.ReportPeriodMonth)
+
+	return resolveReportRange(condition, thisMonth, thisMonth.AddDate(0, 1, 0))
+}
+
+// resolveReportRange は指定された日付で期間を作ります。指定のない端は [defaultFrom, defaultTo) の端にします
+func resolveReportRange(condition *models.ReportCondition, defaultFrom, defaultTo time.Time) (models.ReportRange, error) {
+	from, to := defaultFrom, defaultTo.AddDate(0, 0, -1)
+	if condition.From != nil {
+		from = *condition.From
+	}
+	if condition.To != nil {
+		to = *condition.To
+	}
+	reportRange := models.NewReportRange(from, to)
+
+	if !reportRange.From.Before(reportRange.To) {
+		return models.ReportRange{}, apperror.NewValidation(apperror.FieldError{Field: "to", Code: apperror.FieldCodeMin, Param: "from"})
+	}
+	if limit := reportRange.From.AddDate(maxReportYears, 0, 0); reportRange.To.After(limit) {
+		return models.ReportRange{}, apperror.NewValidation(apperror.FieldError{Field: "to", Code: apperror.FieldCodeMax, Param: limit.AddDate(0, 0, -1).Format(time.DateOnly)})
+	}
+
+	return reportRange, nil
+}
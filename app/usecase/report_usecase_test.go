@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupReportUsecaseContext(t *testing.T) context.Context {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	ctx := util.SetDB(context.Background(), db)
+	return util.SetUserID(ctx, "userID")
+}
+
+func newTestReportUsecase(reportRepository *repository.MockReportRepository, userRepository *repository.MockUserRepository, now time.Time) *reportUsecase {
+	return &reportUsecase{
+		reportRepository: reportRepository,
+		userRepository:   userRepository,
+		now:              func() time.Time { return now },
+	}
+}
+
+// tokyoDate は日本時間の0時を返します
+func tokyoDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, models.ReportLocation)
+}
+
+func TestReportUsecase(t *testing.T) {
+	user := &models.User{ID: "userID", CompanyID: "companyID"}
+	// UTC では11月30日だが、日本時間では12月1日（月曜日）
+	now := time.Date(2025, 11, 30, 15, 30, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	t.Run("期間を指定しない場合は日本時間の今週の未払い残高を集計する", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		mockReportRepository := repository.NewMockReportRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		buckets := []models.ReportRange{{From: tokyoDate(2025, 12, 1), To: tokyoDate(2025, 12, 8)}}
+		reports := []*models.AmountDueReport{{PeriodStart: buckets[0].From}}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockReportRepository.EXPECT().SumAmountDueByPeriod(mock.Anything, user.CompanyID, buckets).Return(reports, nil)
+
+		usecase := newTestReportUsecase(mockReportRepository, mockUserRepository, now)
+		reportRange, result, err := usecase.AmountDue(ctx, &models.ReportCondition{Period: value.ReportPeriodWeek})
+
+		assert.NoError(t, err)
+		assert.Equal(t, buckets[0], reportRange)
+		assert.Equal(t, reports, result)
+	})
+
+	t.Run("指定した期間を含む月ごとに未払い残高を集計する", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		mockReportRepository := repository.NewMockReportRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		buckets := []models.ReportRange{
+			{From: tokyoDate(2025, 11, 1), To: tokyoDate(2025, 12, 1)},
+			{From: tokyoDate(2025, 12, 1), To: tokyoDate(2026, 1, 1)},
+			{From: tokyoDate(2026, 1, 1), To: tokyoDate(2026, 2, 1)},
+		}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockReportRepository.EXPECT().SumAmountDueByPeriod(mock.Anything, user.CompanyID, buckets).Return([]*models.AmountDueReport{}, nil)
+
+		usecase := newTestReportUsecase(mockReportRepository, mockUserRepository, now)
+		reportRange, _, err := usecase.AmountDue(ctx, &models.ReportCondition{
+			From:   date(2025, 11, 15),
+			To:     date(2026, 1, 10),
+			Period: value.ReportPeriodMonth,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReportRange{From: tokyoDate(2025, 11, 15), To: tokyoDate(2026, 1, 11)}, reportRange)
+	})
+
+	t.Run("期間を指定しない場合は日本時間の今月の件数をステータスごとに集計する", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		mockReportRepository := repository.NewMockReportRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		thisMonth := models.ReportRange{From: tokyoDate(2025, 12, 1), To: tokyoDate(2026, 1, 1)}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockReportRepository.EXPECT().CountByStatus(mock.Anything, user.CompanyID, thisMonth).Return([]*models.StatusReport{}, nil)
+
+		usecase := newTestReportUsecase(mockReportRepository, mockUserRepository, now)
+		reportRange, _, err := usecase.StatusCounts(ctx, &models.ReportCondition{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, thisMonth, reportRange)
+	})
+
+	t.Run("支払うべき金額の多い取引先を指定した件数だけ返す", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		mockReportRepository := repository.NewMockReportRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		thisMonth := models.ReportRange{From: tokyoDate(2025, 12, 1), To: tokyoDate(2026, 1, 1)}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockReportRepository.EXPECT().SumByClient(mock.Anything, user.CompanyID, thisMonth, 10).Return([]*models.ClientReport{}, nil)
+
+		usecase := newTestReportUsecase(mockReportRepository, mockUserRepository, now)
+		_, _, err := usecase.TopClients(ctx, &models.ReportCondition{Limit: 10})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("期間を指定しない場合は今月までの12か月の手数料を集計する", func(t *testing.T) {
+		ctx := setupReportUsecaseContext(t)
+		mockReportRepository := repository.NewMockReportRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, user.ID).Return(user, nil)
+		mockReportRepository.EXPECT().SumFeesByPeriod(mock.Anything, user.CompanyID, mock.MatchedBy(func(buckets []models.ReportRange) bool {
+			return len(buckets) == 12 &&
+				buckets[0].From.Equal(tokyoDate(2025, 1, 1)) &&
+				buckets[11].To.Equal(tokyoDate(2026, 1, 1))
+		})).Return([]*models.FeeReport{}, nil)
+
+		usecase := newTestReportUsecase(mockReportRepository, mockUserRepository, now)
+		reportRange, _, err := usecase.MonthlyFees(ctx, &models.ReportCondition{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReportRange{From: tokyoDate(2025, 1, 1), To: tokyoDate(2026, 1, 1)}, reportRange)
+	})
+
+	t.Run("条件が不正な場合はValidationエラー", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			condition *models.ReportCondition
+			expected  apperror.FieldError
+		}{
+			{
+				name:      "集計の単位が不正",
+				condition: &models.ReportCondition{Period: "day"},
+				expected:  apperror.FieldError{Field: "period", Code: apperror.FieldCodeInvalidValue},
+			},
+			{
+				name:      "終了日が開始日より前",
+				condition: &models.ReportCondition{From: date(2025, 12, 2), To: date(2025, 12, 1), Period: value.ReportPeriodWeek},
+				expected:  apperror.FieldError{Field: "to", Code: apperror.FieldCodeMin, Param: "from"},
+			},
+			{
+				name:      "期間が2年を超える",
+				condition: &models.ReportCondition{From: date(2024, 1, 1), To: date(2026, 1, 1), Period: value.ReportPeriodWeek},
+				expected:  apperror.FieldError{Field: "to", Code: apperror.FieldCodeMax, Param: "2025-12-31"},
+			},
+			{
+				name:      "指定した開始日が今週より後",
+				condition: &models.ReportCondition{From: date(2025, 12, 10), Period: value.ReportPeriodWeek},
+				expected:  apperror.FieldError{Field: "to", Code: apperror.FieldCodeMin, Param: "from"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				usecase := newTestReportUsecase(repository.NewMockReportRepository(t), repository.NewMockUserRepository(t), now)
+				_, _, err := usecase.AmountDue(setupReportUsecaseContext(t), tt.condition)
+
+				appErr, ok := apperror.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, apperror.KindValidation, appErr.Kind)
+				assert.Equal(t, []apperror.FieldError{tt.expected}, appErr.Fields)
+			})
+		}
+	})
+
+	t.Run("件数が範囲外の場合はValidationエラー", func(t *testing.T) {
+		tests := []struct {
+			limit    int
+			expected apperror.FieldError
+		}{
+			{limit: 0, expected: apperror.FieldError{Field: "limit", Code: apperror.FieldCodeMin, Param: "1"}},
+			{limit: 101, expected: apperror.FieldError{Field: "limit", Code: apperror.FieldCodeMax, Param: "100"}},
+		}
+
+		for _, tt := range tests {
+			usecase := newTestReportUsecase(repository.NewMockReportRepository(t), repository.NewMockUserRepository(t), now)
+			_, _, err := usecase.TopClients(setupReportUsecaseContext(t), &models.ReportCondition{Limit: tt.limit})
+
+			appErr, ok := apperror.As(err)
+			assert.True(t, ok)
+			assert.Equal(t, []apperror.FieldError{tt.expected}, appErr.Fields)
+		}
+	})
+}
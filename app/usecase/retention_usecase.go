@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+
+	"gorm.io/gorm"
+)
+
+// purgeBatchSize は保存期間を過ぎたデータを1つのトランザクションで処理する件数です
+const purgeBatchSize = 100
+
+type RetentionUsecase interface {
+	// Restore は論理削除した請求書または取引先を、復元できる期間内であれば復元します（管理者のみ）
+	Restore(ctx context.Context, entityType value.EntityType, id string) error
+	// Purge は保存期間を過ぎた論理削除済みのデータを、ポリシーに従って物理削除または匿名化します
+	Purge(ctx context.Context) ([]*models.PurgeResult, error)
+}
+
+var (
+	errAdminRequired        = apperror.NewForbidden(apperror.CodeForbidden, "admin role is required")
+	errDeletedNotFound      = apperror.NewNotFound(apperror.CodeNotFound, "deleted record not found")
+	errRestoreWindowExpired = apperror.NewConflict(apperror.CodeRestoreWindowExpired, "restore window has expired")
+)
+
+type retentionUsecase struct {
+	retentionRepository         repository.RetentionRepository
+	invoiceAttachmentRepository repository.InvoiceAttachmentRepository
+	userRepository              repository.UserRepository
+	blobStore                   repository.BlobStore
+	policies                    []*models.RetentionPolicy
+	now                         func() time.Time
+}
+
+func NewRetentionUsecase(retentionRepository repository.RetentionRepository, invoiceAttachmentRepository repository.InvoiceAttachmentRepository, userRepository repository.UserRepository, blobStore repository.BlobStore, cfg *config.Config) RetentionUsecase {
+	return &tracedRetentionUsecase{
+		next: &retentionUsecase{
+			retentionRepository:         retentionRepository,
+			invoiceAttachmentRepository: invoiceAttachmentRepository,
+			userRepository:              userRepository,
+			blobStore:                   blobStore,
+			policies:                    models.NewRetentionPolicies(cfg.RetentionInvoiceYears, cfg.RetentionInvoiceExtendedYears, cfg.RetentionClientYears, cfg.RestoreGracePeriod),
+			now:                         time.Now,
+		},
+	}
+}
+
+// policy はエンティティの種類の最初のポリシーを返します。復元できる期間は請求書の保存期間の区分によらず同じです
+func (u *retentionUsecase) policy(entityType value.EntityType) (*models.RetentionPolicy, error) {
+	for _, policy := range u.policies {
+		if policy.EntityType == entityType {
+			return policy, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no retention policy for %q", entityType)
+}
+
+// Restore は他社のデータを存在しない場合と同じエラーにします
+func (u *retentionUsecase) Restore(ctx context.Context, entityType value.EntityType, id string) error {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		return err
+	}
+	user, err := u.userRepository.FindByID(db, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsAdmin() {
+		return errAdminRequired
+	}
+
+	policy, err := u.policy(entityType)
+	if err != nil {
+		return err
+	}
+	record, err := u.retentionRepository.FindDeleted(db, entityType, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errDeletedNotFound
+		}
+		return err
+	}
+	if record.CompanyID != user.CompanyID {
+		return errDeletedNotFound
+	}
+	if !policy.CanRestore(record.DeletedAt, u.now()) {
+		return errRestoreWindowExpired
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		return u.retentionRepository.Restore(tx, record)
+	}); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "record restored",
+		slog.String("entity_type", string(entityType)),
+		slog.String("id", id),
+		slog.String("company_id", record.CompanyID),
+	)
+
+	return nil
+}
+
+func (u *retentionUsecase) Purge(ctx context.Context) ([]*models.PurgeResult, error) {
+	db, err := util.GetDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	results := make([]*models.PurgeResult, 0, len(u.policies))
+	for _, policy := range u.policies {
+		result := &models.PurgeResult{EntityType: policy.EntityType, RetentionClass: policy.RetentionClass, Action: policy.Action}
+		for {
+			var ids, blobKeys []string
+			err := db.Transaction(func(tx *gorm.DB) error {
+				var err error
+				ids, err = u.retentionRepository.FindExpired(tx, policy, now, purgeBatchSize)
+				if err != nil || len(ids) == 0 {
+					return err
+				}
+
+				var rows int64
+				if policy.Action == value.RetentionActionAnonymize {
+					rows, err = u.retentionRepository.Anonymize(tx, policy.EntityType, ids, now)
+				} else {
+					if policy.EntityType == value.EntityTypeInvoice {
+						// 添付ファイルの記録は請求書と一緒に削除されるため、内容のキーを先に取得しておく
+						if blobKeys, err = u.invoiceAttachmentRepository.FindStorageKeysByInvoiceIDs(tx, ids); err != nil {
+							return err
+						}
+					}
+					rows, err = u.retentionRepository.Purge(tx, policy.EntityType, ids)
+				}
+				if err != nil {
+					return err
+				}
+				result.Rows += rows
+
+				return nil
+			})
+			if err != nil {
+				return results, fmt.Errorf("failed to %s %s: %w", policy.Action, policy.EntityType, err)
+			}
+			// 記録を削除した後に内容を削除する。削除できなかった内容は記録から参照されないため、ログに残して続ける
+			for _, key := range blobKeys {
+				if err := u.blobStore.Delete(ctx, key); err != nil {
+					slog.WarnContext(ctx, "failed to delete attachment blob",
+						slog.String("storage_key", key),
+						slog.Any("error", err),
+					)
+				}
+			}
+			if len(ids) < purgeBatchSize {
+				break
+			}
+		}
+
+		results = append(results, result)
+		if result.Rows > 0 {
+			slog.InfoContext(ctx, "expired records processed",
+				slog.String("entity_type", string(result.EntityType)),
+				slog.String("retention_class", string(result.RetentionClass)),
+				slog.String("action", string(result.Action)),
+				slog.Int64("rows", result.Rows),
+			)
+		}
+	}
+
+	return results, nil
+}
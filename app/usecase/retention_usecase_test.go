@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupRetentionUsecaseContext(t *testing.T) context.Context {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	ctx := util.SetDB(context.Background(), db)
+	return util.SetUserID(ctx, "userID")
+}
+
+func newTestRetentionUsecase(retentionRepository *repository.MockRetentionRepository, userRepository *repository.MockUserRepository, now time.Time) *retentionUsecase {
+	cfg := config.Default()
+
+	return &retentionUsecase{
+		retentionRepository: retentionRepository,
+		userRepository:      userRepository,
+		policies:            models.NewRetentionPolicies(cfg.RetentionInvoiceYears, cfg.RetentionInvoiceExtendedYears, cfg.RetentionClientYears, cfg.RestoreGracePeriod),
+		now:                 func() time.Time { return now },
+	}
+}
+
+func TestRetentionUsecase_Restore(t *testing.T) {
+	admin := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleAdmin}
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("復元できる期間内なら復元する", func(t *testing.T) {
+		ctx := setupRetentionUsecaseContext(t)
+		mockRetentionRepository := repository.NewMockRetentionRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		record := &models.DeletedRecord{
+			EntityType: value.EntityTypeClient,
+			ID:         "clientID",
+			CompanyID:  admin.CompanyID,
+			DeletedAt:  now.AddDate(0, 0, -29),
+		}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockRetentionRepository.EXPECT().FindDeleted(mock.Anything, value.EntityTypeClient, "clientID").Return(record, nil)
+		mockRetentionRepository.EXPECT().Restore(mock.Anything, record).Return(nil)
+
+		usecase := newTestRetentionUsecase(mockRetentionRepository, mockUserRepository, now)
+		err := usecase.Restore(ctx, value.EntityTypeClient, "clientID")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("復元できる期間を過ぎた場合はConflict", func(t *testing.T) {
+		ctx := setupRetentionUsecaseContext(t)
+		mockRetentionRepository := repository.NewMockRetentionRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockRetentionRepository.EXPECT().FindDeleted(mock.Anything, value.EntityTypeInvoice, "invoiceID").Return(&models.DeletedRecord{
+			EntityType: value.EntityTypeInvoice,
+			ID:         "invoiceID",
+			CompanyID:  admin.CompanyID,
+			DeletedAt:  now.AddDate(0, 0, -30),
+		}, nil)
+
+		usecase := newTestRetentionUsecase(mockRetentionRepository, mockUserRepository, now)
+		err := usecase.Restore(ctx, value.EntityTypeInvoice, "invoiceID")
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.CodeRestoreWindowExpired, appErr.Code)
+	})
+
+	t.Run("管理者以外はForbidden", func(t *testing.T) {
+		ctx := setupRetentionUsecaseContext(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		member := &models.User{ID: "userID", CompanyID: "companyID", Role: value.UserRoleMember}
+		mockUserRepository.EXPECT().FindByID(mock.Anything, member.ID).Return(member, nil)
+
+		usecase := newTestRetentionUsecase(repository.NewMockRetentionRepository(t), mockUserRepository, now)
+		err := usecase.Restore(ctx, value.EntityTypeInvoice, "invoiceID")
+
+		appErr, ok := apperror.As(err)
+		assert.True(t, ok)
+		assert.Equal(t, apperror.KindForbidden, appErr.Kind)
+	})
+
+	t.Run("削除されていない・他社のデータはNotFound", func(t *testing.T) {
+		ctx := setupRetentionUsecaseContext(t)
+		mockRetentionRepository := repository.NewMockRetentionRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, admin.ID).Return(admin, nil)
+		mockRetentionRepository.EXPECT().FindDeleted(mock.Anything, value.EntityTypeInvoice, "activeID").Return(nil, gorm.ErrRecordNotFound)
+		mockRetentionRepository.EXPECT().FindDeleted(mock.Anything, value.EntityTypeInvoice, "otherID").Return(&models.DeletedRecord{
+			EntityType: value.EntityTypeInvoice,
+			ID:         "otherID",
+			CompanyID:  "otherCompanyID",
+			DeletedAt:  now,
+		}, nil)
+
+		usecase := newTestRetentionUsecase(mockRetentionRepository, mockUserRepository, now)
+		for _, id := range []string{"activeID", "otherID"} {
+			err := usecase.Restore(ctx, value.EntityTypeInvoice, id)
+
+			appErr, ok := apperror.As(err)
+			assert.True(t, ok)
+			assert.Equal(t, apperror.KindNotFound, appErr.Kind)
+		}
+	})
+}
+
+func TestRetentionUsecase_Purge(t *testing.T) {
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("ポリシーに従って物理削除・匿名化する", func(t *testing.T) {
+		ctx := setupRetentionUsecaseContext(t)
+		mockRetentionRepository := repository.NewMockRetentionRepository(t)
+
+		// 請求書は1バッチ分を超えるため2回に分けて処理する
+		firstBatch := make([]string, purgeBatchSize)
+		for i := range firstBatch {
+			firstBatch[i] = "invoiceID"
+		}
+		isPolicy := func(entityType value.EntityType, retentionClass value.RetentionClass) interface{} {
+			return mock.MatchedBy(func(p *models.RetentionPolicy) bool {
+				return p.EntityType == entityType && p.RetentionClass == retentionClass
+			})
+		}
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, isPolicy(value.EntityTypeInvoice, value.RetentionClassStandard), now, purgeBatchSize).Return(firstBatch, nil).Once()
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, isPolicy(value.EntityTypeInvoice, value.RetentionClassStandard), now, purgeBatchSize).Return([]string{"lastInvoiceID"}, nil).Once()
+		mockRetentionRepository.EXPECT().Purge(mock.Anything, value.EntityTypeInvoice, firstBatch).Return(purgeBatchSize, nil)
+		mockRetentionRepository.EXPECT().Purge(mock.Anything, value.EntityTypeInvoice, []string{"lastInvoiceID"}).Return(1, nil)
+		// 保存期間の区分が extended の請求書は長い保存期間で判定する
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, mock.MatchedBy(func(p *models.RetentionPolicy) bool {
+			return p.RetentionClass == value.RetentionClassExtended && p.RetentionYears == 10
+		}), now, purgeBatchSize).Return([]string{"extendedInvoiceID"}, nil)
+		mockRetentionRepository.EXPECT().Purge(mock.Anything, value.EntityTypeInvoice, []string{"extendedInvoiceID"}).Return(1, nil)
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, isPolicy(value.EntityTypeClientBankAccount, ""), now, purgeBatchSize).Return([]string{}, nil)
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, isPolicy(value.EntityTypeClient, ""), now, purgeBatchSize).Return([]string{"clientID"}, nil)
+		mockRetentionRepository.EXPECT().Anonymize(mock.Anything, value.EntityTypeClient, []string{"clientID"}, now).Return(1, nil)
+		// 削除した請求書の添付ファイルの内容も削除する
+		mockInvoiceAttachmentRepository := repository.NewMockInvoiceAttachmentRepository(t)
+		mockInvoiceAttachmentRepository.EXPECT().FindStorageKeysByInvoiceIDs(mock.Anything, firstBatch).Return([]string{"invoices/invoiceID/attachments/1"}, nil)
+		mockInvoiceAttachmentRepository.EXPECT().FindStorageKeysByInvoiceIDs(mock.Anything, []string{"lastInvoiceID"}).Return(nil, nil)
+		mockInvoiceAttachmentRepository.EXPECT().FindStorageKeysByInvoiceIDs(mock.Anything, []string{"extendedInvoiceID"}).Return(nil, nil)
+		mockBlobStore := repository.NewMockBlobStore(t)
+		mockBlobStore.EXPECT().Delete(mock.Anything, "invoices/invoiceID/attachments/1").Return(nil)
+
+		usecase := newTestRetentionUsecase(mockRetentionRepository, repository.NewMockUserRepository(t), now)
+		usecase.invoiceAttachmentRepository = mockInvoiceAttachmentRepository
+		usecase.blobStore = mockBlobStore
+		results, err := usecase.Purge(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*models.PurgeResult{
+			{EntityType: value.EntityTypeInvoice, RetentionClass: value.RetentionClassStandard, Action: value.RetentionActionPurge, Rows: purgeBatchSize + 1},
+			{EntityType: value.EntityTypeInvoice, RetentionClass: value.RetentionClassExtended, Action: value.RetentionActionPurge, Rows: 1},
+			{EntityType: value.EntityTypeClientBankAccount, Action: value.RetentionActionPurge, Rows: 0},
+			{EntityType: value.EntityTypeClient, Action: value.RetentionActionAnonymize, Rows: 1},
+		}, results)
+	})
+	t.Run("添付ファイルの内容を削除できなくても続ける", func(t *testing.T) {
+		ctx := setupRetentionUsecaseContext(t)
+		mockRetentionRepository := repository.NewMockRetentionRepository(t)
+		mockInvoiceAttachmentRepository := repository.NewMockInvoiceAttachmentRepository(t)
+		mockBlobStore := repository.NewMockBlobStore(t)
+
+		isInvoicePolicy := mock.MatchedBy(func(p *models.RetentionPolicy) bool { return p.RetentionClass == value.RetentionClassStandard })
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, isInvoicePolicy, now, purgeBatchSize).Return([]string{"invoiceID"}, nil)
+		mockInvoiceAttachmentRepository.EXPECT().FindStorageKeysByInvoiceIDs(mock.Anything, []string{"invoiceID"}).Return([]string{"key1", "key2"}, nil)
+		mockRetentionRepository.EXPECT().Purge(mock.Anything, value.EntityTypeInvoice, []string{"invoiceID"}).Return(1, nil)
+		mockBlobStore.EXPECT().Delete(mock.Anything, "key1").Return(errors.New("connection refused"))
+		mockBlobStore.EXPECT().Delete(mock.Anything, "key2").Return(nil)
+		mockRetentionRepository.EXPECT().FindExpired(mock.Anything, mock.Anything, now, purgeBatchSize).Return([]string{}, nil)
+
+		usecase := newTestRetentionUsecase(mockRetentionRepository, repository.NewMockUserRepository(t), now)
+		usecase.invoiceAttachmentRepository = mockInvoiceAttachmentRepository
+		usecase.blobStore = mockBlobStore
+		results, err := usecase.Purge(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), results[0].Rows)
+	})
+}
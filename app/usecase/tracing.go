@@ -0,0 +1,538 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/ijufumi/practice-202512/app/usecase"
+
+// startSpan はユースケース内の処理単位のスパンを開始します
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan はエラーがあればスパンに記録してから終了します
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracedInvoiceUsecase は InvoiceUsecase の各メソッドをスパンで囲みます
+type tracedInvoiceUsecase struct {
+	next InvoiceUsecase
+}
+
+func (u *tracedInvoiceUsecase) CreateInvoice(ctx context.Context, clientID string, issueDate time.Time, paymentAmount value.Money, paymentDueDate time.Time, lines []*models.InvoiceLine, force bool) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.CreateInvoice", attribute.String("invoice.client_id", clientID), attribute.String("invoice.currency", string(paymentAmount.Currency)), attribute.Int("invoice.lines", len(lines)), attribute.Bool("invoice.force", force))
+	invoice, err := u.next.CreateInvoice(ctx, clientID, issueDate, paymentAmount, paymentDueDate, lines, force)
+	if err == nil {
+		span.SetAttributes(attribute.String("invoice.id", invoice.ID))
+	}
+	endSpan(span, err)
+
+	return invoice, err
+}
+
+func (u *tracedInvoiceUsecase) SearchInvoices(ctx context.Context, condition *models.InvoiceSearchCondition) (*models.InvoicePage, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.SearchInvoices")
+	page, err := u.next.SearchInvoices(ctx, condition)
+	if err == nil {
+		span.SetAttributes(
+			attribute.Int("invoice.count", len(page.Invoices)),
+			attribute.Int64("invoice.total_count", page.TotalCount),
+		)
+	}
+	endSpan(span, err)
+
+	return page, err
+}
+
+func (u *tracedInvoiceUsecase) GetInvoice(ctx context.Context, invoiceID string) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.GetInvoice", attribute.String("invoice.id", invoiceID))
+	invoice, err := u.next.GetInvoice(ctx, invoiceID)
+	endSpan(span, err)
+
+	return invoice, err
+}
+
+func (u *tracedInvoiceUsecase) GetInvoiceByNumber(ctx context.Context, invoiceNumber string) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.GetInvoiceByNumber", attribute.String("invoice.number", invoiceNumber))
+	invoice, err := u.next.GetInvoiceByNumber(ctx, invoiceNumber)
+	if err == nil {
+		span.SetAttributes(attribute.String("invoice.id", invoice.ID))
+	}
+	endSpan(span, err)
+
+	return invoice, err
+}
+
+func (u *tracedInvoiceUsecase) DeleteInvoice(ctx context.Context, invoiceID string, version int) error {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.DeleteInvoice", attribute.String("invoice.id", invoiceID))
+	err := u.next.DeleteInvoice(ctx, invoiceID, version)
+	endSpan(span, err)
+
+	return err
+}
+
+func (u *tracedInvoiceUsecase) UpdateRetentionClass(ctx context.Context, invoiceID string, retentionClass value.RetentionClass, version int) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.UpdateRetentionClass", attribute.String("invoice.id", invoiceID), attribute.String("invoice.retention_class", string(retentionClass)))
+	invoice, err := u.next.UpdateRetentionClass(ctx, invoiceID, retentionClass, version)
+	endSpan(span, err)
+
+	return invoice, err
+}
+
+func (u *tracedInvoiceUsecase) GetNumbering(ctx context.Context) (models.InvoiceNumbering, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.GetNumbering")
+	numbering, err := u.next.GetNumbering(ctx)
+	endSpan(span, err)
+
+	return numbering, err
+}
+
+func (u *tracedInvoiceUsecase) UpdateNumbering(ctx context.Context, numbering models.InvoiceNumbering, version int) (models.InvoiceNumbering, error) {
+	ctx, span := startSpan(ctx, "InvoiceUsecase.UpdateNumbering")
+	updated, err := u.next.UpdateNumbering(ctx, numbering, version)
+	endSpan(span, err)
+
+	return updated, err
+}
+
+// tracedAuthUsecase は AuthUsecase の各メソッドをスパンで囲みます
+type tracedAuthUsecase struct {
+	next AuthUsecase
+}
+
+func (u *tracedAuthUsecase) Login(ctx context.Context, email, password string) (string, error) {
+	ctx, span := startSpan(ctx, "AuthUsecase.Login")
+	token, err := u.next.Login(ctx, email, password)
+	endSpan(span, err)
+
+	return token, err
+}
+
+// tracedClientUsecase は ClientUsecase の各メソッドをスパンで囲みます
+type tracedClientUsecase struct {
+	next ClientUsecase
+}
+
+func (u *tracedClientUsecase) GetClient(ctx context.Context, clientID string) (*models.ClientDetail, error) {
+	ctx, span := startSpan(ctx, "ClientUsecase.GetClient", attribute.String("client.id", clientID))
+	detail, err := u.next.GetClient(ctx, clientID)
+	endSpan(span, err)
+
+	return detail, err
+}
+
+func (u *tracedClientUsecase) DeleteClient(ctx context.Context, clientID string, version int) error {
+	ctx, span := startSpan(ctx, "ClientUsecase.DeleteClient", attribute.String("client.id", clientID))
+	err := u.next.DeleteClient(ctx, clientID, version)
+	endSpan(span, err)
+
+	return err
+}
+
+// tracedRetentionUsecase は RetentionUsecase の各メソッドをスパンで囲みます
+type tracedRetentionUsecase struct {
+	next RetentionUsecase
+}
+
+func (u *tracedRetentionUsecase) Restore(ctx context.Context, entityType value.EntityType, id string) error {
+	ctx, span := startSpan(ctx, "RetentionUsecase.Restore",
+		attribute.String("retention.entity_type", string(entityType)),
+		attribute.String("retention.id", id),
+	)
+	err := u.next.Restore(ctx, entityType, id)
+	endSpan(span, err)
+
+	return err
+}
+
+func (u *tracedRetentionUsecase) Purge(ctx context.Context) ([]*models.PurgeResult, error) {
+	ctx, span := startSpan(ctx, "RetentionUsecase.Purge")
+	results, err := u.next.Purge(ctx)
+	for _, result := range results {
+		span.SetAttributes(attribute.Int64("retention."+string(result.EntityType)+".rows", result.Rows))
+	}
+	endSpan(span, err)
+
+	return results, err
+}
+
+// tracedRecurringInvoiceUsecase は RecurringInvoiceUsecase の各メソッドをスパンで囲みます
+type tracedRecurringInvoiceUsecase struct {
+	next RecurringInvoiceUsecase
+}
+
+func (u *tracedRecurringInvoiceUsecase) CreateRecurringInvoice(ctx context.Context, recurringInvoice *models.RecurringInvoice) (*models.RecurringInvoice, error) {
+	ctx, span := startSpan(ctx, "RecurringInvoiceUsecase.CreateRecurringInvoice", attribute.String("recurring_invoice.client_id", recurringInvoice.ClientID))
+	created, err := u.next.CreateRecurringInvoice(ctx, recurringInvoice)
+	if err == nil {
+		span.SetAttributes(attribute.String("recurring_invoice.id", created.ID))
+	}
+	endSpan(span, err)
+
+	return created, err
+}
+
+func (u *tracedRecurringInvoiceUsecase) ListRecurringInvoices(ctx context.Context) ([]*models.RecurringInvoice, error) {
+	ctx, span := startSpan(ctx, "RecurringInvoiceUsecase.ListRecurringInvoices")
+	recurringInvoices, err := u.next.ListRecurringInvoices(ctx)
+	if err == nil {
+		span.SetAttributes(attribute.Int("recurring_invoice.count", len(recurringInvoices)))
+	}
+	endSpan(span, err)
+
+	return recurringInvoices, err
+}
+
+func (u *tracedRecurringInvoiceUsecase) GetRecurringInvoice(ctx context.Context, recurringInvoiceID string) (*models.RecurringInvoice, error) {
+	ctx, span := startSpan(ctx, "RecurringInvoiceUsecase.GetRecurringInvoice", attribute.String("recurring_invoice.id", recurringInvoiceID))
+	recurringInvoice, err := u.next.GetRecurringInvoice(ctx, recurringInvoiceID)
+	endSpan(span, err)
+
+	return recurringInvoice, err
+}
+
+func (u *tracedRecurringInvoiceUsecase) UpdateRecurringInvoice(ctx context.Context, recurringInvoiceID string, recurringInvoice *models.RecurringInvoice, version int) (*models.RecurringInvoice, error) {
+	ctx, span := startSpan(ctx, "RecurringInvoiceUsecase.UpdateRecurringInvoice", attribute.String("recurring_invoice.id", recurringInvoiceID))
+	updated, err := u.next.UpdateRecurringInvoice(ctx, recurringInvoiceID, recurringInvoice, version)
+	endSpan(span, err)
+
+	return updated, err
+}
+
+func (u *tracedRecurringInvoiceUsecase) DeleteRecurringInvoice(ctx context.Context, recurringInvoiceID string, version int) error {
+	ctx, span := startSpan(ctx, "RecurringInvoiceUsecase.DeleteRecurringInvoice", attribute.String("recurring_invoice.id", recurringInvoiceID))
+	err := u.next.DeleteRecurringInvoice(ctx, recurringInvoiceID, version)
+	endSpan(span, err)
+
+	return err
+}
+
+func (u *tracedRecurringInvoiceUsecase) GenerateInvoices(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "RecurringInvoiceUsecase.GenerateInvoices")
+	generated, err := u.next.GenerateInvoices(ctx)
+	span.SetAttributes(attribute.Int("recurring_invoice.generated", generated))
+	endSpan(span, err)
+
+	return generated, err
+}
+
+// tracedPaymentUsecase は PaymentUsecase の各メソッドをスパンで囲みます
+type tracedPaymentUsecase struct {
+	next PaymentUsecase
+}
+
+func (u *tracedPaymentUsecase) RecordPayment(ctx context.Context, invoiceID string, payment *models.Payment, version int) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "PaymentUsecase.RecordPayment", attribute.String("invoice.id", invoiceID))
+	invoice, err := u.next.RecordPayment(ctx, invoiceID, payment, version)
+	if err == nil {
+		span.SetAttributes(
+			attribute.String("payment.id", payment.ID),
+			attribute.String("invoice.status", string(invoice.Status)),
+		)
+	}
+	endSpan(span, err)
+
+	return invoice, err
+}
+
+func (u *tracedPaymentUsecase) ListPayments(ctx context.Context, invoiceID string) ([]*models.Payment, error) {
+	ctx, span := startSpan(ctx, "PaymentUsecase.ListPayments", attribute.String("invoice.id", invoiceID))
+	payments, err := u.next.ListPayments(ctx, invoiceID)
+	if err == nil {
+		span.SetAttributes(attribute.Int("payment.count", len(payments)))
+	}
+	endSpan(span, err)
+
+	return payments, err
+}
+
+// tracedCreditNoteUsecase は CreditNoteUsecase の各メソッドをスパンで囲みます
+type tracedCreditNoteUsecase struct {
+	next CreditNoteUsecase
+}
+
+func (u *tracedCreditNoteUsecase) IssueCreditNote(ctx context.Context, invoiceID string, creditNote *models.CreditNote, version int) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "CreditNoteUsecase.IssueCreditNote", attribute.String("invoice.id", invoiceID))
+	invoice, err := u.next.IssueCreditNote(ctx, invoiceID, creditNote, version)
+	if err == nil {
+		span.SetAttributes(
+			attribute.String("credit_note.id", creditNote.ID),
+			attribute.String("invoice.status", string(invoice.Status)),
+		)
+	}
+	endSpan(span, err)
+
+	return invoice, err
+}
+
+func (u *tracedCreditNoteUsecase) ListCreditNotes(ctx context.Context, invoiceID string) ([]*models.CreditNote, error) {
+	ctx, span := startSpan(ctx, "CreditNoteUsecase.ListCreditNotes", attribute.String("invoice.id", invoiceID))
+	creditNotes, err := u.next.ListCreditNotes(ctx, invoiceID)
+	if err == nil {
+		span.SetAttributes(attribute.Int("credit_note.count", len(creditNotes)))
+	}
+	endSpan(span, err)
+
+	return creditNotes, err
+}
+
+// tracedApprovalUsecase は ApprovalUsecase の各メソッドをスパンで囲みます
+type tracedApprovalUsecase struct {
+	next ApprovalUsecase
+}
+
+func (u *tracedApprovalUsecase) GetWorkflow(ctx context.Context) (*models.ApprovalWorkflow, error) {
+	ctx, span := startSpan(ctx, "ApprovalUsecase.GetWorkflow")
+	workflow, err := u.next.GetWorkflow(ctx)
+	endSpan(span, err)
+
+	return workflow, err
+}
+
+func (u *tracedApprovalUsecase) UpdateWorkflow(ctx context.Context, rules []*models.ApprovalRule, version int) (*models.ApprovalWorkflow, error) {
+	ctx, span := startSpan(ctx, "ApprovalUsecase.UpdateWorkflow", attribute.Int("approval.steps", len(rules)))
+	updated, err := u.next.UpdateWorkflow(ctx, rules, version)
+	endSpan(span, err)
+
+	return updated, err
+}
+
+func (u *tracedApprovalUsecase) ListApprovals(ctx context.Context, invoiceID string) ([]*models.InvoiceApproval, error) {
+	ctx, span := startSpan(ctx, "ApprovalUsecase.ListApprovals", attribute.String("invoice.id", invoiceID))
+	approvals, err := u.next.ListApprovals(ctx, invoiceID)
+	endSpan(span, err)
+
+	return approvals, err
+}
+
+func (u *tracedApprovalUsecase) Approve(ctx context.Context, invoiceID, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error) {
+	ctx, span := startSpan(ctx, "ApprovalUsecase.Approve", attribute.String("invoice.id", invoiceID))
+	invoice, approvals, err := u.next.Approve(ctx, invoiceID, comment, version)
+	if err == nil {
+		span.SetAttributes(attribute.String("invoice.status", string(invoice.Status)))
+	}
+	endSpan(span, err)
+
+	return invoice, approvals, err
+}
+
+func (u *tracedApprovalUsecase) Reject(ctx context.Context, invoiceID, comment string, version int) (*models.Invoice, []*models.InvoiceApproval, error) {
+	ctx, span := startSpan(ctx, "ApprovalUsecase.Reject", attribute.String("invoice.id", invoiceID))
+	invoice, approvals, err := u.next.Reject(ctx, invoiceID, comment, version)
+	if err == nil {
+		span.SetAttributes(attribute.String("invoice.status", string(invoice.Status)))
+	}
+	endSpan(span, err)
+
+	return invoice, approvals, err
+}
+
+// tracedReportUsecase は ReportUsecase の各メソッドをスパンで囲みます
+type tracedReportUsecase struct {
+	next ReportUsecase
+}
+
+// reportAttributes は集計した期間をスパンの属性にします
+func reportAttributes(reportRange models.ReportRange) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("report.from", reportRange.From.Format(time.DateOnly)),
+		attribute.String("report.to", reportRange.LastDate().Format(time.DateOnly)),
+	}
+}
+
+func (u *tracedReportUsecase) AmountDue(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.AmountDueReport, error) {
+	ctx, span := startSpan(ctx, "ReportUsecase.AmountDue", attribute.String("report.period", string(condition.Period)))
+	reportRange, reports, err := u.next.AmountDue(ctx, condition)
+	if err == nil {
+		span.SetAttributes(reportAttributes(reportRange)...)
+	}
+	endSpan(span, err)
+
+	return reportRange, reports, err
+}
+
+func (u *tracedReportUsecase) StatusCounts(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.StatusReport, error) {
+	ctx, span := startSpan(ctx, "ReportUsecase.StatusCounts")
+	reportRange, reports, err := u.next.StatusCounts(ctx, condition)
+	if err == nil {
+		span.SetAttributes(reportAttributes(reportRange)...)
+	}
+	endSpan(span, err)
+
+	return reportRange, reports, err
+}
+
+func (u *tracedReportUsecase) TopClients(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.ClientReport, error) {
+	ctx, span := startSpan(ctx, "ReportUsecase.TopClients", attribute.Int("report.limit", condition.Limit))
+	reportRange, reports, err := u.next.TopClients(ctx, condition)
+	if err == nil {
+		span.SetAttributes(reportAttributes(reportRange)...)
+	}
+	endSpan(span, err)
+
+	return reportRange, reports, err
+}
+
+func (u *tracedReportUsecase) MonthlyFees(ctx context.Context, condition *models.ReportCondition) (models.ReportRange, []*models.FeeReport, error) {
+	ctx, span := startSpan(ctx, "ReportUsecase.MonthlyFees")
+	reportRange, reports, err := u.next.MonthlyFees(ctx, condition)
+	if err == nil {
+		span.SetAttributes(reportAttributes(reportRange)...)
+	}
+	endSpan(span, err)
+
+	return reportRange, reports, err
+}
+
+// tracedJournalUsecase は JournalUsecase の各メソッドをスパンで囲みます
+type tracedJournalUsecase struct {
+	next JournalUsecase
+}
+
+func (u *tracedJournalUsecase) GetAccounts(ctx context.Context) (models.JournalAccounts, int, error) {
+	ctx, span := startSpan(ctx, "JournalUsecase.GetAccounts")
+	accounts, version, err := u.next.GetAccounts(ctx)
+	endSpan(span, err)
+
+	return accounts, version, err
+}
+
+func (u *tracedJournalUsecase) UpdateAccounts(ctx context.Context, accounts models.JournalAccounts, version int) (models.JournalAccounts, int, error) {
+	ctx, span := startSpan(ctx, "JournalUsecase.UpdateAccounts")
+	updated, newVersion, err := u.next.UpdateAccounts(ctx, accounts, version)
+	endSpan(span, err)
+
+	return updated, newVersion, err
+}
+
+func (u *tracedJournalUsecase) ExportJournal(ctx context.Context, format value.JournalFormat, condition *models.ReportCondition) (models.ReportRange, []*models.JournalEntry, error) {
+	ctx, span := startSpan(ctx, "JournalUsecase.ExportJournal", attribute.String("journal.format", string(format)))
+	reportRange, entries, err := u.next.ExportJournal(ctx, format, condition)
+	if err == nil {
+		span.SetAttributes(reportAttributes(reportRange)...)
+		span.SetAttributes(attribute.Int("journal.entries", len(entries)))
+	}
+	endSpan(span, err)
+
+	return reportRange, entries, err
+}
+
+// tracedReconciliationUsecase は ReconciliationUsecase の各メソッドをスパンで囲みます
+type tracedReconciliationUsecase struct {
+	next ReconciliationUsecase
+}
+
+func (u *tracedReconciliationUsecase) ImportStatement(ctx context.Context, format value.StatementFormat, r io.Reader) (*models.BankStatement, error) {
+	ctx, span := startSpan(ctx, "ReconciliationUsecase.ImportStatement", attribute.String("statement.format", string(format)))
+	bankStatement, err := u.next.ImportStatement(ctx, format, r)
+	if err == nil {
+		span.SetAttributes(
+			attribute.String("statement.id", bankStatement.ID),
+			attribute.Int("statement.lines", len(bankStatement.Lines)),
+			attribute.Int("statement.duplicates", bankStatement.DuplicateCount),
+			attribute.Int("statement.confirmed", bankStatement.CountByStatus(value.StatementLineStatusConfirmed)),
+		)
+	}
+	endSpan(span, err)
+
+	return bankStatement, err
+}
+
+func (u *tracedReconciliationUsecase) ListLines(ctx context.Context, condition *models.BankStatementLineCondition) ([]*models.BankStatementLine, error) {
+	ctx, span := startSpan(ctx, "ReconciliationUsecase.ListLines", attribute.String("statement_line.status", string(condition.Status)))
+	lines, err := u.next.ListLines(ctx, condition)
+	if err == nil {
+		span.SetAttributes(attribute.Int("statement_line.count", len(lines)))
+	}
+	endSpan(span, err)
+
+	return lines, err
+}
+
+func (u *tracedReconciliationUsecase) ConfirmLine(ctx context.Context, lineID string, invoiceID string) (*models.BankStatementLine, *models.Invoice, error) {
+	ctx, span := startSpan(ctx, "ReconciliationUsecase.ConfirmLine",
+		attribute.String("statement_line.id", lineID),
+		attribute.String("invoice.id", invoiceID),
+	)
+	line, invoice, err := u.next.ConfirmLine(ctx, lineID, invoiceID)
+	if err == nil {
+		span.SetAttributes(attribute.Int("statement_line.score", line.Score))
+	}
+	endSpan(span, err)
+
+	return line, invoice, err
+}
+
+func (u *tracedReconciliationUsecase) IgnoreLine(ctx context.Context, lineID string) (*models.BankStatementLine, error) {
+	ctx, span := startSpan(ctx, "ReconciliationUsecase.IgnoreLine", attribute.String("statement_line.id", lineID))
+	line, err := u.next.IgnoreLine(ctx, lineID)
+	endSpan(span, err)
+
+	return line, err
+}
+
+// tracedAttachmentUsecase は AttachmentUsecase の各メソッドをスパンで囲みます
+type tracedAttachmentUsecase struct {
+	next AttachmentUsecase
+}
+
+func (u *tracedAttachmentUsecase) UploadAttachment(ctx context.Context, invoiceID, fileName string, body io.Reader, version int) (*models.InvoiceAttachment, *models.Invoice, error) {
+	ctx, span := startSpan(ctx, "AttachmentUsecase.UploadAttachment", attribute.String("invoice.id", invoiceID))
+	attachment, invoice, err := u.next.UploadAttachment(ctx, invoiceID, fileName, body, version)
+	if err == nil {
+		span.SetAttributes(
+			attribute.String("attachment.id", attachment.ID),
+			attribute.String("attachment.content_type", string(attachment.ContentType)),
+			attribute.Int64("attachment.size", attachment.Size),
+		)
+	}
+	endSpan(span, err)
+
+	return attachment, invoice, err
+}
+
+func (u *tracedAttachmentUsecase) ListAttachments(ctx context.Context, invoiceID string) ([]*models.InvoiceAttachment, error) {
+	ctx, span := startSpan(ctx, "AttachmentUsecase.ListAttachments", attribute.String("invoice.id", invoiceID))
+	attachments, err := u.next.ListAttachments(ctx, invoiceID)
+	if err == nil {
+		span.SetAttributes(attribute.Int("attachment.count", len(attachments)))
+	}
+	endSpan(span, err)
+
+	return attachments, err
+}
+
+func (u *tracedAttachmentUsecase) DownloadAttachment(ctx context.Context, invoiceID, attachmentID string) (*models.InvoiceAttachment, io.ReadCloser, error) {
+	ctx, span := startSpan(ctx, "AttachmentUsecase.DownloadAttachment", attribute.String("invoice.id", invoiceID), attribute.String("attachment.id", attachmentID))
+	attachment, body, err := u.next.DownloadAttachment(ctx, invoiceID, attachmentID)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("attachment.size", attachment.Size))
+	}
+	endSpan(span, err)
+
+	return attachment, body, err
+}
+
+func (u *tracedAttachmentUsecase) DeleteAttachment(ctx context.Context, invoiceID, attachmentID string, version int) (*models.Invoice, error) {
+	ctx, span := startSpan(ctx, "AttachmentUsecase.DeleteAttachment", attribute.String("invoice.id", invoiceID), attribute.String("attachment.id", attachmentID))
+	invoice, err := u.next.DeleteAttachment(ctx, invoiceID, attachmentID, version)
+	endSpan(span, err)
+
+	return invoice, err
+}
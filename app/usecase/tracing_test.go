@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/calendar"
+	"github.com/ijufumi/practice-202512/app/domain/models"
+	repository "github.com/ijufumi/practice-202512/app/domain/repository/mocks"
+	"github.com/ijufumi/practice-202512/app/domain/value"
+	"github.com/ijufumi/practice-202512/app/usecase/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+func setupSpanRecorder() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	return recorder
+}
+
+func TestTracing_InvoiceUsecase(t *testing.T) {
+	t.Run("CreateInvoiceのスパンを記録", func(t *testing.T) {
+		recorder := setupSpanRecorder()
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := newNoDuplicateInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+		mockCompanyRepository := repository.NewMockCompanyRepository(t)
+		mockInvoiceMetrics := mocks.NewMockInvoiceMetrics(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(&models.User{ID: "userID", CompanyID: "companyID"}, nil)
+		mockCompanyRepository.EXPECT().FindByID(mock.Anything, "companyID").
+			Return(&models.Company{ID: "companyID"}, nil)
+		mockInvoiceRepository.EXPECT().Create(mock.Anything, mock.Anything).
+			Run(func(_ *gorm.DB, invoice *models.Invoice) { invoice.ID = "invoiceID" }).Return(nil)
+		mockInvoiceMetrics.EXPECT().InvoiceCreated(mock.Anything).Return()
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, mockCompanyRepository, newCorporateClientRepository(t), repository.NewMockExchangeRateRepository(t), newNoApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mockInvoiceMetrics, config.Default())
+		_, err := usecase.CreateInvoice(ctx, "clientID", time.Now(), value.NewMoney(decimal.NewFromInt(100000), value.CurrencyJPY), time.Now(), nil, false)
+		assert.NoError(t, err)
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "InvoiceUsecase.CreateInvoice", spans[0].Name())
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+		attrs := map[string]string{}
+		for _, attr := range spans[0].Attributes() {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+		assert.Equal(t, "clientID", attrs["invoice.client_id"])
+		assert.Equal(t, "JPY", attrs["invoice.currency"])
+		assert.Equal(t, "invoiceID", attrs["invoice.id"])
+	})
+
+	t.Run("SearchInvoicesのエラーをスパンに記録", func(t *testing.T) {
+		recorder := setupSpanRecorder()
+		ctx, _ := setupInvoiceUsecaseContext(t)
+		mockInvoiceRepository := repository.NewMockInvoiceRepository(t)
+		mockUserRepository := repository.NewMockUserRepository(t)
+
+		mockUserRepository.EXPECT().FindByID(mock.Anything, "userID").
+			Return(nil, errors.New("database error"))
+
+		usecase := NewInvoiceUsecase(mockInvoiceRepository, repository.NewMockInvoiceLineRepository(t), newInvoiceNumberSequenceRepository(t), mockUserRepository, repository.NewMockCompanyRepository(t), repository.NewMockClientRepository(t), repository.NewMockExchangeRateRepository(t), repository.NewMockApprovalRuleRepository(t), repository.NewMockInvoiceApprovalRepository(t), calendar.New(nil), mocks.NewMockInvoiceMetrics(t), config.Default())
+		_, err := usecase.SearchInvoices(ctx, &models.InvoiceSearchCondition{})
+		assert.Error(t, err)
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "InvoiceUsecase.SearchInvoices", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+}
+
+func TestTracing_AuthUsecase(t *testing.T) {
+	t.Run("Loginとパスワード照合のスパンを記録", func(t *testing.T) {
+		recorder := setupSpanRecorder()
+		ctx, _ := setupContext(t)
+		hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		mockRepo := repository.NewMockUserRepository(t)
+		mockRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").
+			Return(&models.User{ID: "userID", Password: string(hashedPassword)}, nil)
+
+		usecase := NewAuthUsecase(mockRepo, &config.Config{JWTSecret: "test-secret"})
+		_, err := usecase.Login(ctx, "test@example.com", "password123")
+		assert.NoError(t, err)
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 2)
+		assert.Equal(t, "bcrypt.CompareHashAndPassword", spans[0].Name())
+		assert.Equal(t, "AuthUsecase.Login", spans[1].Name())
+		assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	})
+}
+
+func TestTracing_ContextPropagation(t *testing.T) {
+	t.Run("リポジトリに渡すDBにユースケースのスパンが設定される", func(t *testing.T) {
+		_ = setupSpanRecorder()
+		ctx, _ := setupContext(t)
+		mockRepo := repository.NewMockUserRepository(t)
+		mockRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").
+			RunAndReturn(func(db *gorm.DB, _ string) (*models.User, error) {
+				spanName := trace.SpanFromContext(db.Statement.Context).(sdktrace.ReadOnlySpan).Name()
+				assert.Equal(t, "AuthUsecase.Login", spanName)
+				return nil, errors.New("database error")
+			})
+
+		usecase := NewAuthUsecase(mockRepo, &config.Config{JWTSecret: "test-secret"})
+		_, err := usecase.Login(ctx, "test@example.com", "password123")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/ijufumi/practice-202512/app/domain/apperror"
+	"github.com/ijufumi/practice-202512/app/domain/repository"
+)
+
+var errVersionMismatch = apperror.NewPreconditionFailed(apperror.CodePreconditionFailed, "version does not match")
+
+// checkVersion はクライアントが指定したバージョンが、取得したデータのバージョンと一致するかを確認します
+func checkVersion(current, expected int) error {
+	if current != expected {
+		return errVersionMismatch
+	}
+
+	return nil
+}
+
+// versionConflictError は取得後に他の更新と競合した場合の *repository.VersionConflictError を、
+// バージョン不一致のエラーに変換します
+func versionConflictError(err error) error {
+	var conflict *repository.VersionConflictError
+	if errors.As(err, &conflict) {
+		return errVersionMismatch.Wrap(err)
+	}
+
+	return err
+}
@@ -10,10 +10,24 @@ import (
 type contextKey string
 
 const (
-	dbContextKey   contextKey = "db"
-	userContextKey contextKey = "user"
+	dbContextKey          contextKey = "db"
+	userContextKey        contextKey = "user"
+	requestIDContextKey   contextKey = "request_id"
+	afterCommitContextKey contextKey = "after_commit"
 )
 
+// AfterCommit collects functions to run after the caller's transaction commits
+type AfterCommit struct {
+	funcs []func()
+}
+
+// Run runs the collected functions in the order they were registered
+func (a *AfterCommit) Run() {
+	for _, f := range a.funcs {
+		f()
+	}
+}
+
 // SetDB sets gorm.DB instance to context
 func SetDB(ctx context.Context, db *gorm.DB) context.Context {
 	return context.WithValue(ctx, dbContextKey, db)
@@ -26,7 +40,8 @@ func GetDB(ctx context.Context) (*gorm.DB, error) {
 		return nil, errors.New("database connection not found in context")
 	}
 
-	return db, nil
+	// 呼び出し元のスパンやリクエストIDをSQLのトレース・ログに引き継ぐ
+	return db.WithContext(ctx), nil
 }
 
 func SetUserID(ctx context.Context, userID string) context.Context {
@@ -41,3 +56,30 @@ func GetUserID(ctx context.Context) (string, error) {
 
 	return userID, nil
 }
+
+// SetAfterCommit sets the collector of functions to run after the caller's transaction commits
+func SetAfterCommit(ctx context.Context, afterCommit *AfterCommit) context.Context {
+	return context.WithValue(ctx, afterCommitContextKey, afterCommit)
+}
+
+// OnCommit registers f to the collector in context, or runs f immediately if no collector is set.
+// 呼び出し元のトランザクションの中で呼ばれた処理が、ロールバックされた結果をメトリクスやログに残さないようにする
+func OnCommit(ctx context.Context, f func()) {
+	if afterCommit, ok := ctx.Value(afterCommitContextKey).(*AfterCommit); ok && afterCommit != nil {
+		afterCommit.funcs = append(afterCommit.funcs, f)
+		return
+	}
+	f()
+}
+
+// SetRequestID sets request ID to context
+func SetRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+// GetRequestID retrieves request ID from context. It returns an empty string if not set
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey).(string)
+
+	return requestID
+}
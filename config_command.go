@@ -0,0 +1,24 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/ijufumi/practice-202512/app/config"
+)
+
+// runConfigCommand は `config` サブコマンドを実行します
+func runConfigCommand(cfg *config.Config, args []string, w io.Writer) error {
+	if len(args) == 0 || args[0] != "print" {
+		return fmt.Errorf("usage: config print [--redacted]")
+	}
+
+	flags := flag.NewFlagSet("config print", flag.ContinueOnError)
+	redacted := flags.Bool("redacted", false, "replace secret values with "+config.RedactedValue)
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	return cfg.WriteYAML(w, *redacted)
+}
@@ -2,19 +2,46 @@ package e2e
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/calendar"
 	"github.com/ijufumi/practice-202512/app/domain/models"
+	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/gateway"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption/encryptiontest"
+	"github.com/ijufumi/practice-202512/app/infrastructure/logging"
+	"github.com/ijufumi/practice-202512/app/infrastructure/metrics"
+	"github.com/ijufumi/practice-202512/app/infrastructure/storage"
+	"github.com/ijufumi/practice-202512/app/infrastructure/tracing"
 	"github.com/ijufumi/practice-202512/app/presentation"
 	"github.com/ijufumi/practice-202512/app/presentation/handler"
+	"github.com/ijufumi/practice-202512/app/presentation/worker"
 	"github.com/ijufumi/practice-202512/app/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -23,17 +50,14 @@ import (
 )
 
 func setupTestDB(t *testing.T) *gorm.DB {
+	// 暗号化列の読み書きに使う鍵
+	encryptiontest.Setup()
+
 	db, err := gorm.Open(sqlite.Open(":memory:?_foreign_keys=on"), &gorm.Config{})
 	assert.NoError(t, err)
 
 	// マイグレーション
-	err = db.AutoMigrate(
-		&entities.Company{},
-		&entities.User{},
-		&entities.Client{},
-		&entities.ClientBankAccount{},
-		&entities.Invoice{},
-	)
+	err = db.AutoMigrate(entities.Models()...)
 	assert.NoError(t, err)
 
 	return db
@@ -94,19 +118,121 @@ func setupTestData(t *testing.T, db *gorm.DB) (string, string) {
 	return user.Email, client.ID
 }
 
-func setupRouter(db *gorm.DB, cfg *config.Config) *httptest.Server {
+func newRouter(t *testing.T, db *gorm.DB, cfg *config.Config) *echo.Echo {
+	appMetrics, err := metrics.New(db)
+	assert.NoError(t, err)
+
 	// 依存性の注入
 	invoiceRepository := gateway.NewInvoiceRepository()
+	invoiceLineRepository := gateway.NewInvoiceLineRepository()
+	invoiceNumberSequenceRepository := gateway.NewInvoiceNumberSequenceRepository()
 	userRepository := gateway.NewUserRepository()
-	invoiceUsecase := usecase.NewInvoiceUsecase(invoiceRepository, userRepository)
+	companyRepository := gateway.NewCompanyRepository()
+	clientRepository := gateway.NewClientRepository()
+	exchangeRateRepository := gateway.NewExchangeRateRepository()
+	approvalRuleRepository := gateway.NewApprovalRuleRepository()
+	invoiceApprovalRepository := gateway.NewInvoiceApprovalRepository()
+	invoiceUsecase := usecase.NewInvoiceUsecase(invoiceRepository, invoiceLineRepository, invoiceNumberSequenceRepository, userRepository, companyRepository, clientRepository, exchangeRateRepository, approvalRuleRepository, invoiceApprovalRepository, calendar.New(nil), appMetrics, cfg)
 	invoiceHandler := handler.NewInvoiceHandler(invoiceUsecase)
 
+	paymentRepository := gateway.NewPaymentRepository()
+	paymentUsecase := usecase.NewPaymentUsecase(invoiceRepository, paymentRepository, userRepository)
+	paymentHandler := handler.NewPaymentHandler(paymentUsecase)
+
+	creditNoteRepository := gateway.NewCreditNoteRepository()
+	creditNoteUsecase := usecase.NewCreditNoteUsecase(invoiceRepository, creditNoteRepository, userRepository)
+	creditNoteHandler := handler.NewCreditNoteHandler(creditNoteUsecase)
+
+	approvalUsecase := usecase.NewApprovalUsecase(invoiceRepository, approvalRuleRepository, invoiceApprovalRepository, userRepository)
+	approvalHandler := handler.NewApprovalHandler(approvalUsecase)
+
 	authUsecase := usecase.NewAuthUsecase(userRepository, cfg)
 	authHandler := handler.NewAuthHandler(authUsecase)
 
-	router := presentation.NewRouter(db, cfg, invoiceHandler, authHandler)
+	healthRepository := gateway.NewHealthRepository()
+	healthUsecase := usecase.NewHealthUsecase(healthRepository)
+	healthHandler := handler.NewHealthHandler(healthUsecase)
+
+	clientBankAccountRepository := gateway.NewClientBankAccountRepository()
+	clientUsecase := usecase.NewClientUsecase(clientRepository, clientBankAccountRepository, invoiceRepository, userRepository)
+	clientHandler := handler.NewClientHandler(clientUsecase)
+
+	recurringInvoiceRepository := gateway.NewRecurringInvoiceRepository()
+	recurringInvoiceUsecase := usecase.NewRecurringInvoiceUsecase(recurringInvoiceRepository, clientRepository, userRepository, invoiceUsecase)
+	recurringInvoiceHandler := handler.NewRecurringInvoiceHandler(recurringInvoiceUsecase)
+
+	reportRepository := gateway.NewReportRepository()
+	reportUsecase := usecase.NewReportUsecase(reportRepository, userRepository)
+	reportHandler := handler.NewReportHandler(reportUsecase)
+
+	journalRepository := gateway.NewJournalRepository()
+	journalAccountRepository := gateway.NewJournalAccountRepository()
+	journalUsecase := usecase.NewJournalUsecase(journalRepository, journalAccountRepository, userRepository)
+	journalHandler := handler.NewJournalHandler(journalUsecase)
+
+	bankStatementRepository := gateway.NewBankStatementRepository()
+	reconciliationUsecase := usecase.NewReconciliationUsecase(bankStatementRepository, invoiceRepository, userRepository, paymentUsecase)
+	reconciliationHandler := handler.NewReconciliationHandler(reconciliationUsecase)
+
+	blobStore, err := storage.New(cfg)
+	assert.NoError(t, err)
+	invoiceAttachmentRepository := gateway.NewInvoiceAttachmentRepository()
+	attachmentUsecase := usecase.NewAttachmentUsecase(invoiceRepository, invoiceAttachmentRepository, userRepository, blobStore, cfg)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentUsecase)
+
+	retentionRepository := gateway.NewRetentionRepository()
+	retentionUsecase := usecase.NewRetentionUsecase(retentionRepository, invoiceAttachmentRepository, userRepository, blobStore, cfg)
+	adminHandler := handler.NewAdminHandler(retentionUsecase)
+
+	return presentation.NewRouter(db, cfg, appMetrics, invoiceHandler, paymentHandler, creditNoteHandler, approvalHandler, authHandler, healthHandler, clientHandler, recurringInvoiceHandler, reportHandler, journalHandler, reconciliationHandler, attachmentHandler, adminHandler)
+}
+
+// newTestConfig は既定値に E2E テスト用の署名鍵と暗号鍵を設定した設定を返します
+func newTestConfig(t *testing.T) *config.Config {
+	cfg := config.Default()
+	cfg.JWTSecret = "test-secret-key-for-e2e-0123456789"
+	cfg.EncryptionKeys = "test:AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+	cfg.EncryptionActiveKeyID = "test"
+	cfg.BlindIndexKey = "//////////////////////////////////////////8="
+	cfg.AttachmentDir = t.TempDir()
+	assert.NoError(t, cfg.Validate())
+
+	return cfg
+}
+
+// setupRouter はテスト用サーバーを起動します。全レスポンスはOpenAPI仕様書で検証されます
+func setupRouter(t *testing.T, db *gorm.DB, cfg *config.Config) *httptest.Server {
+	contract := newOpenAPIContract(t)
+
+	return httptest.NewServer(contract.handler(t, newRouter(t, db, cfg)))
+}
+
+type invoiceListResponse struct {
+	Items      []map[string]interface{} `json:"items"`
+	NextCursor *string                  `json:"next_cursor"`
+	TotalCount int64                    `json:"total_count"`
+}
+
+func login(t *testing.T, serverURL, email string) string {
+	loginReq := map[string]string{
+		"email":    email,
+		"password": "testpassword",
+	}
+	loginBody, _ := json.Marshal(loginReq)
+
+	resp, err := http.Post(
+		serverURL+"/api/login",
+		"application/json",
+		bytes.NewBuffer(loginBody),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	var loginResp map[string]string
+	err = json.NewDecoder(resp.Body).Decode(&loginResp)
+	assert.NoError(t, err)
 
-	return httptest.NewServer(router)
+	return loginResp["token"]
 }
 
 func TestE2E_LoginAndCreateInvoice(t *testing.T) {
@@ -117,12 +243,10 @@ func TestE2E_LoginAndCreateInvoice(t *testing.T) {
 	email, clientID := setupTestData(t, db)
 
 	// テスト用の設定
-	cfg := &config.Config{
-		JWTSecret: "test-secret-key-for-e2e",
-	}
+	cfg := newTestConfig(t)
 
 	// サーバーのセットアップ
-	server := setupRouter(db, cfg)
+	server := setupRouter(t, db, cfg)
 	defer server.Close()
 
 	t.Run("E2E - ログインから請求書作成まで", func(t *testing.T) {
@@ -191,13 +315,13 @@ func TestE2E_LoginAndCreateInvoice(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var invoicesResp []map[string]interface{}
+		var invoicesResp invoiceListResponse
 		err = json.NewDecoder(resp.Body).Decode(&invoicesResp)
 		assert.NoError(t, err)
 		defer func() { _ = resp.Body.Close() }()
 
-		assert.NotEmpty(t, invoicesResp)
-		assert.Equal(t, invoiceResp["id"], invoicesResp[0]["id"])
+		assert.NotEmpty(t, invoicesResp.Items)
+		assert.Equal(t, invoiceResp["id"], invoicesResp.Items[0]["id"])
 	})
 
 	t.Run("E2E - offsetとlimitでページネーション", func(t *testing.T) {
@@ -221,13 +345,14 @@ func TestE2E_LoginAndCreateInvoice(t *testing.T) {
 
 		token := loginResp["token"]
 
-		// Step 2: 複数の請求書を作成
+		// Step 2: 複数の請求書を作成（前のテストと同じ請求書を含むため、重複の疑いがあっても作成する）
 		for i := 0; i < 3; i++ {
 			invoiceReq := map[string]interface{}{
 				"client_id":        clientID,
 				"issue_date":       time.Now().Format(time.DateOnly),
 				"payment_amount":   100000 * (i + 1),
 				"payment_due_date": time.Now().AddDate(0, i+1, 0).Format(time.DateOnly),
+				"force":            true,
 			}
 			invoiceBody, _ := json.Marshal(invoiceReq)
 
@@ -257,13 +382,60 @@ func TestE2E_LoginAndCreateInvoice(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
-		var invoicesResp []map[string]interface{}
+		var invoicesResp invoiceListResponse
 		err = json.NewDecoder(resp.Body).Decode(&invoicesResp)
 		assert.NoError(t, err)
 		defer func() { _ = resp.Body.Close() }()
 
 		// 1件のみ取得されることを確認
-		assert.Len(t, invoicesResp, 1)
+		assert.Len(t, invoicesResp.Items, 1)
+		assert.Equal(t, int64(4), invoicesResp.TotalCount)
+	})
+
+	t.Run("E2E - カーソルでページネーション", func(t *testing.T) {
+		token := login(t, server.URL, email)
+
+		// limit=1で全ページを辿り、重複や欠落がないことを確認
+		var ids []string
+		cursor := ""
+		for {
+			query := url.Values{}
+			query.Set("limit", "1")
+			if cursor != "" {
+				query.Set("cursor", cursor)
+			}
+			req, _ := http.NewRequest(
+				http.MethodGet,
+				server.URL+"/api/invoices?"+query.Encode(),
+				nil,
+			)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			client := &http.Client{}
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var invoicesResp invoiceListResponse
+			err = json.NewDecoder(resp.Body).Decode(&invoicesResp)
+			assert.NoError(t, err)
+			_ = resp.Body.Close()
+
+			for _, item := range invoicesResp.Items {
+				ids = append(ids, item["id"].(string))
+			}
+			if invoicesResp.NextCursor == nil {
+				break
+			}
+			cursor = *invoicesResp.NextCursor
+		}
+
+		assert.Len(t, ids, 4)
+		seen := map[string]bool{}
+		for _, id := range ids {
+			assert.False(t, seen[id])
+			seen[id] = true
+		}
 	})
 
 	t.Run("E2E - 認証エラー", func(t *testing.T) {
@@ -308,6 +480,12 @@ func TestE2E_LoginAndCreateInvoice(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, "application/problem+json", resp.Header.Get("Content-Type"))
+		var problem map[string]interface{}
+		err = json.NewDecoder(resp.Body).Decode(&problem)
+		assert.NoError(t, err)
+		assert.Equal(t, "MISSING_AUTHORIZATION", problem["code"])
 	})
 }
 
@@ -319,12 +497,10 @@ func TestE2E_InvalidRequests(t *testing.T) {
 	email, _ := setupTestData(t, db)
 
 	// テスト用の設定
-	cfg := &config.Config{
-		JWTSecret: "test-secret-key-for-e2e",
-	}
+	cfg := newTestConfig(t)
 
 	// サーバーのセットアップ
-	server := setupRouter(db, cfg)
+	server := setupRouter(t, db, cfg)
 	defer server.Close()
 
 	// ログインしてトークンを取得
@@ -360,10 +536,2326 @@ func TestE2E_InvalidRequests(t *testing.T) {
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", "Bearer "+token)
 
+		req.Header.Set("Accept-Language", "ja")
+
 		client := &http.Client{}
 		resp, err := client.Do(req)
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 		defer func() { _ = resp.Body.Close() }()
+
+		var problem struct {
+			Code   string `json:"code"`
+			Errors []struct {
+				Field   string `json:"field"`
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&problem)
+		assert.NoError(t, err)
+		assert.Equal(t, "VALIDATION_FAILED", problem.Code)
+		assert.Len(t, problem.Errors, 3)
+		assert.Equal(t, "client_id", problem.Errors[0].Field)
+		assert.Equal(t, "client_idは必須です", problem.Errors[0].Message)
+	})
+}
+
+func TestE2E_HealthAndMetrics(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// テスト用の設定
+	cfg := newTestConfig(t)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, cfg)
+	defer server.Close()
+
+	t.Run("E2E - ヘルスチェック", func(t *testing.T) {
+		for _, path := range []string{"/healthz", "/readyz"} {
+			resp, err := http.Get(server.URL + path)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+			_ = resp.Body.Close()
+		}
+	})
+
+	t.Run("E2E - メトリクス", func(t *testing.T) {
+		token := login(t, server.URL, email)
+
+		invoiceReq := map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       time.Now().Format(time.DateOnly),
+			"payment_amount":   "100000",
+			"payment_due_date": time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		}
+		invoiceBody, _ := json.Marshal(invoiceReq)
+		req, _ := http.NewRequest(
+			http.MethodPost,
+			server.URL+"/api/invoices",
+			bytes.NewBuffer(invoiceBody),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		client := &http.Client{}
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		_ = resp.Body.Close()
+
+		resp, err = http.Get(server.URL + "/metrics")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+
+		metrics := string(body)
+		assert.Contains(t, metrics, `http_requests_total{method="POST",route="/api/invoices",status="201"} 1`)
+		assert.Contains(t, metrics, `http_request_duration_seconds_count{method="POST",route="/api/invoices",status="201"} 1`)
+		assert.Contains(t, metrics, `http_requests_total{method="POST",route="/api/login",status="200"} 1`)
+		assert.Contains(t, metrics, "invoices_created_total 1")
+		assert.Contains(t, metrics, "invoice_created_amount_total 104400")
+		assert.Contains(t, metrics, `invoices{status="未処理"} 1`)
+		assert.Contains(t, metrics, `invoice_amount{status="未処理"} 104400`)
+		assert.Contains(t, metrics, "go_sql_open_connections")
+	})
+
+	t.Run("E2E - DBに接続できない場合はreadyzが503", func(t *testing.T) {
+		db := setupTestDB(t)
+		server := setupRouter(t, db, cfg)
+		defer server.Close()
+
+		sqlDB, err := db.DB()
+		assert.NoError(t, err)
+		assert.NoError(t, sqlDB.Close())
+
+		resp, err := http.Get(server.URL + "/readyz")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		_ = resp.Body.Close()
+
+		resp, err = http.Get(server.URL + "/healthz")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+}
+
+func TestE2E_Logging(t *testing.T) {
+	// ログをバッファに出力する
+	var logs bytes.Buffer
+	logger := logging.New(&logs, slog.LevelDebug)
+	defaultLogger := slog.Default()
+	slog.SetDefault(logger)
+	defer slog.SetDefault(defaultLogger)
+
+	// テスト用DBのセットアップ（SQLログもバッファに出力する）
+	db := setupTestDB(t)
+	db.Logger = logging.NewGormLogger(logger, slog.LevelDebug)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// テスト用の設定
+	cfg := newTestConfig(t)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, cfg)
+	defer server.Close()
+
+	t.Run("E2E - リクエストIDがハンドラー・ユースケース・SQLのログに伝播する", func(t *testing.T) {
+		token := login(t, server.URL, email)
+		logs.Reset()
+
+		invoiceReq := map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       time.Now().Format(time.DateOnly),
+			"payment_amount":   "100000",
+			"payment_due_date": time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		}
+		invoiceBody, _ := json.Marshal(invoiceReq)
+		req, _ := http.NewRequest(
+			http.MethodPost,
+			server.URL+"/api/invoices",
+			bytes.NewBuffer(invoiceBody),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("X-Request-ID", "e2e-request-1")
+
+		client := &http.Client{}
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "e2e-request-1", resp.Header.Get("X-Request-ID"))
+		_ = resp.Body.Close()
+
+		messages := map[string]bool{}
+		for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+			var entry map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+			assert.Equal(t, "e2e-request-1", entry["request_id"], line)
+			messages[entry["msg"].(string)] = true
+		}
+		assert.True(t, messages["SQL executed"])
+		assert.True(t, messages["invoice created"])
+		assert.True(t, messages["request"])
+		assert.NotContains(t, logs.String(), token)
+	})
+
+	t.Run("E2E - パスワードとトークンをログに出力しない", func(t *testing.T) {
+		logs.Reset()
+
+		token := login(t, server.URL, email)
+
+		assert.NotEmpty(t, token)
+		assert.Contains(t, logs.String(), "user logged in")
+		assert.NotContains(t, logs.String(), "testpassword")
+		assert.NotContains(t, logs.String(), token)
+		assert.NotContains(t, logs.String(), email)
+	})
+}
+
+func TestE2E_Tracing(t *testing.T) {
+	// スパンをメモリに記録する
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+	assert.NoError(t, db.Use(tracing.NewGormPlugin()))
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// テスト用の設定
+	cfg := newTestConfig(t)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, cfg)
+	defer server.Close()
+
+	t.Run("E2E - HTTP・ユースケース・SQLのスパンが1つのトレースになる", func(t *testing.T) {
+		token := login(t, server.URL, email)
+		recorder.Reset()
+
+		invoiceReq := map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       time.Now().Format(time.DateOnly),
+			"payment_amount":   "100000",
+			"payment_due_date": time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		}
+		invoiceBody, _ := json.Marshal(invoiceReq)
+		req, _ := http.NewRequest(
+			http.MethodPost,
+			server.URL+"/api/invoices",
+			bytes.NewBuffer(invoiceBody),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		client := &http.Client{}
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		_ = resp.Body.Close()
+
+		spans := map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range recorder.Ended() {
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String(), span.Name())
+			spans[span.Name()] = span
+		}
+
+		server, ok := spans["POST /api/invoices"]
+		assert.True(t, ok)
+		assert.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+
+		usecaseSpan, ok := spans["InvoiceUsecase.CreateInvoice"]
+		assert.True(t, ok)
+		assert.Equal(t, server.SpanContext().SpanID(), usecaseSpan.Parent().SpanID())
+
+		// ユーザー検索と請求書登録のSQLがユースケースのスパンの子になる
+		for _, name := range []string{"gorm.select", "gorm.create"} {
+			span, ok := spans[name]
+			assert.True(t, ok, name)
+			assert.Equal(t, usecaseSpan.SpanContext().SpanID(), span.Parent().SpanID(), name)
+		}
+	})
+}
+
+func TestE2E_BodyLimit(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, _ := setupTestData(t, db)
+
+	// テスト用の設定
+	cfg := newTestConfig(t)
+	cfg.BodyLimit = "1K"
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, cfg)
+	defer server.Close()
+
+	t.Run("E2E - 上限以下のリクエストボディは受け付ける", func(t *testing.T) {
+		token := login(t, server.URL, email)
+		assert.NotEmpty(t, token)
+	})
+
+	t.Run("E2E - 上限を超えるリクエストボディは413", func(t *testing.T) {
+		loginReq := map[string]string{
+			"email":    email,
+			"password": strings.Repeat("x", 2048),
+		}
+		loginBody, _ := json.Marshal(loginReq)
+
+		resp, err := http.Post(
+			server.URL+"/api/login",
+			"application/json",
+			bytes.NewBuffer(loginBody),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+		defer func() { _ = resp.Body.Close() }()
+
+		var problem struct {
+			Code string `json:"code"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&problem)
+		assert.NoError(t, err)
+		assert.Equal(t, "PAYLOAD_TOO_LARGE", problem.Code)
+	})
+}
+
+func TestE2E_GetClient(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// 別の企業の取引先
+	otherCompany := &models.Company{
+		CorporateName:      "Other Corporation",
+		RepresentativeName: "Other Representative",
+		PhoneNumber:        "222-2222-2222",
+		PostalCode:         "222-2222",
+		Address:            "Other Address",
+	}
+	err := gateway.NewCompanyRepository().Create(db, otherCompany)
+	assert.NoError(t, err)
+	otherClient := &models.Client{
+		CompanyID:          otherCompany.ID,
+		CorporateName:      "Other Client",
+		RepresentativeName: "Other Client Representative",
+		PhoneNumber:        "333-3333-3333",
+		PostalCode:         "333-3333",
+		Address:            "Other Client Address",
+	}
+	err = gateway.NewClientRepository().Create(db, otherClient)
+	assert.NoError(t, err)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	getClient := func(t *testing.T, id string) *http.Response {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/clients/"+id, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	t.Run("E2E - 取引先を復号して取得し、口座番号はマスクする", func(t *testing.T) {
+		resp := getClient(t, clientID)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var client struct {
+			ID           string `json:"id"`
+			PhoneNumber  string `json:"phone_number"`
+			Address      string `json:"address"`
+			BankAccounts []struct {
+				AccountNumber string `json:"account_number"`
+				AccountName   string `json:"account_name"`
+			} `json:"bank_accounts"`
+		}
+		err := json.NewDecoder(resp.Body).Decode(&client)
+		assert.NoError(t, err)
+		assert.Equal(t, clientID, client.ID)
+		assert.Equal(t, "111-1111-1111", client.PhoneNumber)
+		assert.Equal(t, "Client Address", client.Address)
+		assert.Len(t, client.BankAccounts, 1)
+		assert.Equal(t, "****7890", client.BankAccounts[0].AccountNumber)
+		assert.Equal(t, "Test Account", client.BankAccounts[0].AccountName)
+	})
+
+	t.Run("E2E - 別の企業の取引先は404", func(t *testing.T) {
+		resp := getClient(t, otherClient.ID)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_SoftDeleteAndRestore(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path string, body interface{}, ifMatch string) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			b, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(b)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	listInvoiceIDs := func(t *testing.T) []interface{} {
+		resp := request(t, http.MethodGet, "/api/invoices", nil, "")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var list invoiceListResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+		ids := make([]interface{}, 0, len(list.Items))
+		for _, item := range list.Items {
+			ids = append(ids, item["id"])
+		}
+
+		return ids
+	}
+
+	resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+		"client_id":        clientID,
+		"issue_date":       time.Now().Format(time.DateOnly),
+		"payment_amount":   "100000",
+		"payment_due_date": time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+	}, "")
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	var invoice map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+	_ = resp.Body.Close()
+	invoiceID := invoice["id"].(string)
+	etag := resp.Header.Get("ETag")
+
+	t.Run("E2E - 請求書を削除すると一覧に表示されない", func(t *testing.T) {
+		resp := request(t, http.MethodDelete, "/api/invoices/"+invoiceID, nil, etag)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		assert.NotContains(t, listInvoiceIDs(t), invoiceID)
+
+		// 削除済みの請求書は再度削除できない
+		again := request(t, http.MethodDelete, "/api/invoices/"+invoiceID, nil, `"2"`)
+		defer func() { _ = again.Body.Close() }()
+		assert.Equal(t, http.StatusNotFound, again.StatusCode)
+	})
+
+	t.Run("E2E - 管理者以外は復元できない", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/admin/invoices/"+invoiceID+"/restore", nil, "")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("E2E - 管理者は削除した請求書を復元できる", func(t *testing.T) {
+		err := db.Model(&entities.User{}).Where("email = ?", email).Update("role", "admin").Error
+		assert.NoError(t, err)
+
+		resp := request(t, http.MethodPost, "/api/admin/invoices/"+invoiceID+"/restore", nil, "")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		assert.Contains(t, listInvoiceIDs(t), invoiceID)
+	})
+
+	t.Run("E2E - 管理者は保存期間の区分を変更できる", func(t *testing.T) {
+		get := request(t, http.MethodGet, "/api/invoices/"+invoiceID, nil, "")
+		defer func() { _ = get.Body.Close() }()
+		assert.Equal(t, http.StatusOK, get.StatusCode)
+		current := get.Header.Get("ETag")
+
+		// 復元でバージョンが進んだため、削除前の ETag では変更できない
+		stale := request(t, http.MethodPut, "/api/admin/invoices/"+invoiceID+"/retention-class", map[string]string{"retention_class": "extended"}, etag)
+		defer func() { _ = stale.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionFailed, stale.StatusCode)
+
+		resp := request(t, http.MethodPut, "/api/admin/invoices/"+invoiceID+"/retention-class", map[string]string{"retention_class": "extended"}, current)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, current, resp.Header.Get("ETag"))
+		var updated map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&updated))
+		assert.Equal(t, "extended", updated["retention_class"])
+	})
+
+	t.Run("E2E - 請求書が登録されている取引先は削除できない", func(t *testing.T) {
+		resp := request(t, http.MethodDelete, "/api/clients/"+clientID, nil, `"1"`)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		get := request(t, http.MethodGet, "/api/clients/"+clientID, nil, "")
+		defer func() { _ = get.Body.Close() }()
+		assert.Equal(t, http.StatusOK, get.StatusCode)
+	})
+}
+
+func TestE2E_OptimisticLocking(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+	invoice := &models.Invoice{
+		ClientID:       clientID,
+		IssueDate:      time.Now(),
+		PaymentAmount:  decimal.NewFromInt(10000),
+		PaymentDueDate: time.Now().AddDate(0, 1, 0),
+		Status:         value.InvoiceStatusUnprocessed,
+	}
+	user, err := gateway.NewUserRepository().FindByEmail(db, email)
+	assert.NoError(t, err)
+	invoice.CompanyID = user.CompanyID
+	assert.NoError(t, gateway.NewInvoiceRepository().Create(db, invoice))
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, ifMatch string) *http.Response {
+		req, _ := http.NewRequest(method, server.URL+path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	problemCode := func(t *testing.T, resp *http.Response) string {
+		var problem map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+
+		return fmt.Sprint(problem["code"])
+	}
+
+	t.Run("E2E - GETでETagとバージョンを返す", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/invoices/"+invoice.ID, "")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `"1"`, resp.Header.Get("ETag"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, float64(1), body["version"])
+
+		client := request(t, http.MethodGet, "/api/clients/"+clientID, "")
+		defer func() { _ = client.Body.Close() }()
+		assert.Equal(t, `"1"`, client.Header.Get("ETag"))
+	})
+
+	t.Run("E2E - If-Matchがない場合は428", func(t *testing.T) {
+		resp := request(t, http.MethodDelete, "/api/invoices/"+invoice.ID, "")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionRequired, resp.StatusCode)
+		assert.Equal(t, "PRECONDITION_REQUIRED", problemCode(t, resp))
+	})
+
+	t.Run("E2E - If-MatchのETagが古い・形式が不正な場合は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`"2"`, `W/"1"`, "*", "1"} {
+			resp := request(t, http.MethodDelete, "/api/invoices/"+invoice.ID, ifMatch)
+			assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode, ifMatch)
+			assert.Equal(t, "PRECONDITION_FAILED", problemCode(t, resp))
+			_ = resp.Body.Close()
+		}
+	})
+
+	t.Run("E2E - 復元するとバージョンが進み、削除前のETagでは削除できない", func(t *testing.T) {
+		resp := request(t, http.MethodDelete, "/api/invoices/"+invoice.ID, `"1"`)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		err := db.Model(&entities.User{}).Where("id = ?", user.ID).Update("role", "admin").Error
+		assert.NoError(t, err)
+		resp = request(t, http.MethodPost, "/api/admin/invoices/"+invoice.ID+"/restore", "")
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = request(t, http.MethodDelete, "/api/invoices/"+invoice.ID, `"1"`)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+		resp = request(t, http.MethodGet, "/api/invoices/"+invoice.ID, "")
+		_ = resp.Body.Close()
+		assert.Equal(t, `"3"`, resp.Header.Get("ETag"))
+	})
+}
+
+func TestE2E_DueDateAdjustment(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	// 支払期日だけが違う請求書を作成するため、重複の疑いがあっても作成する
+	createInvoice := func(t *testing.T, issueDate, paymentDueDate string) map[string]interface{} {
+		body, _ := json.Marshal(map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       issueDate,
+			"payment_amount":   "100000",
+			"payment_due_date": paymentDueDate,
+			"force":            true,
+		})
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/invoices", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+
+		return invoice
+	}
+
+	t.Run("E2E - 年末年始の支払期日は前営業日に繰り上げて調整内容を返す", func(t *testing.T) {
+		invoice := createInvoice(t, "2025-12-01", "2025-12-31")
+
+		assert.Equal(t, "2025-12-30T00:00:00Z", invoice["payment_due_date"])
+		assert.Equal(t, map[string]interface{}{
+			"requested_date": "2025-12-31T00:00:00Z",
+			"reason":         "年末年始",
+			"policy":         "previous",
+		}, invoice["due_date_adjustment"])
+	})
+
+	t.Run("E2E - 営業日の支払期日はそのまま", func(t *testing.T) {
+		invoice := createInvoice(t, "2025-12-01", "2025-12-26")
+
+		assert.Equal(t, "2025-12-26T00:00:00Z", invoice["payment_due_date"])
+		assert.NotContains(t, invoice, "due_date_adjustment")
+	})
+}
+
+func TestE2E_InvoiceLines(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	lines := []map[string]interface{}{
+		{"description": "システム保守", "quantity": "2", "unit_price": "50000", "tax_category": "standard"},
+		{"description": "会議用弁当", "quantity": 10, "unit_price": 360, "tax_category": "reduced"},
+		{"description": "収入印紙", "quantity": "1", "unit_price": "200", "tax_category": "exempt"},
+	}
+
+	t.Run("E2E - 明細から支払金額と税区分ごとの消費税を計算する", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"lines":            lines,
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var created map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		// 100,000 + 10,000（10%）+ 3,600 + 288（8%）+ 200（非課税）
+		assert.Equal(t, "114088", created["payment_amount"])
+		assert.Equal(t, "119107", created["invoice_amount"])
+
+		// 取得しても同じ明細と内訳を返す
+		invoiceID, _ := created["id"].(string)
+		get := request(t, http.MethodGet, "/api/invoices/"+invoiceID, nil)
+		defer func() { _ = get.Body.Close() }()
+		assert.Equal(t, http.StatusOK, get.StatusCode)
+
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(get.Body).Decode(&invoice))
+		assert.Equal(t, created["lines"], invoice["lines"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"line_no": float64(1), "description": "システム保守", "quantity": "2", "unit_price": "50000", "tax_category": "standard", "amount": "100000"},
+			map[string]interface{}{"line_no": float64(2), "description": "会議用弁当", "quantity": "10", "unit_price": "360", "tax_category": "reduced", "amount": "3600"},
+			map[string]interface{}{"line_no": float64(3), "description": "収入印紙", "quantity": "1", "unit_price": "200", "tax_category": "exempt", "amount": "200"},
+		}, invoice["lines"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"tax_category": "standard", "tax_rate": "0.1", "amount": "100000", "tax": "10000"},
+			map[string]interface{}{"tax_category": "reduced", "tax_rate": "0.08", "amount": "3600", "tax": "288"},
+			map[string]interface{}{"tax_category": "exempt", "tax_rate": "0", "amount": "200", "tax": "0"},
+		}, invoice["tax_breakdown"])
+	})
+
+	t.Run("E2E - 支払金額だけを指定した請求書は明細を返さない", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"payment_amount":   "100000",
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+		assert.Equal(t, "104400", invoice["invoice_amount"])
+		assert.NotContains(t, invoice, "lines")
+		assert.NotContains(t, invoice, "tax_breakdown")
+	})
+
+	t.Run("E2E - 支払金額と明細を両方指定した場合は400", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"payment_amount":   "100000",
+			"lines":            lines,
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestE2E_WithholdingTax(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// 源泉徴収の対象の個人の取引先
+	corporateClient, err := gateway.NewClientRepository().FindByID(db, clientID)
+	assert.NoError(t, err)
+	designer := &models.Client{
+		CompanyID:           corporateClient.CompanyID,
+		CorporateName:       "Freelance Designer",
+		RepresentativeName:  "Freelance Designer",
+		PhoneNumber:         "444-4444-4444",
+		PostalCode:          "444-4444",
+		Address:             "Designer Address",
+		ClientType:          value.ClientTypeIndividual,
+		Withholding:         true,
+		WithholdingCategory: value.WithholdingCategoryDesign,
+	}
+	assert.NoError(t, gateway.NewClientRepository().Create(db, designer))
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	createInvoice := func(t *testing.T, clientID, paymentAmount string) map[string]interface{} {
+		resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"payment_amount":   paymentAmount,
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+
+		return invoice
+	}
+
+	t.Run("E2E - 個人の取引先の種別と源泉徴収の区分を返す", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/clients/"+designer.ID, nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var client map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&client))
+		assert.Equal(t, "individual", client["client_type"])
+		assert.Equal(t, true, client["withholding"])
+		assert.Equal(t, "design", client["withholding_category"])
+	})
+
+	t.Run("E2E - 100万円を超える支払は超える部分に20.42%を適用して源泉徴収する", func(t *testing.T) {
+		invoice := createInvoice(t, designer.ID, "1500000")
+
+		// 1,000,000 × 10.21% + 500,000 × 20.42%
+		assert.Equal(t, "204200", invoice["withholding_tax"])
+		assert.Equal(t, "1295800", invoice["transfer_amount"])
+		// 手数料と請求金額は源泉徴収の有無で変わらない
+		assert.Equal(t, "1566000", invoice["invoice_amount"])
+
+		// 取得しても作成時に計算した税額を返す
+		invoiceID, _ := invoice["id"].(string)
+		resp := request(t, http.MethodGet, "/api/invoices/"+invoiceID, nil)
+		defer func() { _ = resp.Body.Close() }()
+		var got map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+		assert.Equal(t, "204200", got["withholding_tax"])
+		assert.Equal(t, "1295800", got["transfer_amount"])
+	})
+
+	t.Run("E2E - 法人の取引先は源泉徴収しない", func(t *testing.T) {
+		invoice := createInvoice(t, clientID, "100000")
+
+		assert.Equal(t, "0", invoice["withholding_tax"])
+		assert.Equal(t, "100000", invoice["transfer_amount"])
+	})
+
+	t.Run("E2E - 存在しない取引先の請求書は400", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+			"client_id":        "01HQZXFG0PJ9K8QXW7YM1N2ZZZ",
+			"issue_date":       "2025-12-01",
+			"payment_amount":   "100000",
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestE2E_MultiCurrency(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// 為替レートの読み込み（発行日の翌日のレートは使わない）
+	exchangeRateRepository := gateway.NewExchangeRateRepository()
+	assert.NoError(t, exchangeRateRepository.SaveAll(db, []*models.ExchangeRate{
+		{Currency: value.CurrencyUSD, RateDate: time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC), Rate: decimal.RequireFromString("150.25")},
+		{Currency: value.CurrencyUSD, RateDate: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC), Rate: decimal.RequireFromString("155")},
+	}))
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	var invoiceID string
+
+	t.Run("E2E - 米ドル建ての請求書は発行日以前の最新のレートで円換算して手数料を計算する", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/invoices", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"currency":         "USD",
+			"payment_amount":   "1200.50",
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+		invoiceID, _ = invoice["id"].(string)
+		assert.Equal(t, "USD", invoice["currency"])
+		assert.Equal(t, "1200.5", invoice["payment_amount"])
+		assert.Equal(t, "150.25", invoice["exchange_rate"])
+		// 1200.50 × 150.25 = 180375.125 は1円未満を切り捨てる
+		assert.Equal(t, "180375", invoice["payment_amount_jpy"])
+		assert.Equal(t, "7215", invoice["fee"])
+		assert.Equal(t, "721", invoice["tax"])
+		assert.Equal(t, "188311", invoice["invoice_amount"])
+	})
+
+	t.Run("E2E - 作成後にレートを読み込み直しても作成時の円換算額を返す", func(t *testing.T) {
+		assert.NoError(t, exchangeRateRepository.SaveAll(db, []*models.ExchangeRate{
+			{Currency: value.CurrencyUSD, RateDate: time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC), Rate: decimal.RequireFromString("160")},
+		}))
+
+		resp := request(t, http.MethodGet, "/api/invoices/"+invoiceID, nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+		assert.Equal(t, "150.25", invoice["exchange_rate"])
+		assert.Equal(t, "180375", invoice["payment_amount_jpy"])
+	})
+
+	t.Run("E2E - 支払金額の絞り込みは円換算額で比較する", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/invoices?min_amount=180000&max_amount=181000", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var list map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+		items, _ := list["items"].([]interface{})
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("E2E - 為替レートのない通貨・セント未満の金額は400", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"currency": "EUR", "payment_amount": "1000"},
+			{"currency": "USD", "payment_amount": "10.005"},
+		} {
+			body["client_id"] = clientID
+			body["issue_date"] = "2025-12-01"
+			body["payment_due_date"] = "2025-12-26"
+			resp := request(t, http.MethodPost, "/api/invoices", body)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, body)
+			_ = resp.Body.Close()
+		}
+	})
+}
+
+func TestE2E_RecurringInvoice(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	cfg := newTestConfig(t)
+	server := setupRouter(t, db, cfg)
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, ifMatch string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	// サーバーと同じ組み立てでワーカーを1回だけ動かす（停止済みのコンテキストでは1回処理して終了する）
+	runWorker := func(t *testing.T) {
+		appMetrics, err := metrics.New(db)
+		assert.NoError(t, err)
+		userRepository := gateway.NewUserRepository()
+		invoiceUsecase := usecase.NewInvoiceUsecase(gateway.NewInvoiceRepository(), gateway.NewInvoiceLineRepository(), gateway.NewInvoiceNumberSequenceRepository(), userRepository, gateway.NewCompanyRepository(), gateway.NewClientRepository(), gateway.NewExchangeRateRepository(), gateway.NewApprovalRuleRepository(), gateway.NewInvoiceApprovalRepository(), calendar.New(nil), appMetrics, cfg)
+		recurringInvoiceUsecase := usecase.NewRecurringInvoiceUsecase(gateway.NewRecurringInvoiceRepository(), gateway.NewClientRepository(), userRepository, invoiceUsecase)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.NoError(t, worker.NewRecurringInvoiceWorker(db, recurringInvoiceUsecase, time.Hour).Run(ctx))
+	}
+	countInvoices := func(t *testing.T) int64 {
+		resp := request(t, http.MethodGet, "/api/invoices?client_id="+clientID, "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var list invoiceListResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+
+		return list.TotalCount
+	}
+
+	var recurringInvoiceID, etag string
+
+	t.Run("E2E - 定期請求を作成すると今日以降の最初の発行日を返す", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/recurring-invoices", "", map[string]interface{}{
+			"client_id":        clientID,
+			"payment_amount":   "50000",
+			"cadence":          "monthly",
+			"day_of_month":     1,
+			"payment_due_days": 30,
+			"start_date":       "2025-01-01",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, `"1"`, resp.Header.Get("ETag"))
+
+		var created map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		recurringInvoiceID, _ = created["id"].(string)
+		etag = resp.Header.Get("ETag")
+
+		nextIssueDate, err := time.Parse(time.RFC3339, created["next_issue_date"].(string))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, nextIssueDate.Day())
+		assert.False(t, nextIssueDate.Before(time.Now().UTC().Truncate(24*time.Hour)))
+	})
+
+	t.Run("E2E - 一覧と詳細を取得できる", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/recurring-invoices", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var list map[string][]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+		assert.Len(t, list["items"], 1)
+
+		detail := request(t, http.MethodGet, "/api/recurring-invoices/"+recurringInvoiceID, "", nil)
+		defer func() { _ = detail.Body.Close() }()
+		assert.Equal(t, http.StatusOK, detail.StatusCode)
+		assert.Equal(t, etag, detail.Header.Get("ETag"))
+	})
+
+	t.Run("E2E - 発行日を過ぎた期間の請求書は再起動しても1回だけ作成する", func(t *testing.T) {
+		// 2か月前の1日から止まっていた状態にする
+		now := time.Now()
+		pastIssueDate := time.Date(now.Year(), now.Month()-2, 1, 0, 0, 0, 0, time.UTC)
+		err := db.Model(&entities.RecurringInvoice{}).Where("id = ?", recurringInvoiceID).
+			Update("next_issue_date", pastIssueDate).Error
+		assert.NoError(t, err)
+
+		runWorker(t)
+		assert.Equal(t, int64(3), countInvoices(t))
+
+		// 再起動後にもう一度動かしても同じ期間の請求書は作成しない
+		runWorker(t)
+		assert.Equal(t, int64(3), countInvoices(t))
+
+		var runs int64
+		assert.NoError(t, db.Model(&entities.RecurringInvoiceRun{}).Where("recurring_invoice_id = ?", recurringInvoiceID).Count(&runs).Error)
+		assert.Equal(t, int64(3), runs)
+	})
+
+	t.Run("E2E - 古いETagで更新すると412、最新のETagなら更新できる", func(t *testing.T) {
+		body := map[string]interface{}{
+			"client_id":        clientID,
+			"payment_amount":   "60000",
+			"cadence":          "end_of_month",
+			"payment_due_days": 10,
+			"start_date":       "2025-01-01",
+			"end_date":         "2099-12-31",
+		}
+
+		stale := request(t, http.MethodPut, "/api/recurring-invoices/"+recurringInvoiceID, etag, body)
+		_ = stale.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, stale.StatusCode)
+
+		current := request(t, http.MethodGet, "/api/recurring-invoices/"+recurringInvoiceID, "", nil)
+		_ = current.Body.Close()
+		etag = current.Header.Get("ETag")
+
+		resp := request(t, http.MethodPut, "/api/recurring-invoices/"+recurringInvoiceID, etag, body)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var updated map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&updated))
+		assert.Equal(t, "end_of_month", updated["cadence"])
+		assert.Equal(t, "60000", updated["payment_amount"])
+		etag = resp.Header.Get("ETag")
+	})
+
+	t.Run("E2E - 削除すると取得できない", func(t *testing.T) {
+		resp := request(t, http.MethodDelete, "/api/recurring-invoices/"+recurringInvoiceID, etag, nil)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		detail := request(t, http.MethodGet, "/api/recurring-invoices/"+recurringInvoiceID, "", nil)
+		_ = detail.Body.Close()
+		assert.Equal(t, http.StatusNotFound, detail.StatusCode)
+	})
+}
+
+func TestE2E_Payments(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, ifMatch string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	// 請求金額 104,400 円の請求書
+	resp := request(t, http.MethodPost, "/api/invoices", "", map[string]interface{}{
+		"client_id":        clientID,
+		"issue_date":       "2025-12-01",
+		"payment_amount":   "100000",
+		"payment_due_date": "2025-12-26",
+	})
+	var invoice map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+	_ = resp.Body.Close()
+	invoiceID, _ := invoice["id"].(string)
+	etag := resp.Header.Get("ETag")
+	assert.Equal(t, "0", invoice["paid_amount"])
+	assert.Equal(t, "104400", invoice["outstanding_amount"])
+
+	recordPayment := func(t *testing.T, ifMatch, amount string) *http.Response {
+		return request(t, http.MethodPost, "/api/invoices/"+invoiceID+"/payments", ifMatch, map[string]interface{}{
+			"amount":    amount,
+			"paid_date": "2025-12-20",
+			"method":    "bank_transfer",
+			"reference": "TRX-" + amount,
+		})
+	}
+
+	t.Run("E2E - 一部の支払を記録すると残高が減り一部支払済になる", func(t *testing.T) {
+		resp := recordPayment(t, etag, "60000")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, `"2"`, resp.Header.Get("ETag"))
+
+		var result map[string]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, "60000", result["payment"]["amount"])
+		assert.Equal(t, "一部支払済", result["invoice"]["status"])
+		assert.Equal(t, "44400", result["invoice"]["outstanding_amount"])
+		etag = resp.Header.Get("ETag")
+	})
+
+	t.Run("E2E - 古いETagでは記録できない", func(t *testing.T) {
+		resp := recordPayment(t, `"1"`, "1000")
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("E2E - 残高を超える支払は記録できない", func(t *testing.T) {
+		resp := recordPayment(t, etag, "44401")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var problem map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		assert.Equal(t, "VALIDATION_FAILED", problem["code"])
+	})
+
+	t.Run("E2E - 残りを支払うと支払済になり、支払の一覧を取得できる", func(t *testing.T) {
+		resp := recordPayment(t, etag, "44400")
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		detail := request(t, http.MethodGet, "/api/invoices/"+invoiceID, "", nil)
+		defer func() { _ = detail.Body.Close() }()
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(detail.Body).Decode(&invoice))
+		assert.Equal(t, "支払済", invoice["status"])
+		assert.Equal(t, "104400", invoice["paid_amount"])
+		assert.Equal(t, "0", invoice["outstanding_amount"])
+
+		list := request(t, http.MethodGet, "/api/invoices/"+invoiceID+"/payments", "", nil)
+		defer func() { _ = list.Body.Close() }()
+		assert.Equal(t, http.StatusOK, list.StatusCode)
+		var payments map[string][]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(list.Body).Decode(&payments))
+		assert.Len(t, payments["items"], 2)
+	})
+}
+
+func TestE2E_CreditNotes(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, ifMatch string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	// 請求金額 104,400 円の請求書
+	resp := request(t, http.MethodPost, "/api/invoices", "", map[string]interface{}{
+		"client_id":        clientID,
+		"issue_date":       "2025-12-01",
+		"payment_amount":   "100000",
+		"payment_due_date": "2025-12-26",
+	})
+	var invoice map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+	_ = resp.Body.Close()
+	invoiceID, _ := invoice["id"].(string)
+	etag := resp.Header.Get("ETag")
+	assert.Equal(t, "0", invoice["credited_amount"])
+	assert.Equal(t, "104400", invoice["net_amount"])
+
+	issueCreditNote := func(t *testing.T, ifMatch, reason, paymentAmount string) *http.Response {
+		return request(t, http.MethodPost, "/api/invoices/"+invoiceID+"/credit-notes", ifMatch, map[string]interface{}{
+			"issue_date":     "2025-12-15",
+			"reason":         reason,
+			"payment_amount": paymentAmount,
+		})
+	}
+
+	t.Run("E2E - 返品で手数料・消費税も減額され、元の請求書の金額は変わらない", func(t *testing.T) {
+		resp := issueCreditNote(t, etag, "return", "10000")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, `"2"`, resp.Header.Get("ETag"))
+
+		var result map[string]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, invoiceID, result["credit_note"]["invoice_id"])
+		assert.Equal(t, "400", result["credit_note"]["fee"])
+		assert.Equal(t, "40", result["credit_note"]["tax"])
+		assert.Equal(t, "10440", result["credit_note"]["credit_amount"])
+		assert.Equal(t, "104400", result["invoice"]["invoice_amount"])
+		assert.Equal(t, "10440", result["invoice"]["credited_amount"])
+		assert.Equal(t, "93960", result["invoice"]["net_amount"])
+		assert.Equal(t, "93960", result["invoice"]["outstanding_amount"])
+		assert.Equal(t, "未処理", result["invoice"]["status"])
+		etag = resp.Header.Get("ETag")
+	})
+
+	t.Run("E2E - 減額後の残高まで支払える", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/invoices/"+invoiceID+"/payments", etag, map[string]interface{}{
+			"amount":    "60000",
+			"paid_date": "2025-12-20",
+			"method":    "bank_transfer",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var result map[string]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, "33960", result["invoice"]["outstanding_amount"])
+		etag = resp.Header.Get("ETag")
+	})
+
+	t.Run("E2E - 手数料・消費税を含めて残高を超える減額は発行できない", func(t *testing.T) {
+		resp := issueCreditNote(t, etag, "discount", "32530")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var problem map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		assert.Equal(t, "VALIDATION_FAILED", problem["code"])
+	})
+
+	t.Run("E2E - 残高をすべて値引きすると支払済になり、一覧で減額と元の請求書を確認できる", func(t *testing.T) {
+		resp := issueCreditNote(t, etag, "discount", "32529")
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		search := request(t, http.MethodGet, "/api/invoices", "", nil)
+		defer func() { _ = search.Body.Close() }()
+		var invoices map[string]interface{}
+		assert.NoError(t, json.NewDecoder(search.Body).Decode(&invoices))
+		items, _ := invoices["items"].([]interface{})
+		assert.Len(t, items, 1)
+		item, _ := items[0].(map[string]interface{})
+		assert.Equal(t, "支払済", item["status"])
+		assert.Equal(t, "44400", item["credited_amount"])
+		assert.Equal(t, "60000", item["net_amount"])
+		assert.Equal(t, "0", item["outstanding_amount"])
+
+		list := request(t, http.MethodGet, "/api/invoices/"+invoiceID+"/credit-notes", "", nil)
+		defer func() { _ = list.Body.Close() }()
+		assert.Equal(t, http.StatusOK, list.StatusCode)
+		var creditNotes map[string][]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(list.Body).Decode(&creditNotes))
+		assert.Len(t, creditNotes["items"], 2)
+		for _, creditNote := range creditNotes["items"] {
+			assert.Equal(t, invoiceID, creditNote["invoice_id"])
+		}
+		assert.Equal(t, "return", creditNotes["items"][0]["reason"])
+		assert.Equal(t, "discount", creditNotes["items"][1]["reason"])
+	})
+}
+
+// createTestUser は email のユーザーと同じ会社に、指定したロールのユーザーを作成します
+func createTestUser(t *testing.T, db *gorm.DB, email, newEmail string, role value.UserRole) string {
+	userRepo := gateway.NewUserRepository()
+	base, err := userRepo.FindByEmail(db, email)
+	assert.NoError(t, err)
+
+	user := &models.User{
+		CompanyID: base.CompanyID,
+		Name:      string(role),
+		Email:     newEmail,
+		Password:  base.Password,
+		Role:      role,
+	}
+	assert.NoError(t, userRepo.Create(db, user))
+
+	return user.Email
+}
+
+func TestE2E_ApprovalWorkflow(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+	adminEmail := createTestUser(t, db, email, "admin@example.com", value.UserRoleAdmin)
+	approverEmail := createTestUser(t, db, email, "approver@example.com", value.UserRoleApprover)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	memberToken := login(t, server.URL, email)
+	adminToken := login(t, server.URL, adminEmail)
+	approverToken := login(t, server.URL, approverEmail)
+
+	request := func(t *testing.T, token, method, path, ifMatch string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	problemCode := func(t *testing.T, resp *http.Response) interface{} {
+		var problem map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+
+		return problem["code"]
+	}
+	createInvoice := func(t *testing.T, paymentAmount string) (string, string, string) {
+		resp := request(t, memberToken, http.MethodPost, "/api/invoices", "", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"payment_amount":   paymentAmount,
+			"payment_due_date": "2025-12-26",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+		invoiceID, _ := invoice["id"].(string)
+		status, _ := invoice["status"].(string)
+
+		return invoiceID, status, resp.Header.Get("ETag")
+	}
+
+	t.Run("E2E - 管理者以外は承認フローを変更できない", func(t *testing.T) {
+		resp := request(t, approverToken, http.MethodPut, "/api/admin/approval-workflow", `"1"`, map[string]interface{}{
+			"steps": []map[string]interface{}{{"min_amount": "0", "role": "approver"}},
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("E2E - 管理者が金額に応じた承認フローを設定する", func(t *testing.T) {
+		current := request(t, adminToken, http.MethodGet, "/api/admin/approval-workflow", "", nil)
+		_ = current.Body.Close()
+		assert.Equal(t, http.StatusOK, current.StatusCode)
+		workflowETag := current.Header.Get("ETag")
+		steps := []map[string]interface{}{
+			{"min_amount": "100000", "role": "approver"},
+			{"min_amount": "1000000", "role": "admin"},
+		}
+
+		missing := request(t, adminToken, http.MethodPut, "/api/admin/approval-workflow", "", map[string]interface{}{"steps": steps})
+		_ = missing.Body.Close()
+		assert.Equal(t, http.StatusPreconditionRequired, missing.StatusCode)
+
+		resp := request(t, adminToken, http.MethodPut, "/api/admin/approval-workflow", workflowETag, map[string]interface{}{"steps": steps})
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, workflowETag, resp.Header.Get("ETag"))
+
+		// 取得時の ETag のままでは、他の管理者の変更を上書きできない
+		stale := request(t, adminToken, http.MethodPut, "/api/admin/approval-workflow", workflowETag, map[string]interface{}{"steps": []map[string]interface{}{}})
+		defer func() { _ = stale.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionFailed, stale.StatusCode)
+		assert.Equal(t, "PRECONDITION_FAILED", problemCode(t, stale))
+
+		get := request(t, adminToken, http.MethodGet, "/api/admin/approval-workflow", "", nil)
+		defer func() { _ = get.Body.Close() }()
+		assert.Equal(t, resp.Header.Get("ETag"), get.Header.Get("ETag"))
+		var workflow struct {
+			Steps   []map[string]interface{} `json:"steps"`
+			Version int                      `json:"version"`
+		}
+		assert.NoError(t, json.NewDecoder(get.Body).Decode(&workflow))
+		assert.Len(t, workflow.Steps, 2)
+		assert.Equal(t, float64(2), workflow.Steps[1]["step"])
+		assert.Equal(t, "admin", workflow.Steps[1]["role"])
+		assert.Equal(t, 2, workflow.Version)
+	})
+
+	t.Run("E2E - 下限未満の請求書は承認なしで未処理になる", func(t *testing.T) {
+		_, status, _ := createInvoice(t, "50000")
+		assert.Equal(t, "未処理", status)
+	})
+
+	invoiceID, status, etag := createInvoice(t, "100000")
+	assert.Equal(t, "承認待ち", status)
+	path := "/api/invoices/" + invoiceID
+
+	t.Run("E2E - 承認待ちの請求書には支払を記録できない", func(t *testing.T) {
+		resp := request(t, memberToken, http.MethodPost, path+"/payments", etag, map[string]interface{}{
+			"amount":    "1000",
+			"paid_date": "2025-12-10",
+			"method":    "bank_transfer",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "INVOICE_NOT_PAYABLE", problemCode(t, resp))
+	})
+
+	t.Run("E2E - 作成者は自分の請求書を承認できない", func(t *testing.T) {
+		resp := request(t, memberToken, http.MethodPost, path+"/approve", etag, map[string]interface{}{})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "SELF_APPROVAL_FORBIDDEN", problemCode(t, resp))
+	})
+
+	t.Run("E2E - 承認者が承認すると未処理になり支払を記録できる", func(t *testing.T) {
+		resp := request(t, approverToken, http.MethodPost, path+"/approve", etag, map[string]interface{}{
+			"comment": "金額を確認しました",
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+		etag := resp.Header.Get("ETag")
+
+		var decision map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&decision))
+		invoice, _ := decision["invoice"].(map[string]interface{})
+		assert.Equal(t, "未処理", invoice["status"])
+
+		// 再度の承認は承認待ちでないため競合する
+		again := request(t, adminToken, http.MethodPost, path+"/approve", etag, map[string]interface{}{})
+		assert.Equal(t, http.StatusConflict, again.StatusCode)
+		assert.Equal(t, "NOT_PENDING_APPROVAL", problemCode(t, again))
+		_ = again.Body.Close()
+
+		payment := request(t, memberToken, http.MethodPost, path+"/payments", etag, map[string]interface{}{
+			"amount":    "1000",
+			"paid_date": "2025-12-10",
+			"method":    "bank_transfer",
+		})
+		_ = payment.Body.Close()
+		assert.Equal(t, http.StatusCreated, payment.StatusCode)
+
+		list := request(t, memberToken, http.MethodGet, path+"/approvals", "", nil)
+		defer func() { _ = list.Body.Close() }()
+		assert.Equal(t, http.StatusOK, list.StatusCode)
+		var approvals map[string][]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(list.Body).Decode(&approvals))
+		assert.Len(t, approvals["items"], 1)
+		assert.Equal(t, "approved", approvals["items"][0]["status"])
+		assert.Equal(t, "金額を確認しました", approvals["items"][0]["comment"])
+	})
+
+	t.Run("E2E - 却下には理由が必要で、却下した請求書には支払を記録できない", func(t *testing.T) {
+		rejectedID, _, etag := createInvoice(t, "2000000")
+		rejectedPath := "/api/invoices/" + rejectedID
+
+		resp := request(t, approverToken, http.MethodPost, rejectedPath+"/reject", etag, map[string]interface{}{})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		_ = resp.Body.Close()
+
+		// 1段階目は承認者、2段階目は管理者が担当する
+		resp = request(t, adminToken, http.MethodPost, rejectedPath+"/reject", etag, map[string]interface{}{"comment": "差し戻します"})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		_ = resp.Body.Close()
+
+		resp = request(t, approverToken, http.MethodPost, rejectedPath+"/approve", etag, map[string]interface{}{})
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		etag = resp.Header.Get("ETag")
+		_ = resp.Body.Close()
+
+		resp = request(t, adminToken, http.MethodPost, rejectedPath+"/reject", etag, map[string]interface{}{"comment": "取引条件を確認してください"})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var decision map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&decision))
+		invoice, _ := decision["invoice"].(map[string]interface{})
+		assert.Equal(t, "却下", invoice["status"])
+
+		payment := request(t, memberToken, http.MethodPost, rejectedPath+"/payments", resp.Header.Get("ETag"), map[string]interface{}{
+			"amount":    "1000",
+			"paid_date": "2025-12-10",
+			"method":    "bank_transfer",
+		})
+		defer func() { _ = payment.Body.Close() }()
+		assert.Equal(t, http.StatusConflict, payment.StatusCode)
+	})
+}
+
+func TestE2E_Reports(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	get := func(t *testing.T, path string) (int, map[string]interface{}) {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+		return resp.StatusCode, body
+	}
+
+	// 12月と1月が支払期日の請求書を作成する（支払期日だけが違うため、重複の疑いがあっても作成する）
+	for _, dueDate := range []string{"2025-12-26", "2025-12-31", "2026-01-15"} {
+		data, _ := json.Marshal(map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"payment_amount":   10000,
+			"payment_due_date": dueDate,
+			"force":            true,
+		})
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/invoices", bytes.NewBuffer(data))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		_ = resp.Body.Close()
+	}
+
+	t.Run("E2E - 支払期日の月ごとに未払い残高を集計する", func(t *testing.T) {
+		status, body := get(t, "/api/reports/amount-due?from=2025-12-01&to=2026-01-31&period=month")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "2025-12-01T00:00:00+09:00", body["from"])
+		assert.Equal(t, "2026-01-31T00:00:00+09:00", body["to"])
+
+		items, _ := body["items"].([]interface{})
+		assert.Len(t, items, 2)
+		december, _ := items[0].(map[string]interface{})
+		assert.Equal(t, "2025-12-01T00:00:00+09:00", december["period_start"])
+		assert.Equal(t, float64(2), december["invoice_count"])
+		assert.Equal(t, "20880", december["outstanding_amount"])
+		january, _ := items[1].(map[string]interface{})
+		assert.Equal(t, float64(1), january["invoice_count"])
+	})
+
+	t.Run("E2E - ステータスごとの件数と取引先ごとの金額を集計する", func(t *testing.T) {
+		status, body := get(t, "/api/reports/status-counts?from=2025-12-01&to=2025-12-31")
+		assert.Equal(t, http.StatusOK, status)
+		items, _ := body["items"].([]interface{})
+		assert.Len(t, items, 1)
+		unprocessed, _ := items[0].(map[string]interface{})
+		assert.Equal(t, float64(3), unprocessed["invoice_count"])
+		assert.Equal(t, "31320", unprocessed["invoice_amount"])
+
+		status, body = get(t, "/api/reports/top-clients?from=2025-12-01&to=2025-12-31&limit=5")
+		assert.Equal(t, http.StatusOK, status)
+		items, _ = body["items"].([]interface{})
+		assert.Len(t, items, 1)
+		client, _ := items[0].(map[string]interface{})
+		assert.Equal(t, clientID, client["client_id"])
+		assert.Equal(t, "31320", client["net_amount"])
+	})
+
+	t.Run("E2E - 発行した月ごとに手数料を集計する", func(t *testing.T) {
+		status, body := get(t, "/api/reports/monthly-fees?from=2025-11-01&to=2025-12-31")
+		assert.Equal(t, http.StatusOK, status)
+		items, _ := body["items"].([]interface{})
+		assert.Len(t, items, 2)
+		november, _ := items[0].(map[string]interface{})
+		assert.Equal(t, "0", november["fee"])
+		december, _ := items[1].(map[string]interface{})
+		assert.Equal(t, float64(3), december["invoice_count"])
+		assert.Equal(t, "1200", december["fee"])
+		assert.Equal(t, "120", december["tax"])
+	})
+
+	t.Run("E2E - 期間や単位が不正な場合は400", func(t *testing.T) {
+		for _, path := range []string{
+			"/api/reports/amount-due?period=day",
+			"/api/reports/status-counts?from=2025-12-31&to=2025-12-01",
+			"/api/reports/monthly-fees?from=2023-01-01&to=2025-12-31",
+			"/api/reports/top-clients?limit=101",
+		} {
+			status, _ := get(t, path)
+			assert.Equal(t, http.StatusBadRequest, status, path)
+		}
+	})
+}
+
+func TestE2E_JournalExport(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, ifMatch string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	// 12月に発行し、同じ月に全額を支払った請求書
+	resp := request(t, http.MethodPost, "/api/invoices", "", map[string]interface{}{
+		"client_id":        clientID,
+		"issue_date":       "2025-12-01",
+		"payment_amount":   "10000",
+		"payment_due_date": "2025-12-26",
+	})
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	var invoice map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+	_ = resp.Body.Close()
+	invoiceID, _ := invoice["id"].(string)
+
+	resp = request(t, http.MethodPost, "/api/invoices/"+invoiceID+"/payments", resp.Header.Get("ETag"), map[string]interface{}{
+		"amount":    "10440",
+		"paid_date": "2025-12-20",
+		"method":    "bank_transfer",
+	})
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	t.Run("E2E - 管理者以外は勘定科目を変更できない", func(t *testing.T) {
+		resp := request(t, http.MethodPut, "/api/admin/journal-accounts", `"1"`, map[string]interface{}{"expense": "外注費"})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("E2E - 管理者は勘定科目を変更できる", func(t *testing.T) {
+		err := db.Model(&entities.User{}).Where("email = ?", email).Update("role", "admin").Error
+		assert.NoError(t, err)
+
+		current := request(t, http.MethodGet, "/api/admin/journal-accounts", "", nil)
+		_ = current.Body.Close()
+		assert.Equal(t, http.StatusOK, current.StatusCode)
+		accountsETag := current.Header.Get("ETag")
+
+		missing := request(t, http.MethodPut, "/api/admin/journal-accounts", "", map[string]interface{}{"expense": "外注費"})
+		_ = missing.Body.Close()
+		assert.Equal(t, http.StatusPreconditionRequired, missing.StatusCode)
+
+		resp := request(t, http.MethodPut, "/api/admin/journal-accounts", accountsETag, map[string]interface{}{"expense": "外注費"})
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// 取得時の ETag のままでは、他の管理者の変更を上書きできない
+		stale := request(t, http.MethodPut, "/api/admin/journal-accounts", accountsETag, map[string]interface{}{"bank": "当座預金"})
+		_ = stale.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, stale.StatusCode)
+
+		get := request(t, http.MethodGet, "/api/admin/journal-accounts", "", nil)
+		defer func() { _ = get.Body.Close() }()
+		assert.Equal(t, resp.Header.Get("ETag"), get.Header.Get("ETag"))
+		var accounts map[string]interface{}
+		assert.NoError(t, json.NewDecoder(get.Body).Decode(&accounts))
+		assert.Equal(t, map[string]interface{}{"expense": "外注費", "fee": "支払手数料", "payable": "未払金", "bank": "普通預金", "version": float64(2)}, accounts)
+	})
+
+	t.Run("E2E - 請求書と支払を freee の形式で書き出す", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/journals?format=freee&from=2025-12-01&to=2025-12-31", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=UTF-8", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="journal_freee_2025-12-01_2025-12-31.csv"`, resp.Header.Get("Content-Disposition"))
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"伝票番号,取引日,借方勘定科目,借方税区分,借方金額,借方税額,貸方勘定科目,貸方税区分,貸方金額,貸方税額,摘要",
+			"1,2025/12/01,外注費,課対仕入10%,10000,909,未払金,対象外,10440,0,Client Corporation 請求書",
+			"1,2025/12/01,支払手数料,課対仕入10%,440,40,,,,,Client Corporation 請求書",
+			"2,2025/12/20,未払金,対象外,10440,0,普通預金,対象外,10440,0,Client Corporation 支払",
+		}, "\n")+"\n", string(body))
+	})
+
+	t.Run("E2E - 弥生会計の形式は Shift_JIS で書き出す", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/journals?format=yayoi&from=2025-12-01&to=2025-12-31", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv; charset=Shift_JIS", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, bytes.Count(body, []byte("\r\n")))
+	})
+
+	t.Run("E2E - 形式が不正な場合は400", func(t *testing.T) {
+		for _, path := range []string{"/api/journals", "/api/journals?format=kaikei"} {
+			resp := request(t, http.MethodGet, path, "", nil)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, path)
+			_ = resp.Body.Close()
+		}
+	})
+}
+
+func TestE2E_BankStatementReconciliation(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成（取引先の口座名義は Test Account）
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, contentType string, body io.Reader) *http.Response {
+		req, _ := http.NewRequest(method, server.URL+path, body)
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	createInvoice := func(t *testing.T, paymentAmount, dueDate string) string {
+		data, _ := json.Marshal(map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       "2025-12-01",
+			"payment_amount":   paymentAmount,
+			"payment_due_date": dueDate,
+		})
+		resp := request(t, http.MethodPost, "/api/invoices", "application/json", bytes.NewReader(data))
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+		id, _ := invoice["id"].(string)
+
+		return id
+	}
+
+	// 請求金額 10440 円と 20880 円の請求書
+	exactID := createInvoice(t, "10000", "2025-12-26")
+	partialID := createInvoice(t, "20000", "2025-12-31")
+	statement := "date,amount,name,description,reference\n" +
+		"2025-12-26,-10440,TEST ACCOUNT,12月分,REF-1\n" +
+		"2025-12-30,-5000,TEST ACCOUNT,一部,REF-2\n" +
+		"2025-12-26,50000,ANOTHER COMPANY,入金,REF-3\n"
+
+	var pendingLineID string
+	t.Run("E2E - 明細を取り込み、一致度が満点の出金を自動で消し込む", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/bank-statements?format=csv", "text/csv", strings.NewReader(statement))
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var result struct {
+			LineCount      int `json:"line_count"`
+			ConfirmedCount int `json:"confirmed_count"`
+			PendingCount   int `json:"pending_count"`
+			IgnoredCount   int `json:"ignored_count"`
+			Lines          []struct {
+				ID        string  `json:"id"`
+				Status    string  `json:"status"`
+				InvoiceID *string `json:"invoice_id"`
+				Score     int     `json:"score"`
+			} `json:"lines"`
+		}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, 3, result.LineCount)
+		assert.Equal(t, 1, result.ConfirmedCount)
+		assert.Equal(t, 1, result.PendingCount)
+		assert.Equal(t, 1, result.IgnoredCount)
+		assert.Equal(t, "confirmed", result.Lines[0].Status)
+		assert.Equal(t, exactID, *result.Lines[0].InvoiceID)
+		assert.Equal(t, 100, result.Lines[0].Score)
+		pendingLineID = result.Lines[1].ID
+
+		// 自動で消し込んだ請求書は支払済になる
+		invoice := request(t, http.MethodGet, "/api/invoices/"+exactID, "", nil)
+		defer func() { _ = invoice.Body.Close() }()
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(invoice.Body).Decode(&body))
+		assert.Equal(t, "支払済", body["status"])
+	})
+
+	t.Run("E2E - 同じ明細は2回取り込まない", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/bank-statements?format=csv", "text/csv", strings.NewReader(statement))
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var result map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, float64(0), result["line_count"])
+		assert.Equal(t, float64(3), result["duplicate_count"])
+	})
+
+	t.Run("E2E - 確認待ちの明細を候補と返す", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/bank-statement-lines", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Items []struct {
+				ID         string `json:"id"`
+				Candidates []struct {
+					InvoiceID string `json:"invoice_id"`
+					Score     int    `json:"score"`
+				} `json:"candidates"`
+			} `json:"items"`
+		}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, pendingLineID, result.Items[0].ID)
+		// 支払済になった請求書は候補にしない
+		assert.Len(t, result.Items[0].Candidates, 1)
+		assert.Equal(t, partialID, result.Items[0].Candidates[0].InvoiceID)
+		assert.Equal(t, 10+20+30, result.Items[0].Candidates[0].Score)
+	})
+
+	t.Run("E2E - 確認待ちの明細を請求書の支払として消し込む", func(t *testing.T) {
+		body := strings.NewReader(`{"invoice_id":"` + partialID + `"}`)
+		resp := request(t, http.MethodPost, "/api/bank-statement-lines/"+pendingLineID+"/confirm", "application/json", body)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Line struct {
+				Status string `json:"status"`
+			} `json:"line"`
+			Invoice struct {
+				Status     string `json:"status"`
+				PaidAmount string `json:"paid_amount"`
+			} `json:"invoice"`
+		}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, "confirmed", result.Line.Status)
+		assert.Equal(t, "一部支払済", result.Invoice.Status)
+		assert.Equal(t, "5000", result.Invoice.PaidAmount)
+	})
+
+	t.Run("E2E - 確認待ちでない明細は409", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/bank-statement-lines/"+pendingLineID+"/ignore", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "STATEMENT_LINE_NOT_PENDING")
+	})
+
+	t.Run("E2E - 読み込めない明細は400", func(t *testing.T) {
+		resp := request(t, http.MethodPost, "/api/bank-statements?format=csv", "text/csv", strings.NewReader("date,amount\n2025-12-26,abc\n"))
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"field":"file"`)
+	})
+}
+
+func TestE2E_DuplicateInvoice(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ（発行日の前後3日以内の同額の請求書を重複の疑いとする）
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	create := func(t *testing.T, issueDate string, force bool) (int, map[string]interface{}) {
+		data, _ := json.Marshal(map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       issueDate,
+			"payment_amount":   "100000",
+			"payment_due_date": "2025-12-26",
+			"force":            force,
+		})
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/invoices", bytes.NewBuffer(data))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+		return resp.StatusCode, body
+	}
+
+	status, original := create(t, "2025-12-01", false)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "clear", original["duplicate_check"])
+	assert.Nil(t, original["suspected_duplicate_id"])
+
+	t.Run("E2E - 発行日が近い同額の請求書は重複の疑いとして409", func(t *testing.T) {
+		status, problem := create(t, "2025-12-03", false)
+
+		assert.Equal(t, http.StatusConflict, status)
+		assert.Equal(t, "DUPLICATE_INVOICE_SUSPECTED", problem["code"])
+		fieldErrors, _ := problem["errors"].([]interface{})
+		assert.Len(t, fieldErrors, 1)
+		assert.Contains(t, problem["detail"], original["id"])
+	})
+
+	t.Run("E2E - forceを指定すると作成して疑いのある請求書を記録する", func(t *testing.T) {
+		status, invoice := create(t, "2025-12-03", true)
+
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, "overridden", invoice["duplicate_check"])
+		assert.Equal(t, original["id"], invoice["suspected_duplicate_id"])
+	})
+
+	t.Run("E2E - 発行日が離れた請求書は重複を疑わない", func(t *testing.T) {
+		status, invoice := create(t, "2025-12-10", false)
+
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, "clear", invoice["duplicate_check"])
+	})
+}
+
+func TestE2E_InvoiceNumbering(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ
+	server := setupRouter(t, db, newTestConfig(t))
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, ifMatch string, body interface{}) *http.Response {
+		var reader io.Reader
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, reader)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	create := func(t *testing.T, issueDate string) map[string]interface{} {
+		// 同じ金額の請求書を続けて作成するため、重複の確認は省く
+		resp := request(t, http.MethodPost, "/api/invoices", "", map[string]interface{}{
+			"client_id":        clientID,
+			"issue_date":       issueDate,
+			"payment_amount":   "100000",
+			"payment_due_date": "2026-05-29",
+			"force":            true,
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+
+		return invoice
+	}
+
+	t.Run("E2E - 4月始まりの会計年度ごとに既定の書式で連番を振る", func(t *testing.T) {
+		assert.Equal(t, "INV-2025-000001", create(t, "2026-03-30")["invoice_number"])
+		assert.Equal(t, "INV-2025-000002", create(t, "2026-03-31")["invoice_number"])
+		assert.Equal(t, "INV-2026-000001", create(t, "2026-04-01")["invoice_number"])
+	})
+
+	t.Run("E2E - 請求書番号で請求書を取得できる", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/invoices/by-number/INV-2025-000002", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+		var invoice map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&invoice))
+		assert.Equal(t, "INV-2025-000002", invoice["invoice_number"])
+
+		missing := request(t, http.MethodGet, "/api/invoices/by-number/INV-2025-000099", "", nil)
+		defer func() { _ = missing.Body.Close() }()
+		assert.Equal(t, http.StatusNotFound, missing.StatusCode)
+	})
+
+	t.Run("E2E - 管理者以外は書式を変更できない", func(t *testing.T) {
+		resp := request(t, http.MethodPut, "/api/admin/invoice-numbering", `"1"`, map[string]interface{}{
+			"format":                  "B{YY}-{SEQ:4}",
+			"fiscal_year_start_month": 4,
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("E2E - 書式を変更しても同じ会計年度の連番は続きから振る", func(t *testing.T) {
+		err := db.Model(&entities.User{}).Where("email = ?", email).Update("role", "admin").Error
+		assert.NoError(t, err)
+
+		current := request(t, http.MethodGet, "/api/admin/invoice-numbering", "", nil)
+		_ = current.Body.Close()
+		assert.Equal(t, http.StatusOK, current.StatusCode)
+		numberingETag := current.Header.Get("ETag")
+		numbering := map[string]interface{}{
+			"format":                  "B{YY}-{SEQ:4}",
+			"fiscal_year_start_month": 4,
+		}
+
+		missing := request(t, http.MethodPut, "/api/admin/invoice-numbering", "", numbering)
+		_ = missing.Body.Close()
+		assert.Equal(t, http.StatusPreconditionRequired, missing.StatusCode)
+
+		resp := request(t, http.MethodPut, "/api/admin/invoice-numbering", numberingETag, numbering)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// 取得時の ETag のままでは、他の管理者の変更を上書きできない
+		stale := request(t, http.MethodPut, "/api/admin/invoice-numbering", numberingETag, map[string]interface{}{
+			"format":                  "INV-{YYYY}-{SEQ:6}",
+			"fiscal_year_start_month": 1,
+		})
+		_ = stale.Body.Close()
+		assert.Equal(t, http.StatusPreconditionFailed, stale.StatusCode)
+
+		assert.Equal(t, "B26-0002", create(t, "2026-04-02")["invoice_number"])
+	})
+
+	t.Run("E2E - 連番を含まない書式は400", func(t *testing.T) {
+		resp := request(t, http.MethodPut, "/api/admin/invoice-numbering", `"2"`, map[string]interface{}{
+			"format":                  "INV-{YYYY}",
+			"fiscal_year_start_month": 4,
+		})
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp = request(t, http.MethodGet, "/api/admin/invoice-numbering", "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, `"2"`, resp.Header.Get("ETag"))
+		var numbering map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&numbering))
+		assert.Equal(t, "B{YY}-{SEQ:4}", numbering["format"])
+		assert.Equal(t, float64(2), numbering["version"])
+	})
+}
+
+func TestE2E_InvoiceAttachments(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テストデータの作成
+	email, clientID := setupTestData(t, db)
+
+	// サーバーのセットアップ（添付ファイルは body_limit を超えても上限まで受け付ける）
+	cfg := newTestConfig(t)
+	cfg.AttachmentMaxSize = "2M"
+	server := setupRouter(t, db, cfg)
+	defer server.Close()
+
+	token := login(t, server.URL, email)
+
+	request := func(t *testing.T, method, path, contentType string, body io.Reader) *http.Response {
+		req, _ := http.NewRequest(method, server.URL+path, body)
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	deleteAttachment := func(t *testing.T, invoiceID, attachmentID, ifMatch string) *http.Response {
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/api/invoices/"+invoiceID+"/attachments/"+attachmentID, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+	upload := func(t *testing.T, invoiceID, ifMatch, fileName string, content []byte) *http.Response {
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		part, err := w.CreateFormFile("file", fileName)
+		assert.NoError(t, err)
+		_, _ = part.Write(content)
+		assert.NoError(t, w.Close())
+
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/api/invoices/"+invoiceID+"/attachments", &buf)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	invoiceJSON, _ := json.Marshal(map[string]interface{}{
+		"client_id":        clientID,
+		"issue_date":       "2026-05-01",
+		"payment_amount":   "100000",
+		"payment_due_date": "2026-05-29",
+	})
+	created := request(t, http.MethodPost, "/api/invoices", "application/json", bytes.NewReader(invoiceJSON))
+	defer func() { _ = created.Body.Close() }()
+	assert.Equal(t, http.StatusCreated, created.StatusCode)
+	var invoice map[string]interface{}
+	assert.NoError(t, json.NewDecoder(created.Body).Decode(&invoice))
+	invoiceID := invoice["id"].(string)
+	etag := created.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// 既定の body_limit（1M）を超える PDF
+	pdf := append([]byte("%PDF-1.7\n"), bytes.Repeat([]byte{'0'}, 1536*1024)...)
+	sum := sha256.Sum256(pdf)
+	var attachmentID string
+
+	t.Run("E2E - 添付でIf-Matchがない場合は428", func(t *testing.T) {
+		resp := upload(t, invoiceID, "", "請求書_2026-05.pdf", pdf)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionRequired, resp.StatusCode)
+	})
+
+	t.Run("E2E - 添付で請求書のETagが古い場合は412", func(t *testing.T) {
+		resp := upload(t, invoiceID, `"99"`, "請求書_2026-05.pdf", pdf)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("E2E - PDFを添付してSHA-256を返す", func(t *testing.T) {
+		resp := upload(t, invoiceID, etag, "請求書_2026-05.pdf", pdf)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		// 添付で請求書のバージョンが進み、添付前の ETag では変更できない
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+		stale := upload(t, invoiceID, etag, "請求書_2026-05.pdf", pdf)
+		defer func() { _ = stale.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionFailed, stale.StatusCode)
+		etag = resp.Header.Get("ETag")
+		var attachment map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&attachment))
+		assert.Equal(t, "請求書_2026-05.pdf", attachment["file_name"])
+		assert.Equal(t, "application/pdf", attachment["content_type"])
+		assert.Equal(t, float64(len(pdf)), attachment["size"])
+		assert.Equal(t, hex.EncodeToString(sum[:]), attachment["sha256"])
+		attachmentID = attachment["id"].(string)
+
+		list := request(t, http.MethodGet, "/api/invoices/"+invoiceID+"/attachments", "", nil)
+		defer func() { _ = list.Body.Close() }()
+		assert.Equal(t, http.StatusOK, list.StatusCode)
+		var attachments struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		assert.NoError(t, json.NewDecoder(list.Body).Decode(&attachments))
+		assert.Len(t, attachments.Items, 1)
+	})
+
+	t.Run("E2E - 添付した内容をダウンロードできる", func(t *testing.T) {
+		resp := request(t, http.MethodGet, "/api/invoices/"+invoiceID+"/attachments/"+attachmentID, "", nil)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"))
+		assert.Contains(t, resp.Header.Get("Content-Disposition"), "attachment;")
+		assert.Equal(t, "sha-256=:"+base64.StdEncoding.EncodeToString(sum[:])+":", resp.Header.Get("Repr-Digest"))
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, pdf, body)
+	})
+
+	t.Run("E2E - 添付できない形式は415", func(t *testing.T) {
+		resp := upload(t, invoiceID, etag, "invoice.pdf", []byte("<html><body>invoice</body></html>"))
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+
+	t.Run("E2E - 上限を超えるファイルは413", func(t *testing.T) {
+		resp := upload(t, invoiceID, etag, "large.pdf", append([]byte("%PDF-1.7\n"), bytes.Repeat([]byte{'0'}, 2*1024*1024)...))
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	})
+
+	t.Run("E2E - 削除でIf-Matchがない場合は428", func(t *testing.T) {
+		resp := deleteAttachment(t, invoiceID, attachmentID, "")
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionRequired, resp.StatusCode)
+	})
+
+	t.Run("E2E - 削除で請求書のETagが古い場合は412", func(t *testing.T) {
+		resp := deleteAttachment(t, invoiceID, attachmentID, `"99"`)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("E2E - 削除した添付ファイルは保存先からも削除される", func(t *testing.T) {
+		detail := request(t, http.MethodGet, "/api/invoices/"+invoiceID, "", nil)
+		defer func() { _ = detail.Body.Close() }()
+		// 添付で進んだバージョンが請求書の ETag に反映されている
+		assert.Equal(t, etag, detail.Header.Get("ETag"))
+
+		resp := deleteAttachment(t, invoiceID, attachmentID, etag)
+		defer func() { _ = resp.Body.Close() }()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		// 添付ファイルの削除で請求書のバージョンが進む
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+		assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+
+		_, err := os.Stat(filepath.Join(cfg.AttachmentDir, models.InvoiceAttachmentStorageKey(invoiceID, attachmentID)))
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+
+		missing := request(t, http.MethodGet, "/api/invoices/"+invoiceID+"/attachments/"+attachmentID, "", nil)
+		defer func() { _ = missing.Body.Close() }()
+		assert.Equal(t, http.StatusNotFound, missing.StatusCode)
 	})
 }
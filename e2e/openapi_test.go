@@ -0,0 +1,260 @@
+package e2e
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ijufumi/practice-202512/app/presentation/openapi"
+	"github.com/labstack/echo/v4"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+)
+
+const openAPIResourceURL = "openapi.json"
+
+// openAPIContract は実際のレスポンスを OpenAPI 仕様書のスキーマで検証します
+type openAPIContract struct {
+	mu       sync.Mutex
+	doc      map[string]interface{}
+	compiler *jsonschema.Compiler
+	schemas  map[string]*jsonschema.Schema
+}
+
+func newOpenAPIContract(t *testing.T) *openAPIContract {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(openapi.Spec()))
+	if err != nil {
+		t.Fatalf("OpenAPI仕様書の読み込みに失敗しました: %v", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	if err := compiler.AddResource(openAPIResourceURL, doc); err != nil {
+		t.Fatalf("OpenAPI仕様書の登録に失敗しました: %v", err)
+	}
+
+	return &openAPIContract{
+		doc:      doc.(map[string]interface{}),
+		compiler: compiler,
+		schemas:  map[string]*jsonschema.Schema{},
+	}
+}
+
+// paths は仕様書の paths オブジェクトを返します
+func (c *openAPIContract) paths() map[string]interface{} {
+	paths, _ := c.doc["paths"].(map[string]interface{})
+	return paths
+}
+
+// hasOperation は仕様書に指定のパス・メソッドの操作が定義されているかを返します
+func (c *openAPIContract) hasOperation(method, path string) bool {
+	item, ok := c.paths()[path].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = item[strings.ToLower(method)]
+
+	return ok
+}
+
+// validate はレスポンスが仕様書に定義された内容と一致するかを検証します
+func (c *openAPIContract) validate(method, path string, status int, contentType string, body []byte) error {
+	template, ok := c.matchPath(path)
+	if !ok {
+		return fmt.Errorf("path %s is not documented", path)
+	}
+	pointer := []string{"paths", template, strings.ToLower(method)}
+	operation, ok := c.lookup(pointer).(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("operation %s %s is not documented", method, template)
+	}
+
+	responses, _ := operation["responses"].(map[string]interface{})
+	code := strconv.Itoa(status)
+	if _, ok := responses[code]; !ok {
+		if _, ok := responses["default"]; !ok {
+			return fmt.Errorf("status %d of %s %s is not documented", status, method, template)
+		}
+		code = "default"
+	}
+	pointer = append(pointer, "responses", code)
+	response, _ := c.lookup(pointer).(map[string]interface{})
+	if ref, ok := response["$ref"].(string); ok {
+		pointer = strings.Split(strings.TrimPrefix(ref, "#/"), "/")
+		response, _ = c.lookup(pointer).(map[string]interface{})
+	}
+
+	content, _ := response["content"].(map[string]interface{})
+	if len(content) == 0 && len(body) == 0 {
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("invalid content type %q: %w", contentType, err)
+	}
+	if _, ok := content[mediaType]; !ok {
+		return fmt.Errorf("content type %s of %s %s (%d) is not documented", mediaType, method, template, status)
+	}
+	if mediaType != echo.MIMEApplicationJSON && !strings.HasSuffix(mediaType, "+json") {
+		return nil
+	}
+
+	schema, err := c.compile(append(pointer, "content", mediaType, "schema"))
+	if err != nil {
+		return err
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+
+	return schema.Validate(instance)
+}
+
+// matchPath はリクエストパスに一致する仕様書のパステンプレートを返します
+func (c *openAPIContract) matchPath(path string) (string, bool) {
+	segments := strings.Split(path, "/")
+	for template := range c.paths() {
+		templateSegments := strings.Split(template, "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+		matched := true
+		for i, segment := range templateSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				continue
+			}
+			if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return template, true
+		}
+	}
+
+	return "", false
+}
+
+func (c *openAPIContract) lookup(pointer []string) interface{} {
+	var current interface{} = c.doc
+	for _, token := range pointer {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[token]
+	}
+
+	return current
+}
+
+func (c *openAPIContract) compile(pointer []string) (*jsonschema.Schema, error) {
+	escaped := make([]string, len(pointer))
+	for i, token := range pointer {
+		escaped[i] = strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+	}
+	location := openAPIResourceURL + "#/" + strings.Join(escaped, "/")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if schema, ok := c.schemas[location]; ok {
+		return schema, nil
+	}
+	schema, err := c.compiler.Compile(location)
+	if err != nil {
+		return nil, err
+	}
+	c.schemas[location] = schema
+
+	return schema, nil
+}
+
+// handler は next のレスポンスを仕様書で検証してからクライアントに返すハンドラーを返します
+func (c *openAPIContract) handler(t *testing.T, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := httptest.NewRecorder()
+		next.ServeHTTP(recorder, r)
+
+		result := recorder.Result()
+		body, _ := io.ReadAll(result.Body)
+		if err := c.validate(r.Method, r.URL.Path, result.StatusCode, result.Header.Get(echo.HeaderContentType), body); err != nil {
+			t.Errorf("%s %s のレスポンスがOpenAPI仕様と一致しません: %v\n%s", r.Method, r.URL.RequestURI(), err, body)
+		}
+
+		for key, values := range result.Header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(result.StatusCode)
+		_, _ = w.Write(body)
+	})
+}
+
+func TestE2E_OpenAPI(t *testing.T) {
+	// テスト用DBのセットアップ
+	db := setupTestDB(t)
+
+	// テスト用の設定
+	cfg := newTestConfig(t)
+
+	contract := newOpenAPIContract(t)
+
+	t.Run("E2E - 全ルートが仕様書に定義されている", func(t *testing.T) {
+		router := newRouter(t, db, cfg)
+
+		routes := map[string]bool{}
+		for _, route := range router.Routes() {
+			if route.Method == echo.RouteNotFound {
+				continue
+			}
+			// Echo の :param を OpenAPI の {param} に変換する
+			segments := strings.Split(route.Path, "/")
+			for i, segment := range segments {
+				if strings.HasPrefix(segment, ":") {
+					segments[i] = "{" + strings.TrimPrefix(segment, ":") + "}"
+				}
+			}
+			path := strings.Join(segments, "/")
+			routes[route.Method+" "+path] = true
+
+			assert.True(t, contract.hasOperation(route.Method, path), "%s %s が仕様書に定義されていません", route.Method, path)
+		}
+
+		// 仕様書にのみ存在する操作がないことを確認
+		for path, item := range contract.paths() {
+			for method := range item.(map[string]interface{}) {
+				operation := strings.ToUpper(method) + " " + path
+				assert.True(t, routes[operation], "%s はルーターに存在しません", operation)
+			}
+		}
+	})
+
+	t.Run("E2E - 仕様書とSwagger UIを取得", func(t *testing.T) {
+		server := setupRouter(t, db, cfg)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/api/openapi.json")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, openapi.Spec(), body)
+
+		resp, err = http.Get(server.URL + "/api/docs")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err = io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Contains(t, string(body), "/api/openapi.json")
+	})
+}
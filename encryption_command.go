@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database"
+	"github.com/ijufumi/practice-202512/app/infrastructure/logging"
+)
+
+// runEncryptionCommand は `encryption` サブコマンドを実行します。
+// `encryption reencrypt` はマスターキーのローテーション後に、古いキーで暗号化された値と
+// 暗号化前の平文の値をアクティブなキーで暗号化し直します
+func runEncryptionCommand(cfg *config.Config, args []string, w io.Writer) error {
+	if len(args) == 0 || args[0] != "reencrypt" {
+		return fmt.Errorf("usage: encryption reencrypt [--batch-size n] [--dry-run]")
+	}
+
+	flags := flag.NewFlagSet("encryption reencrypt", flag.ContinueOnError)
+	batchSize := flags.Int("batch-size", 100, "number of rows to re-encrypt per transaction")
+	dryRun := flags.Bool("dry-run", false, "only count rows that need re-encryption")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *batchSize <= 0 {
+		return errors.New("batch-size must be positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger, logLevel, err := setupLogger(cfg)
+	if err != nil {
+		return err
+	}
+	if err := setupEncryption(cfg); err != nil {
+		return err
+	}
+
+	db, err := database.NewConnection(cfg, logging.NewGormLogger(logger, logLevel))
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	results, err := database.Reencrypt(ctx, db, *batchSize, *dryRun)
+	for _, result := range results {
+		_, _ = fmt.Fprintf(w, "%s\t%d\n", result.Table, result.Rows)
+	}
+
+	return err
+}
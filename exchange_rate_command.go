@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database"
+	"github.com/ijufumi/practice-202512/app/infrastructure/database/gateway"
+	"github.com/ijufumi/practice-202512/app/infrastructure/exchangerate"
+	"github.com/ijufumi/practice-202512/app/infrastructure/logging"
+)
+
+// runExchangeRateCommand は `exchange-rates` サブコマンドを実行します。
+// `exchange-rates import <file>` は為替レートファイルのレートを保存します。同じ通貨・日付のレートは置き換えます
+func runExchangeRateCommand(cfg *config.Config, args []string, w io.Writer) error {
+	if len(args) != 2 || args[0] != "import" {
+		return fmt.Errorf("usage: exchange-rates import <file>")
+	}
+
+	rates, err := exchangerate.LoadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	logger, logLevel, err := setupLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.NewConnection(cfg, logging.NewGormLogger(logger, logLevel))
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	if err := gateway.NewExchangeRateRepository().SaveAll(db, rates); err != nil {
+		return fmt.Errorf("failed to save exchange rates: %w", err)
+	}
+	_, _ = fmt.Fprintf(w, "imported %d exchange rates\n", len(rates))
+
+	return nil
+}
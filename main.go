@@ -1,45 +1,246 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/gateway"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+	"github.com/ijufumi/practice-202512/app/infrastructure/logging"
+	"github.com/ijufumi/practice-202512/app/infrastructure/server"
+	"github.com/ijufumi/practice-202512/app/infrastructure/storage"
+	"github.com/ijufumi/practice-202512/app/infrastructure/tracing"
 
 	"github.com/ijufumi/practice-202512/app/config"
+	"github.com/ijufumi/practice-202512/app/domain/calendar"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database"
+	"github.com/ijufumi/practice-202512/app/infrastructure/metrics"
 	"github.com/ijufumi/practice-202512/app/presentation"
 	"github.com/ijufumi/practice-202512/app/presentation/handler"
+	"github.com/ijufumi/practice-202512/app/presentation/worker"
 	"github.com/ijufumi/practice-202512/app/usecase"
 )
 
+var errInvalidConfig = errors.New("invalid configuration")
+
 func main() {
-	// 設定の読み込み
-	cfg := config.Load()
+	// os.Exit は defer を実行しないため、後始末は run の中で済ませる
+	if err := run(os.Args[1:]); err != nil {
+		slog.Error("Application terminated", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	configPath := flags.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or TOML config file (env: CONFIG_FILE)")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: %s [-config file] [config print [--redacted] | encryption reencrypt [--batch-size n] [--dry-run] | exchange-rates import <file>]\n", flags.Name())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	// 設定の読み込み（以降は読み込んだ設定を各コンポーネントに渡す）
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		// 問題のある項目を1行ずつ読めるよう、ログではなくそのまま出力する
+		_, _ = fmt.Fprintln(flags.Output(), err)
+		return errInvalidConfig
+	}
+
+	switch flags.Arg(0) {
+	case "":
+		return serve(cfg)
+	case "config":
+		return runConfigCommand(cfg, flags.Args()[1:], os.Stdout)
+	case "encryption":
+		return runEncryptionCommand(cfg, flags.Args()[1:], os.Stdout)
+	case "exchange-rates":
+		return runExchangeRateCommand(cfg, flags.Args()[1:], os.Stdout)
+	default:
+		flags.Usage()
+		return fmt.Errorf("unknown command %q", flags.Arg(0))
+	}
+}
+
+func serve(cfg *config.Config) error {
+	// SIGINT / SIGTERM を受け取ったらグレースフルシャットダウンを開始する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// ロガー設定
+	logger, logLevel, err := setupLogger(cfg)
+	if err != nil {
+		return err
+	}
+
+	// 暗号化キー設定
+	if err := setupEncryption(cfg); err != nil {
+		return err
+	}
+
+	// 営業日カレンダー
+	businessCalendar, err := setupCalendar(cfg)
+	if err != nil {
+		return err
+	}
+
+	// 添付ファイルの保存先
+	blobStore, err := storage.New(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize attachment storage: %w", err)
+	}
+
+	// トレース設定
+	shutdownTracing, err := tracing.Setup(ctx, cfg, os.Stdout)
+	if err != nil {
+		return fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+	defer func() {
+		// 終了処理中のスパンも送信できるよう、シグナルのコンテキストとは切り離す
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			slog.Error("Failed to shutdown tracing", slog.Any("error", err))
+		}
+	}()
 
 	// データベース接続
-	db, err := database.NewConnection(cfg)
-	log.Println("test")
+	db, err := database.NewConnection(cfg, logging.NewGormLogger(logger, logLevel))
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	// メトリクス
+	appMetrics, err := metrics.New(db)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		return fmt.Errorf("failed to initialize metrics: %w", err)
 	}
 
 	// 依存性の注入
 	invoiceRepository := gateway.NewInvoiceRepository()
+	invoiceLineRepository := gateway.NewInvoiceLineRepository()
+	invoiceNumberSequenceRepository := gateway.NewInvoiceNumberSequenceRepository()
 	userRepository := gateway.NewUserRepository()
-	invoiceUsecase := usecase.NewInvoiceUsecase(invoiceRepository, userRepository)
+	companyRepository := gateway.NewCompanyRepository()
+	clientRepository := gateway.NewClientRepository()
+	exchangeRateRepository := gateway.NewExchangeRateRepository()
+	approvalRuleRepository := gateway.NewApprovalRuleRepository()
+	invoiceApprovalRepository := gateway.NewInvoiceApprovalRepository()
+	invoiceUsecase := usecase.NewInvoiceUsecase(invoiceRepository, invoiceLineRepository, invoiceNumberSequenceRepository, userRepository, companyRepository, clientRepository, exchangeRateRepository, approvalRuleRepository, invoiceApprovalRepository, businessCalendar, appMetrics, cfg)
 	invoiceHandler := handler.NewInvoiceHandler(invoiceUsecase)
 
+	paymentRepository := gateway.NewPaymentRepository()
+	paymentUsecase := usecase.NewPaymentUsecase(invoiceRepository, paymentRepository, userRepository)
+	paymentHandler := handler.NewPaymentHandler(paymentUsecase)
+
+	creditNoteRepository := gateway.NewCreditNoteRepository()
+	creditNoteUsecase := usecase.NewCreditNoteUsecase(invoiceRepository, creditNoteRepository, userRepository)
+	creditNoteHandler := handler.NewCreditNoteHandler(creditNoteUsecase)
+
+	approvalUsecase := usecase.NewApprovalUsecase(invoiceRepository, approvalRuleRepository, invoiceApprovalRepository, userRepository)
+	approvalHandler := handler.NewApprovalHandler(approvalUsecase)
+
 	authUsecase := usecase.NewAuthUsecase(userRepository, cfg)
 	authHandler := handler.NewAuthHandler(authUsecase)
 
+	healthRepository := gateway.NewHealthRepository()
+	healthUsecase := usecase.NewHealthUsecase(healthRepository)
+	healthHandler := handler.NewHealthHandler(healthUsecase)
+
+	clientBankAccountRepository := gateway.NewClientBankAccountRepository()
+	clientUsecase := usecase.NewClientUsecase(clientRepository, clientBankAccountRepository, invoiceRepository, userRepository)
+	clientHandler := handler.NewClientHandler(clientUsecase)
+
+	recurringInvoiceRepository := gateway.NewRecurringInvoiceRepository()
+	recurringInvoiceUsecase := usecase.NewRecurringInvoiceUsecase(recurringInvoiceRepository, clientRepository, userRepository, invoiceUsecase)
+	recurringInvoiceHandler := handler.NewRecurringInvoiceHandler(recurringInvoiceUsecase)
+
+	reportRepository := gateway.NewReportRepository()
+	reportUsecase := usecase.NewReportUsecase(reportRepository, userRepository)
+	reportHandler := handler.NewReportHandler(reportUsecase)
+
+	journalRepository := gateway.NewJournalRepository()
+	journalAccountRepository := gateway.NewJournalAccountRepository()
+	journalUsecase := usecase.NewJournalUsecase(journalRepository, journalAccountRepository, userRepository)
+	journalHandler := handler.NewJournalHandler(journalUsecase)
+
+	bankStatementRepository := gateway.NewBankStatementRepository()
+	reconciliationUsecase := usecase.NewReconciliationUsecase(bankStatementRepository, invoiceRepository, userRepository, paymentUsecase)
+	reconciliationHandler := handler.NewReconciliationHandler(reconciliationUsecase)
+
+	invoiceAttachmentRepository := gateway.NewInvoiceAttachmentRepository()
+	attachmentUsecase := usecase.NewAttachmentUsecase(invoiceRepository, invoiceAttachmentRepository, userRepository, blobStore, cfg)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentUsecase)
+
+	retentionRepository := gateway.NewRetentionRepository()
+	retentionUsecase := usecase.NewRetentionUsecase(retentionRepository, invoiceAttachmentRepository, userRepository, blobStore, cfg)
+	adminHandler := handler.NewAdminHandler(retentionUsecase)
+
 	// ルーター設定
-	router := presentation.NewRouter(db, cfg, invoiceHandler, authHandler)
-	defer func() {
-		_ = router.Close()
-	}()
+	router := presentation.NewRouter(db, cfg, appMetrics, invoiceHandler, paymentHandler, creditNoteHandler, approvalHandler, authHandler, healthHandler, clientHandler, recurringInvoiceHandler, reportHandler, journalHandler, reconciliationHandler, attachmentHandler, adminHandler)
 
-	// サーバー起動
-	if err := router.Start(":8080"); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	// サーバー起動（シグナルを受け取るまでブロックする）
+	srv := server.New(router, cfg)
+	if cfg.PurgeInterval > 0 {
+		srv.AddWorker("purge", worker.NewPurgeWorker(db, retentionUsecase, cfg.PurgeInterval))
+	}
+	if cfg.RecurringInvoiceInterval > 0 {
+		srv.AddWorker("recurring-invoice", worker.NewRecurringInvoiceWorker(db, recurringInvoiceUsecase, cfg.RecurringInvoiceInterval))
 	}
+
+	return srv.Run(ctx)
+}
+
+// setupLogger は設定のログレベルで JSON ロガーを作成し、既定のロガーにします
+func setupLogger(cfg *config.Config) (*slog.Logger, slog.Level, error) {
+	logLevel, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid log level: %w", err)
+	}
+	logger := logging.New(os.Stdout, logLevel)
+	slog.SetDefault(logger)
+
+	return logger, logLevel, nil
+}
+
+// setupEncryption は暗号化列の読み書きに使う Keyring を設定します
+func setupEncryption(cfg *config.Config) error {
+	keyring, err := encryption.NewKeyringFromConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize encryption keyring: %w", err)
+	}
+	encryption.SetDefault(keyring)
+
+	return nil
+}
+
+// setupCalendar は設定の祝日ファイル（指定した場合のみ）を反映した営業日カレンダーを作成します
+func setupCalendar(cfg *config.Config) (*calendar.Calendar, error) {
+	if cfg.HolidayFile == "" {
+		return calendar.New(nil), nil
+	}
+
+	overrides, err := calendar.LoadOverrides(cfg.HolidayFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load holidays: %w", err)
+	}
+
+	return calendar.New(overrides), nil
 }
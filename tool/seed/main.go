@@ -2,6 +2,8 @@ package main
 
 import (
 	"log"
+	"log/slog"
+	"os"
 	"time"
 
 	"github.com/ijufumi/practice-202512/app/config"
@@ -9,6 +11,8 @@ import (
 	"github.com/ijufumi/practice-202512/app/domain/value"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database"
 	"github.com/ijufumi/practice-202512/app/infrastructure/database/gateway"
+	"github.com/ijufumi/practice-202512/app/infrastructure/encryption"
+	"github.com/ijufumi/practice-202512/app/infrastructure/logging"
 	"github.com/shopspring/decimal"
 
 	"golang.org/x/crypto/bcrypt"
@@ -17,10 +21,20 @@ import (
 
 func main() {
 	// 設定の読み込み
-	cfg := config.Load()
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// 暗号化キー設定
+	keyring, err := encryption.NewKeyringFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize encryption keyring: %v", err)
+	}
+	encryption.SetDefault(keyring)
 
 	// データベース接続
-	db, err := database.NewConnection(cfg)
+	db, err := database.NewConnection(cfg, logging.NewGormLogger(slog.Default(), slog.LevelInfo))
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -37,6 +51,7 @@ func main() {
 			PhoneNumber:        "000-0000-0000",
 			PostalCode:         "000-0000",
 			Address:            "test address",
+			DueDatePolicy:      value.DueDatePolicyPrevious,
 		}
 		if err := companyRepository.Create(tx, company); err != nil {
 			return err
@@ -50,6 +65,7 @@ func main() {
 			Name:      "admin",
 			Email:     "admin@localhost.ai",
 			Password:  string(passwordHash),
+			Role:      value.UserRoleAdmin,
 		}
 		if err := userRepository.Create(tx, user); err != nil {
 			return err
@@ -88,6 +104,7 @@ func main() {
 			TaxRate:        decimal.NewFromFloat(0.01),
 			InvoiceAmount:  decimal.NewFromInt(10000),
 			Status:         value.InvoiceStatusProcessed,
+			CreatedBy:      user.ID,
 		}
 		if err := invoiceRepository.Create(tx, invoice); err != nil {
 			return err